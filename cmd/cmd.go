@@ -21,9 +21,22 @@ func init() {
 
 	seedCmd.PersistentFlags().StringVarP(&SeedDir, "dir", "d", "db/seeds", "sql seeds directory")
 
+	importAttendanceCmd.Flags().StringVarP(&ImportAttendanceFile, "file", "f", "", "time-clock CSV export to import")
+	importAttendanceCmd.Flags().BoolVar(&ImportAttendanceDryRun, "dry-run", false, "to report what the import does without storing anything")
+	_ = importAttendanceCmd.MarkFlagRequired("file")
+
+	importCmd.Flags().StringVarP(&ImportKind, "kind", "k", "", "what the file holds: employees, overtimes or reimbursements")
+	importCmd.Flags().StringVarP(&ImportFile, "file", "f", "", "CSV file to import")
+	importCmd.Flags().BoolVar(&ImportDryRun, "dry-run", false, "to check the file without storing anything")
+	importCmd.Flags().StringVar(&ImportReport, "report", "", "CSV file to write the errors of the import to")
+	_ = importCmd.MarkFlagRequired("kind")
+	_ = importCmd.MarkFlagRequired("file")
+
 	rootCmd.AddCommand(httpServerCmd)
 	rootCmd.AddCommand(migrateCmd)
 	rootCmd.AddCommand(seedCmd)
+	rootCmd.AddCommand(importAttendanceCmd)
+	rootCmd.AddCommand(importCmd)
 }
 
 func Execute() {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/asyauqi15/payslip-system/internal/transport"
+	"github.com/asyauqi15/payslip-system/internal/usecase/payroll"
 	jwtauth "github.com/asyauqi15/payslip-system/pkg/jwt-auth"
 	"github.com/spf13/cobra"
 )
@@ -32,6 +33,21 @@ func runHTTPServer(_ *cobra.Command, _ []string) error {
 		log.Fatal(err)
 	}
 
+	if err := cfg.Auth.TwoFactor.LoadSecretKey(); err != nil {
+		if !cfg.IsDevelopment() {
+			log.Fatalf("failed to load two-factor secret key: %s", err)
+		}
+		log.Printf("two-factor authentication is unavailable: %s", err)
+	}
+
+	if err := cfg.HTTPServer.LoadTokenSecrets(); err != nil {
+		log.Fatalf("failed to load token secrets: %s", err)
+	}
+
+	if err := payroll.ValidateRounding(cfg.Payroll.Rounding); err != nil {
+		log.Fatalf("invalid payroll rounding: %s", err)
+	}
+
 	jwtAuth, err := jwtauth.NewJWTAuthentication(cfg.HTTPServer)
 	if err != nil {
 		log.Fatalf("failed to create JWT authentication: %s", err)
@@ -39,7 +55,7 @@ func runHTTPServer(_ *cobra.Command, _ []string) error {
 
 	r := initRegistry(cfg, jwtAuth)
 
-	server, err := transport.NewRESTServer(cfg, r.handler, r.jwt)
+	server, err := transport.NewRESTServer(cfg, r.handler, r.jwt, r.apiKeys)
 	if err != nil {
 		log.Fatalf("failed to initiate http server: %s", err)
 	}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"github.com/asyauqi15/payslip-system/internal/usecase"
+	"github.com/asyauqi15/payslip-system/pkg/csvimport"
+	"github.com/asyauqi15/payslip-system/pkg/notifier"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/spf13/cobra"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var (
+	ImportKind   string
+	ImportFile   string
+	ImportDryRun bool
+	ImportReport string
+)
+
+var importCmd = &cobra.Command{
+	Run:   runImport,
+	Use:   "import",
+	Short: "Import employees, overtime or reimbursements from a CSV file",
+}
+
+func runImport(_ *cobra.Command, _ []string) {
+	ctx := context.TODO()
+
+	cfg, err := loadConfig(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	file, err := os.Open(ImportFile)
+	if err != nil {
+		log.Fatalf("failed to open %s: %v", ImportFile, err)
+	}
+	defer file.Close()
+
+	db, err := gorm.Open(postgres.Open(cfg.Database.Source), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("failed to connect to the database: %v", err)
+	}
+
+	r := repository.InitializeRepository(db)
+
+	// Imported employees get their password reset link through the notifier
+	n, err := notifier.New(cfg.Notifier.Driver)
+	if err != nil {
+		log.Fatalf("failed to initialize notifier: %v", err)
+	}
+
+	u := usecase.InitializeUseCase(cfg, r, nil, n)
+
+	result, err := u.BulkImport.Import(ctx, v1.ImportKind(ImportKind), file, ImportDryRun)
+	if err != nil {
+		log.Fatalf("import failed: %v", err)
+	}
+
+	if ImportReport != "" {
+		if err := writeImportReport(ImportReport, result.Errors); err != nil {
+			log.Fatalf("failed to write %s: %v", ImportReport, err)
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		log.Fatal(err)
+	}
+
+	if len(result.Errors) > 0 {
+		os.Exit(1)
+	}
+}
+
+func writeImportReport(path string, importErrs []v1.ImportError) error {
+	report, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer report.Close()
+
+	errs := make([]csvimport.Error, 0, len(importErrs))
+	for _, e := range importErrs {
+		reportErr := csvimport.Error{Line: e.Row, Message: e.Message}
+		if e.Column != nil {
+			reportErr.Column = *e.Column
+		}
+		errs = append(errs, reportErr)
+	}
+
+	if err := csvimport.WriteReport(report, errs); err != nil {
+		return err
+	}
+	return report.Close()
+}
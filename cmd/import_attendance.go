@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"github.com/asyauqi15/payslip-system/internal/usecase/attendance_import"
+	"github.com/spf13/cobra"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var (
+	ImportAttendanceFile   string
+	ImportAttendanceDryRun bool
+)
+
+var importAttendanceCmd = &cobra.Command{
+	Run:   runImportAttendance,
+	Use:   "import-attendance",
+	Short: "Import attendance from a time-clock CSV export",
+}
+
+func runImportAttendance(_ *cobra.Command, _ []string) {
+	ctx := context.TODO()
+
+	cfg, err := loadConfig(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	file, err := os.Open(ImportAttendanceFile)
+	if err != nil {
+		log.Fatalf("failed to open %s: %v", ImportAttendanceFile, err)
+	}
+	defer file.Close()
+
+	db, err := gorm.Open(postgres.Open(cfg.Database.Source), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("failed to connect to the database: %v", err)
+	}
+
+	r := repository.InitializeRepository(db)
+	u := attendance_import.NewUsecase(r.AttendanceRepository, r.EmployeeRepository, r.LocationRepository, r.AttendancePeriodRepository, r.PayrollRepository)
+
+	result, err := u.ImportAttendance(ctx, file, ImportAttendanceDryRun)
+	if err != nil {
+		log.Fatalf("import failed: %v", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		log.Fatal(err)
+	}
+
+	if len(result.Errors) > 0 {
+		os.Exit(1)
+	}
+}
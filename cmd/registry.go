@@ -1,13 +1,16 @@
 package cmd
 
 import (
+	"context"
 	"log"
 
 	"github.com/asyauqi15/payslip-system/internal"
 	"github.com/asyauqi15/payslip-system/internal/handler"
 	"github.com/asyauqi15/payslip-system/internal/repository"
 	"github.com/asyauqi15/payslip-system/internal/usecase"
+	"github.com/asyauqi15/payslip-system/internal/usecase/service_account"
 	jwtauth "github.com/asyauqi15/payslip-system/pkg/jwt-auth"
+	"github.com/asyauqi15/payslip-system/pkg/notifier"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -15,6 +18,7 @@ import (
 type registry struct {
 	handler *handler.Registry
 	jwt     *jwtauth.JWTAuthentication
+	apiKeys service_account.Usecase
 }
 
 func initRegistry(cfg internal.Config, jwt *jwtauth.JWTAuthentication) *registry {
@@ -25,12 +29,36 @@ func initRegistry(cfg internal.Config, jwt *jwtauth.JWTAuthentication) *registry
 
 	r := repository.InitializeRepository(db)
 
-	u := usecase.InitializeUseCase(r, jwt)
+	if len(cfg.Auth.TwoFactor.SecretKey) == 0 {
+		ensureTwoFactorOptional(r)
+	}
+
+	n, err := notifier.New(cfg.Notifier.Driver)
+	if err != nil {
+		log.Fatalf("failed to initialize notifier: %v", err)
+	}
+
+	u := usecase.InitializeUseCase(cfg, r, jwt, n)
 
 	h := handler.InitializeHandler(u)
 
 	return &registry{
 		handler: h,
 		jwt:     jwt,
+		apiKeys: u.ServiceAccount,
+	}
+}
+
+// ensureTwoFactorOptional stops the server when the two-factor secret key is
+// not loaded but a role requires two-factor authentication. Its users could
+// not enroll, so they would never get their permissions.
+func ensureTwoFactorOptional(r *repository.Registry) {
+	query := repository.NewQuery().Where("require_two_factor", repository.OpEqual, true)
+	count, err := r.RoleRepository.Count(context.Background(), query, nil)
+	if err != nil {
+		log.Fatalf("failed to find roles requiring two-factor authentication: %v", err)
+	}
+	if count > 0 {
+		log.Fatalf("%d roles require two-factor authentication, but the two-factor secret key is not loaded", count)
 	}
 }
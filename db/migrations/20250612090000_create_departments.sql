@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE departments (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    cost_center VARCHAR(50) NOT NULL,
+    parent_id BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (parent_id) REFERENCES departments(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_departments_parent_id ON departments(parent_id);
+
+ALTER TABLE employees
+    ADD COLUMN department_id BIGINT REFERENCES departments(id) ON DELETE SET NULL,
+    ADD COLUMN manager_id BIGINT REFERENCES employees(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_employees_department_id ON employees(department_id);
+CREATE INDEX idx_employees_manager_id ON employees(manager_id);
+
+-- Payslips keep the department the employee belonged to when payroll ran,
+-- so cost center reports do not shift when employees move later.
+ALTER TABLE payslips
+    ADD COLUMN department_id BIGINT REFERENCES departments(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_payslips_department_id ON payslips(department_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payslips DROP COLUMN IF EXISTS department_id;
+ALTER TABLE employees DROP COLUMN IF EXISTS manager_id;
+ALTER TABLE employees DROP COLUMN IF EXISTS department_id;
+DROP TABLE IF EXISTS departments;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Submissions recorded before approvals existed were already counted by payroll,
+-- so they are backfilled as approved. New submissions start as pending.
+ALTER TABLE overtimes
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'approved',
+    ADD COLUMN reviewed_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN reviewed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN review_note TEXT NOT NULL DEFAULT '';
+ALTER TABLE overtimes ALTER COLUMN status SET DEFAULT 'pending';
+
+CREATE INDEX idx_overtimes_status ON overtimes(status);
+
+ALTER TABLE reimbursements
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'approved',
+    ADD COLUMN reviewed_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN reviewed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN review_note TEXT NOT NULL DEFAULT '';
+ALTER TABLE reimbursements ALTER COLUMN status SET DEFAULT 'pending';
+
+CREATE INDEX idx_reimbursements_status ON reimbursements(status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reimbursements
+    DROP COLUMN IF EXISTS review_note,
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS reviewed_by,
+    DROP COLUMN IF EXISTS status;
+ALTER TABLE overtimes
+    DROP COLUMN IF EXISTS review_note,
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS reviewed_by,
+    DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
@@ -0,0 +1,80 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE roles (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(50) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE role_permissions (
+    id BIGSERIAL PRIMARY KEY,
+    role_id BIGINT NOT NULL,
+    permission VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_role_permissions_role_permission ON role_permissions(role_id, permission);
+
+-- Built-in roles matching the previously hard-coded role checks
+INSERT INTO roles (name, description) VALUES
+('admin', 'Full access including payroll and role management'),
+('manager', 'Employee self-service plus review of direct reports'),
+('default', 'Employee self-service');
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r
+CROSS JOIN (VALUES
+    ('attendance:submit'),
+    ('overtime:submit'),
+    ('reimbursement:submit'),
+    ('payslip:read:own'),
+    ('submission:review:any'),
+    ('attendance_period:write'),
+    ('payroll:run'),
+    ('payroll:read'),
+    ('department:read'),
+    ('department:write'),
+    ('employee:write'),
+    ('role:manage')
+) AS p(permission)
+WHERE r.name = 'admin';
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r
+CROSS JOIN (VALUES
+    ('attendance:submit'),
+    ('overtime:submit'),
+    ('reimbursement:submit'),
+    ('payslip:read:own'),
+    ('submission:review:team')
+) AS p(permission)
+WHERE r.name = 'manager';
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r
+CROSS JOIN (VALUES
+    ('attendance:submit'),
+    ('overtime:submit'),
+    ('reimbursement:submit'),
+    ('payslip:read:own')
+) AS p(permission)
+WHERE r.name = 'default';
+
+-- Users reference roles by name so existing tokens and data keep working
+ALTER TABLE users
+    ADD CONSTRAINT fk_users_role FOREIGN KEY (role) REFERENCES roles(name) ON UPDATE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP CONSTRAINT IF EXISTS fk_users_role;
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS roles;
+-- +goose StatementEnd
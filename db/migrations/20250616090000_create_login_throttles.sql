@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE login_throttles (
+    id BIGSERIAL PRIMARY KEY,
+    scope VARCHAR(20) NOT NULL,
+    identifier VARCHAR(255) NOT NULL,
+    failed_attempts INTEGER NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_login_throttles_scope_identifier ON login_throttles(scope, identifier);
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT id, 'login:unlock' FROM roles WHERE name = 'admin';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM role_permissions WHERE permission = 'login:unlock';
+DROP TABLE IF EXISTS login_throttles;
+-- +goose StatementEnd
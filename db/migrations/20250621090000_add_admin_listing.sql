@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Admin listings page by (sort column, id), so the default sort columns get
+-- matching indexes
+CREATE INDEX idx_attendances_clock_in_time_id ON attendances(clock_in_time, id);
+CREATE INDEX idx_overtimes_start_at_id ON overtimes(start_at, id);
+CREATE INDEX idx_reimbursements_date_id ON reimbursements(date, id);
+CREATE INDEX idx_payrolls_created_at_id ON payrolls(created_at, id);
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r
+CROSS JOIN (VALUES
+    ('attendance_period:read'),
+    ('submission:read:any')
+) AS p(permission)
+WHERE r.name = 'admin';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM role_permissions WHERE permission IN ('attendance_period:read', 'submission:read:any');
+DROP INDEX IF EXISTS idx_payrolls_created_at_id;
+DROP INDEX IF EXISTS idx_reimbursements_date_id;
+DROP INDEX IF EXISTS idx_overtimes_start_at_id;
+DROP INDEX IF EXISTS idx_attendances_clock_in_time_id;
+-- +goose StatementEnd
@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Voided submissions and removed periods and payrolls are kept for the audit
+-- trail and hidden from every query by deleted_at
+ALTER TABLE overtimes ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE reimbursements ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE attendance_periods ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE payrolls ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE payslips ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_overtimes_deleted_at ON overtimes(deleted_at);
+CREATE INDEX idx_reimbursements_deleted_at ON reimbursements(deleted_at);
+CREATE INDEX idx_attendance_periods_deleted_at ON attendance_periods(deleted_at);
+CREATE INDEX idx_payrolls_deleted_at ON payrolls(deleted_at);
+CREATE INDEX idx_payslips_deleted_at ON payslips(deleted_at);
+
+-- A removed payroll must not keep its period from being run again
+ALTER TABLE payrolls DROP CONSTRAINT IF EXISTS payrolls_attendance_period_id_key;
+DROP INDEX IF EXISTS idx_payrolls_attendance_period_id;
+CREATE UNIQUE INDEX idx_payrolls_attendance_period_id ON payrolls(attendance_period_id) WHERE deleted_at IS NULL;
+
+-- Payrolls run before drafts existed were already paid out, so they are
+-- backfilled as finalized. New payrolls start as drafts.
+ALTER TABLE payrolls
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'finalized',
+    ADD COLUMN finalized_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN finalized_at TIMESTAMP WITH TIME ZONE;
+UPDATE payrolls SET finalized_at = updated_at;
+ALTER TABLE payrolls ALTER COLUMN status SET DEFAULT 'draft';
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r
+CROSS JOIN (VALUES
+    ('payroll:approve'),
+    ('submission:void')
+) AS p(permission)
+WHERE r.name = 'admin';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM role_permissions WHERE permission IN ('payroll:approve', 'submission:void');
+
+ALTER TABLE payrolls
+    DROP COLUMN IF EXISTS finalized_at,
+    DROP COLUMN IF EXISTS finalized_by,
+    DROP COLUMN IF EXISTS status;
+
+DELETE FROM payslips WHERE deleted_at IS NOT NULL;
+DELETE FROM payrolls WHERE deleted_at IS NOT NULL;
+DELETE FROM attendance_periods WHERE deleted_at IS NOT NULL;
+DELETE FROM reimbursements WHERE deleted_at IS NOT NULL;
+DELETE FROM overtimes WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_payrolls_attendance_period_id;
+CREATE UNIQUE INDEX idx_payrolls_attendance_period_id ON payrolls(attendance_period_id);
+
+ALTER TABLE payslips DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE payrolls DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE attendance_periods DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE overtimes DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
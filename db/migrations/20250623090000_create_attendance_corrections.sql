@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A correction fixes a missing check-in or check-out of a past day. The
+-- original times are kept on the correction so the attendance row can be
+-- traced back to what was recorded before the approval.
+CREATE TABLE attendance_corrections (
+    id BIGSERIAL PRIMARY KEY,
+    employee_id BIGINT NOT NULL,
+    attendance_id BIGINT,
+    date DATE NOT NULL,
+    clock_in_time VARCHAR(255) NOT NULL DEFAULT '',
+    clock_out_time VARCHAR(255) NOT NULL DEFAULT '',
+    original_clock_in_time VARCHAR(255) NOT NULL DEFAULT '',
+    original_clock_out_time VARCHAR(255) NOT NULL DEFAULT '',
+    reason TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    reviewed_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    review_note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (employee_id) REFERENCES employees(id) ON DELETE CASCADE,
+    FOREIGN KEY (attendance_id) REFERENCES attendances(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_attendance_corrections_employee_id ON attendance_corrections(employee_id);
+CREATE INDEX idx_attendance_corrections_attendance_id ON attendance_corrections(attendance_id);
+CREATE INDEX idx_attendance_corrections_status ON attendance_corrections(status);
+
+ALTER TABLE attendances
+    ADD COLUMN corrected BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN correction_id BIGINT REFERENCES attendance_corrections(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE attendances
+    DROP COLUMN IF EXISTS correction_id,
+    DROP COLUMN IF EXISTS corrected;
+
+DROP TABLE IF EXISTS attendance_corrections;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Employees without a location keep working Monday to Friday, 09:00 to 17:00
+-- in the server's timezone.
+CREATE TABLE locations (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    timezone VARCHAR(64) NOT NULL,
+    shift_start VARCHAR(5) NOT NULL,
+    shift_end VARCHAR(5) NOT NULL,
+    workdays VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE employees
+    ADD COLUMN location_id BIGINT REFERENCES locations(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_employees_location_id ON employees(location_id);
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r
+CROSS JOIN (VALUES
+    ('location:read'),
+    ('location:write')
+) AS p(permission)
+WHERE r.name = 'admin';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM role_permissions WHERE permission IN ('location:read', 'location:write');
+ALTER TABLE employees DROP COLUMN IF EXISTS location_id;
+DROP TABLE IF EXISTS locations;
+-- +goose StatementEnd
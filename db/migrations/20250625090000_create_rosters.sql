@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Employees of a rostered location work the shifts assigned to them per
+-- date; a date without a roster entry is a day off.
+ALTER TABLE locations
+    ADD COLUMN rostered BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE shifts (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    start_time VARCHAR(5) NOT NULL,
+    end_time VARCHAR(5) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE roster_entries (
+    id BIGSERIAL PRIMARY KEY,
+    employee_id BIGINT NOT NULL REFERENCES employees(id),
+    date DATE NOT NULL,
+    shift_id BIGINT NOT NULL REFERENCES shifts(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_roster_entries_employee_date ON roster_entries(employee_id, date);
+CREATE INDEX idx_roster_entries_shift_id ON roster_entries(shift_id);
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, p.permission
+FROM roles r
+CROSS JOIN (VALUES
+    ('roster:read'),
+    ('roster:write')
+) AS p(permission)
+WHERE r.name = 'admin';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM role_permissions WHERE permission IN ('roster:read', 'roster:write');
+DROP TABLE IF EXISTS roster_entries;
+DROP TABLE IF EXISTS shifts;
+ALTER TABLE locations DROP COLUMN IF EXISTS rostered;
+-- +goose StatementEnd
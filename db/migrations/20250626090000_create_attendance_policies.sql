@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Attendance is checked against the geofences and IP allowlist of the
+-- employee's location and the device the employee is bound to. Failed checks
+-- do not refuse the attendance but are recorded as violations for review.
+ALTER TABLE locations
+    ADD COLUMN allowed_networks TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE employees
+    ADD COLUMN device_id VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE TABLE geofences (
+    id BIGSERIAL PRIMARY KEY,
+    location_id BIGINT NOT NULL REFERENCES locations(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    radius_meters DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_geofences_location_id ON geofences(location_id);
+
+CREATE TABLE attendance_violations (
+    id BIGSERIAL PRIMARY KEY,
+    attendance_id BIGINT NOT NULL REFERENCES attendances(id) ON DELETE CASCADE,
+    employee_id BIGINT NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    attendance_type VARCHAR(20) NOT NULL,
+    kind VARCHAR(50) NOT NULL,
+    detail TEXT NOT NULL DEFAULT '',
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    accuracy DOUBLE PRECISION,
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    device_id VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    reviewed_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    review_note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_attendance_violations_attendance_id ON attendance_violations(attendance_id);
+CREATE INDEX idx_attendance_violations_employee_id ON attendance_violations(employee_id);
+CREATE INDEX idx_attendance_violations_status ON attendance_violations(status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS attendance_violations;
+DROP TABLE IF EXISTS geofences;
+ALTER TABLE employees DROP COLUMN IF EXISTS device_id;
+ALTER TABLE locations DROP COLUMN IF EXISTS allowed_networks;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Time clocks identify employees by the number they are enrolled under,
+-- which attendance imports map back to the employee.
+ALTER TABLE employees
+    ADD COLUMN code VARCHAR(50);
+
+CREATE UNIQUE INDEX idx_employees_code ON employees(code);
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, 'attendance:import'
+FROM roles r
+WHERE r.name = 'admin';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM role_permissions WHERE permission = 'attendance:import';
+DROP INDEX IF EXISTS idx_employees_code;
+ALTER TABLE employees DROP COLUMN IF EXISTS code;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Bulk imports create employees and submissions from CSV files.
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, 'data:import'
+FROM roles r
+WHERE r.name = 'admin';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM role_permissions WHERE permission = 'data:import';
+-- +goose StatementEnd
@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Amounts recorded so far are in rupiah.
+ALTER TABLE employees
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE reimbursements
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE payslips
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE payrolls
+    ADD COLUMN reporting_currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+
+CREATE TABLE exchange_rates (
+    id BIGSERIAL PRIMARY KEY,
+    from_currency VARCHAR(3) NOT NULL,
+    to_currency VARCHAR(3) NOT NULL,
+    effective_date DATE NOT NULL,
+    rate NUMERIC(20, 10) NOT NULL CHECK (rate > 0),
+    created_by BIGINT REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_exchange_rates_pair_date ON exchange_rates(from_currency, to_currency, effective_date);
+
+-- The rates each payroll converted with, as they were when it ran
+CREATE TABLE payroll_exchange_rates (
+    id BIGSERIAL PRIMARY KEY,
+    payroll_id BIGINT NOT NULL REFERENCES payrolls(id),
+    from_currency VARCHAR(3) NOT NULL,
+    to_currency VARCHAR(3) NOT NULL,
+    effective_date DATE NOT NULL,
+    rate NUMERIC(20, 10) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_payroll_exchange_rates_pair ON payroll_exchange_rates(payroll_id, from_currency, to_currency);
+
+INSERT INTO role_permissions (role_id, permission)
+SELECT r.id, 'exchange_rate:manage'
+FROM roles r
+WHERE r.name = 'admin';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM role_permissions WHERE permission = 'exchange_rate:manage';
+DROP TABLE IF EXISTS payroll_exchange_rates;
+DROP TABLE IF EXISTS exchange_rates;
+ALTER TABLE payrolls DROP COLUMN IF EXISTS reporting_currency;
+ALTER TABLE payslips DROP COLUMN IF EXISTS currency;
+ALTER TABLE reimbursements DROP COLUMN IF EXISTS currency;
+ALTER TABLE employees DROP COLUMN IF EXISTS currency;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- What rounding to whole units changed per calculation and currency of each
+-- payroll, with the rounding mode it used
+CREATE TABLE payroll_roundings (
+    id BIGSERIAL PRIMARY KEY,
+    payroll_id BIGINT NOT NULL REFERENCES payrolls(id),
+    calculation VARCHAR(50) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    rounding_mode VARCHAR(20) NOT NULL,
+    difference NUMERIC(30, 10) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_payroll_roundings_calculation ON payroll_roundings(payroll_id, calculation, currency);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payroll_roundings;
+-- +goose StatementEnd
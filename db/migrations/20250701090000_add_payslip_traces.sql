@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- How each payslip was calculated: the records it was calculated from, the
+-- rates and every step
+CREATE TABLE payslip_traces (
+    id BIGSERIAL PRIMARY KEY,
+    payslip_id BIGINT NOT NULL REFERENCES payslips(id),
+    calculation JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_payslip_traces_payslip_id ON payslip_traces(payslip_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payslip_traces;
+-- +goose StatementEnd
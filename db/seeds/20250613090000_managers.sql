@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Historical submissions are treated as already reviewed so sample payrolls keep their totals
+UPDATE overtimes SET status = 'approved' WHERE start_at >= '2025-01-01' AND start_at < '2025-06-01';
+UPDATE reimbursements SET status = 'approved' WHERE date >= '2025-01-01' AND date < '2025-06-01';
+
+-- employee001 manages employee002 to employee010
+UPDATE users SET role = 'manager' WHERE username = 'employee001';
+
+UPDATE employees
+SET manager_id = (
+    SELECT e.id FROM employees e JOIN users u ON u.id = e.user_id WHERE u.username = 'employee001'
+)
+WHERE user_id IN (
+    SELECT id FROM users WHERE username BETWEEN 'employee002' AND 'employee010'
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE employees SET manager_id = NULL
+WHERE user_id IN (
+    SELECT id FROM users WHERE username BETWEEN 'employee002' AND 'employee010'
+);
+UPDATE users SET role = 'default' WHERE username = 'employee001';
+-- +goose StatementEnd
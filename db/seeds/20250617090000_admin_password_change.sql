@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The seeded admin password is public, so it has to be changed on first login
+UPDATE users SET must_change_password = TRUE WHERE username = 'admin' AND password_changed_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE users SET must_change_password = FALSE WHERE username = 'admin';
+-- +goose StatementEnd
@@ -2,34 +2,274 @@ package internal
 
 import (
 	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
 	"time"
 )
 
+// EnvironmentDevelopment is the environment of a developer's machine, where
+// the HTTP server starts without the secrets a deployment needs.
+const EnvironmentDevelopment = "development"
+
 type Config struct {
-	Database   DatabaseConfig   `mapstructure:"database"`
-	HTTPServer HTTPServerConfig `mapstructure:"http_server"`
+	Environment string           `mapstructure:"environment"`
+	Database    DatabaseConfig   `mapstructure:"database"`
+	HTTPServer  HTTPServerConfig `mapstructure:"http_server"`
+	Auth        AuthConfig       `mapstructure:"auth"`
+	Notifier    NotifierConfig   `mapstructure:"notifier"`
+	Payroll     PayrollConfig    `mapstructure:"payroll"`
 }
 
 type DatabaseConfig struct {
 	Source string `mapstructure:"source"`
 }
 
+// HTTPServerConfig holds the HS256 secrets tokens are signed with as base64
+// encoded values of at least 32 bytes, read from a file or from the
+// environment variable it names by LoadTokenSecrets. The access token secret
+// is only needed while no AccessTokenSigning key is configured.
 type HTTPServerConfig struct {
-	Port                      int           `mapstructure:"port"`
-	AccessTokenSecretEncoded  string        `mapstructure:"access_token_secret_encoded"`
-	RefreshTokenSecretEncoded string        `mapstructure:"refresh_token_secret_encoded"`
-	AccessTokenDuration       time.Duration `mapstructure:"access_token_duration"`
-	RefreshTokenDuration      time.Duration `mapstructure:"refresh_token_duration"`
-	ReadHeaderTimeout         time.Duration `mapstructure:"read_header_timeout"`
-	ReadTimeout               time.Duration `mapstructure:"read_timeout"`
-	IdleTimeout               time.Duration `mapstructure:"idle_timeout"`
-	WriteTimeout              time.Duration `mapstructure:"write_timeout"`
+	Port                   int           `mapstructure:"port"`
+	AccessTokenSecretFile  string        `mapstructure:"access_token_secret_file"`
+	AccessTokenSecretEnv   string        `mapstructure:"access_token_secret_env"`
+	AccessTokenSecret      []byte        `mapstructure:"-"`
+	RefreshTokenSecretFile string        `mapstructure:"refresh_token_secret_file"`
+	RefreshTokenSecretEnv  string        `mapstructure:"refresh_token_secret_env"`
+	RefreshTokenSecret     []byte        `mapstructure:"-"`
+	AccessTokenDuration    time.Duration `mapstructure:"access_token_duration"`
+	RefreshTokenDuration   time.Duration `mapstructure:"refresh_token_duration"`
+	AccessTokenSigning     SigningConfig `mapstructure:"access_token_signing"`
+	ReadHeaderTimeout      time.Duration `mapstructure:"read_header_timeout"`
+	ReadTimeout            time.Duration `mapstructure:"read_timeout"`
+	IdleTimeout            time.Duration `mapstructure:"idle_timeout"`
+	WriteTimeout           time.Duration `mapstructure:"write_timeout"`
+}
+
+// minTokenSecretBytes is the smallest HS256 secret accepted, the size of the
+// hash.
+const minTokenSecretBytes = 32
+
+// SigningConfig holds the asymmetric keys access tokens are signed with. When
+// no key is configured, access tokens are signed with HS256 using
+// AccessTokenSecret. The algorithm of a key follows its type: RSA keys
+// sign with RS256 and Ed25519 keys with EdDSA.
+//
+// Only ActiveKeyID signs new tokens; every other key still verifies tokens and
+// is published in the JWKS. A key with RetiredAt set is dropped once
+// GracePeriod (defaulting to the access token duration) has passed since then.
+type SigningConfig struct {
+	ActiveKeyID string             `mapstructure:"active_key_id"`
+	GracePeriod time.Duration      `mapstructure:"grace_period"`
+	Keys        []SigningKeyConfig `mapstructure:"keys"`
+}
+
+// SigningKeyConfig identifies a key by KeyID, which is sent as the kid header
+// of the tokens it signs. The PEM encoded private key is read from
+// PrivateKeyFile or from the environment variable named by PrivateKeyEnv.
+// RetiredAt is an RFC 3339 timestamp.
+type SigningKeyConfig struct {
+	KeyID          string `mapstructure:"kid"`
+	PrivateKeyFile string `mapstructure:"private_key_file"`
+	PrivateKeyEnv  string `mapstructure:"private_key_env"`
+	RetiredAt      string `mapstructure:"retired_at"`
+}
+
+type AuthConfig struct {
+	LoginThrottle              LoginThrottleConfig  `mapstructure:"login_throttle"`
+	PasswordPolicy             PasswordPolicyConfig `mapstructure:"password_policy"`
+	PasswordResetTokenDuration time.Duration        `mapstructure:"password_reset_token_duration"`
+	TwoFactor                  TwoFactorConfig      `mapstructure:"two_factor"`
+	OIDC                       OIDCConfig           `mapstructure:"oidc"`
+}
+
+// LoginThrottleConfig controls failed login tracking. Failures are counted per
+// username and per client IP within AttemptWindow. From DelayAfter failures of
+// a username on, its next attempt is refused until BaseDelay (doubling per
+// further failure, capped at MaxDelay) has passed; reaching MaxAttempts (or
+// IPMaxAttempts for an IP address) locks logins for LockoutDuration. A zero
+// limit disables that rule.
+type LoginThrottleConfig struct {
+	MaxAttempts     int           `mapstructure:"max_attempts"`
+	IPMaxAttempts   int           `mapstructure:"ip_max_attempts"`
+	LockoutDuration time.Duration `mapstructure:"lockout_duration"`
+	DelayAfter      int           `mapstructure:"delay_after"`
+	BaseDelay       time.Duration `mapstructure:"base_delay"`
+	MaxDelay        time.Duration `mapstructure:"max_delay"`
+	AttemptWindow   time.Duration `mapstructure:"attempt_window"`
+}
+
+// PasswordPolicyConfig is enforced whenever a user sets a new password.
+// HistorySize is the number of previous passwords, including the current one,
+// that cannot be reused.
+type PasswordPolicyConfig struct {
+	MinLength        int  `mapstructure:"min_length"`
+	RequireUppercase bool `mapstructure:"require_uppercase"`
+	RequireLowercase bool `mapstructure:"require_lowercase"`
+	RequireDigit     bool `mapstructure:"require_digit"`
+	RequireSymbol    bool `mapstructure:"require_symbol"`
+	HistorySize      int  `mapstructure:"history_size"`
+}
+
+// TwoFactorConfig controls TOTP two-factor authentication. TOTP secrets are
+// encrypted at rest with a base64 encoded 32 byte AES key, read from
+// SecretKeyFile or from the environment variable named by SecretKeyEnv into
+// SecretKey by LoadSecretKey. A login challenge accepts at most
+// MaxChallengeAttempts wrong codes.
+type TwoFactorConfig struct {
+	Issuer               string        `mapstructure:"issuer"`
+	SecretKeyFile        string        `mapstructure:"secret_key_file"`
+	SecretKeyEnv         string        `mapstructure:"secret_key_env"`
+	SecretKey            []byte        `mapstructure:"-"`
+	ChallengeDuration    time.Duration `mapstructure:"challenge_duration"`
+	MaxChallengeAttempts int           `mapstructure:"max_challenge_attempts"`
+}
+
+// OIDCConfig enables single sign-on through an OpenID Connect provider using
+// the authorization code flow with PKCE. Provider identities are linked to the
+// user whose username equals the UsernameClaim of the ID token (the verified
+// email by default). With JITProvisioning, unknown users are created with
+// DefaultRole instead of being rejected. A login has to be completed within
+// StateDuration.
+type OIDCConfig struct {
+	Enabled         bool          `mapstructure:"enabled"`
+	IssuerURL       string        `mapstructure:"issuer_url"`
+	ClientID        string        `mapstructure:"client_id"`
+	ClientSecret    string        `mapstructure:"client_secret"`
+	RedirectURL     string        `mapstructure:"redirect_url"`
+	Scopes          []string      `mapstructure:"scopes"`
+	UsernameClaim   string        `mapstructure:"username_claim"`
+	JITProvisioning bool          `mapstructure:"jit_provisioning"`
+	DefaultRole     string        `mapstructure:"default_role"`
+	StateDuration   time.Duration `mapstructure:"state_duration"`
+}
+
+type NotifierConfig struct {
+	Driver string `mapstructure:"driver"`
+}
+
+// PayrollConfig controls payroll runs. Payroll totals are converted to
+// ReportingCurrency, a three-letter currency code defaulting to IDR.
+type PayrollConfig struct {
+	ReportingCurrency string           `mapstructure:"reporting_currency"`
+	Rounding          RoundingConfig   `mapstructure:"rounding"`
+	Comparison        ComparisonConfig `mapstructure:"comparison"`
+}
+
+// RoundingConfig sets how each payroll calculation rounds to whole units:
+// half_up, bankers or truncate. Calculations left out round half up.
+type RoundingConfig struct {
+	ProratedSalary string `mapstructure:"prorated_salary"`
+	Overtime       string `mapstructure:"overtime"`
+	Conversion     string `mapstructure:"conversion"` // between currencies
+}
+
+// ComparisonConfig sets when a change to an employee's amounts between two
+// payrolls is flagged: when it is above both thresholds. A zero threshold
+// flags any change.
+type ComparisonConfig struct {
+	VariancePercent float64 `mapstructure:"variance_percent"` // of the previous amount
+	VarianceAmount  int64   `mapstructure:"variance_amount"`  // in whole units of the payslip currency
+}
+
+// LoadTokenSecrets reads and decodes the secrets tokens are signed with.
+func (h *HTTPServerConfig) LoadTokenSecrets() error {
+	if len(h.AccessTokenSigning.Keys) == 0 {
+		secret, err := readTokenSecret(h.AccessTokenSecretFile, h.AccessTokenSecretEnv, "access_token_secret")
+		if err != nil {
+			return err
+		}
+		h.AccessTokenSecret = secret
+	}
+
+	secret, err := readTokenSecret(h.RefreshTokenSecretFile, h.RefreshTokenSecretEnv, "refresh_token_secret")
+	if err != nil {
+		return err
+	}
+	h.RefreshTokenSecret = secret
+	return nil
 }
 
 func (h *HTTPServerConfig) GetAccessTokenSecret() ([]byte, error) {
-	return base64.StdEncoding.DecodeString(h.AccessTokenSecretEncoded)
+	if len(h.AccessTokenSecret) == 0 {
+		return nil, errors.New("access token secret is not loaded")
+	}
+	return h.AccessTokenSecret, nil
 }
 
 func (h *HTTPServerConfig) GetRefreshTokenSecret() ([]byte, error) {
-	return base64.StdEncoding.DecodeString(h.RefreshTokenSecretEncoded)
+	if len(h.RefreshTokenSecret) == 0 {
+		return nil, errors.New("refresh token secret is not loaded")
+	}
+	return h.RefreshTokenSecret, nil
+}
+
+func readTokenSecret(file, env, option string) ([]byte, error) {
+	secret, err := readSecret(file, env, option)
+	if err != nil {
+		return nil, err
+	}
+	if len(secret) < minTokenSecretBytes {
+		return nil, fmt.Errorf("%s is %d bytes, want at least %d", option, len(secret), minTokenSecretBytes)
+	}
+	return secret, nil
+}
+
+// readSecret reads a base64 encoded secret from a file or from an environment
+// variable. option is the name of the setting, without its _file or _env
+// suffix.
+func readSecret(file, env, option string) ([]byte, error) {
+	var encoded string
+	switch {
+	case file != "" && env != "":
+		return nil, fmt.Errorf("%s_file and %s_env are mutually exclusive", option, option)
+	case file != "":
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		encoded = string(data)
+	case env != "":
+		value, ok := os.LookupEnv(env)
+		if !ok || value == "" {
+			return nil, fmt.Errorf("environment variable %s is not set", env)
+		}
+		encoded = value
+	default:
+		return nil, fmt.Errorf("either %s_file or %s_env is required", option, option)
+	}
+
+	secret, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", option, err)
+	}
+	return secret, nil
+}
+
+// IsDevelopment reports whether the configuration is for a developer's
+// machine.
+func (c *Config) IsDevelopment() bool {
+	return c.Environment == EnvironmentDevelopment
+}
+
+// LoadSecretKey reads and decodes the key TOTP secrets are encrypted with.
+func (t *TwoFactorConfig) LoadSecretKey() error {
+	key, err := readSecret(t.SecretKeyFile, t.SecretKeyEnv, "secret_key")
+	if err != nil {
+		return err
+	}
+	if len(key) != 32 {
+		return fmt.Errorf("secret key is %d bytes, want 32", len(key))
+	}
+
+	t.SecretKey = key
+	return nil
+}
+
+func (t *TwoFactorConfig) GetSecretKey() ([]byte, error) {
+	if len(t.SecretKey) == 0 {
+		return nil, errors.New("two-factor secret key is not loaded")
+	}
+	return t.SecretKey, nil
 }
@@ -1,9 +1,10 @@
 package constant
 
 const (
-	ContextKeyUserID    = "user_id"
-	ContextKeyUsername  = "username"
-	ContextKeyUserRole  = "user_role"
-	ContextKeyIPAddress = "ip_address"
-	ContextKeyRequestID = "request_id"
+	ContextKeyUserID          = "user_id"
+	ContextKeyUsername        = "username"
+	ContextKeyUserRole        = "user_role"
+	ContextKeyUserPermissions = "user_permissions"
+	ContextKeyIPAddress       = "ip_address"
+	ContextKeyRequestID       = "request_id"
 )
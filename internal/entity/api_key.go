@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+// APIKey authenticates a service account. The key handed out is
+// "<prefix>.<secret>"; the prefix identifies the key and only the SHA-256
+// hash of the secret is stored. The scopes of a key are the permissions its
+// requests are authorized with.
+type APIKey struct {
+	Base
+	UserID     int64  `gorm:"not null;index"`
+	Name       string `gorm:"not null"`
+	Prefix     string `gorm:"uniqueIndex;not null"`
+	SecretHash string `gorm:"not null"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedBy  int64 `gorm:"not null"`
+}
+
+// Active reports whether the key can still authenticate requests.
+func (k *APIKey) Active(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+type APIKeyScope struct {
+	Base
+	APIKeyID int64  `gorm:"not null;uniqueIndex:idx_api_key_scopes_key_scope"`
+	Scope    string `gorm:"not null;uniqueIndex:idx_api_key_scopes_key_scope"`
+}
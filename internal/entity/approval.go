@@ -0,0 +1,19 @@
+package entity
+
+import "fmt"
+
+const (
+	ApprovalStatusPending  = "pending"
+	ApprovalStatusApproved = "approved"
+	ApprovalStatusRejected = "rejected"
+)
+
+// ValidateApprovalStatusFilter checks a filter on review status. An empty
+// filter is allowed; what it lists is up to the caller.
+func ValidateApprovalStatusFilter(status string) error {
+	switch status {
+	case "", ApprovalStatusPending, ApprovalStatusApproved, ApprovalStatusRejected:
+		return nil
+	}
+	return fmt.Errorf("invalid status filter %q, expected %s, %s or %s", status, ApprovalStatusPending, ApprovalStatusApproved, ApprovalStatusRejected)
+}
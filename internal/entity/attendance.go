@@ -5,4 +5,6 @@ type Attendance struct {
 	EmployeeID   int64  `gorm:"not null;index"`
 	ClockInTime  string `gorm:"not null"`
 	ClockOutTime string
+	Corrected    bool   `gorm:"not null;default:false"`
+	CorrectionID *int64 // approved correction that last changed the record
 }
@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// AttendanceCorrection asks to fill in a missing check-in or check-out of a
+// past day. Times are RFC 3339 strings like on Attendance; an empty time
+// keeps the recorded one. AttendanceID and the original times point at the
+// record the correction replaces and are empty when the day had none.
+type AttendanceCorrection struct {
+	Base
+	EmployeeID           int64     `gorm:"not null;index"`
+	AttendanceID         *int64    `gorm:"index"`
+	Date                 time.Time `gorm:"type:date;not null"`
+	ClockInTime          string
+	ClockOutTime         string
+	OriginalClockInTime  string
+	OriginalClockOutTime string
+	Reason               string `gorm:"not null"`
+	Status               string `gorm:"not null;index"`
+	ReviewedBy           *int64
+	ReviewedAt           *time.Time
+	ReviewNote           string
+}
+
+// CorrectedTimes returns the check-in and check-out the attendance has once
+// the correction is applied: requested times replace the original ones.
+func (c *AttendanceCorrection) CorrectedTimes() (clockIn, clockOut string) {
+	clockIn, clockOut = c.OriginalClockInTime, c.OriginalClockOutTime
+	if c.ClockInTime != "" {
+		clockIn = c.ClockInTime
+	}
+	if c.ClockOutTime != "" {
+		clockOut = c.ClockOutTime
+	}
+	return clockIn, clockOut
+}
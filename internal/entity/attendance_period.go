@@ -9,8 +9,9 @@ import (
 
 type AttendancePeriod struct {
 	Base
-	StartDate time.Time `gorm:"not null;index"`
-	EndDate   time.Time `gorm:"not null;index"`
+	StartDate time.Time      `gorm:"not null;index"`
+	EndDate   time.Time      `gorm:"not null;index"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
 func (ap AttendancePeriod) BeforeCreate(tx *gorm.DB) (err error) {
@@ -31,7 +32,7 @@ func (ap AttendancePeriod) BeforeCreate(tx *gorm.DB) (err error) {
 func (ap AttendancePeriod) BeforeUpdate(tx *gorm.DB) (err error) {
 	var count int64
 	err = tx.Model(&AttendancePeriod{}).
-		Where("id != ? AND start_date <= ? AND end_date >= ?", ap.ID, ap.EndDate, ap.StartDate).
+		Where("id != ? AND start_date <= ? AND end_date >= ? AND deleted_at IS NULL", ap.ID, ap.EndDate, ap.StartDate).
 		Count(&count).Error
 	if err != nil {
 		return err
@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"strings"
+)
+
+// Geofence is a circle around an office of a location. When a location has
+// geofences, check-ins and check-outs are expected from inside one of them.
+type Geofence struct {
+	Base
+	LocationID   int64   `gorm:"not null;index"`
+	Name         string  `gorm:"not null"`
+	Latitude     float64 `gorm:"not null"`
+	Longitude    float64 `gorm:"not null"`
+	RadiusMeters float64 `gorm:"not null"`
+}
+
+// MaxLocationAccuracy is the largest accuracy radius in meters a reported
+// position can have and still be checked against geofences.
+const MaxLocationAccuracy = 200.0
+
+const earthRadiusMeters = 6371000.0
+
+// DistanceMeters returns the great-circle distance between the center of the
+// geofence and a position.
+func (g *Geofence) DistanceMeters(latitude, longitude float64) float64 {
+	lat1, lat2 := g.Latitude*math.Pi/180, latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLng := (longitude - g.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Contains reports whether a position may be inside the geofence, given
+// that it is only known within the accuracy radius.
+func (g *Geofence) Contains(latitude, longitude, accuracy float64) bool {
+	return g.DistanceMeters(latitude, longitude) <= g.RadiusMeters+accuracy
+}
+
+// AttendancePolicy holds the checks an attendance submission of an employee
+// is held against. Empty geofences or networks skip that check, and an
+// employee without a bound device is bound to the first one they use.
+type AttendancePolicy struct {
+	Geofences       []Geofence
+	AllowedNetworks []*net.IPNet
+	DeviceID        string
+}
+
+// AttendanceEvidence is what a submission tells about where it was made.
+type AttendanceEvidence struct {
+	Latitude  *float64
+	Longitude *float64
+	Accuracy  *float64 // meters
+	IPAddress string
+	DeviceID  string
+}
+
+// Check returns a violation for every check the evidence fails. The
+// violations only carry their kind and detail.
+func (p *AttendancePolicy) Check(evidence AttendanceEvidence) []AttendanceViolation {
+	var violations []AttendanceViolation
+	if v := p.checkGeofences(evidence); v != nil {
+		violations = append(violations, *v)
+	}
+	if v := p.checkNetwork(evidence.IPAddress); v != nil {
+		violations = append(violations, *v)
+	}
+	if p.DeviceID != "" && evidence.DeviceID != p.DeviceID {
+		detail := "no device ID was sent"
+		if evidence.DeviceID != "" {
+			detail = fmt.Sprintf("device %q is not the bound device", evidence.DeviceID)
+		}
+		violations = append(violations, AttendanceViolation{Kind: ViolationDeviceMismatch, Detail: detail})
+	}
+	return violations
+}
+
+func (p *AttendancePolicy) checkGeofences(evidence AttendanceEvidence) *AttendanceViolation {
+	if len(p.Geofences) == 0 {
+		return nil
+	}
+	if evidence.Latitude == nil || evidence.Longitude == nil {
+		return &AttendanceViolation{Kind: ViolationLocationMissing, Detail: "no position was sent"}
+	}
+
+	accuracy := 0.0
+	if evidence.Accuracy != nil {
+		accuracy = *evidence.Accuracy
+	}
+	if accuracy > MaxLocationAccuracy {
+		return &AttendanceViolation{
+			Kind:   ViolationLocationInaccurate,
+			Detail: fmt.Sprintf("position is only accurate to %.0f m", accuracy),
+		}
+	}
+
+	nearest := math.Inf(1)
+	for i := range p.Geofences {
+		geofence := &p.Geofences[i]
+		if geofence.Contains(*evidence.Latitude, *evidence.Longitude, accuracy) {
+			return nil
+		}
+		nearest = math.Min(nearest, geofence.DistanceMeters(*evidence.Latitude, *evidence.Longitude)-geofence.RadiusMeters)
+	}
+	return &AttendanceViolation{
+		Kind:   ViolationOutsideGeofence,
+		Detail: fmt.Sprintf("position is %.0f m outside the nearest geofence", nearest),
+	}
+}
+
+func (p *AttendancePolicy) checkNetwork(ipAddress string) *AttendanceViolation {
+	if len(p.AllowedNetworks) == 0 {
+		return nil
+	}
+	ip := net.ParseIP(ipAddress)
+	if ip != nil {
+		for _, network := range p.AllowedNetworks {
+			if network.Contains(ip) {
+				return nil
+			}
+		}
+	}
+	return &AttendanceViolation{
+		Kind:   ViolationIPNotAllowed,
+		Detail: fmt.Sprintf("IP address %q is not in the allowlist", ipAddress),
+	}
+}
+
+// ParseNetworks parses a comma-separated list of IP addresses and CIDR
+// ranges. A single address is taken as a range of its own.
+func ParseNetworks(s string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if !strings.Contains(part, "/") {
+			ip := net.ParseIP(part)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q", part)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP range %q", part)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// FormatNetworks returns the stored form of an allowlist.
+func FormatNetworks(networks []*net.IPNet) string {
+	parts := make([]string, 0, len(networks))
+	for _, network := range networks {
+		parts = append(parts, network.String())
+	}
+	return strings.Join(parts, ",")
+}
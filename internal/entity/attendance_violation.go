@@ -0,0 +1,35 @@
+package entity
+
+import "time"
+
+// Kinds of attendance violation.
+const (
+	ViolationLocationMissing    = "location_missing"
+	ViolationLocationInaccurate = "location_inaccurate"
+	ViolationOutsideGeofence    = "outside_geofence"
+	ViolationIPNotAllowed       = "ip_not_allowed"
+	ViolationDeviceMismatch     = "device_mismatch"
+)
+
+// AttendanceViolation flags a check-in or check-out that failed a check of
+// the employee's attendance policy. The attendance is recorded regardless
+// and the violation waits for review; rejecting it keeps the attendance out
+// of payroll. The evidence sent with the submission is kept for the
+// reviewer.
+type AttendanceViolation struct {
+	Base
+	AttendanceID   int64  `gorm:"not null;index"`
+	EmployeeID     int64  `gorm:"not null;index"`
+	AttendanceType string `gorm:"not null"` // check_in or check_out
+	Kind           string `gorm:"not null"`
+	Detail         string `gorm:"not null"`
+	Latitude       *float64
+	Longitude      *float64
+	Accuracy       *float64
+	IPAddress      string
+	DeviceID       string
+	Status         string `gorm:"not null;index"`
+	ReviewedBy     *int64
+	ReviewedAt     *time.Time
+	ReviewNote     string
+}
@@ -49,4 +49,17 @@ const (
 	AuditLogActionCreate = "create"
 	AuditLogActionUpdate = "update"
 	AuditLogActionDelete = "delete"
+
+	AuditLogActionLoginFailed = "login_failed"
+	AuditLogActionLoginUnlock = "login_unlock"
+
+	AuditLogActionPasswordChange = "password_change"
+	AuditLogActionPasswordReset  = "password_reset"
+
+	AuditLogActionTwoFactorEnable  = "two_factor_enable"
+	AuditLogActionTwoFactorDisable = "two_factor_disable"
+	AuditLogActionTwoFactorReset   = "two_factor_reset"
+
+	AuditLogActionAPIKeyRevoke  = "api_key_revoke"
+	AuditLogActionAPIKeyRequest = "api_key_request"
 )
@@ -20,33 +20,9 @@ type Base struct {
 
 func (b Base) BeforeUpdate(tx *gorm.DB) (err error) {
 	// Get the current record from database before update
-	if tx.Statement.Schema != nil {
-		// Create a new instance of the same type
-		modelType := tx.Statement.Schema.ModelType
-		if modelType.Kind() == reflect.Ptr {
-			modelType = modelType.Elem()
-		}
-
-		currentRecord := reflect.New(modelType).Interface()
-
-		// Find the current record by ID
-		var recordID interface{}
-		if field := tx.Statement.Schema.LookUpField("ID"); field != nil {
-			recordID, _ = field.ValueOf(tx.Statement.Context, tx.Statement.ReflectValue)
-		}
-
-		if recordID != nil {
-			err := tx.Where("id = ?", recordID).First(currentRecord).Error
-			if err == nil {
-				// Convert to map for storage
-				dataBytes, _ := json.Marshal(currentRecord)
-				var dataMap map[string]interface{}
-				json.Unmarshal(dataBytes, &dataMap)
-
-				// Store in context
-				tx.Statement.Context = context.WithValue(tx.Statement.Context, "data_before", dataMap)
-			}
-		}
+	if dataMap := currentRecord(tx); dataMap != nil {
+		// Store in context
+		tx.Statement.Context = context.WithValue(tx.Statement.Context, "data_before", dataMap)
 	}
 	return nil
 }
@@ -189,3 +165,84 @@ func (b Base) AfterCreate(tx *gorm.DB) (err error) {
 
 	return tx.Create(&auditLog).Error
 }
+
+func (b Base) BeforeDelete(tx *gorm.DB) (err error) {
+	// Keep the full record, it is gone from every query after the delete
+	if dataMap := currentRecord(tx); dataMap != nil {
+		tx.Statement.Context = context.WithValue(tx.Statement.Context, "data_before", dataMap)
+	}
+	return nil
+}
+
+func (b Base) AfterDelete(tx *gorm.DB) (err error) {
+	// Skip audit logging in test environment
+	if tx.Statement.Context != nil {
+		if skipAudit := tx.Statement.Context.Value("skip_audit"); skipAudit != nil {
+			return nil
+		}
+	}
+
+	dataBefore, _ := tx.Statement.Context.Value("data_before").(map[string]interface{})
+	if dataBefore == nil {
+		return nil
+	}
+
+	// Get user context (with nil checks)
+	var userID string
+	var ipAddress string
+
+	if userIDVal := tx.Statement.Context.Value(constant.ContextKeyUserID); userIDVal != nil {
+		userID, _ = userIDVal.(string)
+	}
+	if ipVal := tx.Statement.Context.Value(constant.ContextKeyIPAddress); ipVal != nil {
+		ipAddress, _ = ipVal.(string)
+	}
+
+	// Create audit log entry with the full prior state
+	auditLog := AuditLog{
+		TableName:  tx.Statement.Table,
+		RecordID:   cast.ToInt64(dataBefore["ID"]),
+		Action:     AuditLogActionDelete,
+		DataBefore: dataBefore,
+		DataAfter:  nil,
+		UserID:     userID,
+		IPAddress:  ipAddress,
+	}
+
+	return tx.Create(&auditLog).Error
+}
+
+// currentRecord loads the stored version of the record a statement works on,
+// as a map for the audit log. It returns nil if the record cannot be found.
+func currentRecord(tx *gorm.DB) map[string]interface{} {
+	if tx.Statement.Schema == nil {
+		return nil
+	}
+
+	// Create a new instance of the same type
+	modelType := tx.Statement.Schema.ModelType
+	if modelType.Kind() == reflect.Ptr {
+		modelType = modelType.Elem()
+	}
+
+	current := reflect.New(modelType).Interface()
+
+	// Find the current record by ID
+	var recordID interface{}
+	if field := tx.Statement.Schema.LookUpField("ID"); field != nil {
+		recordID, _ = field.ValueOf(tx.Statement.Context, tx.Statement.ReflectValue)
+	}
+	if recordID == nil {
+		return nil
+	}
+
+	if err := tx.Where("id = ?", recordID).First(current).Error; err != nil {
+		return nil
+	}
+
+	// Convert to map for storage
+	dataBytes, _ := json.Marshal(current)
+	var dataMap map[string]interface{}
+	json.Unmarshal(dataBytes, &dataMap)
+	return dataMap
+}
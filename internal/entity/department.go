@@ -0,0 +1,9 @@
+package entity
+
+type Department struct {
+	Base
+	Code       string `gorm:"uniqueIndex;not null"`
+	Name       string `gorm:"not null"`
+	CostCenter string `gorm:"not null"`
+	ParentID   *int64 `gorm:"index"`
+}
@@ -2,6 +2,12 @@ package entity
 
 type Employee struct {
 	Base
-	UserID     int64 `gorm:"not null;uniqueIndex"`
-	BaseSalary int64 `gorm:"not null"`
+	UserID       int64   `gorm:"not null;uniqueIndex"`
+	BaseSalary   int64   `gorm:"not null"`
+	DepartmentID *int64  `gorm:"index"`
+	ManagerID    *int64  `gorm:"index"`
+	LocationID   *int64  `gorm:"index"`
+	DeviceID     string  // device attendance is bound to, set by the first submission with one
+	Code         *string `gorm:"uniqueIndex"`                 // number the employee is enrolled under on time clocks
+	Currency     string  `gorm:"size:3;not null;default:IDR"` // of the base salary and payslips
 }
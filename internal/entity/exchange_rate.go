@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+// DefaultCurrency is the currency of amounts recorded before currencies were
+// tracked, and of employees and payrolls created without one.
+const DefaultCurrency = "IDR"
+
+// ExchangeRate is the value of one unit of FromCurrency in ToCurrency from
+// EffectiveDate on, until a later rate for the same pair takes over. Rate is
+// a decimal kept as text so no precision is lost on the way to the database.
+type ExchangeRate struct {
+	Base
+	FromCurrency  string    `gorm:"size:3;not null;uniqueIndex:idx_exchange_rates_pair_date"`
+	ToCurrency    string    `gorm:"size:3;not null;uniqueIndex:idx_exchange_rates_pair_date"`
+	EffectiveDate time.Time `gorm:"type:date;not null;uniqueIndex:idx_exchange_rates_pair_date"`
+	Rate          string    `gorm:"type:numeric(20,10);not null"`
+	CreatedBy     *int64
+}
+
+// PayrollExchangeRate records a rate a payroll converted amounts with, so the
+// payroll can be explained after the rate table has moved on.
+type PayrollExchangeRate struct {
+	Base
+	PayrollID     int64     `gorm:"not null;uniqueIndex:idx_payroll_exchange_rates_pair"`
+	FromCurrency  string    `gorm:"size:3;not null;uniqueIndex:idx_payroll_exchange_rates_pair"`
+	ToCurrency    string    `gorm:"size:3;not null;uniqueIndex:idx_payroll_exchange_rates_pair"`
+	EffectiveDate time.Time `gorm:"type:date;not null"` // of the rate the conversion used
+	Rate          string    `gorm:"type:numeric(20,10);not null"`
+}
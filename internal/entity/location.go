@@ -0,0 +1,211 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Timezones are loaded by name, so the zone database is embedded for
+	// hosts that do not ship one
+	_ "time/tzdata"
+)
+
+// Location is a site employees work at. Its timezone and work schedule
+// decide which day an attendance or overtime belongs to and which days count
+// as working days.
+type Location struct {
+	Base
+	Name       string `gorm:"uniqueIndex;not null"`
+	Timezone   string `gorm:"not null"` // IANA name, for example Asia/Jakarta
+	ShiftStart string `gorm:"not null"` // HH:MM in the location's timezone
+	ShiftEnd   string `gorm:"not null"`
+	Workdays   string `gorm:"not null"` // comma-separated, for example mon,tue,wed,thu,fri
+	Rostered   bool   `gorm:"not null;default:false"`
+	// AllowedNetworks lists the IP addresses and CIDR ranges attendance is
+	// expected from, comma-separated; empty allows any
+	AllowedNetworks string `gorm:"not null;default:''"`
+}
+
+// WorkSchedule returns the parsed schedule of the location.
+func (l *Location) WorkSchedule() (*WorkSchedule, error) {
+	zone, err := time.LoadLocation(l.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q", l.Timezone)
+	}
+	shiftStart, err := ParseClock(l.ShiftStart)
+	if err != nil {
+		return nil, err
+	}
+	shiftEnd, err := ParseClock(l.ShiftEnd)
+	if err != nil {
+		return nil, err
+	}
+	hours := NewShiftHours(shiftStart, shiftEnd)
+	workdays, err := ParseWorkdays(l.Workdays)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WorkSchedule{
+		Zone:       zone,
+		ShiftStart: hours.Start,
+		ShiftEnd:   hours.End,
+		Workdays:   workdays,
+		Rostered:   l.Rostered,
+	}, nil
+}
+
+// WorkSchedule is the working time of an employee: the timezone their days
+// are counted in, the regular shift and the days of the week they work.
+// Rostered schedules instead work the shifts in Roster, and every date
+// without one is a day off.
+type WorkSchedule struct {
+	Zone       *time.Location
+	ShiftStart time.Duration // since midnight
+	ShiftEnd   time.Duration
+	Workdays   [7]bool // indexed by time.Weekday
+	Rostered   bool
+	Roster     map[string]ShiftHours // keyed by date as YYYY-MM-DD
+}
+
+// ShiftHours is the working time of one day as offsets from its midnight. A
+// shift that crosses midnight ends more than 24 hours after that midnight.
+type ShiftHours struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// NewShiftHours returns the shift between two times of day. An end at or
+// before the start is taken to be on the next day.
+func NewShiftHours(start, end time.Duration) ShiftHours {
+	if end <= start {
+		end += 24 * time.Hour
+	}
+	return ShiftHours{Start: start, End: end}
+}
+
+// NightShiftCarryOver is how long after a shift that crossed midnight ends
+// the time still belongs to the day the shift started, so that overtime
+// directly after a night shift counts towards that night.
+const NightShiftCarryOver = 3 * time.Hour
+
+// DefaultWorkSchedule applies to employees without a location: Monday to
+// Friday from 09:00 to 17:00 in the server's timezone.
+func DefaultWorkSchedule() *WorkSchedule {
+	return &WorkSchedule{
+		Zone:       time.Local,
+		ShiftStart: 9 * time.Hour,
+		ShiftEnd:   17 * time.Hour,
+		Workdays:   [7]bool{time.Monday: true, time.Tuesday: true, time.Wednesday: true, time.Thursday: true, time.Friday: true},
+	}
+}
+
+// Now returns the current time in the schedule's timezone.
+func (s *WorkSchedule) Now() time.Time {
+	return time.Now().In(s.Zone)
+}
+
+// Date returns the day, formatted as YYYY-MM-DD, that an instant falls on in
+// the schedule's timezone.
+func (s *WorkSchedule) Date(t time.Time) string {
+	return t.In(s.Zone).Format("2006-01-02")
+}
+
+// Midnight returns the start of a calendar date in the schedule's timezone.
+func (s *WorkSchedule) Midnight(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, s.Zone)
+}
+
+// IsWorkday reports whether the schedule works on a calendar date.
+func (s *WorkSchedule) IsWorkday(date time.Time) bool {
+	if s.Rostered {
+		_, ok := s.Roster[date.Format("2006-01-02")]
+		return ok
+	}
+	return s.Workdays[date.Weekday()]
+}
+
+// ShiftOn returns the shift worked on a calendar date. It is zero for a day
+// off of a rostered schedule.
+func (s *WorkSchedule) ShiftOn(date time.Time) ShiftHours {
+	if s.Rostered {
+		return s.Roster[date.Format("2006-01-02")]
+	}
+	return ShiftHours{Start: s.ShiftStart, End: s.ShiftEnd}
+}
+
+// ShiftEndOn returns when the shift of the day an instant falls on ends,
+// which is on the next day for a shift that crosses midnight.
+func (s *WorkSchedule) ShiftEndOn(t time.Time) time.Time {
+	day := t.In(s.Zone)
+	return s.Midnight(day).Add(s.ShiftOn(day).End)
+}
+
+// WorkDay returns the midnight of the work day an instant belongs to. That
+// is the day it falls on, except after a shift that crossed midnight: until
+// NightShiftCarryOver after that shift ends, time belongs to the day the
+// shift started.
+func (s *WorkSchedule) WorkDay(t time.Time) time.Time {
+	local := t.In(s.Zone)
+	day := s.Midnight(local)
+	previous := day.AddDate(0, 0, -1)
+	if s.IsWorkday(previous) && local.Before(s.ShiftEndOn(previous).Add(NightShiftCarryOver)) {
+		return previous
+	}
+	return day
+}
+
+// WorkingDays counts the workdays between two calendar dates, both
+// inclusive.
+func (s *WorkSchedule) WorkingDays(first, last time.Time) int {
+	count := 0
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if s.IsWorkday(day) {
+			count++
+		}
+	}
+	return count
+}
+
+var weekdayNames = [7]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// ParseClock parses a time of day formatted as HH:MM.
+func ParseClock(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// ParseWorkdays parses a comma-separated list of weekday abbreviations.
+func ParseWorkdays(value string) ([7]bool, error) {
+	var workdays [7]bool
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		found := false
+		for day, dayName := range weekdayNames {
+			if name == dayName {
+				workdays[day] = true
+				found = true
+			}
+		}
+		if !found {
+			return workdays, fmt.Errorf("invalid workday %q", name)
+		}
+	}
+	return workdays, nil
+}
+
+// FormatWorkdays lists the workdays as stored on a location, starting with
+// Monday.
+func FormatWorkdays(workdays [7]bool) string {
+	names := make([]string, 0, len(workdays))
+	for i := range workdays {
+		day := (i + 1) % 7
+		if workdays[day] {
+			names = append(names, weekdayNames[day])
+		}
+	}
+	return strings.Join(names, ",")
+}
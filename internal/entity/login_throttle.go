@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// LoginThrottle counts consecutive failed logins for a username or a client
+// IP address. The counter restarts once the last failure falls outside the
+// configured attempt window and is cleared on a successful login or an admin
+// unlock.
+type LoginThrottle struct {
+	Base
+	Scope          string    `gorm:"not null;uniqueIndex:idx_login_throttles_scope_identifier"`
+	Identifier     string    `gorm:"not null;uniqueIndex:idx_login_throttles_scope_identifier"`
+	FailedAttempts int       `gorm:"not null"`
+	LastFailedAt   time.Time `gorm:"not null"`
+}
+
+const (
+	LoginThrottleScopeUsername = "username"
+	LoginThrottleScopeIP       = "ip"
+)
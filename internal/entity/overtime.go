@@ -1,6 +1,10 @@
 package entity
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Overtime struct {
 	Base
@@ -8,4 +12,9 @@ type Overtime struct {
 	StartAt     time.Time `gorm:"not null;index"`
 	EndAt       time.Time `gorm:"not null"`
 	Description string    `gorm:"not null"`
+	Status      string    `gorm:"not null;index"`
+	ReviewedBy  *int64
+	ReviewedAt  *time.Time
+	ReviewNote  string
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
 }
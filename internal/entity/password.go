@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// PasswordHistory keeps previous password hashes of a user so the password
+// policy can reject reusing them.
+type PasswordHistory struct {
+	Base
+	UserID       int64  `gorm:"not null;index"`
+	PasswordHash string `gorm:"not null"`
+}
+
+// PasswordResetToken is a one-time token issued by an admin-initiated reset.
+// Only the SHA-256 hash of the token is stored.
+type PasswordResetToken struct {
+	Base
+	UserID    int64     `gorm:"not null;index"`
+	TokenHash string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedBy int64 `gorm:"not null"`
+}
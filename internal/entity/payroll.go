@@ -1,10 +1,48 @@
 package entity
 
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Payroll statuses. A payroll is run as a draft that can still be removed and
+// run again; finalizing it releases the payslips for good.
+const (
+	PayrollStatusDraft     = "draft"
+	PayrollStatusFinalized = "finalized"
+)
+
 type Payroll struct {
 	Base
-	AttendancePeriodID int64 `gorm:"not null;uniqueIndex"`
-	TotalEmployees     int64 `gorm:"column:employees_count;not null"`
-	TotalReimbursement int64 `gorm:"not null"`
-	TotalOvertime      int64 `gorm:"not null"`
-	TotalPayroll       int64 `gorm:"not null"`
+	AttendancePeriodID int64  `gorm:"not null;uniqueIndex:idx_payrolls_attendance_period_id,where:deleted_at IS NULL"`
+	TotalEmployees     int64  `gorm:"column:employees_count;not null"`
+	TotalReimbursement int64  `gorm:"not null"`
+	TotalOvertime      int64  `gorm:"not null"`
+	TotalPayroll       int64  `gorm:"not null"`
+	ReportingCurrency  string `gorm:"size:3;not null;default:IDR"` // of the totals
+	Status             string `gorm:"not null;default:draft"`
+	FinalizedBy        *int64
+	FinalizedAt        *time.Time
+	DeletedAt          gorm.DeletedAt `gorm:"index"`
+}
+
+// Payroll calculations that round amounts to whole units, each with its own
+// rounding mode.
+const (
+	RoundingProratedSalary = "prorated_salary"
+	RoundingOvertime       = "overtime"
+	RoundingConversion     = "conversion"
+)
+
+// PayrollRounding adds up what rounding one calculation of a payroll changed
+// in one currency: the rounded amounts less the exact ones. Difference is a
+// decimal kept as text, like exchange rates.
+type PayrollRounding struct {
+	Base
+	PayrollID    int64  `gorm:"not null;uniqueIndex:idx_payroll_roundings_calculation"`
+	Calculation  string `gorm:"not null;uniqueIndex:idx_payroll_roundings_calculation"`
+	Currency     string `gorm:"size:3;not null;uniqueIndex:idx_payroll_roundings_calculation"`
+	RoundingMode string `gorm:"not null"`
+	Difference   string `gorm:"type:numeric(30,10);not null"`
 }
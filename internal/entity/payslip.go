@@ -1,15 +1,20 @@
 package entity
 
+import "gorm.io/gorm"
+
 type Payslip struct {
 	Base
-	EmployeeID         int64 `gorm:"not null;index"`
-	PayrollID          int64 `gorm:"not null;index"`
-	BaseSalary         int64 `gorm:"not null"`
-	AttendanceCount    int   `gorm:"not null;default:0"`
-	TotalWorkingDays   int   `gorm:"not null"`
-	ProratedSalary     int64 `gorm:"not null"`
-	OvertimeTotalHours int   `gorm:"not null;default:0"`
-	OvertimeTotalPay   int64 `gorm:"column:overtime_total_amount;not null;default:0"`
-	ReimbursementTotal int64 `gorm:"not null"`
-	TotalTakeHome      int64 `gorm:"not null"`
+	EmployeeID         int64          `gorm:"not null;index"`
+	PayrollID          int64          `gorm:"not null;index"`
+	BaseSalary         int64          `gorm:"not null"`
+	AttendanceCount    int            `gorm:"not null;default:0"`
+	TotalWorkingDays   int            `gorm:"not null"`
+	ProratedSalary     int64          `gorm:"not null"`
+	OvertimeTotalHours int            `gorm:"not null;default:0"`
+	OvertimeTotalPay   int64          `gorm:"column:overtime_total_amount;not null;default:0"`
+	ReimbursementTotal int64          `gorm:"not null"`
+	TotalTakeHome      int64          `gorm:"not null"`
+	Currency           string         `gorm:"size:3;not null;default:IDR"` // of every amount on the payslip
+	DepartmentID       *int64         `gorm:"index"`
+	DeletedAt          gorm.DeletedAt `gorm:"index"`
 }
@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+)
+
+// PayslipTrace records how a payslip was calculated, so it can be explained
+// after the records it was calculated from have changed.
+type PayslipTrace struct {
+	Base
+	PayslipID   int64              `gorm:"not null;uniqueIndex"`
+	Calculation PayslipCalculation `gorm:"type:jsonb;not null"`
+}
+
+// PayslipCalculation is what a payslip was calculated from and each step of
+// the calculation. Exact amounts and rates are decimals kept as text.
+type PayslipCalculation struct {
+	Currency           string                   `json:"currency"`
+	BaseSalary         int64                    `json:"base_salary"`
+	WorkingDays        int                      `json:"working_days"`
+	AttendanceIDs      []int64                  `json:"attendance_ids"`
+	Overtimes          []TracedOvertime         `json:"overtimes"`
+	Reimbursements     []TracedReimbursement    `json:"reimbursements"`
+	HourlyRate         string                   `json:"hourly_rate"`
+	OvertimeMultiplier int64                    `json:"overtime_multiplier"`
+	ExchangeRates      []TracedExchangeRate     `json:"exchange_rates"`
+	Steps              []PayslipCalculationStep `json:"steps"`
+}
+
+// TracedOvertime is approved overtime a payslip paid, in whole hours.
+type TracedOvertime struct {
+	ID    int64 `json:"id"`
+	Hours int   `json:"hours"`
+}
+
+// TracedReimbursement is an approved reimbursement a payslip paid, in the
+// currency it was submitted in.
+type TracedReimbursement struct {
+	ID       int64  `json:"id"`
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// TracedExchangeRate is a rate a payslip converted a reimbursement with.
+type TracedExchangeRate struct {
+	FromCurrency string `json:"from_currency"`
+	ToCurrency   string `json:"to_currency"`
+	Rate         string `json:"rate"`
+}
+
+// PayslipCalculationStep is one step of a payslip calculation. Formula shows
+// the values the step worked with; Exact is its result before rounding.
+// Steps that round to whole units have a rounding mode and a result.
+type PayslipCalculationStep struct {
+	Name         string `json:"name"`
+	Formula      string `json:"formula"`
+	Exact        string `json:"exact"`
+	RoundingMode string `json:"rounding_mode,omitempty"`
+	Result       *int64 `json:"result,omitempty"`
+}
+
+// Value implements the driver.Valuer interface
+func (c PayslipCalculation) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+// Scan implements the sql.Scanner interface
+func (c *PayslipCalculation) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(bytes, c)
+}
@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// RefreshToken tracks an issued refresh token. Only the SHA-256 hash of the
+// token is stored. Tokens obtained from the same login share a FamilyID so a
+// reused (already rotated) token can revoke the whole chain.
+type RefreshToken struct {
+	Base
+	UserID       int64     `gorm:"not null;index"`
+	JTI          string    `gorm:"column:jti;uniqueIndex;not null"`
+	FamilyID     string    `gorm:"not null;index"`
+	TokenHash    string    `gorm:"not null"`
+	ExpiresAt    time.Time `gorm:"not null"`
+	RotatedAt    *time.Time
+	RevokedAt    *time.Time
+	ReplacedByID *int64
+}
@@ -1,11 +1,21 @@
 package entity
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Reimbursement struct {
 	Base
 	EmployeeID  int64     `gorm:"not null;index"`
 	Amount      int64     `gorm:"not null"`
+	Currency    string    `gorm:"size:3;not null;default:IDR"`
 	Date        time.Time `gorm:"not null"`
 	Description string    `gorm:"not null"`
+	Status      string    `gorm:"not null;index"`
+	ReviewedBy  *int64
+	ReviewedAt  *time.Time
+	ReviewNote  string
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
 }
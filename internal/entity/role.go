@@ -0,0 +1,90 @@
+package entity
+
+import "slices"
+
+type Role struct {
+	Base
+	Name             string `gorm:"uniqueIndex;not null"`
+	Description      string `gorm:"not null"`
+	RequireTwoFactor bool   `gorm:"not null;default:false"`
+}
+
+type RolePermission struct {
+	Base
+	RoleID     int64  `gorm:"not null;uniqueIndex:idx_role_permissions_role_permission"`
+	Permission string `gorm:"not null;uniqueIndex:idx_role_permissions_role_permission"`
+}
+
+const (
+	PermissionAttendanceSubmit      = "attendance:submit"
+	PermissionOvertimeSubmit        = "overtime:submit"
+	PermissionReimbursementSubmit   = "reimbursement:submit"
+	PermissionPayslipReadOwn        = "payslip:read:own"
+	PermissionSubmissionReviewTeam  = "submission:review:team"
+	PermissionSubmissionReviewAny   = "submission:review:any"
+	PermissionSubmissionReadAny     = "submission:read:any"
+	PermissionSubmissionVoid        = "submission:void"
+	PermissionAttendancePeriodRead  = "attendance_period:read"
+	PermissionAttendancePeriodWrite = "attendance_period:write"
+	PermissionPayrollRun            = "payroll:run"
+	PermissionPayrollRead           = "payroll:read"
+	PermissionPayrollApprove        = "payroll:approve"
+	PermissionDepartmentRead        = "department:read"
+	PermissionDepartmentWrite       = "department:write"
+	PermissionLocationRead          = "location:read"
+	PermissionLocationWrite         = "location:write"
+	PermissionRosterRead            = "roster:read"
+	PermissionRosterWrite           = "roster:write"
+	PermissionAttendanceImport      = "attendance:import"
+	PermissionDataImport            = "data:import"
+	PermissionExchangeRateManage    = "exchange_rate:manage"
+	PermissionEmployeeWrite         = "employee:write"
+	PermissionRoleManage            = "role:manage"
+	PermissionSessionRevoke         = "session:revoke"
+	PermissionLoginUnlock           = "login:unlock"
+	PermissionPasswordReset         = "password:reset"
+	PermissionTwoFactorReset        = "two_factor:reset"
+	PermissionServiceAccountManage  = "service_account:manage"
+)
+
+// Permissions lists every permission the application checks. Roles can only
+// be granted permissions from this list.
+var Permissions = []string{
+	PermissionAttendanceSubmit,
+	PermissionOvertimeSubmit,
+	PermissionReimbursementSubmit,
+	PermissionPayslipReadOwn,
+	PermissionSubmissionReviewTeam,
+	PermissionSubmissionReviewAny,
+	PermissionSubmissionReadAny,
+	PermissionSubmissionVoid,
+	PermissionAttendancePeriodRead,
+	PermissionAttendancePeriodWrite,
+	PermissionPayrollRun,
+	PermissionPayrollRead,
+	PermissionPayrollApprove,
+	PermissionDepartmentRead,
+	PermissionDepartmentWrite,
+	PermissionLocationRead,
+	PermissionLocationWrite,
+	PermissionRosterRead,
+	PermissionRosterWrite,
+	PermissionAttendanceImport,
+	PermissionDataImport,
+	PermissionExchangeRateManage,
+	PermissionEmployeeWrite,
+	PermissionRoleManage,
+	PermissionSessionRevoke,
+	PermissionLoginUnlock,
+	PermissionPasswordReset,
+	PermissionTwoFactorReset,
+	PermissionServiceAccountManage,
+}
+
+func IsValidPermission(permission string) bool {
+	return slices.Contains(Permissions, permission)
+}
+
+func HasPermission(granted []string, permission string) bool {
+	return slices.Contains(granted, permission)
+}
@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// Shift is a shift template, such as a morning shift from 06:00 to 14:00,
+// that is assigned to employees of rostered locations per date. A shift
+// whose end is at or before its start, such as 22:00 to 06:00, ends on the
+// next day.
+type Shift struct {
+	Base
+	Name      string `gorm:"uniqueIndex;not null"`
+	StartTime string `gorm:"not null"` // HH:MM in the employee's timezone
+	EndTime   string `gorm:"not null"`
+}
+
+// Hours returns the parsed start and end of the shift.
+func (s *Shift) Hours() (ShiftHours, error) {
+	start, err := ParseClock(s.StartTime)
+	if err != nil {
+		return ShiftHours{}, err
+	}
+	end, err := ParseClock(s.EndTime)
+	if err != nil {
+		return ShiftHours{}, err
+	}
+	return NewShiftHours(start, end), nil
+}
+
+// RosterEntry assigns a shift to an employee on one date. An employee has at
+// most one entry per date.
+type RosterEntry struct {
+	Base
+	EmployeeID int64     `gorm:"not null;uniqueIndex:idx_roster_entries_employee_date"`
+	Date       time.Time `gorm:"type:date;not null;uniqueIndex:idx_roster_entries_employee_date"`
+	ShiftID    int64     `gorm:"not null;index"`
+}
@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// UserTwoFactor holds a user's TOTP secret, encrypted with the configured
+// two-factor secret key. EnabledAt stays nil until the user confirmed the
+// enrollment with a valid code. LastUsedStep is the TOTP time step of the
+// last accepted code and prevents replaying it.
+type UserTwoFactor struct {
+	Base
+	UserID          int64  `gorm:"uniqueIndex;not null"`
+	SecretEncrypted string `gorm:"not null"`
+	EnabledAt       *time.Time
+	LastUsedStep    int64 `gorm:"not null;default:0"`
+}
+
+func (t *UserTwoFactor) Enabled() bool {
+	return t != nil && t.EnabledAt != nil
+}
+
+// TwoFactorRecoveryCode is a single-use code that replaces a TOTP code when
+// the authenticator device is lost. Only the SHA-256 hash is stored.
+type TwoFactorRecoveryCode struct {
+	Base
+	UserID   int64  `gorm:"not null;uniqueIndex:idx_two_factor_recovery_codes_user_code"`
+	CodeHash string `gorm:"not null;uniqueIndex:idx_two_factor_recovery_codes_user_code"`
+	UsedAt   *time.Time
+}
+
+// LoginChallenge is the pending second step of a login. The challenge token
+// returned by /auth/login is only stored as a SHA-256 hash.
+type LoginChallenge struct {
+	Base
+	UserID         int64     `gorm:"not null;index"`
+	TokenHash      string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt      time.Time `gorm:"not null"`
+	FailedAttempts int       `gorm:"not null;default:0"`
+	CompletedAt    *time.Time
+}
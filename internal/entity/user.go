@@ -1,15 +1,24 @@
 package entity
 
+import "time"
+
 type User struct {
 	Base
-	Username     string `gorm:"uniqueIndex;not null"`
-	PasswordHash string `gorm:"not null"`
-	Role         string `gorm:"not null"`
+	Username           string `gorm:"uniqueIndex;not null"`
+	PasswordHash       string `gorm:"not null"`
+	Role               string `gorm:"not null"`
+	MustChangePassword bool   `gorm:"not null;default:false"`
+	PasswordChangedAt  *time.Time
+	IsServiceAccount   bool `gorm:"not null;default:false"`
 }
 
 const (
 	UserRoleAdmin   = "admin"
+	UserRoleManager = "manager"
 	UserRoleDefault = "default"
+	// UserRoleServiceAccount has no permissions; service accounts act with the
+	// scopes of the API key they authenticated with
+	UserRoleServiceAccount = "service_account"
 )
 
 const (
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// UserIdentity links a user to the subject of an OpenID Connect provider. The
+// subject, not the email, identifies the user on later logins.
+type UserIdentity struct {
+	Base
+	UserID  int64  `gorm:"not null;index"`
+	Issuer  string `gorm:"not null;uniqueIndex:idx_user_identities_issuer_subject"`
+	Subject string `gorm:"not null;uniqueIndex:idx_user_identities_issuer_subject"`
+	Email   string
+}
+
+// OIDCLoginState is a pending single sign-on login. The state parameter sent
+// to the provider is only stored as a SHA-256 hash; the nonce and PKCE code
+// verifier are needed to complete the login.
+type OIDCLoginState struct {
+	Base
+	StateHash    string    `gorm:"uniqueIndex;not null"`
+	Nonce        string    `gorm:"not null"`
+	CodeVerifier string    `gorm:"not null"`
+	ExpiresAt    time.Time `gorm:"not null"`
+	UsedAt       *time.Time
+}
+
+func (OIDCLoginState) TableName() string {
+	return "oidc_login_states"
+}
@@ -0,0 +1,95 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// maxImportFileSize bounds the time-clock export read from the request body.
+const maxImportFileSize = 10 << 20
+
+func (h *HandlerImpl) ImportAttendance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dryRun := false
+	if dryRunStr := r.URL.Query().Get("dry_run"); dryRunStr != "" {
+		parsed, err := strconv.ParseBool(dryRunStr)
+		if err != nil {
+			logger.Error(ctx, "invalid dry_run", "dry_run", dryRunStr, "error", err)
+			resp := &v1.DefaultErrorResponse{}
+			resp.Error.Message = "invalid dry_run"
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, resp)
+			return
+		}
+		dryRun = parsed
+	}
+
+	result, err := h.attendanceImportUsecase.ImportAttendance(ctx, http.MaxBytesReader(w, r.Body, maxImportFileSize), dryRun)
+	if err != nil {
+		logger.Error(ctx, "failed to import attendance", "dry_run", dryRun, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, result)
+}
+
+func (h *HandlerImpl) AssignEmployeeCode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	employeeIDStr := chi.URLParam(r, "id")
+	employeeID, err := strconv.ParseInt(employeeIDStr, 10, 64)
+	if err != nil {
+		logger.Error(ctx, "invalid employee ID", "id", employeeIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid employee ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	var req v1.EmployeeCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	err = h.organizationUsecase.AssignEmployeeCode(ctx, employeeID, req)
+	if err != nil {
+		logger.Error(ctx, "failed to assign employee code", "employee_id", employeeID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,139 @@
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/admin"
+	approvalmock "github.com/asyauqi15/payslip-system/internal/usecase/approval/mock"
+	attendanceimportmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_import/mock"
+	attendanceperiodmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_period/mock"
+	authmock "github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	bulkimportmock "github.com/asyauqi15/payslip-system/internal/usecase/bulk_import/mock"
+	exchangeratemock "github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate/mock"
+	organizationmock "github.com/asyauqi15/payslip-system/internal/usecase/organization/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
+	payrollmock "github.com/asyauqi15/payslip-system/internal/usecase/payroll/mock"
+	rolemock "github.com/asyauqi15/payslip-system/internal/usecase/role/mock"
+	rostermock "github.com/asyauqi15/payslip-system/internal/usecase/roster/mock"
+	searchmock "github.com/asyauqi15/payslip-system/internal/usecase/search/mock"
+	serviceaccountmock "github.com/asyauqi15/payslip-system/internal/usecase/service_account/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminHandler_ImportAttendance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	file := "employee_code,timestamp,direction\n0042,2025-01-06 08:58:00,in\n"
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func()
+		expectedStatus int
+		expectError    bool
+	}{
+		{
+			name:  "successful import",
+			query: "",
+			setupMock: func() {
+				mockAttendanceImportUsecase.EXPECT().
+					ImportAttendance(gomock.Any(), gomock.Any(), false).
+					Return(&v1.AttendanceImportResult{Applied: true, Rows: 1, Created: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectError:    false,
+		},
+		{
+			name:  "dry run",
+			query: "?dry_run=true",
+			setupMock: func() {
+				mockAttendanceImportUsecase.EXPECT().
+					ImportAttendance(gomock.Any(), gomock.Any(), true).
+					Return(&v1.AttendanceImportResult{DryRun: true, Rows: 1, Created: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectError:    false,
+		},
+		{
+			name:           "invalid dry_run",
+			query:          "?dry_run=maybe",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:  "unreadable file",
+			query: "",
+			setupMock: func() {
+				mockAttendanceImportUsecase.EXPECT().
+					ImportAttendance(gomock.Any(), gomock.Any(), false).
+					Return(nil, httppkg.NewBadRequestError("the file has no direction column"))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/attendances/import"+tt.query, strings.NewReader(file))
+			req.Header.Set("Content-Type", "text/csv")
+
+			w := httptest.NewRecorder()
+			handler.ImportAttendance(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectError {
+				var errorResp v1.DefaultErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &errorResp); err != nil {
+					t.Error("Failed to unmarshal error response:", err)
+				}
+				if errorResp.Error.Message == "" {
+					t.Error("Expected error message in response")
+				}
+			}
+		})
+	}
+}
@@ -3,10 +3,12 @@ package admin
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/asyauqi15/payslip-system/pkg/logger"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 )
 
@@ -44,3 +46,38 @@ func (h *HandlerImpl) CreateAttendancePeriod(w http.ResponseWriter, r *http.Requ
 
 	w.WriteHeader(http.StatusCreated)
 }
+
+func (h *HandlerImpl) DeleteAttendancePeriod(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	attendancePeriodIDStr := chi.URLParam(r, "id")
+	attendancePeriodID, err := strconv.ParseInt(attendancePeriodIDStr, 10, 64)
+	if err != nil || attendancePeriodID <= 0 {
+		logger.Error(ctx, "invalid attendance period ID", "id", attendancePeriodIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid attendance period ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	logger.Info(ctx, "deleting attendance period", "attendance_period_id", attendancePeriodID)
+
+	err = h.attendancePeriodUsecase.DeleteAttendancePeriod(ctx, attendancePeriodID)
+	if err != nil {
+		logger.Error(ctx, "failed to delete attendance period", "attendance_period_id", attendancePeriodID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
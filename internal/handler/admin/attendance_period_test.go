@@ -11,10 +11,22 @@ import (
 
 	"github.com/asyauqi15/payslip-system/internal/entity"
 	"github.com/asyauqi15/payslip-system/internal/handler/admin"
+	approvalmock "github.com/asyauqi15/payslip-system/internal/usecase/approval/mock"
+	attendanceimportmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_import/mock"
 	attendanceperiodmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_period/mock"
+	authmock "github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	bulkimportmock "github.com/asyauqi15/payslip-system/internal/usecase/bulk_import/mock"
+	exchangeratemock "github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate/mock"
+	organizationmock "github.com/asyauqi15/payslip-system/internal/usecase/organization/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
 	payrollmock "github.com/asyauqi15/payslip-system/internal/usecase/payroll/mock"
+	rolemock "github.com/asyauqi15/payslip-system/internal/usecase/role/mock"
+	rostermock "github.com/asyauqi15/payslip-system/internal/usecase/roster/mock"
+	searchmock "github.com/asyauqi15/payslip-system/internal/usecase/search/mock"
+	serviceaccountmock "github.com/asyauqi15/payslip-system/internal/usecase/service_account/mock"
 	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime/types"
 	"go.uber.org/mock/gomock"
 )
@@ -25,10 +37,32 @@ func TestAdminHandler_CreateAttendancePeriod(t *testing.T) {
 
 	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
 	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
 
 	handler := admin.NewHandler(
 		mockAttendancePeriodUsecase,
 		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
 	)
 
 	tests := []struct {
@@ -160,3 +194,86 @@ func TestAdminHandler_CreateAttendancePeriod(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminHandler_DeleteAttendancePeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	tests := []struct {
+		name           string
+		id             string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "empty period deleted",
+			id:   "1",
+			setupMock: func() {
+				mockAttendancePeriodUsecase.EXPECT().DeleteAttendancePeriod(gomock.Any(), int64(1)).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "period with payroll",
+			id:   "2",
+			setupMock: func() {
+				mockAttendancePeriodUsecase.EXPECT().DeleteAttendancePeriod(gomock.Any(), int64(2)).Return(httppkg.NewConflictError("attendance period has a payroll"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "invalid attendance period ID",
+			id:             "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodDelete, "/admin/attendance-periods/"+tt.id, nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.DeleteAttendancePeriod(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+func (h *HandlerImpl) GetAttendancePolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	locationIDStr := chi.URLParam(r, "id")
+	locationID, err := strconv.ParseInt(locationIDStr, 10, 64)
+	if err != nil || locationID <= 0 {
+		logger.Error(ctx, "invalid location ID", "id", locationIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid location ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	policy, err := h.organizationUsecase.GetAttendancePolicy(ctx, locationID)
+	if err != nil {
+		logger.Error(ctx, "failed to get attendance policy", "location_id", locationID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, policy)
+}
+
+func (h *HandlerImpl) UpdateAttendancePolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	locationIDStr := chi.URLParam(r, "id")
+	locationID, err := strconv.ParseInt(locationIDStr, 10, 64)
+	if err != nil || locationID <= 0 {
+		logger.Error(ctx, "invalid location ID", "id", locationIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid location ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	var req v1.AttendancePolicy
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	policy, err := h.organizationUsecase.UpdateAttendancePolicy(ctx, locationID, req)
+	if err != nil {
+		logger.Error(ctx, "failed to update attendance policy", "location_id", locationID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, policy)
+}
+
+func (h *HandlerImpl) UnbindEmployeeDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	employeeIDStr := chi.URLParam(r, "id")
+	employeeID, err := strconv.ParseInt(employeeIDStr, 10, 64)
+	if err != nil || employeeID <= 0 {
+		logger.Error(ctx, "invalid employee ID", "id", employeeIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid employee ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	if err := h.organizationUsecase.UnbindEmployeeDevice(ctx, employeeID); err != nil {
+		logger.Error(ctx, "failed to unbind employee device", "employee_id", employeeID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,264 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/admin"
+	approvalmock "github.com/asyauqi15/payslip-system/internal/usecase/approval/mock"
+	attendanceimportmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_import/mock"
+	attendanceperiodmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_period/mock"
+	authmock "github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	bulkimportmock "github.com/asyauqi15/payslip-system/internal/usecase/bulk_import/mock"
+	exchangeratemock "github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate/mock"
+	organizationmock "github.com/asyauqi15/payslip-system/internal/usecase/organization/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
+	payrollmock "github.com/asyauqi15/payslip-system/internal/usecase/payroll/mock"
+	rolemock "github.com/asyauqi15/payslip-system/internal/usecase/role/mock"
+	rostermock "github.com/asyauqi15/payslip-system/internal/usecase/roster/mock"
+	searchmock "github.com/asyauqi15/payslip-system/internal/usecase/search/mock"
+	serviceaccountmock "github.com/asyauqi15/payslip-system/internal/usecase/service_account/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminHandler_UpdateAttendancePolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	validRequest := v1.AttendancePolicy{
+		Geofences: []v1.Geofence{
+			{Name: "HQ", Latitude: -6.2, Longitude: 106.8166, RadiusMeters: 150},
+		},
+		AllowedNetworks: []string{"10.0.0.0/8"},
+	}
+
+	tests := []struct {
+		name           string
+		locationID     string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+		expectError    bool
+	}{
+		{
+			name:        "successful update",
+			locationID:  "4",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockOrganizationUsecase.EXPECT().
+					UpdateAttendancePolicy(gomock.Any(), int64(4), validRequest).
+					Return(&validRequest, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectError:    false,
+		},
+		{
+			name:           "invalid location ID",
+			locationID:     "abc",
+			requestBody:    validRequest,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:           "invalid request body",
+			locationID:     "4",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:        "invalid IP range",
+			locationID:  "4",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockOrganizationUsecase.EXPECT().
+					UpdateAttendancePolicy(gomock.Any(), int64(4), validRequest).
+					Return(nil, httppkg.NewBadRequestError("invalid IP range \"10.0.0.0/40\""))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/admin/locations/"+tt.locationID+"/attendance-policy", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.locationID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.UpdateAttendancePolicy(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectError {
+				var errorResp v1.DefaultErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &errorResp); err != nil {
+					t.Error("Failed to unmarshal error response:", err)
+				}
+				if errorResp.Error.Message == "" {
+					t.Error("Expected error message in response")
+				}
+			}
+		})
+	}
+}
+
+func TestAdminHandler_UnbindEmployeeDevice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	tests := []struct {
+		name           string
+		employeeID     string
+		setupMock      func()
+		expectedStatus int
+		expectError    bool
+	}{
+		{
+			name:       "successful unbinding",
+			employeeID: "1",
+			setupMock: func() {
+				mockOrganizationUsecase.EXPECT().
+					UnbindEmployeeDevice(gomock.Any(), int64(1)).
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+			expectError:    false,
+		},
+		{
+			name:           "invalid employee ID",
+			employeeID:     "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:       "employee not found",
+			employeeID: "1",
+			setupMock: func() {
+				mockOrganizationUsecase.EXPECT().
+					UnbindEmployeeDevice(gomock.Any(), int64(1)).
+					Return(httppkg.NewNotFoundError("employee not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodDelete, "/admin/employees/"+tt.employeeID+"/device", nil)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.employeeID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.UnbindEmployeeDevice(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectError {
+				var errorResp v1.DefaultErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &errorResp); err != nil {
+					t.Error("Failed to unmarshal error response:", err)
+				}
+				if errorResp.Error.Message == "" {
+					t.Error("Expected error message in response")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+func (h *HandlerImpl) CreateDepartment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req v1.DepartmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	department, err := h.organizationUsecase.CreateDepartment(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "failed to create department", "code", req.Code, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, department)
+}
+
+func (h *HandlerImpl) ListDepartments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	departments, err := h.organizationUsecase.ListDepartments(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to list departments", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, departments)
+}
+
+func (h *HandlerImpl) UpdateDepartment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	departmentIDStr := chi.URLParam(r, "id")
+	departmentID, err := strconv.ParseInt(departmentIDStr, 10, 64)
+	if err != nil || departmentID <= 0 {
+		logger.Error(ctx, "invalid department ID", "id", departmentIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid department ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	var req v1.DepartmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	department, err := h.organizationUsecase.UpdateDepartment(ctx, departmentID, req)
+	if err != nil {
+		logger.Error(ctx, "failed to update department", "department_id", departmentID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, department)
+}
+
+func (h *HandlerImpl) AssignEmployeeOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	employeeIDStr := chi.URLParam(r, "id")
+	employeeID, err := strconv.ParseInt(employeeIDStr, 10, 64)
+	if err != nil || employeeID <= 0 {
+		logger.Error(ctx, "invalid employee ID", "id", employeeIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid employee ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	var req v1.EmployeeOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	err = h.organizationUsecase.AssignEmployeeOrganization(ctx, employeeID, req)
+	if err != nil {
+		logger.Error(ctx, "failed to assign employee organization", "employee_id", employeeID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
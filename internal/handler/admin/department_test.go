@@ -0,0 +1,272 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/admin"
+	approvalmock "github.com/asyauqi15/payslip-system/internal/usecase/approval/mock"
+	attendanceimportmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_import/mock"
+	attendanceperiodmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_period/mock"
+	authmock "github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	bulkimportmock "github.com/asyauqi15/payslip-system/internal/usecase/bulk_import/mock"
+	exchangeratemock "github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate/mock"
+	organizationmock "github.com/asyauqi15/payslip-system/internal/usecase/organization/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
+	payrollmock "github.com/asyauqi15/payslip-system/internal/usecase/payroll/mock"
+	rolemock "github.com/asyauqi15/payslip-system/internal/usecase/role/mock"
+	rostermock "github.com/asyauqi15/payslip-system/internal/usecase/roster/mock"
+	searchmock "github.com/asyauqi15/payslip-system/internal/usecase/search/mock"
+	serviceaccountmock "github.com/asyauqi15/payslip-system/internal/usecase/service_account/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminHandler_CreateDepartment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	validRequest := v1.DepartmentRequest{
+		Code:       "ENG",
+		Name:       "Engineering",
+		CostCenter: "CC-100",
+	}
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+		expectError    bool
+	}{
+		{
+			name:        "successful creation",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockOrganizationUsecase.EXPECT().
+					CreateDepartment(gomock.Any(), validRequest).
+					Return(&v1.Department{Id: 1, Code: "ENG", Name: "Engineering", CostCenter: "CC-100"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectError:    false,
+		},
+		{
+			name:           "invalid request body",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:        "duplicate code",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockOrganizationUsecase.EXPECT().
+					CreateDepartment(gomock.Any(), validRequest).
+					Return(nil, httppkg.NewConflictError("department code already exists"))
+			},
+			expectedStatus: http.StatusConflict,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/departments", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.CreateDepartment(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectError {
+				var errorResp v1.DefaultErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &errorResp); err != nil {
+					t.Error("Failed to unmarshal error response:", err)
+				}
+				if errorResp.Error.Message == "" {
+					t.Error("Expected error message in response")
+				}
+			}
+		})
+	}
+}
+
+func TestAdminHandler_AssignEmployeeOrganization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	departmentID := int64(3)
+	managerID := int64(7)
+	validRequest := v1.EmployeeOrganizationRequest{
+		DepartmentId: &departmentID,
+		ManagerId:    &managerID,
+	}
+
+	tests := []struct {
+		name           string
+		employeeID     string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+		expectError    bool
+	}{
+		{
+			name:        "successful assignment",
+			employeeID:  "1",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockOrganizationUsecase.EXPECT().
+					AssignEmployeeOrganization(gomock.Any(), int64(1), validRequest).
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+			expectError:    false,
+		},
+		{
+			name:           "invalid employee ID",
+			employeeID:     "abc",
+			requestBody:    validRequest,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:           "employee ID zero",
+			employeeID:     "0",
+			requestBody:    validRequest,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:        "reporting line cycle",
+			employeeID:  "1",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockOrganizationUsecase.EXPECT().
+					AssignEmployeeOrganization(gomock.Any(), int64(1), validRequest).
+					Return(httppkg.NewUnprocessableEntityError("reporting line cannot contain cycles"))
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			requestBody, err := json.Marshal(tt.requestBody)
+			if err != nil {
+				t.Fatal("Failed to marshal request body:", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/admin/employees/"+tt.employeeID+"/organization", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.employeeID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.AssignEmployeeOrganization(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectError {
+				var errorResp v1.DefaultErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &errorResp); err != nil {
+					t.Error("Failed to unmarshal error response:", err)
+				}
+				if errorResp.Error.Message == "" {
+					t.Error("Expected error message in response")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+func (h *HandlerImpl) CreateExchangeRate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req v1.ExchangeRateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	rate, err := h.exchangeRateUsecase.CreateExchangeRate(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "failed to create exchange rate", "from", req.FromCurrency, "to", req.ToCurrency, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, rate)
+}
+
+func (h *HandlerImpl) ListExchangeRates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var params v1.GetAdminExchangeRatesParams
+	if from := r.URL.Query().Get("from_currency"); from != "" {
+		params.FromCurrency = &from
+	}
+	if to := r.URL.Query().Get("to_currency"); to != "" {
+		params.ToCurrency = &to
+	}
+
+	rates, err := h.exchangeRateUsecase.ListExchangeRates(ctx, params)
+	if err != nil {
+		logger.Error(ctx, "failed to list exchange rates", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, rates)
+}
+
+func (h *HandlerImpl) AssignEmployeeCurrency(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	employeeIDStr := chi.URLParam(r, "id")
+	employeeID, err := strconv.ParseInt(employeeIDStr, 10, 64)
+	if err != nil || employeeID <= 0 {
+		logger.Error(ctx, "invalid employee ID", "id", employeeIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid employee ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	var req v1.EmployeeCurrencyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	err = h.organizationUsecase.AssignEmployeeCurrency(ctx, employeeID, req)
+	if err != nil {
+		logger.Error(ctx, "failed to assign employee currency", "employee_id", employeeID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,222 @@
+package admin_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/admin"
+	approvalmock "github.com/asyauqi15/payslip-system/internal/usecase/approval/mock"
+	attendanceimportmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_import/mock"
+	attendanceperiodmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_period/mock"
+	authmock "github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	bulkimportmock "github.com/asyauqi15/payslip-system/internal/usecase/bulk_import/mock"
+	exchangeratemock "github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate/mock"
+	organizationmock "github.com/asyauqi15/payslip-system/internal/usecase/organization/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
+	payrollmock "github.com/asyauqi15/payslip-system/internal/usecase/payroll/mock"
+	rolemock "github.com/asyauqi15/payslip-system/internal/usecase/role/mock"
+	rostermock "github.com/asyauqi15/payslip-system/internal/usecase/roster/mock"
+	searchmock "github.com/asyauqi15/payslip-system/internal/usecase/search/mock"
+	serviceaccountmock "github.com/asyauqi15/payslip-system/internal/usecase/service_account/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminHandler_CreateExchangeRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	effectiveDate := openapi_types.Date{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	validRequest := v1.ExchangeRateRequest{FromCurrency: "USD", ToCurrency: "IDR", EffectiveDate: effectiveDate, Rate: "16250.5"}
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+		expectError    bool
+	}{
+		{
+			name:        "successful creation",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockExchangeRateUsecase.EXPECT().
+					CreateExchangeRate(gomock.Any(), validRequest).
+					Return(&v1.ExchangeRate{Id: 1, FromCurrency: "USD", ToCurrency: "IDR", EffectiveDate: effectiveDate, Rate: "16250.5"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectError:    false,
+		},
+		{
+			name:           "invalid request body",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:        "rate already set for the date",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockExchangeRateUsecase.EXPECT().
+					CreateExchangeRate(gomock.Any(), validRequest).
+					Return(nil, httppkg.NewConflictError("USD to IDR already has a rate effective on 2025-01-01"))
+			},
+			expectedStatus: http.StatusConflict,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/exchange-rates", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.CreateExchangeRate(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectError {
+				var errorResp v1.DefaultErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &errorResp); err != nil {
+					t.Error("Failed to unmarshal error response:", err)
+				}
+				if errorResp.Error.Message == "" {
+					t.Error("Expected error message in response")
+				}
+			}
+		})
+	}
+}
+
+func TestAdminHandler_ListExchangeRates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	usd := "USD"
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:  "rates from one currency",
+			query: "?from_currency=USD",
+			setupMock: func() {
+				mockExchangeRateUsecase.EXPECT().
+					ListExchangeRates(gomock.Any(), v1.GetAdminExchangeRatesParams{FromCurrency: &usd}).
+					Return([]v1.ExchangeRate{{Id: 1, FromCurrency: "USD", ToCurrency: "IDR", Rate: "16250.5"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "invalid currency",
+			query: "?to_currency=dollar",
+			setupMock: func() {
+				mockExchangeRateUsecase.EXPECT().
+					ListExchangeRates(gomock.Any(), gomock.Any()).
+					Return(nil, httppkg.NewBadRequestError(`invalid currency "dollar", expected a three-letter code`))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/exchange-rates"+tt.query, nil)
+
+			w := httptest.NewRecorder()
+			handler.ListExchangeRates(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
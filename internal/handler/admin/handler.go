@@ -3,27 +3,120 @@ package admin
 import (
 	"net/http"
 
+	"github.com/asyauqi15/payslip-system/internal/usecase/approval"
+	"github.com/asyauqi15/payslip-system/internal/usecase/attendance_import"
 	"github.com/asyauqi15/payslip-system/internal/usecase/attendance_period"
+	"github.com/asyauqi15/payslip-system/internal/usecase/auth"
+	"github.com/asyauqi15/payslip-system/internal/usecase/bulk_import"
+	"github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate"
+	"github.com/asyauqi15/payslip-system/internal/usecase/organization"
+	"github.com/asyauqi15/payslip-system/internal/usecase/password"
 	"github.com/asyauqi15/payslip-system/internal/usecase/payroll"
+	"github.com/asyauqi15/payslip-system/internal/usecase/role"
+	"github.com/asyauqi15/payslip-system/internal/usecase/roster"
+	"github.com/asyauqi15/payslip-system/internal/usecase/search"
+	"github.com/asyauqi15/payslip-system/internal/usecase/service_account"
 )
 
 type Handler interface {
 	CreateAttendancePeriod(w http.ResponseWriter, r *http.Request)
+	DeleteAttendancePeriod(w http.ResponseWriter, r *http.Request)
 	RunPayroll(w http.ResponseWriter, r *http.Request)
 	GetPayrollSummary(w http.ResponseWriter, r *http.Request)
+	FinalizePayroll(w http.ResponseWriter, r *http.Request)
+	DeletePayroll(w http.ResponseWriter, r *http.Request)
+	ComparePayrolls(w http.ResponseWriter, r *http.Request)
+	ExplainPayslip(w http.ResponseWriter, r *http.Request)
+	CreateDepartment(w http.ResponseWriter, r *http.Request)
+	ListDepartments(w http.ResponseWriter, r *http.Request)
+	UpdateDepartment(w http.ResponseWriter, r *http.Request)
+	AssignEmployeeOrganization(w http.ResponseWriter, r *http.Request)
+	CreateLocation(w http.ResponseWriter, r *http.Request)
+	ListLocations(w http.ResponseWriter, r *http.Request)
+	UpdateLocation(w http.ResponseWriter, r *http.Request)
+	AssignEmployeeLocation(w http.ResponseWriter, r *http.Request)
+	GetAttendancePolicy(w http.ResponseWriter, r *http.Request)
+	UpdateAttendancePolicy(w http.ResponseWriter, r *http.Request)
+	UnbindEmployeeDevice(w http.ResponseWriter, r *http.Request)
+	AssignEmployeeCode(w http.ResponseWriter, r *http.Request)
+	AssignEmployeeCurrency(w http.ResponseWriter, r *http.Request)
+	ImportAttendance(w http.ResponseWriter, r *http.Request)
+	Import(w http.ResponseWriter, r *http.Request)
+	CreateShift(w http.ResponseWriter, r *http.Request)
+	ListShifts(w http.ResponseWriter, r *http.Request)
+	UploadRoster(w http.ResponseWriter, r *http.Request)
+	ListRoster(w http.ResponseWriter, r *http.Request)
+	CreateExchangeRate(w http.ResponseWriter, r *http.Request)
+	ListExchangeRates(w http.ResponseWriter, r *http.Request)
+	ListPermissions(w http.ResponseWriter, r *http.Request)
+	ListRoles(w http.ResponseWriter, r *http.Request)
+	CreateRole(w http.ResponseWriter, r *http.Request)
+	UpdateRole(w http.ResponseWriter, r *http.Request)
+	AssignUserRole(w http.ResponseWriter, r *http.Request)
+	RevokeUserSessions(w http.ResponseWriter, r *http.Request)
+	UnlockUser(w http.ResponseWriter, r *http.Request)
+	ResetTwoFactor(w http.ResponseWriter, r *http.Request)
+	IssuePasswordReset(w http.ResponseWriter, r *http.Request)
+	CreateServiceAccount(w http.ResponseWriter, r *http.Request)
+	ListServiceAccounts(w http.ResponseWriter, r *http.Request)
+	CreateAPIKey(w http.ResponseWriter, r *http.Request)
+	ListAPIKeys(w http.ResponseWriter, r *http.Request)
+	RevokeAPIKey(w http.ResponseWriter, r *http.Request)
+	ListAttendancePeriods(w http.ResponseWriter, r *http.Request)
+	ListPayrolls(w http.ResponseWriter, r *http.Request)
+	ListAttendances(w http.ResponseWriter, r *http.Request)
+	ListOvertimes(w http.ResponseWriter, r *http.Request)
+	ListReimbursements(w http.ResponseWriter, r *http.Request)
+	VoidOvertime(w http.ResponseWriter, r *http.Request)
+	VoidReimbursement(w http.ResponseWriter, r *http.Request)
+	RestoreOvertime(w http.ResponseWriter, r *http.Request)
+	RestoreReimbursement(w http.ResponseWriter, r *http.Request)
 }
 
 type HandlerImpl struct {
 	attendancePeriodUsecase attendance_period.Usecase
 	payrollUsecase          payroll.Usecase
+	organizationUsecase     organization.Usecase
+	roleUsecase             role.Usecase
+	authUsecase             auth.Usecase
+	passwordUsecase         password.Usecase
+	serviceAccountUsecase   service_account.Usecase
+	searchUsecase           search.Usecase
+	approvalUsecase         approval.Usecase
+	rosterUsecase           roster.Usecase
+	attendanceImportUsecase attendance_import.Usecase
+	bulkImportUsecase       bulk_import.Usecase
+	exchangeRateUsecase     exchange_rate.Usecase
 }
 
 func NewHandler(
 	attendancePeriodUsecase attendance_period.Usecase,
 	payrollUsecase payroll.Usecase,
+	organizationUsecase organization.Usecase,
+	roleUsecase role.Usecase,
+	authUsecase auth.Usecase,
+	passwordUsecase password.Usecase,
+	serviceAccountUsecase service_account.Usecase,
+	searchUsecase search.Usecase,
+	approvalUsecase approval.Usecase,
+	rosterUsecase roster.Usecase,
+	attendanceImportUsecase attendance_import.Usecase,
+	bulkImportUsecase bulk_import.Usecase,
+	exchangeRateUsecase exchange_rate.Usecase,
 ) Handler {
 	return &HandlerImpl{
 		attendancePeriodUsecase: attendancePeriodUsecase,
 		payrollUsecase:          payrollUsecase,
+		organizationUsecase:     organizationUsecase,
+		roleUsecase:             roleUsecase,
+		authUsecase:             authUsecase,
+		passwordUsecase:         passwordUsecase,
+		serviceAccountUsecase:   serviceAccountUsecase,
+		searchUsecase:           searchUsecase,
+		approvalUsecase:         approvalUsecase,
+		rosterUsecase:           rosterUsecase,
+		attendanceImportUsecase: attendanceImportUsecase,
+		bulkImportUsecase:       bulkImportUsecase,
+		exchangeRateUsecase:     exchangeRateUsecase,
 	}
 }
@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/asyauqi15/payslip-system/pkg/csvimport"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// Import runs a bulk import. Clients asking for text/csv get the errors as a
+// report file to fix the upload with, instead of the JSON result.
+func (h *HandlerImpl) Import(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	kind := v1.ImportKind(chi.URLParam(r, "kind"))
+
+	dryRun := false
+	if dryRunStr := r.URL.Query().Get("dry_run"); dryRunStr != "" {
+		parsed, err := strconv.ParseBool(dryRunStr)
+		if err != nil {
+			logger.Error(ctx, "invalid dry_run", "dry_run", dryRunStr, "error", err)
+			resp := &v1.DefaultErrorResponse{}
+			resp.Error.Message = "invalid dry_run"
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, resp)
+			return
+		}
+		dryRun = parsed
+	}
+
+	result, err := h.bulkImportUsecase.Import(ctx, kind, http.MaxBytesReader(w, r.Body, maxImportFileSize), dryRun)
+	if err != nil {
+		logger.Error(ctx, "failed to import", "kind", kind, "dry_run", dryRun, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	if strings.Contains(r.Header.Get("Accept"), "text/csv") {
+		errs := make([]csvimport.Error, 0, len(result.Errors))
+		for _, e := range result.Errors {
+			reportErr := csvimport.Error{Line: e.Row, Message: e.Message}
+			if e.Column != nil {
+				reportErr.Column = *e.Column
+			}
+			errs = append(errs, reportErr)
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="`+string(kind)+`-errors.csv"`)
+		w.WriteHeader(http.StatusOK)
+		if err := csvimport.WriteReport(w, errs); err != nil {
+			logger.Error(ctx, "failed to write import report", "kind", kind, "error", err)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, result)
+}
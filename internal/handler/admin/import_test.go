@@ -0,0 +1,166 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/admin"
+	approvalmock "github.com/asyauqi15/payslip-system/internal/usecase/approval/mock"
+	attendanceimportmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_import/mock"
+	attendanceperiodmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_period/mock"
+	authmock "github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	bulkimportmock "github.com/asyauqi15/payslip-system/internal/usecase/bulk_import/mock"
+	exchangeratemock "github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate/mock"
+	organizationmock "github.com/asyauqi15/payslip-system/internal/usecase/organization/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
+	payrollmock "github.com/asyauqi15/payslip-system/internal/usecase/payroll/mock"
+	rolemock "github.com/asyauqi15/payslip-system/internal/usecase/role/mock"
+	rostermock "github.com/asyauqi15/payslip-system/internal/usecase/roster/mock"
+	searchmock "github.com/asyauqi15/payslip-system/internal/usecase/search/mock"
+	serviceaccountmock "github.com/asyauqi15/payslip-system/internal/usecase/service_account/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminHandler_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	file := "username,base_salary\nalice,5000000\nbob,lots\n"
+	column := "base_salary"
+	result := &v1.ImportResult{
+		Kind:     v1.Employees,
+		Rows:     2,
+		Imported: 1,
+		Errors:   []v1.ImportError{{Row: 3, Column: &column, Message: `invalid base salary "lots"`}},
+	}
+
+	tests := []struct {
+		name           string
+		kind           string
+		query          string
+		accept         string
+		setupMock      func()
+		expectedStatus int
+		expectedBody   string
+		expectError    bool
+	}{
+		{
+			name: "successful import",
+			kind: "employees",
+			setupMock: func() {
+				mockBulkImportUsecase.EXPECT().
+					Import(gomock.Any(), v1.Employees, gomock.Any(), false).
+					Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectError:    false,
+		},
+		{
+			name:   "error report",
+			kind:   "employees",
+			query:  "?dry_run=true",
+			accept: "text/csv",
+			setupMock: func() {
+				mockBulkImportUsecase.EXPECT().
+					Import(gomock.Any(), v1.Employees, gomock.Any(), true).
+					Return(result, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "line,column,message\n3,base_salary,\"invalid base salary \"\"lots\"\"\"\n",
+			expectError:    false,
+		},
+		{
+			name:           "invalid dry_run",
+			kind:           "employees",
+			query:          "?dry_run=maybe",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name: "unknown kind",
+			kind: "payslips",
+			setupMock: func() {
+				mockBulkImportUsecase.EXPECT().
+					Import(gomock.Any(), v1.ImportKind("payslips"), gomock.Any(), false).
+					Return(nil, httppkg.NewBadRequestError(`unknown import kind "payslips"`))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/imports/"+tt.kind+tt.query, strings.NewReader(file))
+			req.Header.Set("Content-Type", "text/csv")
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("kind", tt.kind)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.Import(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedBody != "" && w.Body.String() != tt.expectedBody {
+				t.Errorf("Expected body %q but got %q", tt.expectedBody, w.Body.String())
+			}
+
+			if tt.expectError {
+				var errorResp v1.DefaultErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &errorResp); err != nil {
+					t.Error("Failed to unmarshal error response:", err)
+				}
+				if errorResp.Error.Message == "" {
+					t.Error("Expected error message in response")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+func (h *HandlerImpl) CreateLocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req v1.LocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	location, err := h.organizationUsecase.CreateLocation(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "failed to create location", "name", req.Name, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, location)
+}
+
+func (h *HandlerImpl) ListLocations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	locations, err := h.organizationUsecase.ListLocations(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to list locations", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, locations)
+}
+
+func (h *HandlerImpl) UpdateLocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	locationIDStr := chi.URLParam(r, "id")
+	locationID, err := strconv.ParseInt(locationIDStr, 10, 64)
+	if err != nil || locationID <= 0 {
+		logger.Error(ctx, "invalid location ID", "id", locationIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid location ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	var req v1.LocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	location, err := h.organizationUsecase.UpdateLocation(ctx, locationID, req)
+	if err != nil {
+		logger.Error(ctx, "failed to update location", "location_id", locationID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, location)
+}
+
+func (h *HandlerImpl) AssignEmployeeLocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	employeeIDStr := chi.URLParam(r, "id")
+	employeeID, err := strconv.ParseInt(employeeIDStr, 10, 64)
+	if err != nil || employeeID <= 0 {
+		logger.Error(ctx, "invalid employee ID", "id", employeeIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid employee ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	var req v1.EmployeeLocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	err = h.organizationUsecase.AssignEmployeeLocation(ctx, employeeID, req)
+	if err != nil {
+		logger.Error(ctx, "failed to assign employee location", "employee_id", employeeID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
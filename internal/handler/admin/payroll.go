@@ -51,7 +51,7 @@ func (h *HandlerImpl) GetPayrollSummary(w http.ResponseWriter, r *http.Request)
 	// Get payroll ID from URL path
 	payrollIDStr := chi.URLParam(r, "id")
 	payrollID, err := strconv.ParseInt(payrollIDStr, 10, 64)
-	if err != nil {
+	if err != nil || payrollID <= 0 {
 		logger.Error(ctx, "invalid payroll ID", "id", payrollIDStr, "error", err)
 		resp := &v1.DefaultErrorResponse{}
 		resp.Error.Message = "invalid payroll ID"
@@ -60,9 +60,24 @@ func (h *HandlerImpl) GetPayrollSummary(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	logger.Info(ctx, "getting payroll summary", "payroll_id", payrollID)
+	// Optional department filter
+	var departmentID *int64
+	if departmentIDStr := r.URL.Query().Get("department_id"); departmentIDStr != "" {
+		parsed, err := strconv.ParseInt(departmentIDStr, 10, 64)
+		if err != nil {
+			logger.Error(ctx, "invalid department ID", "department_id", departmentIDStr, "error", err)
+			resp := &v1.DefaultErrorResponse{}
+			resp.Error.Message = "invalid department ID"
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, resp)
+			return
+		}
+		departmentID = &parsed
+	}
+
+	logger.Info(ctx, "getting payroll summary", "payroll_id", payrollID, "department_id", departmentID)
 
-	summary, err := h.payrollUsecase.GetPayrollSummary(ctx, payrollID)
+	summary, err := h.payrollUsecase.GetPayrollSummary(ctx, payrollID, departmentID)
 	if err != nil {
 		logger.Error(ctx, "failed to get payroll summary", "payroll_id", payrollID, "error", err)
 		resp := &v1.DefaultErrorResponse{}
@@ -81,3 +96,155 @@ func (h *HandlerImpl) GetPayrollSummary(w http.ResponseWriter, r *http.Request)
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, summary)
 }
+
+func (h *HandlerImpl) FinalizePayroll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	payrollIDStr := chi.URLParam(r, "id")
+	payrollID, err := strconv.ParseInt(payrollIDStr, 10, 64)
+	if err != nil || payrollID <= 0 {
+		logger.Error(ctx, "invalid payroll ID", "id", payrollIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid payroll ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	logger.Info(ctx, "finalizing payroll", "payroll_id", payrollID)
+
+	err = h.payrollUsecase.FinalizePayroll(ctx, payrollID)
+	if err != nil {
+		logger.Error(ctx, "failed to finalize payroll", "payroll_id", payrollID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HandlerImpl) DeletePayroll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	payrollIDStr := chi.URLParam(r, "id")
+	payrollID, err := strconv.ParseInt(payrollIDStr, 10, 64)
+	if err != nil || payrollID <= 0 {
+		logger.Error(ctx, "invalid payroll ID", "id", payrollIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid payroll ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	logger.Info(ctx, "deleting payroll", "payroll_id", payrollID)
+
+	err = h.payrollUsecase.DeletePayroll(ctx, payrollID)
+	if err != nil {
+		logger.Error(ctx, "failed to delete payroll", "payroll_id", payrollID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HandlerImpl) ExplainPayslip(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	payslipIDStr := chi.URLParam(r, "id")
+	payslipID, err := strconv.ParseInt(payslipIDStr, 10, 64)
+	if err != nil || payslipID <= 0 {
+		logger.Error(ctx, "invalid payslip ID", "id", payslipIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid payslip ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	explanation, err := h.payrollUsecase.ExplainPayslip(ctx, payslipID)
+	if err != nil {
+		logger.Error(ctx, "failed to explain payslip", "payslip_id", payslipID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, explanation)
+}
+
+func (h *HandlerImpl) ComparePayrolls(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var params v1.GetAdminPayrollComparisonParams
+	for _, param := range []struct {
+		name  string
+		value **int64
+	}{
+		{"payroll_id", &params.PayrollId},
+		{"attendance_period_id", &params.AttendancePeriodId},
+		{"previous_payroll_id", &params.PreviousPayrollId},
+	} {
+		valueStr := r.URL.Query().Get(param.name)
+		if valueStr == "" {
+			continue
+		}
+		value, err := strconv.ParseInt(valueStr, 10, 64)
+		if err != nil {
+			logger.Error(ctx, "invalid comparison parameter", "parameter", param.name, "value", valueStr, "error", err)
+			resp := &v1.DefaultErrorResponse{}
+			resp.Error.Message = "invalid " + param.name
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, resp)
+			return
+		}
+		*param.value = &value
+	}
+
+	comparison, err := h.payrollUsecase.ComparePayrolls(ctx, params)
+	if err != nil {
+		logger.Error(ctx, "failed to compare payrolls", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, comparison)
+}
@@ -10,8 +10,19 @@ import (
 	"time"
 
 	"github.com/asyauqi15/payslip-system/internal/handler/admin"
+	approvalmock "github.com/asyauqi15/payslip-system/internal/usecase/approval/mock"
+	attendanceimportmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_import/mock"
 	attendanceperiodmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_period/mock"
+	authmock "github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	bulkimportmock "github.com/asyauqi15/payslip-system/internal/usecase/bulk_import/mock"
+	exchangeratemock "github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate/mock"
+	organizationmock "github.com/asyauqi15/payslip-system/internal/usecase/organization/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
 	payrollmock "github.com/asyauqi15/payslip-system/internal/usecase/payroll/mock"
+	rolemock "github.com/asyauqi15/payslip-system/internal/usecase/role/mock"
+	rostermock "github.com/asyauqi15/payslip-system/internal/usecase/roster/mock"
+	searchmock "github.com/asyauqi15/payslip-system/internal/usecase/search/mock"
+	serviceaccountmock "github.com/asyauqi15/payslip-system/internal/usecase/service_account/mock"
 	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 	"github.com/go-chi/chi/v5"
@@ -25,10 +36,32 @@ func TestAdminHandler_RunPayroll(t *testing.T) {
 
 	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
 	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
 
 	handler := admin.NewHandler(
 		mockAttendancePeriodUsecase,
 		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
 	)
 
 	tests := []struct {
@@ -173,15 +206,38 @@ func TestAdminHandler_GetPayrollSummary(t *testing.T) {
 
 	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
 	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
 
 	handler := admin.NewHandler(
 		mockAttendancePeriodUsecase,
 		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
 	)
 
 	tests := []struct {
 		name           string
 		payrollID      string
+		query          string
 		setupMock      func()
 		expectedStatus int
 		expectError    bool
@@ -224,12 +280,32 @@ func TestAdminHandler_GetPayrollSummary(t *testing.T) {
 					},
 				}
 				mockPayrollUsecase.EXPECT().
-					GetPayrollSummary(gomock.Any(), int64(1)).
+					GetPayrollSummary(gomock.Any(), int64(1), gomock.Nil()).
 					Return(summaryResponse, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectError:    false,
 		},
+		{
+			name:      "filtered by department",
+			payrollID: "1",
+			query:     "?department_id=3",
+			setupMock: func() {
+				mockPayrollUsecase.EXPECT().
+					GetPayrollSummary(gomock.Any(), int64(1), gomock.Eq(int64Ptr(3))).
+					Return(&v1.AdminPayrollSummaryResponse{PayrollId: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectError:    false,
+		},
+		{
+			name:           "invalid department ID",
+			payrollID:      "1",
+			query:          "?department_id=abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
 		{
 			name:           "invalid payroll ID",
 			payrollID:      "invalid",
@@ -242,7 +318,7 @@ func TestAdminHandler_GetPayrollSummary(t *testing.T) {
 			payrollID: "999",
 			setupMock: func() {
 				mockPayrollUsecase.EXPECT().
-					GetPayrollSummary(gomock.Any(), int64(999)).
+					GetPayrollSummary(gomock.Any(), int64(999), gomock.Nil()).
 					Return(nil, httppkg.NewNotFoundError("payroll not found"))
 			},
 			expectedStatus: http.StatusNotFound,
@@ -253,7 +329,7 @@ func TestAdminHandler_GetPayrollSummary(t *testing.T) {
 			payrollID: "1",
 			setupMock: func() {
 				mockPayrollUsecase.EXPECT().
-					GetPayrollSummary(gomock.Any(), int64(1)).
+					GetPayrollSummary(gomock.Any(), int64(1), gomock.Nil()).
 					Return(nil, httppkg.NewInternalServerError("database connection failed"))
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -265,7 +341,7 @@ func TestAdminHandler_GetPayrollSummary(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock()
 
-			req := httptest.NewRequest(http.MethodGet, "/admin/payrolls/"+tt.payrollID, nil)
+			req := httptest.NewRequest(http.MethodGet, "/admin/payrolls/"+tt.payrollID+tt.query, nil)
 			req = req.WithContext(context.Background())
 
 			rctx := chi.NewRouteContext()
@@ -292,3 +368,390 @@ func TestAdminHandler_GetPayrollSummary(t *testing.T) {
 		})
 	}
 }
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func TestAdminHandler_DeletePayroll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	tests := []struct {
+		name           string
+		id             string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "draft payroll removed",
+			id:   "1",
+			setupMock: func() {
+				mockPayrollUsecase.EXPECT().DeletePayroll(gomock.Any(), int64(1)).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "finalized payroll",
+			id:   "2",
+			setupMock: func() {
+				mockPayrollUsecase.EXPECT().DeletePayroll(gomock.Any(), int64(2)).Return(httppkg.NewConflictError("only draft payrolls can be removed"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "invalid payroll ID",
+			id:             "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "payroll ID zero",
+			id:             "0",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodDelete, "/admin/payrolls/"+tt.id, nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.DeletePayroll(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_FinalizePayroll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	tests := []struct {
+		name           string
+		id             string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "draft payroll finalized",
+			id:   "1",
+			setupMock: func() {
+				mockPayrollUsecase.EXPECT().FinalizePayroll(gomock.Any(), int64(1)).Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "payroll not found",
+			id:   "999",
+			setupMock: func() {
+				mockPayrollUsecase.EXPECT().FinalizePayroll(gomock.Any(), int64(999)).Return(httppkg.NewNotFoundError("payroll not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/payrolls/"+tt.id, nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.FinalizePayroll(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestAdminHandler_ExplainPayslip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	result := int64(4347826)
+	explanation := &v1.PayslipExplanation{
+		PayslipId:     7,
+		PayrollId:     1,
+		EmployeeId:    1,
+		Currency:      "IDR",
+		BaseSalary:    5000000,
+		WorkingDays:   23,
+		AttendanceIds: []int64{1, 2},
+		Steps: []v1.PayslipCalculationStep{
+			{Name: v1.PayslipCalculationStepName("prorated_salary"), Formula: "5000000 base salary × 20 attendance days / 23 working days", Exact: "4347826.0869565217", Result: &result},
+		},
+		TotalTakeHome: 4347826,
+	}
+
+	tests := []struct {
+		name           string
+		id             string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "payslip explained",
+			id:   "7",
+			setupMock: func() {
+				mockPayrollUsecase.EXPECT().ExplainPayslip(gomock.Any(), int64(7)).Return(explanation, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "no calculation recorded",
+			id:   "8",
+			setupMock: func() {
+				mockPayrollUsecase.EXPECT().ExplainPayslip(gomock.Any(), int64(8)).Return(nil, httppkg.NewNotFoundError("no calculation recorded for this payslip"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid payslip ID",
+			id:             "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "payslip ID zero",
+			id:             "0",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/payslips/"+tt.id+"/explanation", nil)
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.id)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.ExplainPayslip(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus == http.StatusOK {
+				var response v1.PayslipExplanation
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Fatal("Failed to unmarshal response:", err)
+				}
+				if response.PayslipId != 7 || len(response.Steps) != 1 || *response.Steps[0].Result != 4347826 {
+					t.Errorf("Unexpected explanation: %+v", response)
+				}
+			}
+		})
+	}
+}
+
+func TestAdminHandler_ComparePayrolls(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	payrollID := int64(2)
+	comparison := &v1.PayrollComparison{
+		Current:          v1.ComparedPayroll{PayrollId: &payrollID, ReportingCurrency: "IDR", TotalPayroll: 4561462},
+		Previous:         v1.ComparedPayroll{PayrollId: int64Ptr(1), ReportingCurrency: "IDR", TotalPayroll: 4347826},
+		Thresholds:       v1.VarianceThresholds{VariancePercent: 10, VarianceAmount: 100000},
+		Employees:        []v1.EmployeeComparison{},
+		AddedEmployees:   []v1.PayslipAmounts{},
+		RemovedEmployees: []v1.PayslipAmounts{},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:  "payroll compared with a given payroll",
+			query: "?payroll_id=2&previous_payroll_id=1",
+			setupMock: func() {
+				mockPayrollUsecase.EXPECT().
+					ComparePayrolls(gomock.Any(), v1.GetAdminPayrollComparisonParams{PayrollId: &payrollID, PreviousPayrollId: int64Ptr(1)}).
+					Return(comparison, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "preview without a previous payroll",
+			query: "?attendance_period_id=3",
+			setupMock: func() {
+				mockPayrollUsecase.EXPECT().
+					ComparePayrolls(gomock.Any(), v1.GetAdminPayrollComparisonParams{AttendancePeriodId: int64Ptr(3)}).
+					Return(nil, httppkg.NewNotFoundError("no previous payroll to compare with"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid previous payroll ID",
+			query:          "?payroll_id=2&previous_payroll_id=abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/payroll-comparison"+tt.query, nil)
+			w := httptest.NewRecorder()
+			handler.ComparePayrolls(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus == http.StatusOK {
+				var response v1.PayrollComparison
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Fatal("Failed to unmarshal response:", err)
+				}
+				if response.Current.PayrollId == nil || *response.Current.PayrollId != 2 || response.Thresholds.VariancePercent != 10 {
+					t.Errorf("Unexpected comparison: %+v", response)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/render"
+)
+
+func (h *HandlerImpl) CreateShift(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req v1.ShiftRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	shift, err := h.rosterUsecase.CreateShift(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "failed to create shift", "name", req.Name, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, shift)
+}
+
+func (h *HandlerImpl) ListShifts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	shifts, err := h.rosterUsecase.ListShifts(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to list shifts", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, shifts)
+}
+
+func (h *HandlerImpl) UploadRoster(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req v1.RosterUploadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	result, err := h.rosterUsecase.UploadRoster(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "failed to upload roster", "entries", len(req.Entries), "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, result)
+}
+
+func (h *HandlerImpl) ListRoster(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q, err := parseListQuery(r.URL.Query())
+	if err == nil && (q.from == nil || q.to == nil) {
+		err = fmt.Errorf("from and to dates are required")
+	}
+	if err != nil {
+		logger.Error(ctx, "invalid roster query", "query", r.URL.RawQuery, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	entries, err := h.rosterUsecase.ListRoster(ctx, v1.GetAdminRostersParams{
+		EmployeeId: q.employeeID,
+		From:       *q.from,
+		To:         *q.to,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to list roster", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, entries)
+}
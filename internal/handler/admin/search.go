@@ -0,0 +1,296 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/render"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// listQuery holds the query parameters shared by the admin listings. Sort,
+// order and status are passed through as given; the search usecase checks
+// them against what each listing supports.
+type listQuery struct {
+	limit              *int
+	cursor             *string
+	sort               string
+	order              string
+	status             string
+	employeeID         *int64
+	attendancePeriodID *int64
+	from               *openapi_types.Date
+	to                 *openapi_types.Date
+}
+
+func parseListQuery(query url.Values) (listQuery, error) {
+	q := listQuery{
+		sort:   query.Get("sort"),
+		order:  query.Get("order"),
+		status: query.Get("status"),
+	}
+
+	if s := query.Get("limit"); s != "" {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return q, fmt.Errorf("invalid limit")
+		}
+		q.limit = &v
+	}
+
+	if s := query.Get("cursor"); s != "" {
+		q.cursor = &s
+	}
+
+	for _, p := range []struct {
+		name  string
+		label string
+		dst   **int64
+	}{
+		{"employee_id", "employee ID", &q.employeeID},
+		{"attendance_period_id", "attendance period ID", &q.attendancePeriodID},
+	} {
+		if s := query.Get(p.name); s != "" {
+			v, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return q, fmt.Errorf("invalid %s", p.label)
+			}
+			*p.dst = &v
+		}
+	}
+
+	for _, p := range []struct {
+		name string
+		dst  **openapi_types.Date
+	}{{"from", &q.from}, {"to", &q.to}} {
+		if s := query.Get(p.name); s != "" {
+			v, err := time.Parse("2006-01-02", s)
+			if err != nil {
+				return q, fmt.Errorf("invalid %s date, expected YYYY-MM-DD", p.name)
+			}
+			*p.dst = &openapi_types.Date{Time: v}
+		}
+	}
+
+	return q, nil
+}
+
+// optional turns an empty query parameter into an absent one.
+func optional[S ~string](value string) *S {
+	if value == "" {
+		return nil
+	}
+	s := S(value)
+	return &s
+}
+
+func (h *HandlerImpl) ListAttendancePeriods(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q, err := parseListQuery(r.URL.Query())
+	if err != nil {
+		logger.Error(ctx, "invalid list query", "query", r.URL.RawQuery, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	list, err := h.searchUsecase.ListAttendancePeriods(ctx, v1.GetAdminAttendancePeriodsParams{
+		Limit:  q.limit,
+		Cursor: q.cursor,
+		Sort:   optional[v1.GetAdminAttendancePeriodsParamsSort](q.sort),
+		Order:  optional[v1.GetAdminAttendancePeriodsParamsOrder](q.order),
+		From:   q.from,
+		To:     q.to,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to list attendance periods", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, list)
+}
+
+func (h *HandlerImpl) ListPayrolls(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q, err := parseListQuery(r.URL.Query())
+	if err != nil {
+		logger.Error(ctx, "invalid list query", "query", r.URL.RawQuery, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	list, err := h.searchUsecase.ListPayrolls(ctx, v1.GetAdminPayrollsParams{
+		Limit:              q.limit,
+		Cursor:             q.cursor,
+		Sort:               optional[v1.GetAdminPayrollsParamsSort](q.sort),
+		Order:              optional[v1.GetAdminPayrollsParamsOrder](q.order),
+		From:               q.from,
+		To:                 q.to,
+		AttendancePeriodId: q.attendancePeriodID,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to list payrolls", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, list)
+}
+
+func (h *HandlerImpl) ListAttendances(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q, err := parseListQuery(r.URL.Query())
+	if err != nil {
+		logger.Error(ctx, "invalid list query", "query", r.URL.RawQuery, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	list, err := h.searchUsecase.ListAttendances(ctx, v1.GetAdminAttendancesParams{
+		Limit:      q.limit,
+		Cursor:     q.cursor,
+		Sort:       optional[v1.GetAdminAttendancesParamsSort](q.sort),
+		Order:      optional[v1.GetAdminAttendancesParamsOrder](q.order),
+		EmployeeId: q.employeeID,
+		From:       q.from,
+		To:         q.to,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to list attendances", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, list)
+}
+
+func (h *HandlerImpl) ListOvertimes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q, err := parseListQuery(r.URL.Query())
+	if err != nil {
+		logger.Error(ctx, "invalid list query", "query", r.URL.RawQuery, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	list, err := h.searchUsecase.ListOvertimes(ctx, v1.GetAdminOvertimesParams{
+		Limit:      q.limit,
+		Cursor:     q.cursor,
+		Sort:       optional[v1.GetAdminOvertimesParamsSort](q.sort),
+		Order:      optional[v1.GetAdminOvertimesParamsOrder](q.order),
+		EmployeeId: q.employeeID,
+		Status:     optional[v1.GetAdminOvertimesParamsStatus](q.status),
+		From:       q.from,
+		To:         q.to,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to list overtimes", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, list)
+}
+
+func (h *HandlerImpl) ListReimbursements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q, err := parseListQuery(r.URL.Query())
+	if err != nil {
+		logger.Error(ctx, "invalid list query", "query", r.URL.RawQuery, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	list, err := h.searchUsecase.ListReimbursements(ctx, v1.GetAdminReimbursementsParams{
+		Limit:      q.limit,
+		Cursor:     q.cursor,
+		Sort:       optional[v1.GetAdminReimbursementsParamsSort](q.sort),
+		Order:      optional[v1.GetAdminReimbursementsParamsOrder](q.order),
+		EmployeeId: q.employeeID,
+		Status:     optional[v1.GetAdminReimbursementsParamsStatus](q.status),
+		From:       q.from,
+		To:         q.to,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to list reimbursements", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, list)
+}
@@ -0,0 +1,154 @@
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/admin"
+	approvalmock "github.com/asyauqi15/payslip-system/internal/usecase/approval/mock"
+	attendanceimportmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_import/mock"
+	attendanceperiodmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_period/mock"
+	authmock "github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	bulkimportmock "github.com/asyauqi15/payslip-system/internal/usecase/bulk_import/mock"
+	exchangeratemock "github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate/mock"
+	organizationmock "github.com/asyauqi15/payslip-system/internal/usecase/organization/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
+	payrollmock "github.com/asyauqi15/payslip-system/internal/usecase/payroll/mock"
+	rolemock "github.com/asyauqi15/payslip-system/internal/usecase/role/mock"
+	rostermock "github.com/asyauqi15/payslip-system/internal/usecase/roster/mock"
+	searchmock "github.com/asyauqi15/payslip-system/internal/usecase/search/mock"
+	serviceaccountmock "github.com/asyauqi15/payslip-system/internal/usecase/service_account/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminHandler_ListReimbursements(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	limit := 5
+	cursor := "abc"
+	employeeID := int64(2)
+	amount := v1.Amount
+	asc := v1.GetAdminReimbursementsParamsOrderAsc
+	approved := v1.GetAdminReimbursementsParamsStatusApproved
+	from := openapi_types.Date{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	nextCursor := "def"
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:  "query parameters passed to usecase",
+			query: "?limit=5&cursor=abc&sort=amount&order=asc&employee_id=2&status=approved&from=2025-01-01",
+			setupMock: func() {
+				mockSearchUsecase.EXPECT().
+					ListReimbursements(gomock.Any(), v1.GetAdminReimbursementsParams{
+						Limit:      &limit,
+						Cursor:     &cursor,
+						Sort:       &amount,
+						Order:      &asc,
+						EmployeeId: &employeeID,
+						Status:     &approved,
+						From:       &from,
+					}).
+					Return(&v1.ReimbursementListResponse{
+						Data:       []v1.ReimbursementSubmission{{Id: 4, EmployeeId: 2, Amount: 150000}},
+						Pagination: v1.CursorPagination{Limit: 5, NextCursor: &nextCursor},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "invalid cursor",
+			query: "?cursor=stale",
+			setupMock: func() {
+				mockSearchUsecase.EXPECT().
+					ListReimbursements(gomock.Any(), gomock.Any()).
+					Return(nil, httppkg.NewBadRequestError("invalid cursor"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid employee ID",
+			query:          "?employee_id=abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid limit",
+			query:          "?limit=ten",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid date",
+			query:          "?to=2025/01/31",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/reimbursements"+tt.query, nil)
+
+			w := httptest.NewRecorder()
+			handler.ListReimbursements(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if w.Code == http.StatusOK {
+				var response v1.ReimbursementListResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Error("Failed to unmarshal response:", err)
+				}
+				if len(response.Data) != 1 || response.Pagination.NextCursor == nil || *response.Pagination.NextCursor != "def" {
+					t.Errorf("Unexpected response: %+v", response)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+func (h *HandlerImpl) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req v1.ServiceAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	serviceAccount, err := h.serviceAccountUsecase.CreateServiceAccount(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "failed to create service account", "name", req.Name, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, serviceAccount)
+}
+
+func (h *HandlerImpl) ListServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	serviceAccounts, err := h.serviceAccountUsecase.ListServiceAccounts(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to list service accounts", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, serviceAccounts)
+}
+
+func (h *HandlerImpl) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	serviceAccountIDStr := chi.URLParam(r, "id")
+	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
+	if err != nil || serviceAccountID <= 0 {
+		logger.Error(ctx, "invalid service account ID", "id", serviceAccountIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid service account ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	var req v1.APIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	apiKey, err := h.serviceAccountUsecase.CreateAPIKey(ctx, serviceAccountID, req)
+	if err != nil {
+		logger.Error(ctx, "failed to create api key", "service_account_id", serviceAccountID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, apiKey)
+}
+
+func (h *HandlerImpl) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	serviceAccountIDStr := chi.URLParam(r, "id")
+	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
+	if err != nil || serviceAccountID <= 0 {
+		logger.Error(ctx, "invalid service account ID", "id", serviceAccountIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid service account ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	apiKeys, err := h.serviceAccountUsecase.ListAPIKeys(ctx, serviceAccountID)
+	if err != nil {
+		logger.Error(ctx, "failed to list api keys", "service_account_id", serviceAccountID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, apiKeys)
+}
+
+func (h *HandlerImpl) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	serviceAccountIDStr := chi.URLParam(r, "id")
+	serviceAccountID, err := strconv.ParseInt(serviceAccountIDStr, 10, 64)
+	if err != nil || serviceAccountID <= 0 {
+		logger.Error(ctx, "invalid service account ID", "id", serviceAccountIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid service account ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	apiKeyIDStr := chi.URLParam(r, "keyId")
+	apiKeyID, err := strconv.ParseInt(apiKeyIDStr, 10, 64)
+	if err != nil || apiKeyID <= 0 {
+		logger.Error(ctx, "invalid API key ID", "id", apiKeyIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid API key ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	err = h.serviceAccountUsecase.RevokeAPIKey(ctx, serviceAccountID, apiKeyID)
+	if err != nil {
+		logger.Error(ctx, "failed to revoke api key", "service_account_id", serviceAccountID, "api_key_id", apiKeyID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,258 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/handler/admin"
+	approvalmock "github.com/asyauqi15/payslip-system/internal/usecase/approval/mock"
+	attendanceimportmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_import/mock"
+	attendanceperiodmock "github.com/asyauqi15/payslip-system/internal/usecase/attendance_period/mock"
+	authmock "github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	bulkimportmock "github.com/asyauqi15/payslip-system/internal/usecase/bulk_import/mock"
+	exchangeratemock "github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate/mock"
+	organizationmock "github.com/asyauqi15/payslip-system/internal/usecase/organization/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
+	payrollmock "github.com/asyauqi15/payslip-system/internal/usecase/payroll/mock"
+	rolemock "github.com/asyauqi15/payslip-system/internal/usecase/role/mock"
+	rostermock "github.com/asyauqi15/payslip-system/internal/usecase/roster/mock"
+	searchmock "github.com/asyauqi15/payslip-system/internal/usecase/search/mock"
+	serviceaccountmock "github.com/asyauqi15/payslip-system/internal/usecase/service_account/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminHandler_CreateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	request := v1.APIKeyRequest{Name: "payroll export", Scopes: []string{entity.PermissionPayrollRead}}
+
+	tests := []struct {
+		name             string
+		serviceAccountID string
+		requestBody      interface{}
+		setupMock        func()
+		expectedStatus   int
+	}{
+		{
+			name:             "key created",
+			serviceAccountID: "30",
+			requestBody:      request,
+			setupMock: func() {
+				mockServiceAccountUsecase.EXPECT().
+					CreateAPIKey(gomock.Any(), int64(30), request).
+					Return(&v1.APIKeyCreatedResponse{
+						ApiKey: v1.APIKey{Id: 4, Name: "payroll export", Prefix: "psk_0123456789ab", Scopes: request.Scopes},
+						Key:    "psk_0123456789ab.secret",
+					}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:             "scope not held by caller",
+			serviceAccountID: "30",
+			requestBody:      request,
+			setupMock: func() {
+				mockServiceAccountUsecase.EXPECT().
+					CreateAPIKey(gomock.Any(), int64(30), request).
+					Return(nil, httppkg.NewForbiddenError("cannot grant a scope you do not have: payroll:read"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:             "invalid service account ID",
+			serviceAccountID: "abc",
+			requestBody:      request,
+			setupMock:        func() {},
+			expectedStatus:   http.StatusBadRequest,
+		},
+		{
+			name:             "service account ID zero",
+			serviceAccountID: "0",
+			requestBody:      request,
+			setupMock:        func() {},
+			expectedStatus:   http.StatusBadRequest,
+		},
+		{
+			name:             "invalid request body",
+			serviceAccountID: "30",
+			requestBody:      "invalid json",
+			setupMock:        func() {},
+			expectedStatus:   http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/service-accounts/"+tt.serviceAccountID+"/api-keys", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.serviceAccountID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.CreateAPIKey(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if w.Code == http.StatusCreated {
+				var response v1.APIKeyCreatedResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Error("Failed to unmarshal response:", err)
+				}
+				if response.Key != "psk_0123456789ab.secret" || response.ApiKey.Id != 4 {
+					t.Errorf("Unexpected response: %+v", response)
+				}
+			}
+		})
+	}
+}
+
+func TestAdminHandler_RevokeAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodUsecase := attendanceperiodmock.NewMockUsecase(ctrl)
+	mockPayrollUsecase := payrollmock.NewMockUsecase(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockRoleUsecase := rolemock.NewMockUsecase(ctrl)
+	mockAuthUsecase := authmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	mockServiceAccountUsecase := serviceaccountmock.NewMockUsecase(ctrl)
+	mockSearchUsecase := searchmock.NewMockUsecase(ctrl)
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	mockRosterUsecase := rostermock.NewMockUsecase(ctrl)
+	mockAttendanceImportUsecase := attendanceimportmock.NewMockUsecase(ctrl)
+	mockBulkImportUsecase := bulkimportmock.NewMockUsecase(ctrl)
+	mockExchangeRateUsecase := exchangeratemock.NewMockUsecase(ctrl)
+
+	handler := admin.NewHandler(
+		mockAttendancePeriodUsecase,
+		mockPayrollUsecase,
+		mockOrganizationUsecase,
+		mockRoleUsecase,
+		mockAuthUsecase,
+		mockPasswordUsecase,
+		mockServiceAccountUsecase,
+		mockSearchUsecase,
+		mockApprovalUsecase,
+		mockRosterUsecase,
+		mockAttendanceImportUsecase,
+		mockBulkImportUsecase,
+		mockExchangeRateUsecase,
+	)
+
+	tests := []struct {
+		name           string
+		keyID          string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:  "key revoked",
+			keyID: "4",
+			setupMock: func() {
+				mockServiceAccountUsecase.EXPECT().
+					RevokeAPIKey(gomock.Any(), int64(30), int64(4)).
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:  "already revoked",
+			keyID: "4",
+			setupMock: func() {
+				mockServiceAccountUsecase.EXPECT().
+					RevokeAPIKey(gomock.Any(), int64(30), int64(4)).
+					Return(httppkg.NewConflictError("api key is already revoked"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "invalid key ID",
+			keyID:          "abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "key ID zero",
+			keyID:          "0",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodDelete, "/admin/service-accounts/30/api-keys/"+tt.keyID, nil)
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", "30")
+			rctx.URLParams.Add("keyId", tt.keyID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.RevokeAPIKey(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+func (h *HandlerImpl) VoidOvertime(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	overtimeIDStr := chi.URLParam(r, "id")
+	overtimeID, err := strconv.ParseInt(overtimeIDStr, 10, 64)
+	if err != nil || overtimeID <= 0 {
+		logger.Error(ctx, "invalid overtime ID", "id", overtimeIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid overtime ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	logger.Info(ctx, "voiding overtime", "overtime_id", overtimeID)
+
+	err = h.approvalUsecase.VoidOvertime(ctx, overtimeID)
+	if err != nil {
+		logger.Error(ctx, "failed to void overtime", "overtime_id", overtimeID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HandlerImpl) VoidReimbursement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	reimbursementIDStr := chi.URLParam(r, "id")
+	reimbursementID, err := strconv.ParseInt(reimbursementIDStr, 10, 64)
+	if err != nil || reimbursementID <= 0 {
+		logger.Error(ctx, "invalid reimbursement ID", "id", reimbursementIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid reimbursement ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	logger.Info(ctx, "voiding reimbursement", "reimbursement_id", reimbursementID)
+
+	err = h.approvalUsecase.VoidReimbursement(ctx, reimbursementID)
+	if err != nil {
+		logger.Error(ctx, "failed to void reimbursement", "reimbursement_id", reimbursementID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HandlerImpl) RestoreOvertime(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	overtimeIDStr := chi.URLParam(r, "id")
+	overtimeID, err := strconv.ParseInt(overtimeIDStr, 10, 64)
+	if err != nil || overtimeID <= 0 {
+		logger.Error(ctx, "invalid overtime ID", "id", overtimeIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid overtime ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	logger.Info(ctx, "restoring overtime", "overtime_id", overtimeID)
+
+	err = h.approvalUsecase.RestoreOvertime(ctx, overtimeID)
+	if err != nil {
+		logger.Error(ctx, "failed to restore overtime", "overtime_id", overtimeID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HandlerImpl) RestoreReimbursement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	reimbursementIDStr := chi.URLParam(r, "id")
+	reimbursementID, err := strconv.ParseInt(reimbursementIDStr, 10, 64)
+	if err != nil || reimbursementID <= 0 {
+		logger.Error(ctx, "invalid reimbursement ID", "id", reimbursementIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid reimbursement ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	logger.Info(ctx, "restoring reimbursement", "reimbursement_id", reimbursementID)
+
+	err = h.approvalUsecase.RestoreReimbursement(ctx, reimbursementID)
+	if err != nil {
+		logger.Error(ctx, "failed to restore reimbursement", "reimbursement_id", reimbursementID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
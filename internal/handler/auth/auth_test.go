@@ -7,10 +7,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/asyauqi15/payslip-system/internal/handler/auth"
 	authpkg "github.com/asyauqi15/payslip-system/internal/usecase/auth"
 	"github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
 	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 	"go.uber.org/mock/gomock"
@@ -21,14 +23,15 @@ func TestAuthHandler_Login(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockAuthUsecase := mock.NewMockUsecase(ctrl)
-	handler := auth.NewHandler(mockAuthUsecase)
+	handler := auth.NewHandler(mockAuthUsecase, passwordmock.NewMockUsecase(ctrl))
 
 	tests := []struct {
-		name           string
-		requestBody    interface{}
-		setupMock      func()
-		expectedStatus int
-		expectError    bool
+		name               string
+		requestBody        interface{}
+		setupMock          func()
+		expectedStatus     int
+		expectedRetryAfter string
+		expectError        bool
 	}{
 		{
 			name: "successful login",
@@ -48,6 +51,20 @@ func TestAuthHandler_Login(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectError:    false,
 		},
+		{
+			name: "second factor required",
+			requestBody: v1.AuthRequest{
+				Username: "admin",
+				Password: "password123",
+			},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					Auth(gomock.Any(), "admin", "password123").
+					Return(&authpkg.Result{ChallengeToken: "challenge_token_123", ChallengeExpiresAt: time.Now().Add(5 * time.Minute)}, nil)
+			},
+			expectedStatus: http.StatusAccepted,
+			expectError:    false,
+		},
 		{
 			name: "invalid credentials",
 			requestBody: v1.AuthRequest{
@@ -71,7 +88,7 @@ func TestAuthHandler_Login(t *testing.T) {
 			setupMock: func() {
 				mockAuthUsecase.EXPECT().
 					Auth(gomock.Any(), "nonexistent", "password123").
-					Return(nil, httppkg.NewNotFoundError("username or password is incorrect"))
+					Return(nil, httppkg.NewUnauthorizedError("username or password is incorrect"))
 			},
 			expectedStatus: http.StatusUnauthorized,
 			expectError:    true,
@@ -94,7 +111,7 @@ func TestAuthHandler_Login(t *testing.T) {
 					Auth(gomock.Any(), "", "password123").
 					Return(nil, httppkg.NewBadRequestError("username is required"))
 			},
-			expectedStatus: http.StatusUnauthorized,
+			expectedStatus: http.StatusBadRequest,
 			expectError:    true,
 		},
 		{
@@ -108,9 +125,24 @@ func TestAuthHandler_Login(t *testing.T) {
 					Auth(gomock.Any(), "testuser", "").
 					Return(nil, httppkg.NewBadRequestError("password is required"))
 			},
-			expectedStatus: http.StatusUnauthorized,
+			expectedStatus: http.StatusBadRequest,
 			expectError:    true,
 		},
+		{
+			name: "too many failed attempts",
+			requestBody: v1.AuthRequest{
+				Username: "testuser",
+				Password: "password123",
+			},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					Auth(gomock.Any(), "testuser", "password123").
+					Return(nil, httppkg.NewTooManyRequestsError("too many failed login attempts, try again later", 1500*time.Millisecond))
+			},
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedRetryAfter: "2",
+			expectError:        true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -145,6 +177,10 @@ func TestAuthHandler_Login(t *testing.T) {
 				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
 			}
 
+			if retryAfter := w.Header().Get("Retry-After"); retryAfter != tt.expectedRetryAfter {
+				t.Errorf("Expected Retry-After %q but got %q", tt.expectedRetryAfter, retryAfter)
+			}
+
 			if !tt.expectError {
 				// For successful requests, verify response structure
 				if w.Code == http.StatusOK {
@@ -167,6 +203,20 @@ func TestAuthHandler_Login(t *testing.T) {
 						t.Errorf("Expected Content-Type application/json but got %s", contentType)
 					}
 				}
+
+				// A pending second factor returns only the challenge
+				if w.Code == http.StatusAccepted {
+					var response v1.TwoFactorChallengeResponse
+					if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+						t.Error("Failed to unmarshal response:", err)
+					}
+					if response.ChallengeToken != "challenge_token_123" {
+						t.Errorf("Unexpected challenge response: %+v", response)
+					}
+					if bytes.Contains(w.Body.Bytes(), []byte("access_token")) {
+						t.Error("Expected no access token before the second factor")
+					}
+				}
 			}
 		})
 	}
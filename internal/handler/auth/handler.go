@@ -2,19 +2,32 @@ package auth
 
 import (
 	authusecase "github.com/asyauqi15/payslip-system/internal/usecase/auth"
+	"github.com/asyauqi15/payslip-system/internal/usecase/password"
 	"net/http"
 )
 
 type Handler interface {
 	Login(w http.ResponseWriter, r *http.Request)
+	LoginTwoFactor(w http.ResponseWriter, r *http.Request)
+	StartOIDCLogin(w http.ResponseWriter, r *http.Request)
+	CompleteOIDCLogin(w http.ResponseWriter, r *http.Request)
+	Refresh(w http.ResponseWriter, r *http.Request)
+	Logout(w http.ResponseWriter, r *http.Request)
+	ChangePassword(w http.ResponseWriter, r *http.Request)
+	ResetPassword(w http.ResponseWriter, r *http.Request)
+	EnrollTwoFactor(w http.ResponseWriter, r *http.Request)
+	ActivateTwoFactor(w http.ResponseWriter, r *http.Request)
+	DisableTwoFactor(w http.ResponseWriter, r *http.Request)
 }
 
 type HandlerImpl struct {
-	authUsecase authusecase.Usecase
+	authUsecase     authusecase.Usecase
+	passwordUsecase password.Usecase
 }
 
-func NewHandler(authUsecase authusecase.Usecase) Handler {
+func NewHandler(authUsecase authusecase.Usecase, passwordUsecase password.Usecase) Handler {
 	return &HandlerImpl{
-		authUsecase: authUsecase,
+		authUsecase:     authUsecase,
+		passwordUsecase: passwordUsecase,
 	}
 }
@@ -2,8 +2,12 @@ package auth
 
 import (
 	"encoding/json"
+	"math"
 	"net/http"
+	"strconv"
 
+	authusecase "github.com/asyauqi15/payslip-system/internal/usecase/auth"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
 	"github.com/asyauqi15/payslip-system/pkg/logger"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 	"github.com/go-chi/render"
@@ -24,18 +28,84 @@ func (h *HandlerImpl) Login(w http.ResponseWriter, r *http.Request) {
 	result, err := h.authUsecase.Auth(ctx, req.Username, req.Password)
 	if err != nil {
 		logger.Error(ctx, "login failed", "username", req.Username, "error", err)
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(*httppkg.ErrorWrapper); ok && httpErr.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(httpErr.RetryAfter.Seconds()))))
+		}
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
 		return
 	}
 
-	logger.Info(ctx, "login successful", "username", req.Username)
+	// The password was correct but the second factor is still missing
+	if result.ChallengeToken != "" {
+		logger.Info(ctx, "login requires second factor", "username", req.Username)
 
-	response := v1.AuthResponse{
-		AccessToken:  result.AccessToken,
-		RefreshToken: result.RefreshToken,
+		render.Status(r, http.StatusAccepted)
+		render.JSON(w, r, v1.TwoFactorChallengeResponse{
+			ChallengeToken: result.ChallengeToken,
+			ExpiresAt:      result.ChallengeExpiresAt,
+		})
+		return
 	}
 
+	logger.Info(ctx, "login successful", "username", req.Username)
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	render.JSON(w, r, response)
+	render.JSON(w, r, toAuthResponse(result))
+}
+
+func (h *HandlerImpl) LoginTwoFactor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req v1.TwoFactorLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode two-factor login request", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	result, err := h.authUsecase.VerifyLoginChallenge(ctx, req.ChallengeToken, req.Code)
+	if err != nil {
+		logger.Error(ctx, "two-factor login failed", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(*httppkg.ErrorWrapper); ok && httpErr.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(httpErr.RetryAfter.Seconds()))))
+		}
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, toAuthResponse(result))
+}
+
+func toAuthResponse(result *authusecase.Result) v1.AuthResponse {
+	return v1.AuthResponse{
+		AccessToken:                 result.AccessToken,
+		RefreshToken:                result.RefreshToken,
+		PasswordChangeRequired:      result.PasswordChangeRequired,
+		TwoFactorEnrollmentRequired: result.TwoFactorEnrollmentRequired,
+	}
 }
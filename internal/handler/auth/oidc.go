@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/render"
+)
+
+func (h *HandlerImpl) StartOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	authorization, err := h.authUsecase.StartOIDCLogin(ctx)
+	if err != nil {
+		logger.Error(ctx, "failed to start single sign-on", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, v1.OIDCAuthorizationResponse{
+		AuthorizationUrl: authorization.AuthorizationURL,
+		ExpiresAt:        authorization.ExpiresAt,
+	})
+}
+
+func (h *HandlerImpl) CompleteOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req v1.OIDCCallbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode oidc callback request", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	result, err := h.authUsecase.CompleteOIDCLogin(ctx, req.Code, req.State)
+	if err != nil {
+		logger.Error(ctx, "single sign-on failed", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	// The account has two-factor authentication enabled
+	if result.ChallengeToken != "" {
+		render.Status(r, http.StatusAccepted)
+		render.JSON(w, r, v1.TwoFactorChallengeResponse{
+			ChallengeToken: result.ChallengeToken,
+			ExpiresAt:      result.ChallengeExpiresAt,
+		})
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, toAuthResponse(result))
+}
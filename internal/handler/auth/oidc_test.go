@@ -0,0 +1,177 @@
+package auth_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/auth"
+	authpkg "github.com/asyauqi15/payslip-system/internal/usecase/auth"
+	"github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthHandler_StartOIDCLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthUsecase := mock.NewMockUsecase(ctrl)
+	handler := auth.NewHandler(mockAuthUsecase, passwordmock.NewMockUsecase(ctrl))
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "login started",
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					StartOIDCLogin(gomock.Any()).
+					Return(&authpkg.OIDCAuthorization{
+						AuthorizationURL: "https://login.example.com/authorize?state=abc",
+						ExpiresAt:        time.Now().Add(10 * time.Minute),
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "single sign-on disabled",
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					StartOIDCLogin(gomock.Any()).
+					Return(nil, httppkg.NewNotFoundError("single sign-on is not enabled"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/oidc/login", nil)
+
+			w := httptest.NewRecorder()
+			handler.StartOIDCLogin(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if w.Code == http.StatusOK {
+				var response v1.OIDCAuthorizationResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Error("Failed to unmarshal response:", err)
+				}
+				if response.AuthorizationUrl != "https://login.example.com/authorize?state=abc" {
+					t.Errorf("Unexpected authorization URL: %s", response.AuthorizationUrl)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthHandler_CompleteOIDCLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthUsecase := mock.NewMockUsecase(ctrl)
+	handler := auth.NewHandler(mockAuthUsecase, passwordmock.NewMockUsecase(ctrl))
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:        "successful login",
+			requestBody: v1.OIDCCallbackRequest{Code: "code_123", State: "state_123"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					CompleteOIDCLogin(gomock.Any(), "code_123", "state_123").
+					Return(&authpkg.Result{AccessToken: "access_token_456", RefreshToken: "refresh_token_456"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "second factor required",
+			requestBody: v1.OIDCCallbackRequest{Code: "code_123", State: "state_123"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					CompleteOIDCLogin(gomock.Any(), "code_123", "state_123").
+					Return(&authpkg.Result{ChallengeToken: "challenge_token_123", ChallengeExpiresAt: time.Now().Add(5 * time.Minute)}, nil)
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:        "no linked account",
+			requestBody: v1.OIDCCallbackRequest{Code: "code_123", State: "state_123"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					CompleteOIDCLogin(gomock.Any(), "code_123", "state_123").
+					Return(nil, httppkg.NewForbiddenError("no account is linked to this identity"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "invalid request body",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/oidc/callback", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.CompleteOIDCLogin(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			switch w.Code {
+			case http.StatusOK:
+				var response v1.AuthResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Error("Failed to unmarshal response:", err)
+				}
+				if response.AccessToken != "access_token_456" || response.RefreshToken != "refresh_token_456" {
+					t.Errorf("Unexpected token pair in response: %+v", response)
+				}
+			case http.StatusAccepted:
+				var response v1.TwoFactorChallengeResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Error("Failed to unmarshal response:", err)
+				}
+				if response.ChallengeToken != "challenge_token_123" {
+					t.Errorf("Unexpected challenge in response: %+v", response)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package auth_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/auth"
+	"github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthHandler_ChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	handler := auth.NewHandler(mock.NewMockUsecase(ctrl), mockPasswordUsecase)
+
+	validRequest := v1.ChangePasswordRequest{CurrentPassword: "Current-Pass1", NewPassword: "Brand-New-Pass1"}
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:        "successful change",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockPasswordUsecase.EXPECT().
+					ChangePassword(gomock.Any(), validRequest).
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:        "policy violation",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockPasswordUsecase.EXPECT().
+					ChangePassword(gomock.Any(), validRequest).
+					Return(httppkg.NewUnprocessableEntityError("password must contain a digit"))
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "invalid request body",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/password", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.ChangePassword(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_ResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+	handler := auth.NewHandler(mock.NewMockUsecase(ctrl), mockPasswordUsecase)
+
+	validRequest := v1.PasswordResetRequest{Token: "reset-token", NewPassword: "Brand-New-Pass1"}
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:        "successful reset",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockPasswordUsecase.EXPECT().
+					ResetPassword(gomock.Any(), validRequest).
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:        "expired token",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockPasswordUsecase.EXPECT().
+					ResetPassword(gomock.Any(), validRequest).
+					Return(httppkg.NewBadRequestError("invalid or expired reset token"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid request body",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/password/reset", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.ResetPassword(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
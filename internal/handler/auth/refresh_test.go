@@ -0,0 +1,165 @@
+package auth_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/auth"
+	authpkg "github.com/asyauqi15/payslip-system/internal/usecase/auth"
+	"github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthHandler_Refresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthUsecase := mock.NewMockUsecase(ctrl)
+	handler := auth.NewHandler(mockAuthUsecase, passwordmock.NewMockUsecase(ctrl))
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:        "successful refresh",
+			requestBody: v1.RefreshTokenRequest{RefreshToken: "refresh_token_123"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					Refresh(gomock.Any(), "refresh_token_123").
+					Return(&authpkg.Result{AccessToken: "access_token_456", RefreshToken: "refresh_token_456"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "reused refresh token",
+			requestBody: v1.RefreshTokenRequest{RefreshToken: "refresh_token_123"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					Refresh(gomock.Any(), "refresh_token_123").
+					Return(nil, httppkg.NewUnauthorizedError("refresh token reuse detected"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid request body",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/refresh", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.Refresh(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if w.Code == http.StatusOK {
+				var response v1.AuthResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Error("Failed to unmarshal response:", err)
+				}
+				if response.AccessToken != "access_token_456" || response.RefreshToken != "refresh_token_456" {
+					t.Errorf("Unexpected token pair in response: %+v", response)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthHandler_Logout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthUsecase := mock.NewMockUsecase(ctrl)
+	handler := auth.NewHandler(mockAuthUsecase, passwordmock.NewMockUsecase(ctrl))
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:        "successful logout",
+			requestBody: v1.RefreshTokenRequest{RefreshToken: "refresh_token_123"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					Logout(gomock.Any(), "refresh_token_123").
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:        "invalid refresh token",
+			requestBody: v1.RefreshTokenRequest{RefreshToken: "garbage"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					Logout(gomock.Any(), "garbage").
+					Return(httppkg.NewUnauthorizedError("invalid refresh token"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid request body",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/logout", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.Logout(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
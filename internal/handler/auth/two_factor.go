@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/render"
+)
+
+func (h *HandlerImpl) EnrollTwoFactor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	enrollment, err := h.authUsecase.EnrollTwoFactor(ctx)
+	if err != nil {
+		logger.Error(ctx, "two-factor enrollment failed", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, v1.TwoFactorEnrollmentResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningUri: enrollment.ProvisioningURI,
+	})
+}
+
+func (h *HandlerImpl) ActivateTwoFactor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req v1.TwoFactorCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode two-factor activation request", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	codes, err := h.authUsecase.ActivateTwoFactor(ctx, req.Code)
+	if err != nil {
+		logger.Error(ctx, "two-factor activation failed", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, v1.TwoFactorRecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+func (h *HandlerImpl) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req v1.TwoFactorCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode two-factor disable request", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	if err := h.authUsecase.DisableTwoFactor(ctx, req.Code); err != nil {
+		logger.Error(ctx, "two-factor disable failed", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
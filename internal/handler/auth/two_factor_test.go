@@ -0,0 +1,318 @@
+package auth_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/auth"
+	authpkg "github.com/asyauqi15/payslip-system/internal/usecase/auth"
+	"github.com/asyauqi15/payslip-system/internal/usecase/auth/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthHandler_LoginTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthUsecase := mock.NewMockUsecase(ctrl)
+	handler := auth.NewHandler(mockAuthUsecase, passwordmock.NewMockUsecase(ctrl))
+
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		setupMock          func()
+		expectedStatus     int
+		expectedRetryAfter string
+	}{
+		{
+			name:        "successful login",
+			requestBody: v1.TwoFactorLoginRequest{ChallengeToken: "challenge_token_123", Code: "123456"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					VerifyLoginChallenge(gomock.Any(), "challenge_token_123", "123456").
+					Return(&authpkg.Result{AccessToken: "access_token_456", RefreshToken: "refresh_token_456"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "invalid code",
+			requestBody: v1.TwoFactorLoginRequest{ChallengeToken: "challenge_token_123", Code: "000000"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					VerifyLoginChallenge(gomock.Any(), "challenge_token_123", "000000").
+					Return(nil, httppkg.NewUnauthorizedError("invalid two-factor code"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:        "username locked out",
+			requestBody: v1.TwoFactorLoginRequest{ChallengeToken: "challenge_token_123", Code: "123456"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					VerifyLoginChallenge(gomock.Any(), "challenge_token_123", "123456").
+					Return(nil, httppkg.NewTooManyRequestsError("too many failed login attempts, try again later", 10*time.Second))
+			},
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedRetryAfter: "10",
+		},
+		{
+			name:           "invalid request body",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/login/2fa", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.LoginTwoFactor(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+			if retryAfter := w.Header().Get("Retry-After"); retryAfter != tt.expectedRetryAfter {
+				t.Errorf("Expected Retry-After %q but got %q", tt.expectedRetryAfter, retryAfter)
+			}
+
+			if w.Code == http.StatusOK {
+				var response v1.AuthResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Error("Failed to unmarshal response:", err)
+				}
+				if response.AccessToken != "access_token_456" || response.RefreshToken != "refresh_token_456" {
+					t.Errorf("Unexpected token pair in response: %+v", response)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthHandler_EnrollTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthUsecase := mock.NewMockUsecase(ctrl)
+	handler := auth.NewHandler(mockAuthUsecase, passwordmock.NewMockUsecase(ctrl))
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "successful enrollment",
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					EnrollTwoFactor(gomock.Any()).
+					Return(&authpkg.TwoFactorEnrollment{Secret: "JBSWY3DPEHPK3PXP", ProvisioningURI: "otpauth://totp/Payslip%20System:admin?secret=JBSWY3DPEHPK3PXP"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "already enabled",
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					EnrollTwoFactor(gomock.Any()).
+					Return(nil, httppkg.NewConflictError("two-factor authentication is already enabled"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/2fa/enroll", nil)
+
+			w := httptest.NewRecorder()
+			handler.EnrollTwoFactor(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if w.Code == http.StatusOK {
+				var response v1.TwoFactorEnrollmentResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Error("Failed to unmarshal response:", err)
+				}
+				if response.Secret == "" || response.ProvisioningUri == "" {
+					t.Errorf("Unexpected enrollment response: %+v", response)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthHandler_ActivateTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthUsecase := mock.NewMockUsecase(ctrl)
+	handler := auth.NewHandler(mockAuthUsecase, passwordmock.NewMockUsecase(ctrl))
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:        "successful activation",
+			requestBody: v1.TwoFactorCodeRequest{Code: "123456"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					ActivateTwoFactor(gomock.Any(), "123456").
+					Return([]string{"abcde-12345", "fghij-67890"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "invalid code",
+			requestBody: v1.TwoFactorCodeRequest{Code: "000000"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					ActivateTwoFactor(gomock.Any(), "000000").
+					Return(nil, httppkg.NewUnprocessableEntityError("invalid two-factor code"))
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "invalid request body",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/2fa/activate", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.ActivateTwoFactor(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if w.Code == http.StatusOK {
+				var response v1.TwoFactorRecoveryCodesResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Error("Failed to unmarshal response:", err)
+				}
+				if len(response.RecoveryCodes) != 2 {
+					t.Errorf("Unexpected recovery codes: %v", response.RecoveryCodes)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthHandler_DisableTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthUsecase := mock.NewMockUsecase(ctrl)
+	handler := auth.NewHandler(mockAuthUsecase, passwordmock.NewMockUsecase(ctrl))
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:        "successful disable",
+			requestBody: v1.TwoFactorCodeRequest{Code: "123456"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					DisableTwoFactor(gomock.Any(), "123456").
+					Return(nil)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:        "required by role",
+			requestBody: v1.TwoFactorCodeRequest{Code: "123456"},
+			setupMock: func() {
+				mockAuthUsecase.EXPECT().
+					DisableTwoFactor(gomock.Any(), "123456").
+					Return(httppkg.NewForbiddenError("two-factor authentication is required for your role"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "invalid request body",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/2fa/disable", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			handler.DisableTwoFactor(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
@@ -12,7 +12,7 @@ import (
 func (h *HandlerImpl) SubmitAttendance(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var req v1.PostEmployeeAttendanceJSONBody
+	var req v1.AttendanceRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		logger.Error(ctx, "failed to decode request body", "error", err)
 		resp := &v1.DefaultErrorResponse{}
@@ -22,7 +22,7 @@ func (h *HandlerImpl) SubmitAttendance(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.attendanceUsecase.SubmitAttendance(ctx, req.AttendanceType)
+	result, err := h.attendanceUsecase.SubmitAttendance(ctx, req)
 	if err != nil {
 		logger.Error(ctx, "failed to submit attendance", "error", err)
 		resp := &v1.DefaultErrorResponse{}
@@ -38,5 +38,39 @@ func (h *HandlerImpl) SubmitAttendance(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.WriteHeader(http.StatusCreated)
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, result)
+}
+
+func (h *HandlerImpl) SubmitAttendanceCorrection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req v1.AttendanceCorrectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	correction, err := h.attendanceUsecase.SubmitCorrection(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "failed to submit attendance correction", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, correction)
 }
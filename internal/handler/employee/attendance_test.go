@@ -7,15 +7,18 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/asyauqi15/payslip-system/internal/constant"
 	"github.com/asyauqi15/payslip-system/internal/handler/employee"
 	attendancemock "github.com/asyauqi15/payslip-system/internal/usecase/attendance/mock"
+	historymock "github.com/asyauqi15/payslip-system/internal/usecase/history/mock"
 	overtimemock "github.com/asyauqi15/payslip-system/internal/usecase/overtime/mock"
 	payslipmock "github.com/asyauqi15/payslip-system/internal/usecase/payslip/mock"
 	reimbursementmock "github.com/asyauqi15/payslip-system/internal/usecase/reimbursement/mock"
 	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"go.uber.org/mock/gomock"
 )
 
@@ -27,14 +30,18 @@ func TestEmployeeHandler_SubmitAttendance(t *testing.T) {
 	mockOvertimeUsecase := overtimemock.NewMockUsecase(ctrl)
 	mockPayslipUsecase := payslipmock.NewMockUsecase(ctrl)
 	mockReimbursementUsecase := reimbursementmock.NewMockUsecase(ctrl)
+	mockHistoryUsecase := historymock.NewMockUsecase(ctrl)
 
 	handler := employee.NewHandler(
 		mockAttendanceUsecase,
 		mockOvertimeUsecase,
 		mockPayslipUsecase,
 		mockReimbursementUsecase,
+		mockHistoryUsecase,
 	)
 
+	deviceID := "phone-2"
+
 	tests := []struct {
 		name           string
 		requestBody    interface{}
@@ -45,32 +52,53 @@ func TestEmployeeHandler_SubmitAttendance(t *testing.T) {
 	}{
 		{
 			name: "successful check-in",
-			requestBody: v1.PostEmployeeAttendanceJSONBody{
-				AttendanceType: v1.CheckIn,
+			requestBody: v1.AttendanceRequest{
+				AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn,
 			},
 			setupContext: func() context.Context {
 				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
 			},
 			setupMock: func() {
 				mockAttendanceUsecase.EXPECT().
-					SubmitAttendance(gomock.Any(), v1.CheckIn).
-					Return(nil)
+					SubmitAttendance(gomock.Any(), v1.AttendanceRequest{AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn}).
+					Return(&v1.AttendanceResult{}, nil)
 			},
 			expectedStatus: http.StatusCreated,
 			expectError:    false,
 		},
 		{
 			name: "successful check-out",
-			requestBody: v1.PostEmployeeAttendanceJSONBody{
-				AttendanceType: v1.CheckOut,
+			requestBody: v1.AttendanceRequest{
+				AttendanceType: v1.AttendanceRequestAttendanceTypeCheckOut,
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				mockAttendanceUsecase.EXPECT().
+					SubmitAttendance(gomock.Any(), v1.AttendanceRequest{AttendanceType: v1.AttendanceRequestAttendanceTypeCheckOut}).
+					Return(&v1.AttendanceResult{}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectError:    false,
+		},
+		{
+			name: "check-in flagged for review",
+			requestBody: v1.AttendanceRequest{
+				AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn,
+				DeviceId:       &deviceID,
 			},
 			setupContext: func() context.Context {
 				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
 			},
 			setupMock: func() {
+				violations := []v1.AttendanceViolationKind{v1.DeviceMismatch}
 				mockAttendanceUsecase.EXPECT().
-					SubmitAttendance(gomock.Any(), v1.CheckOut).
-					Return(nil)
+					SubmitAttendance(gomock.Any(), v1.AttendanceRequest{
+						AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn,
+						DeviceId:       &deviceID,
+					}).
+					Return(&v1.AttendanceResult{Flagged: true, Violations: &violations}, nil)
 			},
 			expectedStatus: http.StatusCreated,
 			expectError:    false,
@@ -85,48 +113,48 @@ func TestEmployeeHandler_SubmitAttendance(t *testing.T) {
 		},
 		{
 			name: "weekend attendance error",
-			requestBody: v1.PostEmployeeAttendanceJSONBody{
-				AttendanceType: v1.CheckIn,
+			requestBody: v1.AttendanceRequest{
+				AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn,
 			},
 			setupContext: func() context.Context {
 				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
 			},
 			setupMock: func() {
 				mockAttendanceUsecase.EXPECT().
-					SubmitAttendance(gomock.Any(), v1.CheckIn).
-					Return(httppkg.NewBadRequestError("attendance not allowed on weekends"))
+					SubmitAttendance(gomock.Any(), v1.AttendanceRequest{AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn}).
+					Return(nil, httppkg.NewBadRequestError("attendance not allowed on weekends"))
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectError:    true,
 		},
 		{
 			name: "already checked in error",
-			requestBody: v1.PostEmployeeAttendanceJSONBody{
-				AttendanceType: v1.CheckIn,
+			requestBody: v1.AttendanceRequest{
+				AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn,
 			},
 			setupContext: func() context.Context {
 				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
 			},
 			setupMock: func() {
 				mockAttendanceUsecase.EXPECT().
-					SubmitAttendance(gomock.Any(), v1.CheckIn).
-					Return(httppkg.NewBadRequestError("already checked in today"))
+					SubmitAttendance(gomock.Any(), v1.AttendanceRequest{AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn}).
+					Return(nil, httppkg.NewBadRequestError("already checked in today"))
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectError:    true,
 		},
 		{
 			name: "unauthorized user",
-			requestBody: v1.PostEmployeeAttendanceJSONBody{
-				AttendanceType: v1.CheckIn,
+			requestBody: v1.AttendanceRequest{
+				AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn,
 			},
 			setupContext: func() context.Context {
 				return context.Background()
 			},
 			setupMock: func() {
 				mockAttendanceUsecase.EXPECT().
-					SubmitAttendance(gomock.Any(), v1.CheckIn).
-					Return(httppkg.NewUnauthorizedError("user not authenticated"))
+					SubmitAttendance(gomock.Any(), v1.AttendanceRequest{AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn}).
+					Return(nil, httppkg.NewUnauthorizedError("user not authenticated"))
 			},
 			expectedStatus: http.StatusUnauthorized,
 			expectError:    true,
@@ -172,3 +200,101 @@ func TestEmployeeHandler_SubmitAttendance(t *testing.T) {
 		})
 	}
 }
+
+func TestEmployeeHandler_SubmitAttendanceCorrection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendanceUsecase := attendancemock.NewMockUsecase(ctrl)
+
+	handler := employee.NewHandler(
+		mockAttendanceUsecase,
+		overtimemock.NewMockUsecase(ctrl),
+		payslipmock.NewMockUsecase(ctrl),
+		reimbursementmock.NewMockUsecase(ctrl),
+		historymock.NewMockUsecase(ctrl),
+	)
+
+	clockOut := time.Date(2025, 1, 6, 17, 0, 0, 0, time.UTC)
+	validRequest := v1.AttendanceCorrectionRequest{
+		Date:         openapi_types.Date{Time: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)},
+		ClockOutTime: &clockOut,
+		Reason:       "App was down",
+	}
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+		expectError    bool
+	}{
+		{
+			name:        "correction submitted",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockAttendanceUsecase.EXPECT().
+					SubmitCorrection(gomock.Any(), gomock.Any()).
+					Return(&v1.AttendanceCorrectionSubmission{Id: 8, Status: v1.AttendanceCorrectionSubmissionStatusPending}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectError:    false,
+		},
+		{
+			name:           "invalid request body",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:        "correction already pending",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockAttendanceUsecase.EXPECT().
+					SubmitCorrection(gomock.Any(), gomock.Any()).
+					Return(nil, httppkg.NewConflictError("a correction for this date is already pending"))
+			},
+			expectedStatus: http.StatusConflict,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/employee/attendance/corrections", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			req = req.WithContext(context.WithValue(req.Context(), constant.ContextKeyUserID, int64(1)))
+
+			w := httptest.NewRecorder()
+			handler.SubmitAttendanceCorrection(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectError {
+				var errorResp v1.DefaultErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &errorResp); err != nil {
+					t.Error("Failed to unmarshal error response:", err)
+				}
+				if errorResp.Error.Message == "" {
+					t.Error("Expected error message in response")
+				}
+			}
+		})
+	}
+}
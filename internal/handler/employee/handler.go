@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/asyauqi15/payslip-system/internal/usecase/attendance"
+	"github.com/asyauqi15/payslip-system/internal/usecase/history"
 	"github.com/asyauqi15/payslip-system/internal/usecase/overtime"
 	"github.com/asyauqi15/payslip-system/internal/usecase/payslip"
 	"github.com/asyauqi15/payslip-system/internal/usecase/reimbursement"
@@ -11,9 +12,14 @@ import (
 
 type Handler interface {
 	SubmitAttendance(w http.ResponseWriter, r *http.Request)
+	SubmitAttendanceCorrection(w http.ResponseWriter, r *http.Request)
 	SubmitOvertime(w http.ResponseWriter, r *http.Request)
 	GetPayslip(w http.ResponseWriter, r *http.Request)
 	SubmitReimbursement(w http.ResponseWriter, r *http.Request)
+	ListAttendance(w http.ResponseWriter, r *http.Request)
+	ListOvertime(w http.ResponseWriter, r *http.Request)
+	ListReimbursements(w http.ResponseWriter, r *http.Request)
+	ListPayslips(w http.ResponseWriter, r *http.Request)
 }
 
 type HandlerImpl struct {
@@ -21,6 +27,7 @@ type HandlerImpl struct {
 	overtimeUsecase      overtime.Usecase
 	payslipUsecase       payslip.Usecase
 	reimbursementUsecase reimbursement.Usecase
+	historyUsecase       history.Usecase
 }
 
 func NewHandler(
@@ -28,11 +35,13 @@ func NewHandler(
 	overtimeUsecase overtime.Usecase,
 	payslipUsecase payslip.Usecase,
 	reimbursementUsecase reimbursement.Usecase,
+	historyUsecase history.Usecase,
 ) Handler {
 	return &HandlerImpl{
 		attendanceUsecase:    attendanceUsecase,
 		overtimeUsecase:      overtimeUsecase,
 		payslipUsecase:       payslipUsecase,
 		reimbursementUsecase: reimbursementUsecase,
+		historyUsecase:       historyUsecase,
 	}
 }
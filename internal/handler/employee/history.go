@@ -0,0 +1,232 @@
+package employee
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/render"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// historyQuery holds the paging and date filters shared by the history
+// endpoints. Range checks are left to the history usecase.
+type historyQuery struct {
+	page               *int
+	pageSize           *int
+	from               *openapi_types.Date
+	to                 *openapi_types.Date
+	attendancePeriodID *int64
+}
+
+func parseHistoryQuery(query url.Values) (historyQuery, error) {
+	var q historyQuery
+
+	for _, p := range []struct {
+		name string
+		dst  **int
+	}{{"page", &q.page}, {"page_size", &q.pageSize}} {
+		if s := query.Get(p.name); s != "" {
+			v, err := strconv.Atoi(s)
+			if err != nil {
+				return q, fmt.Errorf("invalid %s", p.name)
+			}
+			*p.dst = &v
+		}
+	}
+
+	for _, p := range []struct {
+		name string
+		dst  **openapi_types.Date
+	}{{"from", &q.from}, {"to", &q.to}} {
+		if s := query.Get(p.name); s != "" {
+			v, err := time.Parse("2006-01-02", s)
+			if err != nil {
+				return q, fmt.Errorf("invalid %s date, expected YYYY-MM-DD", p.name)
+			}
+			*p.dst = &openapi_types.Date{Time: v}
+		}
+	}
+
+	if s := query.Get("attendance_period_id"); s != "" {
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return q, fmt.Errorf("invalid attendance period ID")
+		}
+		q.attendancePeriodID = &v
+	}
+
+	return q, nil
+}
+
+func (h *HandlerImpl) ListAttendance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q, err := parseHistoryQuery(r.URL.Query())
+	if err != nil {
+		logger.Error(ctx, "invalid history query", "query", r.URL.RawQuery, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	history, err := h.historyUsecase.ListAttendances(ctx, v1.GetEmployeeAttendanceParams{
+		Page:               q.page,
+		PageSize:           q.pageSize,
+		From:               q.from,
+		To:                 q.to,
+		AttendancePeriodId: q.attendancePeriodID,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to list attendances", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, history)
+}
+
+func (h *HandlerImpl) ListOvertime(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q, err := parseHistoryQuery(r.URL.Query())
+	if err != nil {
+		logger.Error(ctx, "invalid history query", "query", r.URL.RawQuery, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	params := v1.GetEmployeeOvertimeParams{
+		Page:               q.page,
+		PageSize:           q.pageSize,
+		From:               q.from,
+		To:                 q.to,
+		AttendancePeriodId: q.attendancePeriodID,
+	}
+	if status := r.URL.Query().Get("status"); status != "" {
+		s := v1.GetEmployeeOvertimeParamsStatus(status)
+		params.Status = &s
+	}
+
+	history, err := h.historyUsecase.ListOvertimes(ctx, params)
+	if err != nil {
+		logger.Error(ctx, "failed to list overtimes", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, history)
+}
+
+func (h *HandlerImpl) ListReimbursements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q, err := parseHistoryQuery(r.URL.Query())
+	if err != nil {
+		logger.Error(ctx, "invalid history query", "query", r.URL.RawQuery, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	params := v1.GetEmployeeReimbursementParams{
+		Page:               q.page,
+		PageSize:           q.pageSize,
+		From:               q.from,
+		To:                 q.to,
+		AttendancePeriodId: q.attendancePeriodID,
+	}
+	if status := r.URL.Query().Get("status"); status != "" {
+		s := v1.GetEmployeeReimbursementParamsStatus(status)
+		params.Status = &s
+	}
+
+	history, err := h.historyUsecase.ListReimbursements(ctx, params)
+	if err != nil {
+		logger.Error(ctx, "failed to list reimbursements", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, history)
+}
+
+func (h *HandlerImpl) ListPayslips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	q, err := parseHistoryQuery(r.URL.Query())
+	if err != nil {
+		logger.Error(ctx, "invalid history query", "query", r.URL.RawQuery, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	history, err := h.historyUsecase.ListPayslips(ctx, v1.GetEmployeePayslipsParams{
+		Page:               q.page,
+		PageSize:           q.pageSize,
+		From:               q.from,
+		To:                 q.to,
+		AttendancePeriodId: q.attendancePeriodID,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to list payslips", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, history)
+}
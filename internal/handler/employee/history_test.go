@@ -0,0 +1,132 @@
+package employee_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/employee"
+	attendancemock "github.com/asyauqi15/payslip-system/internal/usecase/attendance/mock"
+	historymock "github.com/asyauqi15/payslip-system/internal/usecase/history/mock"
+	overtimemock "github.com/asyauqi15/payslip-system/internal/usecase/overtime/mock"
+	payslipmock "github.com/asyauqi15/payslip-system/internal/usecase/payslip/mock"
+	reimbursementmock "github.com/asyauqi15/payslip-system/internal/usecase/reimbursement/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEmployeeHandler_ListOvertime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendanceUsecase := attendancemock.NewMockUsecase(ctrl)
+	mockOvertimeUsecase := overtimemock.NewMockUsecase(ctrl)
+	mockPayslipUsecase := payslipmock.NewMockUsecase(ctrl)
+	mockReimbursementUsecase := reimbursementmock.NewMockUsecase(ctrl)
+	mockHistoryUsecase := historymock.NewMockUsecase(ctrl)
+
+	handler := employee.NewHandler(
+		mockAttendanceUsecase,
+		mockOvertimeUsecase,
+		mockPayslipUsecase,
+		mockReimbursementUsecase,
+		mockHistoryUsecase,
+	)
+
+	page := 2
+	pageSize := 10
+	pending := v1.GetEmployeeOvertimeParamsStatusPending
+	from := openapi_types.Date{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	to := openapi_types.Date{Time: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:  "filters passed to usecase",
+			query: "?page=2&page_size=10&from=2025-01-01&to=2025-01-31&status=pending",
+			setupMock: func() {
+				mockHistoryUsecase.EXPECT().
+					ListOvertimes(gomock.Any(), v1.GetEmployeeOvertimeParams{Page: &page, PageSize: &pageSize, From: &from, To: &to, Status: &pending}).
+					Return(&v1.OvertimeHistoryResponse{
+						Data:       []v1.OvertimeSubmission{{Id: 7, EmployeeId: 2, Status: v1.OvertimeSubmissionStatusPending}},
+						Pagination: v1.Pagination{Page: 2, PageSize: 10, Total: 11},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "no filters",
+			query: "",
+			setupMock: func() {
+				mockHistoryUsecase.EXPECT().
+					ListOvertimes(gomock.Any(), v1.GetEmployeeOvertimeParams{}).
+					Return(&v1.OvertimeHistoryResponse{
+						Data:       []v1.OvertimeSubmission{},
+						Pagination: v1.Pagination{Page: 1, PageSize: 20, Total: 0},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "usecase rejects filter",
+			query: "?page_size=500",
+			setupMock: func() {
+				mockHistoryUsecase.EXPECT().
+					ListOvertimes(gomock.Any(), gomock.Any()).
+					Return(nil, httppkg.NewBadRequestError("page_size must be between 1 and 100"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid date",
+			query:          "?from=01-01-2025",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid page",
+			query:          "?page=first",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid attendance period ID",
+			query:          "?attendance_period_id=abc",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/employee/overtime"+tt.query, nil)
+
+			w := httptest.NewRecorder()
+			handler.ListOvertime(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if w.Code == http.StatusOK {
+				var response v1.OvertimeHistoryResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Error("Failed to unmarshal response:", err)
+				}
+				if response.Data == nil {
+					t.Error("Expected data in response")
+				}
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/asyauqi15/payslip-system/internal/constant"
 	"github.com/asyauqi15/payslip-system/internal/handler/employee"
 	attendancemock "github.com/asyauqi15/payslip-system/internal/usecase/attendance/mock"
+	historymock "github.com/asyauqi15/payslip-system/internal/usecase/history/mock"
 	overtimemock "github.com/asyauqi15/payslip-system/internal/usecase/overtime/mock"
 	payslipmock "github.com/asyauqi15/payslip-system/internal/usecase/payslip/mock"
 	reimbursementmock "github.com/asyauqi15/payslip-system/internal/usecase/reimbursement/mock"
@@ -27,12 +28,14 @@ func TestEmployeeHandler_GetPayslip(t *testing.T) {
 	mockOvertimeUsecase := overtimemock.NewMockUsecase(ctrl)
 	mockPayslipUsecase := payslipmock.NewMockUsecase(ctrl)
 	mockReimbursementUsecase := reimbursementmock.NewMockUsecase(ctrl)
+	mockHistoryUsecase := historymock.NewMockUsecase(ctrl)
 
 	handler := employee.NewHandler(
 		mockAttendanceUsecase,
 		mockOvertimeUsecase,
 		mockPayslipUsecase,
 		mockReimbursementUsecase,
+		mockHistoryUsecase,
 	)
 
 	tests := []struct {
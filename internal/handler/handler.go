@@ -4,6 +4,7 @@ import (
 	"github.com/asyauqi15/payslip-system/internal/handler/admin"
 	"github.com/asyauqi15/payslip-system/internal/handler/auth"
 	"github.com/asyauqi15/payslip-system/internal/handler/employee"
+	"github.com/asyauqi15/payslip-system/internal/handler/manager"
 	"github.com/asyauqi15/payslip-system/internal/usecase"
 )
 
@@ -11,12 +12,14 @@ type Registry struct {
 	Auth     auth.Handler
 	Employee employee.Handler
 	Admin    admin.Handler
+	Manager  manager.Handler
 }
 
 func InitializeHandler(usecase *usecase.Registry) *Registry {
 	return &Registry{
-		Auth:     auth.NewHandler(usecase.Auth),
-		Employee: employee.NewHandler(usecase.SubmitAttendance, usecase.SubmitOvertime, usecase.GetPayslip, usecase.SubmitReimbursement),
-		Admin:    admin.NewHandler(usecase.CreateAttendancePeriod, usecase.PayrollUsecase),
+		Auth:     auth.NewHandler(usecase.Auth, usecase.Password),
+		Employee: employee.NewHandler(usecase.SubmitAttendance, usecase.SubmitOvertime, usecase.GetPayslip, usecase.SubmitReimbursement, usecase.History),
+		Admin:    admin.NewHandler(usecase.CreateAttendancePeriod, usecase.PayrollUsecase, usecase.Organization, usecase.Role, usecase.Auth, usecase.Password, usecase.ServiceAccount, usecase.Search, usecase.Approval, usecase.Roster, usecase.AttendanceImport, usecase.BulkImport, usecase.ExchangeRate),
+		Manager:  manager.NewHandler(usecase.Approval),
 	}
 }
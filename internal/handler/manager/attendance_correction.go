@@ -0,0 +1,81 @@
+package manager
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+func (h *HandlerImpl) ListAttendanceCorrections(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	status := r.URL.Query().Get("status")
+
+	corrections, err := h.approvalUsecase.ListAttendanceCorrections(ctx, status)
+	if err != nil {
+		logger.Error(ctx, "failed to list attendance corrections", "status", status, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, corrections)
+}
+
+func (h *HandlerImpl) ReviewAttendanceCorrection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	correctionIDStr := chi.URLParam(r, "id")
+	correctionID, err := strconv.ParseInt(correctionIDStr, 10, 64)
+	if err != nil || correctionID <= 0 {
+		logger.Error(ctx, "invalid attendance correction ID", "id", correctionIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid attendance correction ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	var req v1.ReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	correction, err := h.approvalUsecase.ReviewAttendanceCorrection(ctx, correctionID, req)
+	if err != nil {
+		logger.Error(ctx, "failed to review attendance correction", "correction_id", correctionID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, correction)
+}
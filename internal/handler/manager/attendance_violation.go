@@ -0,0 +1,81 @@
+package manager
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+func (h *HandlerImpl) ListAttendanceViolations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	status := r.URL.Query().Get("status")
+
+	violations, err := h.approvalUsecase.ListAttendanceViolations(ctx, status)
+	if err != nil {
+		logger.Error(ctx, "failed to list attendance violations", "status", status, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, violations)
+}
+
+func (h *HandlerImpl) ReviewAttendanceViolation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	violationIDStr := chi.URLParam(r, "id")
+	violationID, err := strconv.ParseInt(violationIDStr, 10, 64)
+	if err != nil || violationID <= 0 {
+		logger.Error(ctx, "invalid attendance violation ID", "id", violationIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid attendance violation ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	var req v1.ReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	violation, err := h.approvalUsecase.ReviewAttendanceViolation(ctx, violationID, req)
+	if err != nil {
+		logger.Error(ctx, "failed to review attendance violation", "violation_id", violationID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, violation)
+}
@@ -0,0 +1,138 @@
+package manager_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/manager"
+	approvalmock "github.com/asyauqi15/payslip-system/internal/usecase/approval/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/mock/gomock"
+)
+
+func TestManagerHandler_ReviewAttendanceViolation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	handler := manager.NewHandler(mockApprovalUsecase)
+
+	validRequest := v1.ReviewRequest{Decision: v1.ReviewRequestDecisionApproved}
+
+	tests := []struct {
+		name           string
+		violationID    string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+		expectError    bool
+	}{
+		{
+			name:        "successful approval",
+			violationID: "1",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockApprovalUsecase.EXPECT().
+					ReviewAttendanceViolation(gomock.Any(), int64(1), validRequest).
+					Return(&v1.AttendanceViolationSubmission{Id: 1, Status: v1.AttendanceViolationSubmissionStatusApproved}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectError:    false,
+		},
+		{
+			name:           "invalid attendance violation ID",
+			violationID:    "abc",
+			requestBody:    validRequest,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:           "attendance violation ID zero",
+			violationID:    "0",
+			requestBody:    validRequest,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:           "invalid request body",
+			violationID:    "1",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:        "day already paid out",
+			violationID: "1",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockApprovalUsecase.EXPECT().
+					ReviewAttendanceViolation(gomock.Any(), int64(1), validRequest).
+					Return(nil, httppkg.NewConflictError("payroll for this period is already finalized"))
+			},
+			expectedStatus: http.StatusConflict,
+			expectError:    true,
+		},
+		{
+			name:        "not a direct report",
+			violationID: "1",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockApprovalUsecase.EXPECT().
+					ReviewAttendanceViolation(gomock.Any(), int64(1), validRequest).
+					Return(nil, httppkg.NewForbiddenError("can only review submissions of direct reports"))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/manager/attendance-violations/"+tt.violationID+"/review", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.violationID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.ReviewAttendanceViolation(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectError {
+				var errorResp v1.DefaultErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &errorResp); err != nil {
+					t.Error("Failed to unmarshal error response:", err)
+				}
+				if errorResp.Error.Message == "" {
+					t.Error("Expected error message in response")
+				}
+			}
+		})
+	}
+}
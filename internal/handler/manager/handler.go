@@ -0,0 +1,28 @@
+package manager
+
+import (
+	"net/http"
+
+	"github.com/asyauqi15/payslip-system/internal/usecase/approval"
+)
+
+type Handler interface {
+	ListOvertimes(w http.ResponseWriter, r *http.Request)
+	ReviewOvertime(w http.ResponseWriter, r *http.Request)
+	ListReimbursements(w http.ResponseWriter, r *http.Request)
+	ReviewReimbursement(w http.ResponseWriter, r *http.Request)
+	ListAttendanceCorrections(w http.ResponseWriter, r *http.Request)
+	ReviewAttendanceCorrection(w http.ResponseWriter, r *http.Request)
+	ListAttendanceViolations(w http.ResponseWriter, r *http.Request)
+	ReviewAttendanceViolation(w http.ResponseWriter, r *http.Request)
+}
+
+type HandlerImpl struct {
+	approvalUsecase approval.Usecase
+}
+
+func NewHandler(approvalUsecase approval.Usecase) Handler {
+	return &HandlerImpl{
+		approvalUsecase: approvalUsecase,
+	}
+}
@@ -0,0 +1,193 @@
+package manager_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/handler/manager"
+	approvalmock "github.com/asyauqi15/payslip-system/internal/usecase/approval/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/mock/gomock"
+)
+
+func TestManagerHandler_ListOvertimes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	handler := manager.NewHandler(mockApprovalUsecase)
+
+	startTime := time.Date(2025, 1, 1, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func()
+		expectedStatus int
+		expectedCount  int
+	}{
+		{
+			name:  "pending by default",
+			query: "",
+			setupMock: func() {
+				mockApprovalUsecase.EXPECT().
+					ListOvertimes(gomock.Any(), "").
+					Return([]v1.OvertimeSubmission{
+						{Id: 1, EmployeeId: 2, StartTime: startTime, EndTime: startTime.Add(2 * time.Hour), Status: v1.OvertimeSubmissionStatusPending},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+		},
+		{
+			name:  "invalid status filter",
+			query: "?status=unknown",
+			setupMock: func() {
+				mockApprovalUsecase.EXPECT().
+					ListOvertimes(gomock.Any(), "unknown").
+					Return(nil, httppkg.NewBadRequestError("invalid status filter"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			req := httptest.NewRequest(http.MethodGet, "/manager/overtimes"+tt.query, nil)
+			w := httptest.NewRecorder()
+			handler.ListOvertimes(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedStatus == http.StatusOK {
+				var overtimes []v1.OvertimeSubmission
+				if err := json.Unmarshal(w.Body.Bytes(), &overtimes); err != nil {
+					t.Fatal("Failed to unmarshal response:", err)
+				}
+				if len(overtimes) != tt.expectedCount {
+					t.Errorf("Expected %d overtimes but got %d", tt.expectedCount, len(overtimes))
+				}
+			}
+		})
+	}
+}
+
+func TestManagerHandler_ReviewOvertime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockApprovalUsecase := approvalmock.NewMockUsecase(ctrl)
+	handler := manager.NewHandler(mockApprovalUsecase)
+
+	validRequest := v1.ReviewRequest{Decision: v1.ReviewRequestDecisionApproved}
+
+	tests := []struct {
+		name           string
+		overtimeID     string
+		requestBody    interface{}
+		setupMock      func()
+		expectedStatus int
+		expectError    bool
+	}{
+		{
+			name:        "successful approval",
+			overtimeID:  "1",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockApprovalUsecase.EXPECT().
+					ReviewOvertime(gomock.Any(), int64(1), validRequest).
+					Return(&v1.OvertimeSubmission{Id: 1, Status: v1.OvertimeSubmissionStatusApproved}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectError:    false,
+		},
+		{
+			name:           "invalid overtime ID",
+			overtimeID:     "abc",
+			requestBody:    validRequest,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:           "overtime ID zero",
+			overtimeID:     "0",
+			requestBody:    validRequest,
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:           "invalid request body",
+			overtimeID:     "1",
+			requestBody:    "invalid json",
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectError:    true,
+		},
+		{
+			name:        "not a direct report",
+			overtimeID:  "1",
+			requestBody: validRequest,
+			setupMock: func() {
+				mockApprovalUsecase.EXPECT().
+					ReviewOvertime(gomock.Any(), int64(1), validRequest).
+					Return(nil, httppkg.NewForbiddenError("can only review submissions of direct reports"))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var requestBody []byte
+			var err error
+			if str, ok := tt.requestBody.(string); ok {
+				requestBody = []byte(str)
+			} else {
+				requestBody, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal("Failed to marshal request body:", err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/manager/overtimes/"+tt.overtimeID+"/review", bytes.NewBuffer(requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", tt.overtimeID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+			w := httptest.NewRecorder()
+			handler.ReviewOvertime(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d but got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectError {
+				var errorResp v1.DefaultErrorResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &errorResp); err != nil {
+					t.Error("Failed to unmarshal error response:", err)
+				}
+				if errorResp.Error.Message == "" {
+					t.Error("Expected error message in response")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package manager
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+func (h *HandlerImpl) ListReimbursements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	status := r.URL.Query().Get("status")
+
+	reimbursements, err := h.approvalUsecase.ListReimbursements(ctx, status)
+	if err != nil {
+		logger.Error(ctx, "failed to list reimbursements", "status", status, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, reimbursements)
+}
+
+func (h *HandlerImpl) ReviewReimbursement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	reimbursementIDStr := chi.URLParam(r, "id")
+	reimbursementID, err := strconv.ParseInt(reimbursementIDStr, 10, 64)
+	if err != nil || reimbursementID <= 0 {
+		logger.Error(ctx, "invalid reimbursement ID", "id", reimbursementIDStr, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid reimbursement ID"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	var req v1.ReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Error(ctx, "failed to decode request body", "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "invalid request payload"
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, resp)
+		return
+	}
+
+	reimbursement, err := h.approvalUsecase.ReviewReimbursement(ctx, reimbursementID, req)
+	if err != nil {
+		logger.Error(ctx, "failed to review reimbursement", "reimbursement_id", reimbursementID, "error", err)
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = err.Error()
+
+		if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+			render.Status(r, httpErr.HTTPStatus())
+		} else {
+			render.Status(r, http.StatusInternalServerError)
+		}
+
+		render.JSON(w, r, resp)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, reimbursement)
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_api_key_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository APIKeyRepository
+type APIKeyRepository interface {
+	BaseRepository[entity.APIKey]
+	CreateWithScopes(ctx context.Context, key *entity.APIKey, scopes []string, tx *gorm.DB) error
+	FindScopes(ctx context.Context, apiKeyID int64, tx *gorm.DB) ([]string, error)
+	Revoke(ctx context.Context, id int64, revokedAt time.Time, tx *gorm.DB) (bool, error)
+	MarkUsed(ctx context.Context, id int64, usedAt time.Time, tx *gorm.DB) error
+}
+
+type APIKeyRepositoryImpl struct {
+	BaseRepositoryImpl[entity.APIKey]
+}
+
+func NewAPIKeyRepository(db *BaseRepositoryImpl[entity.APIKey]) APIKeyRepository {
+	return &APIKeyRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// CreateWithScopes stores a key together with its scopes in one transaction.
+func (r *APIKeyRepositoryImpl) CreateWithScopes(ctx context.Context, key *entity.APIKey, scopes []string, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(key).Error; err != nil {
+			return err
+		}
+
+		if len(scopes) == 0 {
+			return nil
+		}
+
+		keyScopes := make([]entity.APIKeyScope, 0, len(scopes))
+		for _, scope := range scopes {
+			keyScopes = append(keyScopes, entity.APIKeyScope{
+				APIKeyID: key.ID,
+				Scope:    scope,
+			})
+		}
+
+		return tx.Create(&keyScopes).Error
+	})
+}
+
+func (r *APIKeyRepositoryImpl) FindScopes(ctx context.Context, apiKeyID int64, tx *gorm.DB) ([]string, error) {
+	conn := r.UseTransaction(tx)
+	var scopes []string
+
+	err := conn.WithContext(ctx).Model(&entity.APIKeyScope{}).
+		Where("api_key_id = ?", apiKeyID).
+		Order("scope").
+		Pluck("scope", &scopes).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scopes, nil
+}
+
+// Revoke revokes a key. It returns false when the key was already revoked.
+func (r *APIKeyRepositoryImpl) Revoke(ctx context.Context, id int64, revokedAt time.Time, tx *gorm.DB) (bool, error) {
+	conn := r.UseTransaction(tx)
+
+	result := conn.WithContext(ctx).Model(&entity.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		UpdateColumns(map[string]interface{}{
+			"revoked_at": revokedAt,
+			"updated_at": revokedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// MarkUsed records when a key last authenticated a request. The column is
+// updated directly so every request does not produce an update audit log.
+func (r *APIKeyRepositoryImpl) MarkUsed(ctx context.Context, id int64, usedAt time.Time, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Model(&entity.APIKey{}).
+		Where("id = ?", id).
+		UpdateColumn("last_used_at", usedAt).Error
+}
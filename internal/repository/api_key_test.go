@@ -0,0 +1,133 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupAPIKeyRepoTest() (*gorm.DB, sqlmock.Sqlmock, repository.APIKeyRepository) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       db,
+		DriverName: "postgres",
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	baseRepo := &repository.BaseRepositoryImpl[entity.APIKey]{DB: gormDB}
+	repo := repository.NewAPIKeyRepository(baseRepo)
+
+	return gormDB, mock, repo
+}
+
+func TestAPIKeyRepository_Revoke(t *testing.T) {
+	_, mock, repo := setupAPIKeyRepoTest()
+
+	query := `UPDATE "api_keys" SET "revoked_at"=$1,"updated_at"=$2 WHERE id = $3 AND revoked_at IS NULL`
+	revokedAt := time.Date(2025, 6, 20, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		setupMock     func()
+		expectError   bool
+		expectRevoked bool
+	}{
+		{
+			name: "active key",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(revokedAt, revokedAt, int64(4)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectRevoked: true,
+		},
+		{
+			name: "already revoked key",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(revokedAt, revokedAt, int64(4)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			expectRevoked: false,
+		},
+		{
+			name: "database error",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(revokedAt, revokedAt, int64(4)).
+					WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			revoked, err := repo.Revoke(context.Background(), 4, revokedAt, nil)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+
+			if revoked != tt.expectRevoked {
+				t.Errorf("Expected revoked %v but got %v", tt.expectRevoked, revoked)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("There were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestAPIKeyRepository_FindScopes(t *testing.T) {
+	_, mock, repo := setupAPIKeyRepoTest()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "scope" FROM "api_key_scopes" WHERE api_key_id = $1 ORDER BY scope`)).
+		WithArgs(int64(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"scope"}).
+			AddRow(entity.PermissionDepartmentRead).
+			AddRow(entity.PermissionPayrollRead))
+
+	scopes, err := repo.FindScopes(context.Background(), 4, nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if len(scopes) != 2 || scopes[0] != entity.PermissionDepartmentRead || scopes[1] != entity.PermissionPayrollRead {
+		t.Errorf("Unexpected scopes: %v", scopes)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("There were unfulfilled expectations: %s", err)
+	}
+}
@@ -12,6 +12,10 @@ import (
 type AttendanceRepository interface {
 	BaseRepository[entity.Attendance]
 	CountAttendanceInPeriod(ctx context.Context, employeeID int64, startDate, endDate time.Time, tx *gorm.DB) (int64, error)
+	FindIDsInPeriod(ctx context.Context, employeeID int64, startDate, endDate time.Time, tx *gorm.DB) ([]int64, error)
+	FindByEmployee(ctx context.Context, employeeID int64, dates DateRange, page Pagination, tx *gorm.DB) ([]entity.Attendance, int64, error)
+	Record(ctx context.Context, attendance *entity.Attendance, violations []entity.AttendanceViolation, tx *gorm.DB) error
+	Import(ctx context.Context, attendances []entity.Attendance, tx *gorm.DB) error
 }
 
 type AttendanceRepositoryImpl struct {
@@ -24,15 +28,13 @@ func NewAttendanceRepository(db *BaseRepositoryImpl[entity.Attendance]) Attendan
 	}
 }
 
+// CountAttendanceInPeriod counts the attendance an employee clocked in within
+// the period. Attendance with a rejected violation does not count.
 func (r *AttendanceRepositoryImpl) CountAttendanceInPeriod(ctx context.Context, employeeID int64, startDate, endDate time.Time, tx *gorm.DB) (int64, error) {
 	conn := r.UseTransaction(tx)
 	var count int64
 
-	err := conn.WithContext(ctx).Model(&entity.Attendance{}).
-		Where("employee_id = ? AND clock_in_time >= ? AND clock_in_time <= ?",
-			employeeID,
-			startDate.Format("2006-01-02 00:00:00"),
-			endDate.Format("2006-01-02 23:59:59")).
+	err := countedInPeriod(conn.WithContext(ctx), employeeID, startDate, endDate).
 		Count(&count).Error
 
 	if err != nil {
@@ -41,3 +43,103 @@ func (r *AttendanceRepositoryImpl) CountAttendanceInPeriod(ctx context.Context,
 
 	return count, nil
 }
+
+// FindIDsInPeriod returns the IDs of the attendance CountAttendanceInPeriod
+// counts, in clock-in order.
+func (r *AttendanceRepositoryImpl) FindIDsInPeriod(ctx context.Context, employeeID int64, startDate, endDate time.Time, tx *gorm.DB) ([]int64, error) {
+	conn := r.UseTransaction(tx)
+	var ids []int64
+
+	err := countedInPeriod(conn.WithContext(ctx), employeeID, startDate, endDate).
+		Order("clock_in_time").
+		Pluck("id", &ids).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// countedInPeriod selects the attendance clocked in on the days from the start
+// to the end date that has no rejected violation.
+func countedInPeriod(db *gorm.DB, employeeID int64, startDate, endDate time.Time) *gorm.DB {
+	query := NewQuery().Where("employee_id", OpEqual, employeeID)
+	WhereRange(query, "clock_in_time", ClockInRange(DaysBetween(startDate, endDate)))
+
+	return query.applyConditions(db.Model(&entity.Attendance{})).
+		Where("NOT EXISTS (SELECT 1 FROM attendance_violations WHERE attendance_violations.attendance_id = attendances.id AND attendance_violations.status = ?)",
+			entity.ApprovalStatusRejected)
+}
+
+// ClockInRange turns a date range into bounds on clock_in_time. Clock-in
+// times are stored as RFC 3339 strings, which sort like the dates they start
+// with.
+func ClockInRange(dates DateRange) Range[string] {
+	var r Range[string]
+	if dates.From != nil {
+		from := dates.From.Format("2006-01-02")
+		r.From = &from
+	}
+	if dates.To != nil {
+		to := dates.To.Format("2006-01-02")
+		r.To = &to
+	}
+	return r
+}
+
+// FindByEmployee returns one page of an employee's attendance that was
+// clocked in within the date range, newest first, and the total number of
+// matches.
+func (r *AttendanceRepositoryImpl) FindByEmployee(ctx context.Context, employeeID int64, dates DateRange, page Pagination, tx *gorm.DB) ([]entity.Attendance, int64, error) {
+	query := NewQuery().Where("employee_id", OpEqual, employeeID)
+	WhereRange(query, "clock_in_time", ClockInRange(dates)).OrderBy("clock_in_time", true)
+
+	return findPage(ctx, &r.BaseRepositoryImpl, query, page, tx)
+}
+
+// Record stores an attendance together with the violations its submission
+// raised in one transaction. The attendance is created when it has no id yet
+// and saved otherwise; the violations are linked to it.
+func (r *AttendanceRepositoryImpl) Record(ctx context.Context, attendance *entity.Attendance, violations []entity.AttendanceViolation, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if attendance.ID == 0 {
+			if err := tx.Create(attendance).Error; err != nil {
+				return err
+			}
+		} else if err := tx.Save(attendance).Error; err != nil {
+			return err
+		}
+
+		for i := range violations {
+			violations[i].AttendanceID = attendance.ID
+			if err := tx.Create(&violations[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Import stores attendances taken from a time-clock export in one
+// transaction, so an import is applied all together or not at all. An
+// attendance is created when it has no id yet and saved otherwise.
+func (r *AttendanceRepositoryImpl) Import(ctx context.Context, attendances []entity.Attendance, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range attendances {
+			attendance := &attendances[i]
+			if attendance.ID == 0 {
+				if err := tx.Create(attendance).Error; err != nil {
+					return err
+				}
+			} else if err := tx.Save(attendance).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
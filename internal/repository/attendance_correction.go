@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_attendance_correction_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository AttendanceCorrectionRepository
+type AttendanceCorrectionRepository interface {
+	BaseRepository[entity.AttendanceCorrection]
+	Apply(ctx context.Context, correction *entity.AttendanceCorrection, attendance *entity.Attendance, tx *gorm.DB) error
+}
+
+type AttendanceCorrectionRepositoryImpl struct {
+	BaseRepositoryImpl[entity.AttendanceCorrection]
+}
+
+func NewAttendanceCorrectionRepository(db *BaseRepositoryImpl[entity.AttendanceCorrection]) AttendanceCorrectionRepository {
+	return &AttendanceCorrectionRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// Apply stores an approved correction together with the attendance it
+// produces in one transaction. The attendance is created when it has no id
+// yet; either way it is flagged as corrected and linked to the correction,
+// which in turn is linked to the attendance.
+func (r *AttendanceCorrectionRepositoryImpl) Apply(ctx context.Context, correction *entity.AttendanceCorrection, attendance *entity.Attendance, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		attendance.Corrected = true
+		attendance.CorrectionID = &correction.ID
+
+		if attendance.ID == 0 {
+			if err := tx.Create(attendance).Error; err != nil {
+				return err
+			}
+		} else if err := tx.Save(attendance).Error; err != nil {
+			return err
+		}
+
+		correction.AttendanceID = &attendance.ID
+		return tx.Save(correction).Error
+	})
+}
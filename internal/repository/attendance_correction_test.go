@@ -0,0 +1,63 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+)
+
+func TestAttendanceCorrectionRepository_Apply(t *testing.T) {
+	db, mock := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := db.DB()
+		sqlDB.Close()
+	}()
+
+	repo := repository.NewAttendanceCorrectionRepository(&repository.BaseRepositoryImpl[entity.AttendanceCorrection]{DB: db})
+
+	correction := &entity.AttendanceCorrection{
+		Base:         entity.Base{ID: 8, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		EmployeeID:   2,
+		Date:         time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+		ClockInTime:  "2025-01-06T09:00:00Z",
+		ClockOutTime: "2025-01-06T17:00:00Z",
+		Reason:       "Forgot to check in",
+		Status:       entity.ApprovalStatusApproved,
+	}
+	attendance := &entity.Attendance{
+		EmployeeID:   2,
+		ClockInTime:  correction.ClockInTime,
+		ClockOutTime: correction.ClockOutTime,
+	}
+	ctx := context.WithValue(context.Background(), "skip_audit", true)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attendances"`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), correction.ClockInTime, correction.ClockOutTime, true, int64(8)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(30))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "attendance_corrections" WHERE id = $1`)).
+		WithArgs(int64(8), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id"}).AddRow(8, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "attendance_corrections" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := repo.Apply(ctx, correction, attendance, nil); err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+
+	if !attendance.Corrected || attendance.CorrectionID == nil || *attendance.CorrectionID != 8 {
+		t.Errorf("Expected attendance to be flagged and linked to correction 8 but got %+v", attendance)
+	}
+	if correction.AttendanceID == nil || *correction.AttendanceID != 30 {
+		t.Errorf("Expected correction to link attendance 30 but got %v", correction.AttendanceID)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
@@ -58,11 +58,11 @@ func TestAttendancePeriodRepository_Create(t *testing.T) {
 			name: "successful creation",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendance_periods" WHERE start_date <= $1 AND end_date >= $2`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendance_periods" WHERE (start_date <= $1 AND end_date >= $2) AND "attendance_periods"."deleted_at" IS NULL`)).
 					WithArgs(endDate, startDate).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
-				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attendance_periods" ("created_at","updated_at","start_date","end_date") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), startDate, endDate).
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attendance_periods" ("created_at","updated_at","start_date","end_date","deleted_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), startDate, endDate, nil).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 				mock.ExpectCommit()
 			},
@@ -72,7 +72,7 @@ func TestAttendancePeriodRepository_Create(t *testing.T) {
 			name: "overlapping period error",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendance_periods" WHERE start_date <= $1 AND end_date >= $2`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendance_periods" WHERE (start_date <= $1 AND end_date >= $2) AND "attendance_periods"."deleted_at" IS NULL`)).
 					WithArgs(endDate, startDate).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 				mock.ExpectRollback()
@@ -83,7 +83,7 @@ func TestAttendancePeriodRepository_Create(t *testing.T) {
 			name: "database error",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendance_periods" WHERE start_date <= $1 AND end_date >= $2`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendance_periods" WHERE (start_date <= $1 AND end_date >= $2) AND "attendance_periods"."deleted_at" IS NULL`)).
 					WithArgs(endDate, startDate).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attendance_periods"`)).
@@ -157,10 +157,10 @@ func TestAttendancePeriodRepository_Update(t *testing.T) {
 			name: "successful update",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendance_periods" WHERE id != $1 AND start_date <= $2 AND end_date >= $3`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendance_periods" WHERE (id != $1 AND start_date <= $2 AND end_date >= $3 AND deleted_at IS NULL) AND "attendance_periods"."deleted_at" IS NULL`)).
 					WithArgs(int64(1), endDate, startDate).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
-				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "attendance_periods" SET "updated_at"=$1,"start_date"=$2,"end_date"=$3 WHERE "id" = $4`)).
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "attendance_periods" SET "updated_at"=$1,"start_date"=$2,"end_date"=$3 WHERE "attendance_periods"."deleted_at" IS NULL AND "id" = $4`)).
 					WithArgs(sqlmock.AnyArg(), startDate, endDate, int64(1)).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
@@ -171,7 +171,7 @@ func TestAttendancePeriodRepository_Update(t *testing.T) {
 			name: "overlapping period error",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendance_periods" WHERE id != $1 AND start_date <= $2 AND end_date >= $3`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendance_periods" WHERE (id != $1 AND start_date <= $2 AND end_date >= $3 AND deleted_at IS NULL) AND "attendance_periods"."deleted_at" IS NULL`)).
 					WithArgs(int64(1), endDate, startDate).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 				mock.ExpectRollback()
@@ -182,7 +182,7 @@ func TestAttendancePeriodRepository_Update(t *testing.T) {
 			name: "database error",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendance_periods" WHERE id != $1 AND start_date <= $2 AND end_date >= $3`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendance_periods" WHERE (id != $1 AND start_date <= $2 AND end_date >= $3 AND deleted_at IS NULL) AND "attendance_periods"."deleted_at" IS NULL`)).
 					WithArgs(int64(1), endDate, startDate).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "attendance_periods"`)).
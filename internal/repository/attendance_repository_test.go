@@ -2,6 +2,7 @@ package repository_test
 
 import (
 	"context"
+	"errors"
 	"regexp"
 	"testing"
 	"time"
@@ -56,8 +57,8 @@ func TestAttendanceRepository_Create(t *testing.T) {
 			name: "successful creation",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attendances" ("created_at","updated_at","employee_id","clock_in_time","clock_out_time") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), "2025-01-01T09:00:00Z", "").
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attendances" ("created_at","updated_at","employee_id","clock_in_time","clock_out_time","corrected","correction_id") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), "2025-01-01T09:00:00Z", "", false, nil).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 				mock.ExpectCommit()
 			},
@@ -108,6 +109,7 @@ func TestAttendanceRepository_CountAttendanceInPeriod(t *testing.T) {
 	employeeID := int64(1)
 	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	endDate := time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC)
+	countQuery := `SELECT count(*) FROM "attendances" WHERE employee_id = $1 AND clock_in_time >= $2 AND clock_in_time < $3 AND (NOT EXISTS (SELECT 1 FROM attendance_violations WHERE attendance_violations.attendance_id = attendances.id AND attendance_violations.status = $4))`
 
 	tests := []struct {
 		name          string
@@ -118,8 +120,8 @@ func TestAttendanceRepository_CountAttendanceInPeriod(t *testing.T) {
 		{
 			name: "successful count",
 			setupMock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendances" WHERE employee_id = $1 AND clock_in_time >= $2 AND clock_in_time <= $3`)).
-					WithArgs(employeeID, startDate.Format("2006-01-02 00:00:00"), endDate.Format("2006-01-02 23:59:59")).
+				mock.ExpectQuery(regexp.QuoteMeta(countQuery)).
+					WithArgs(employeeID, "2025-01-01", "2025-02-01", entity.ApprovalStatusRejected).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(20))
 			},
 			expectError:   false,
@@ -128,8 +130,8 @@ func TestAttendanceRepository_CountAttendanceInPeriod(t *testing.T) {
 		{
 			name: "database error",
 			setupMock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendances" WHERE employee_id = $1 AND clock_in_time >= $2 AND clock_in_time <= $3`)).
-					WithArgs(employeeID, startDate.Format("2006-01-02 00:00:00"), endDate.Format("2006-01-02 23:59:59")).
+				mock.ExpectQuery(regexp.QuoteMeta(countQuery)).
+					WithArgs(employeeID, "2025-01-01", "2025-02-01", entity.ApprovalStatusRejected).
 					WillReturnError(gorm.ErrInvalidDB)
 			},
 			expectError:   true,
@@ -138,8 +140,8 @@ func TestAttendanceRepository_CountAttendanceInPeriod(t *testing.T) {
 		{
 			name: "no attendance records",
 			setupMock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendances" WHERE employee_id = $1 AND clock_in_time >= $2 AND clock_in_time <= $3`)).
-					WithArgs(employeeID, startDate.Format("2006-01-02 00:00:00"), endDate.Format("2006-01-02 23:59:59")).
+				mock.ExpectQuery(regexp.QuoteMeta(countQuery)).
+					WithArgs(employeeID, "2025-01-01", "2025-02-01", entity.ApprovalStatusRejected).
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 			},
 			expectError:   false,
@@ -173,6 +175,67 @@ func TestAttendanceRepository_CountAttendanceInPeriod(t *testing.T) {
 	}
 }
 
+func TestAttendanceRepository_FindIDsInPeriod(t *testing.T) {
+	_, mock, repo := setupAttendanceRepoTest()
+
+	employeeID := int64(1)
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC)
+	idsQuery := `SELECT "id" FROM "attendances" WHERE employee_id = $1 AND clock_in_time >= $2 AND clock_in_time < $3 AND (NOT EXISTS (SELECT 1 FROM attendance_violations WHERE attendance_violations.attendance_id = attendances.id AND attendance_violations.status = $4)) ORDER BY clock_in_time`
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		expectError bool
+		expectedIDs []int64
+	}{
+		{
+			name: "attendance in clock-in order",
+			setupMock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(idsQuery)).
+					WithArgs(employeeID, "2025-01-01", "2025-02-01", entity.ApprovalStatusRejected).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4).AddRow(2))
+			},
+			expectError: false,
+			expectedIDs: []int64{4, 2},
+		},
+		{
+			name: "database error",
+			setupMock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(idsQuery)).
+					WithArgs(employeeID, "2025-01-01", "2025-02-01", entity.ApprovalStatusRejected).
+					WillReturnError(gorm.ErrInvalidDB)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			ids, err := repo.FindIDsInPeriod(context.Background(), employeeID, startDate, endDate, nil)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				if len(ids) != len(tt.expectedIDs) || ids[0] != tt.expectedIDs[0] || ids[1] != tt.expectedIDs[1] {
+					t.Errorf("Expected IDs %v but got %v", tt.expectedIDs, ids)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
 func TestAttendanceRepository_Updates(t *testing.T) {
 	_, mock, repo := setupAttendanceRepoTest()
 
@@ -240,3 +303,88 @@ func TestAttendanceRepository_Updates(t *testing.T) {
 		})
 	}
 }
+
+func TestAttendanceRepository_Record(t *testing.T) {
+	_, mock, repo := setupAttendanceRepoTest()
+
+	attendance := &entity.Attendance{
+		EmployeeID:  1,
+		ClockInTime: "2025-01-06T09:00:00Z",
+	}
+	violations := []entity.AttendanceViolation{
+		{EmployeeID: 1, AttendanceType: "check_in", Kind: entity.ViolationOutsideGeofence, Detail: "position is 900 m outside the nearest geofence", Status: entity.ApprovalStatusPending},
+	}
+
+	ctx := context.WithValue(context.Background(), "skip_audit", true)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attendances"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attendance_violations"`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(12), int64(1), "check_in", entity.ViolationOutsideGeofence,
+			sqlmock.AnyArg(), nil, nil, nil, "", "", entity.ApprovalStatusPending, nil, nil, "").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectCommit()
+
+	if err := repo.Record(ctx, attendance, violations, nil); err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+
+	if violations[0].AttendanceID != 12 {
+		t.Errorf("Expected violation to link attendance 12 but got %d", violations[0].AttendanceID)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
+
+func TestAttendanceRepository_Import(t *testing.T) {
+	_, mock, repo := setupAttendanceRepoTest()
+
+	ctx := context.WithValue(context.Background(), "skip_audit", true)
+
+	t.Run("creates new and saves existing attendances", func(t *testing.T) {
+		attendances := []entity.Attendance{
+			{EmployeeID: 1, ClockInTime: "2025-01-06T09:00:00+07:00", ClockOutTime: "2025-01-06T17:00:00+07:00"},
+			{Base: entity.Base{ID: 4}, EmployeeID: 1, ClockInTime: "2025-01-07T08:55:00+07:00", ClockOutTime: "2025-01-07T17:10:00+07:00"},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attendances"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "attendances"`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		if err := repo.Import(ctx, attendances, nil); err != nil {
+			t.Fatalf("Expected no error but got: %v", err)
+		}
+		if attendances[0].ID != 12 {
+			t.Errorf("Expected created attendance to get id 12 but got %d", attendances[0].ID)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("Unfulfilled expectations: %v", err)
+		}
+	})
+
+	t.Run("rolls back when one attendance fails", func(t *testing.T) {
+		attendances := []entity.Attendance{
+			{EmployeeID: 1, ClockInTime: "2025-01-08T09:00:00+07:00"},
+			{EmployeeID: 1, ClockInTime: "2025-01-09T09:00:00+07:00"},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attendances"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(13))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "attendances"`)).
+			WillReturnError(errors.New("connection reset"))
+		mock.ExpectRollback()
+
+		if err := repo.Import(ctx, attendances, nil); err == nil {
+			t.Fatal("Expected an error but got none")
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("Unfulfilled expectations: %v", err)
+		}
+	})
+}
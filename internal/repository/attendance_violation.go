@@ -0,0 +1,18 @@
+package repository
+
+import "github.com/asyauqi15/payslip-system/internal/entity"
+
+//go:generate mockgen -destination=./mock/mock_attendance_violation_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository AttendanceViolationRepository
+type AttendanceViolationRepository interface {
+	BaseRepository[entity.AttendanceViolation]
+}
+
+type AttendanceViolationRepositoryImpl struct {
+	BaseRepositoryImpl[entity.AttendanceViolation]
+}
+
+func NewAttendanceViolationRepository(db *BaseRepositoryImpl[entity.AttendanceViolation]) AttendanceViolationRepository {
+	return &AttendanceViolationRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+// AuditLogRepository writes audit entries that are not produced by the entity
+// hooks, such as security events. AuditLog has no hooks of its own, so it does
+// not use BaseRepository.
+//
+//go:generate mockgen -destination=./mock/mock_audit_log_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository AuditLogRepository
+type AuditLogRepository interface {
+	Create(ctx context.Context, auditLog *entity.AuditLog, tx *gorm.DB) error
+}
+
+type AuditLogRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) AuditLogRepository {
+	return &AuditLogRepositoryImpl{
+		DB: db,
+	}
+}
+
+func (r *AuditLogRepositoryImpl) Create(ctx context.Context, auditLog *entity.AuditLog, tx *gorm.DB) error {
+	conn := r.DB
+	if tx != nil {
+		conn = tx
+	}
+
+	return conn.WithContext(ctx).Create(auditLog).Error
+}
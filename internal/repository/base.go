@@ -11,6 +11,8 @@ type Entity interface {
 	AfterCreate(tx *gorm.DB) (err error)
 	AfterUpdate(tx *gorm.DB) (err error)
 	BeforeUpdate(tx *gorm.DB) (err error)
+	BeforeDelete(tx *gorm.DB) (err error)
+	AfterDelete(tx *gorm.DB) (err error)
 }
 
 type BaseRepositoryImpl[T Entity] struct {
@@ -19,11 +21,18 @@ type BaseRepositoryImpl[T Entity] struct {
 
 type BaseRepository[T Entity] interface {
 	Create(ctx context.Context, o *T, tx *gorm.DB) (*T, error)
+	CreateAll(ctx context.Context, records []T, tx *gorm.DB) error
 	Updates(ctx context.Context, o *T, u T, tx *gorm.DB) (*T, error)
 	Save(ctx context.Context, o *T, tx *gorm.DB) error
+	Delete(ctx context.Context, o *T, tx *gorm.DB) error
+	Restore(ctx context.Context, o *T, tx *gorm.DB) error
 	FindByID(ctx context.Context, i uint, tx *gorm.DB) (*T, error)
+	FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*T, error)
 	FindByTemplate(ctx context.Context, t *T, tx *gorm.DB) ([]T, error)
 	FindOneByTemplate(ctx context.Context, o *T, tx *gorm.DB) (*T, error)
+	Find(ctx context.Context, q *Query, tx *gorm.DB) ([]T, error)
+	Count(ctx context.Context, q *Query, tx *gorm.DB) (int64, error)
+	List(ctx context.Context, q *Query, tx *gorm.DB) (*CursorPage[T], error)
 }
 
 func (b *BaseRepositoryImpl[T]) UseTransaction(tx *gorm.DB) *gorm.DB {
@@ -44,6 +53,22 @@ func (b *BaseRepositoryImpl[T]) Create(ctx context.Context, o *T, tx *gorm.DB) (
 	return o, nil
 }
 
+// CreateAll creates the records in one transaction, so either all of them
+// are created or none is. They are created one at a time so the hooks of each
+// run, as for Create.
+func (b *BaseRepositoryImpl[T]) CreateAll(ctx context.Context, records []T, tx *gorm.DB) error {
+	conn := b.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range records {
+			if err := tx.Omit(clause.Associations).Create(&records[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (b *BaseRepositoryImpl[T]) Updates(ctx context.Context, o *T, u T, tx *gorm.DB) (*T, error) {
 	conn := b.UseTransaction(tx)
 	err := conn.WithContext(ctx).Model(o).Updates(u).Error
@@ -59,6 +84,24 @@ func (b *BaseRepositoryImpl[T]) Save(ctx context.Context, o *T, tx *gorm.DB) err
 	return conn.WithContext(ctx).Omit(clause.Associations).Save(o).Error
 }
 
+// Delete removes the record. Entities with a DeletedAt field are soft deleted
+// and drop out of every query; the others are removed for good.
+func (b *BaseRepositoryImpl[T]) Delete(ctx context.Context, o *T, tx *gorm.DB) error {
+	conn := b.UseTransaction(tx)
+	return conn.WithContext(ctx).Delete(o).Error
+}
+
+// Restore brings back a soft deleted record. The update hooks see the deleted
+// row, so the restore is audited like any other update.
+func (b *BaseRepositoryImpl[T]) Restore(ctx context.Context, o *T, tx *gorm.DB) error {
+	conn := b.UseTransaction(tx)
+	return conn.WithContext(ctx).
+		Session(&gorm.Session{PropagateUnscoped: true}).
+		Unscoped().
+		Model(o).
+		Update("deleted_at", nil).Error
+}
+
 func (b *BaseRepositoryImpl[T]) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*T, error) {
 	conn := b.UseTransaction(tx)
 	var result T
@@ -70,6 +113,19 @@ func (b *BaseRepositoryImpl[T]) FindByID(ctx context.Context, i uint, tx *gorm.D
 	return &result, nil
 }
 
+// FindDeletedByID finds a soft deleted record, for example to restore it. It
+// only works for entities with a DeletedAt field.
+func (b *BaseRepositoryImpl[T]) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*T, error) {
+	conn := b.UseTransaction(tx)
+	var result T
+	err := conn.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").First(&result, i).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 func (b *BaseRepositoryImpl[T]) FindByTemplate(ctx context.Context, t *T, tx *gorm.DB) ([]T, error) {
 	conn := b.UseTransaction(tx)
 	var results []T
@@ -94,3 +150,81 @@ func (b *BaseRepositoryImpl[T]) FindOneByTemplate(ctx context.Context, o *T, tx
 
 	return &result, nil
 }
+
+// Find returns the rows matching the query in its order, honouring its
+// limit and offset.
+func (b *BaseRepositoryImpl[T]) Find(ctx context.Context, q *Query, tx *gorm.DB) ([]T, error) {
+	if err := q.validate(); err != nil {
+		return nil, err
+	}
+
+	conn := b.UseTransaction(tx)
+	query, err := q.filter(conn.WithContext(ctx).Model(new(T)), new(T))
+	if err != nil {
+		return nil, err
+	}
+
+	var results []T
+	err = q.page(query, q.limit).Find(&results).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// Count returns the number of rows matching the conditions of the query,
+// ignoring its ordering and paging.
+func (b *BaseRepositoryImpl[T]) Count(ctx context.Context, q *Query, tx *gorm.DB) (int64, error) {
+	if err := q.validate(); err != nil {
+		return 0, err
+	}
+
+	conn := b.UseTransaction(tx)
+	var count int64
+	err := q.applyConditions(conn.WithContext(ctx).Model(new(T))).Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// List returns the page of rows matching the query. It fetches one row more
+// than the limit to know whether another page follows.
+func (b *BaseRepositoryImpl[T]) List(ctx context.Context, q *Query, tx *gorm.DB) (*CursorPage[T], error) {
+	if err := q.validate(); err != nil {
+		return nil, err
+	}
+	if q.offset > 0 {
+		return nil, errors.New("list pages by cursor, not offset")
+	}
+
+	limit := q.limit
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+
+	conn := b.UseTransaction(tx)
+	query, err := q.filter(conn.WithContext(ctx).Model(new(T)), new(T))
+	if err != nil {
+		return nil, err
+	}
+
+	var results []T
+	err = q.page(query, limit+1).Find(&results).Error
+	if err != nil {
+		return nil, err
+	}
+
+	page := &CursorPage[T]{Items: results}
+	if len(results) > limit {
+		page.Items = results[:limit]
+		page.NextCursor, err = q.encodeCursor(ctx, conn, &page.Items[limit-1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
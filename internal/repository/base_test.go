@@ -2,7 +2,10 @@ package repository_test
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -59,8 +62,8 @@ func TestBaseRepository_Create(t *testing.T) {
 			name: "successful creation",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users" ("created_at","updated_at","username","password_hash","role") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "testuser", "hashedpassword", "admin").
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users" ("created_at","updated_at","username","password_hash","role","must_change_password","password_changed_at","is_service_account") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "testuser", "hashedpassword", "admin", false, nil, false).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 				mock.ExpectCommit()
 			},
@@ -105,6 +108,80 @@ func TestBaseRepository_Create(t *testing.T) {
 	}
 }
 
+func TestBaseRepository_CreateAll(t *testing.T) {
+	db, mock := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := db.DB()
+		sqlDB.Close()
+	}()
+
+	repo := &repository.BaseRepositoryImpl[entity.User]{DB: db}
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		expectError bool
+	}{
+		{
+			name: "creates every record in one transaction",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "first", "", "default", true, nil, false).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "second", "", "default", true, nil, false).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name: "a failing record rolls back the others",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users"`)).
+					WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			users := []entity.User{
+				{Username: "first", Role: "default", MustChangePassword: true},
+				{Username: "second", Role: "default", MustChangePassword: true},
+			}
+
+			ctx := context.WithValue(context.Background(), "skip_audit", true)
+			err := repo.CreateAll(ctx, users, nil)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				if users[0].ID != 1 || users[1].ID != 2 {
+					t.Errorf("Expected IDs 1 and 2 but got %d and %d", users[0].ID, users[1].ID)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
 func TestBaseRepository_FindByID(t *testing.T) {
 	db, mock := setupMockDB(t)
 	defer func() {
@@ -278,7 +355,7 @@ func TestBaseRepository_Save(t *testing.T) {
 			setupMock: func() {
 				mock.ExpectBegin()
 				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "testuser", "hashedpassword", "admin").
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "testuser", "hashedpassword", "admin", false, nil, false).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 				mock.ExpectCommit()
 			},
@@ -454,3 +531,143 @@ func TestBaseRepository_FindOneByTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseRepository_Delete(t *testing.T) {
+	db, mock := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := db.DB()
+		sqlDB.Close()
+	}()
+
+	repo := &repository.BaseRepositoryImpl[entity.Overtime]{DB: db}
+
+	startAt := time.Date(2025, 1, 15, 18, 0, 0, 0, time.UTC)
+	overtime := &entity.Overtime{Base: entity.Base{ID: 7}, EmployeeID: 2, StartAt: startAt, Status: entity.ApprovalStatusApproved}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "overtimes" WHERE id = $1 AND "overtimes"."deleted_at" IS NULL ORDER BY "overtimes"."id" LIMIT $2`)).
+		WithArgs(int64(7), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "start_at", "description", "status"}).
+			AddRow(7, 2, startAt, "Release night", entity.ApprovalStatusApproved))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "overtimes" SET "deleted_at"=$1 WHERE "overtimes"."id" = $2 AND "overtimes"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// The audit entry keeps the full record, not only a diff
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_logs" ("table_name","record_id","action","data_before","data_after","user_id","ip_address","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs("overtimes", int64(7), entity.AuditLogActionDelete, jsonContaining{`"Description":"Release night"`, `"Status":"approved"`}, nil, "", "", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	if err := repo.Delete(context.Background(), overtime, nil); err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
+
+func TestBaseRepository_Restore(t *testing.T) {
+	db, mock := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := db.DB()
+		sqlDB.Close()
+	}()
+
+	repo := &repository.BaseRepositoryImpl[entity.Reimbursement]{DB: db}
+
+	reimbursement := &entity.Reimbursement{Base: entity.Base{ID: 3}, EmployeeID: 2}
+	deletedAt := time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), "skip_audit", true)
+
+	mock.ExpectBegin()
+	// The hooks read the deleted row, so the restore is audited as an update
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "reimbursements" WHERE id = $1 ORDER BY "reimbursements"."id" LIMIT $2`)).
+		WithArgs(int64(3), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "deleted_at"}).AddRow(3, 2, deletedAt))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "reimbursements" SET "deleted_at"=$1,"updated_at"=$2 WHERE "id" = $3`)).
+		WithArgs(nil, sqlmock.AnyArg(), int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := repo.Restore(ctx, reimbursement, nil); err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
+
+func TestBaseRepository_FindDeletedByID(t *testing.T) {
+	tests := []struct {
+		name        string
+		rows        *sqlmock.Rows
+		expectedErr error
+	}{
+		{
+			name: "soft deleted record is found",
+			rows: sqlmock.NewRows([]string{"id", "employee_id", "deleted_at"}).AddRow(3, 2, time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:        "record that is not deleted is not found",
+			rows:        sqlmock.NewRows([]string{"id", "employee_id", "deleted_at"}),
+			expectedErr: gorm.ErrRecordNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupMockDB(t)
+			defer func() {
+				sqlDB, _ := db.DB()
+				sqlDB.Close()
+			}()
+
+			repo := &repository.BaseRepositoryImpl[entity.Reimbursement]{DB: db}
+
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "reimbursements" WHERE deleted_at IS NOT NULL AND "reimbursements"."id" = $1 ORDER BY "reimbursements"."id" LIMIT $2`)).
+				WithArgs(3, 1).
+				WillReturnRows(tt.rows)
+
+			reimbursement, err := repo.FindDeletedByID(context.Background(), 3, nil)
+			if tt.expectedErr != nil {
+				if !errors.Is(err, tt.expectedErr) {
+					t.Errorf("Expected error %v but got: %v", tt.expectedErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if reimbursement.ID != 3 || !reimbursement.DeletedAt.Valid {
+					t.Errorf("Expected deleted reimbursement 3 but got %+v", reimbursement)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+// jsonContaining matches a JSON argument that contains every fragment.
+type jsonContaining []string
+
+func (j jsonContaining) Match(v driver.Value) bool {
+	var s string
+	switch value := v.(type) {
+	case []byte:
+		s = string(value)
+	case string:
+		s = value
+	default:
+		return false
+	}
+	for _, fragment := range j {
+		if !strings.Contains(s, fragment) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,18 @@
+package repository
+
+import "github.com/asyauqi15/payslip-system/internal/entity"
+
+//go:generate mockgen -destination=./mock/mock_department_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository DepartmentRepository
+type DepartmentRepository interface {
+	BaseRepository[entity.Department]
+}
+
+type DepartmentRepositoryImpl struct {
+	BaseRepositoryImpl[entity.Department]
+}
+
+func NewDepartmentRepository(db *BaseRepositoryImpl[entity.Department]) DepartmentRepository {
+	return &DepartmentRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
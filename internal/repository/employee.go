@@ -1,10 +1,18 @@
 package repository
 
-import "github.com/asyauqi15/payslip-system/internal/entity"
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
 
 //go:generate mockgen -destination=./mock/mock_employee_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository EmployeeRepository
 type EmployeeRepository interface {
 	BaseRepository[entity.Employee]
+	CreateWithUsers(ctx context.Context, users []entity.User, employees []entity.Employee, tx *gorm.DB) error
 }
 
 type EmployeeRepositoryImpl struct {
@@ -16,3 +24,27 @@ func NewEmployeeRepository(db *BaseRepositoryImpl[entity.Employee]) EmployeeRepo
 		BaseRepositoryImpl: *db,
 	}
 }
+
+// CreateWithUsers creates each employee together with the user at the same
+// index, linking the two, in one transaction so either every employee is
+// created or none is.
+func (r *EmployeeRepositoryImpl) CreateWithUsers(ctx context.Context, users []entity.User, employees []entity.Employee, tx *gorm.DB) error {
+	if len(users) != len(employees) {
+		return errors.New("every employee needs a user")
+	}
+
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range employees {
+			if err := tx.Omit(clause.Associations).Create(&users[i]).Error; err != nil {
+				return err
+			}
+			employees[i].UserID = users[i].ID
+			if err := tx.Omit(clause.Associations).Create(&employees[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -55,8 +55,8 @@ func TestEmployeeRepository_Create(t *testing.T) {
 			name: "successful creation",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "employees" ("created_at","updated_at","user_id","base_salary") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(50000)).
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "employees" ("created_at","updated_at","user_id","base_salary","department_id","manager_id","location_id","device_id","code","currency") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(50000), nil, nil, nil, "", nil, entity.DefaultCurrency).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 				mock.ExpectCommit()
 			},
@@ -101,6 +101,72 @@ func TestEmployeeRepository_Create(t *testing.T) {
 	}
 }
 
+func TestEmployeeRepository_CreateWithUsers(t *testing.T) {
+	_, mock, repo := setupEmployeeRepoTest()
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		expectError bool
+	}{
+		{
+			name: "creates the user then the employee linked to it",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "alice", "", "default", true, nil, false).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "employees"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(7), int64(50000), nil, nil, nil, "", nil, entity.DefaultCurrency).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name: "a failing employee rolls back its user",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users"`)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "employees"`)).
+					WillReturnError(gorm.ErrDuplicatedKey)
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			users := []entity.User{{Username: "alice", Role: "default", MustChangePassword: true}}
+			employees := []entity.Employee{{BaseSalary: 50000}}
+
+			ctx := context.WithValue(context.Background(), "skip_audit", true)
+			err := repo.CreateWithUsers(ctx, users, employees, nil)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				if employees[0].UserID != 7 {
+					t.Errorf("Expected employee linked to user 7 but got %d", employees[0].UserID)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
 func TestEmployeeRepository_FindByID(t *testing.T) {
 	_, mock, repo := setupEmployeeRepoTest()
 
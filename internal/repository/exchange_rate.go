@@ -0,0 +1,18 @@
+package repository
+
+import "github.com/asyauqi15/payslip-system/internal/entity"
+
+//go:generate mockgen -destination=./mock/mock_exchange_rate_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository ExchangeRateRepository
+type ExchangeRateRepository interface {
+	BaseRepository[entity.ExchangeRate]
+}
+
+type ExchangeRateRepositoryImpl struct {
+	BaseRepositoryImpl[entity.ExchangeRate]
+}
+
+func NewExchangeRateRepository(db *BaseRepositoryImpl[entity.ExchangeRate]) ExchangeRateRepository {
+	return &ExchangeRateRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_location_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository LocationRepository
+type LocationRepository interface {
+	BaseRepository[entity.Location]
+	ScheduleFor(ctx context.Context, employee *entity.Employee, dates DateRange, tx *gorm.DB) (*entity.WorkSchedule, error)
+	AttendancePolicyFor(ctx context.Context, employee *entity.Employee, tx *gorm.DB) (*entity.AttendancePolicy, error)
+	Geofences(ctx context.Context, locationID int64, tx *gorm.DB) ([]entity.Geofence, error)
+	SaveAttendancePolicy(ctx context.Context, location *entity.Location, geofences []entity.Geofence, tx *gorm.DB) error
+}
+
+type LocationRepositoryImpl struct {
+	BaseRepositoryImpl[entity.Location]
+}
+
+func NewLocationRepository(db *BaseRepositoryImpl[entity.Location]) LocationRepository {
+	return &LocationRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// ScheduleFor returns the work schedule of the employee's location, or the
+// default schedule when the employee has no location. For a rostered location
+// the schedule holds the employee's roster within the date range.
+func (r *LocationRepositoryImpl) ScheduleFor(ctx context.Context, employee *entity.Employee, dates DateRange, tx *gorm.DB) (*entity.WorkSchedule, error) {
+	if employee.LocationID == nil {
+		return entity.DefaultWorkSchedule(), nil
+	}
+
+	location, err := r.FindOneByTemplate(ctx, &entity.Location{Base: entity.Base{ID: *employee.LocationID}}, tx)
+	if err != nil {
+		return nil, err
+	}
+	if location == nil {
+		return entity.DefaultWorkSchedule(), nil
+	}
+
+	schedule, err := location.WorkSchedule()
+	if err != nil {
+		return nil, err
+	}
+	if schedule.Rostered {
+		if schedule.Roster, err = r.rosterFor(ctx, employee.ID, dates, tx); err != nil {
+			return nil, err
+		}
+	}
+
+	return schedule, nil
+}
+
+// AttendancePolicyFor returns the checks attendance of the employee is held
+// against: the geofences and allowlist of their location, if any, and the
+// device they are bound to.
+func (r *LocationRepositoryImpl) AttendancePolicyFor(ctx context.Context, employee *entity.Employee, tx *gorm.DB) (*entity.AttendancePolicy, error) {
+	policy := &entity.AttendancePolicy{DeviceID: employee.DeviceID}
+	if employee.LocationID == nil {
+		return policy, nil
+	}
+
+	location, err := r.FindOneByTemplate(ctx, &entity.Location{Base: entity.Base{ID: *employee.LocationID}}, tx)
+	if err != nil {
+		return nil, err
+	}
+	if location == nil {
+		return policy, nil
+	}
+
+	if policy.AllowedNetworks, err = entity.ParseNetworks(location.AllowedNetworks); err != nil {
+		return nil, err
+	}
+	if policy.Geofences, err = r.Geofences(ctx, location.ID, tx); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Geofences returns the geofences of a location in the order they were set.
+func (r *LocationRepositoryImpl) Geofences(ctx context.Context, locationID int64, tx *gorm.DB) ([]entity.Geofence, error) {
+	conn := r.UseTransaction(tx)
+
+	var geofences []entity.Geofence
+	err := conn.WithContext(ctx).Where("location_id = ?", locationID).Order("id").Find(&geofences).Error
+	if err != nil {
+		return nil, err
+	}
+	return geofences, nil
+}
+
+// SaveAttendancePolicy stores the allowlist of a location and replaces its
+// geofences in one transaction.
+func (r *LocationRepositoryImpl) SaveAttendancePolicy(ctx context.Context, location *entity.Location, geofences []entity.Geofence, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(location).Update("allowed_networks", location.AllowedNetworks).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("location_id = ?", location.ID).Delete(&entity.Geofence{}).Error; err != nil {
+			return err
+		}
+		for i := range geofences {
+			geofences[i].LocationID = location.ID
+			if err := tx.Create(&geofences[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+type rosterShift struct {
+	Date      time.Time
+	StartTime string
+	EndTime   string
+}
+
+// rosterFor loads the shifts assigned to an employee within a date range,
+// keyed by date.
+func (r *LocationRepositoryImpl) rosterFor(ctx context.Context, employeeID int64, dates DateRange, tx *gorm.DB) (map[string]entity.ShiftHours, error) {
+	conn := r.UseTransaction(tx)
+
+	query := conn.WithContext(ctx).Table("roster_entries").
+		Select("roster_entries.date, shifts.start_time, shifts.end_time").
+		Joins("JOIN shifts ON shifts.id = roster_entries.shift_id").
+		Where("roster_entries.employee_id = ?", employeeID)
+	if dates.From != nil {
+		query = query.Where("roster_entries.date >= ?", dates.From.Format("2006-01-02"))
+	}
+	if dates.To != nil {
+		query = query.Where("roster_entries.date < ?", dates.To.Format("2006-01-02"))
+	}
+
+	var rows []rosterShift
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	roster := make(map[string]entity.ShiftHours, len(rows))
+	for _, row := range rows {
+		shift := entity.Shift{StartTime: row.StartTime, EndTime: row.EndTime}
+		hours, err := shift.Hours()
+		if err != nil {
+			return nil, err
+		}
+		roster[row.Date.Format("2006-01-02")] = hours
+	}
+	return roster, nil
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_login_challenge_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository LoginChallengeRepository
+type LoginChallengeRepository interface {
+	BaseRepository[entity.LoginChallenge]
+	RecordFailure(ctx context.Context, id int64, failedAt time.Time, tx *gorm.DB) error
+	Complete(ctx context.Context, id int64, completedAt time.Time, tx *gorm.DB) (bool, error)
+}
+
+type LoginChallengeRepositoryImpl struct {
+	BaseRepositoryImpl[entity.LoginChallenge]
+}
+
+func NewLoginChallengeRepository(db *BaseRepositoryImpl[entity.LoginChallenge]) LoginChallengeRepository {
+	return &LoginChallengeRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// RecordFailure atomically increments the failed attempts of a challenge.
+func (r *LoginChallengeRepositoryImpl) RecordFailure(ctx context.Context, id int64, failedAt time.Time, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Model(&entity.LoginChallenge{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"failed_attempts": gorm.Expr("failed_attempts + 1"),
+			"updated_at":      failedAt,
+		}).Error
+}
+
+// Complete consumes a challenge. It returns false when the challenge was
+// already completed, so a challenge token can only issue tokens once.
+func (r *LoginChallengeRepositoryImpl) Complete(ctx context.Context, id int64, completedAt time.Time, tx *gorm.DB) (bool, error) {
+	conn := r.UseTransaction(tx)
+
+	result := conn.WithContext(ctx).Model(&entity.LoginChallenge{}).
+		Where("id = ? AND completed_at IS NULL", id).
+		UpdateColumns(map[string]interface{}{
+			"completed_at": completedAt,
+			"updated_at":   completedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_login_throttle_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository LoginThrottleRepository
+type LoginThrottleRepository interface {
+	BaseRepository[entity.LoginThrottle]
+	RecordFailure(ctx context.Context, scope string, identifier string, failedAt time.Time, windowStart time.Time, tx *gorm.DB) (*entity.LoginThrottle, error)
+	Reset(ctx context.Context, scope string, identifier string, tx *gorm.DB) error
+}
+
+type LoginThrottleRepositoryImpl struct {
+	BaseRepositoryImpl[entity.LoginThrottle]
+}
+
+func NewLoginThrottleRepository(db *BaseRepositoryImpl[entity.LoginThrottle]) LoginThrottleRepository {
+	return &LoginThrottleRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// RecordFailure atomically increments the failure counter of a scope and
+// identifier pair and returns the updated row. The counter restarts at one
+// when the previous failure happened before windowStart.
+func (r *LoginThrottleRepositoryImpl) RecordFailure(ctx context.Context, scope string, identifier string, failedAt time.Time, windowStart time.Time, tx *gorm.DB) (*entity.LoginThrottle, error) {
+	conn := r.UseTransaction(tx)
+	var throttle entity.LoginThrottle
+
+	err := conn.WithContext(ctx).Raw(`INSERT INTO login_throttles (scope, identifier, failed_attempts, last_failed_at, created_at, updated_at)
+VALUES (?, ?, 1, ?, ?, ?)
+ON CONFLICT (scope, identifier) DO UPDATE SET
+failed_attempts = CASE WHEN login_throttles.last_failed_at < ? THEN 1 ELSE login_throttles.failed_attempts + 1 END,
+last_failed_at = EXCLUDED.last_failed_at,
+updated_at = EXCLUDED.updated_at
+RETURNING *`, scope, identifier, failedAt, failedAt, failedAt, windowStart).Scan(&throttle).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &throttle, nil
+}
+
+func (r *LoginThrottleRepositoryImpl) Reset(ctx context.Context, scope string, identifier string, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).
+		Where("scope = ? AND identifier = ?", scope, identifier).
+		Delete(&entity.LoginThrottle{}).Error
+}
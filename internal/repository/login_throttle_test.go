@@ -0,0 +1,125 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupLoginThrottleRepoTest() (*gorm.DB, sqlmock.Sqlmock, repository.LoginThrottleRepository) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       db,
+		DriverName: "postgres",
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	baseRepo := &repository.BaseRepositoryImpl[entity.LoginThrottle]{DB: gormDB}
+	repo := repository.NewLoginThrottleRepository(baseRepo)
+
+	return gormDB, mock, repo
+}
+
+func TestLoginThrottleRepository_RecordFailure(t *testing.T) {
+	_, mock, repo := setupLoginThrottleRepoTest()
+
+	query := `INSERT INTO login_throttles (scope, identifier, failed_attempts, last_failed_at, created_at, updated_at)
+VALUES ($1, $2, 1, $3, $4, $5)
+ON CONFLICT (scope, identifier) DO UPDATE SET
+failed_attempts = CASE WHEN login_throttles.last_failed_at < $6 THEN 1 ELSE login_throttles.failed_attempts + 1 END,
+last_failed_at = EXCLUDED.last_failed_at,
+updated_at = EXCLUDED.updated_at
+RETURNING *`
+	failedAt := time.Date(2025, 6, 16, 9, 0, 0, 0, time.UTC)
+	windowStart := failedAt.Add(-15 * time.Minute)
+
+	tests := []struct {
+		name             string
+		setupMock        func()
+		expectError      bool
+		expectedAttempts int
+	}{
+		{
+			name: "increments existing counter",
+			setupMock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(entity.LoginThrottleScopeUsername, "employee001", failedAt, failedAt, failedAt, windowStart).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "scope", "identifier", "failed_attempts", "last_failed_at", "created_at", "updated_at"}).
+						AddRow(1, entity.LoginThrottleScopeUsername, "employee001", 3, failedAt, failedAt, failedAt))
+			},
+			expectedAttempts: 3,
+		},
+		{
+			name: "database error",
+			setupMock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(entity.LoginThrottleScopeUsername, "employee001", failedAt, failedAt, failedAt, windowStart).
+					WillReturnError(gorm.ErrInvalidDB)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			throttle, err := repo.RecordFailure(context.Background(), entity.LoginThrottleScopeUsername, "employee001", failedAt, windowStart, nil)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if throttle.FailedAttempts != tt.expectedAttempts {
+					t.Errorf("Expected %d failed attempts but got %d", tt.expectedAttempts, throttle.FailedAttempts)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("There were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestLoginThrottleRepository_Reset(t *testing.T) {
+	_, mock, repo := setupLoginThrottleRepoTest()
+
+	query := `DELETE FROM "login_throttles" WHERE scope = $1 AND identifier = $2`
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(query)).
+		WithArgs(entity.LoginThrottleScopeUsername, "employee001").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := repo.Reset(context.Background(), entity.LoginThrottleScopeUsername, "employee001", nil); err != nil {
+		t.Errorf("Expected no error but got: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("There were unfulfilled expectations: %s", err)
+	}
+}
@@ -0,0 +1,294 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/asyauqi15/payslip-system/internal/repository (interfaces: APIKeyRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock/mock_api_key_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository APIKeyRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockAPIKeyRepository is a mock of APIKeyRepository interface.
+type MockAPIKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAPIKeyRepositoryMockRecorder is the mock recorder for MockAPIKeyRepository.
+type MockAPIKeyRepositoryMockRecorder struct {
+	mock *MockAPIKeyRepository
+}
+
+// NewMockAPIKeyRepository creates a new mock instance.
+func NewMockAPIKeyRepository(ctrl *gomock.Controller) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockAPIKeyRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockAPIKeyRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAPIKeyRepository)(nil).Count), ctx, q, tx)
+}
+
+// Create mocks base method.
+func (m *MockAPIKeyRepository) Create(ctx context.Context, o *entity.APIKey, tx *gorm.DB) (*entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, o, tx)
+	ret0, _ := ret[0].(*entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAPIKeyRepositoryMockRecorder) Create(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPIKeyRepository)(nil).Create), ctx, o, tx)
+}
+
+// CreateAll mocks base method.
+func (m *MockAPIKeyRepository) CreateAll(ctx context.Context, records []entity.APIKey, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockAPIKeyRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockAPIKeyRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// CreateWithScopes mocks base method.
+func (m *MockAPIKeyRepository) CreateWithScopes(ctx context.Context, key *entity.APIKey, scopes []string, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithScopes", ctx, key, scopes, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithScopes indicates an expected call of CreateWithScopes.
+func (mr *MockAPIKeyRepositoryMockRecorder) CreateWithScopes(ctx, key, scopes, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithScopes", reflect.TypeOf((*MockAPIKeyRepository)(nil).CreateWithScopes), ctx, key, scopes, tx)
+}
+
+// Delete mocks base method.
+func (m *MockAPIKeyRepository) Delete(ctx context.Context, o *entity.APIKey, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAPIKeyRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAPIKeyRepository)(nil).Delete), ctx, o, tx)
+}
+
+// Find mocks base method.
+func (m *MockAPIKeyRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockAPIKeyRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockAPIKeyRepository)(nil).Find), ctx, q, tx)
+}
+
+// FindByID mocks base method.
+func (m *MockAPIKeyRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindByID), ctx, i, tx)
+}
+
+// FindByTemplate mocks base method.
+func (m *MockAPIKeyRepository) FindByTemplate(ctx context.Context, t *entity.APIKey, tx *gorm.DB) ([]entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTemplate", ctx, t, tx)
+	ret0, _ := ret[0].([]entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTemplate indicates an expected call of FindByTemplate.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindByTemplate(ctx, t, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindByTemplate), ctx, t, tx)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockAPIKeyRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
+// FindOneByTemplate mocks base method.
+func (m *MockAPIKeyRepository) FindOneByTemplate(ctx context.Context, o *entity.APIKey, tx *gorm.DB) (*entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByTemplate", ctx, o, tx)
+	ret0, _ := ret[0].(*entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByTemplate indicates an expected call of FindOneByTemplate.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindOneByTemplate(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindOneByTemplate), ctx, o, tx)
+}
+
+// FindScopes mocks base method.
+func (m *MockAPIKeyRepository) FindScopes(ctx context.Context, apiKeyID int64, tx *gorm.DB) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindScopes", ctx, apiKeyID, tx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindScopes indicates an expected call of FindScopes.
+func (mr *MockAPIKeyRepositoryMockRecorder) FindScopes(ctx, apiKeyID, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindScopes", reflect.TypeOf((*MockAPIKeyRepository)(nil).FindScopes), ctx, apiKeyID, tx)
+}
+
+// List mocks base method.
+func (m *MockAPIKeyRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.APIKey], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.APIKey])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAPIKeyRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAPIKeyRepository)(nil).List), ctx, q, tx)
+}
+
+// MarkUsed mocks base method.
+func (m *MockAPIKeyRepository) MarkUsed(ctx context.Context, id int64, usedAt time.Time, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUsed", ctx, id, usedAt, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockAPIKeyRepositoryMockRecorder) MarkUsed(ctx, id, usedAt, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockAPIKeyRepository)(nil).MarkUsed), ctx, id, usedAt, tx)
+}
+
+// Restore mocks base method.
+func (m *MockAPIKeyRepository) Restore(ctx context.Context, o *entity.APIKey, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAPIKeyRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAPIKeyRepository)(nil).Restore), ctx, o, tx)
+}
+
+// Revoke mocks base method.
+func (m *MockAPIKeyRepository) Revoke(ctx context.Context, id int64, revokedAt time.Time, tx *gorm.DB) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, id, revokedAt, tx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockAPIKeyRepositoryMockRecorder) Revoke(ctx, id, revokedAt, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockAPIKeyRepository)(nil).Revoke), ctx, id, revokedAt, tx)
+}
+
+// Save mocks base method.
+func (m *MockAPIKeyRepository) Save(ctx context.Context, o *entity.APIKey, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAPIKeyRepositoryMockRecorder) Save(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAPIKeyRepository)(nil).Save), ctx, o, tx)
+}
+
+// Updates mocks base method.
+func (m *MockAPIKeyRepository) Updates(ctx context.Context, o *entity.APIKey, u entity.APIKey, tx *gorm.DB) (*entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Updates", ctx, o, u, tx)
+	ret0, _ := ret[0].(*entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Updates indicates an expected call of Updates.
+func (mr *MockAPIKeyRepositoryMockRecorder) Updates(ctx, o, u, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Updates", reflect.TypeOf((*MockAPIKeyRepository)(nil).Updates), ctx, o, u, tx)
+}
@@ -0,0 +1,249 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/asyauqi15/payslip-system/internal/repository (interfaces: AttendanceCorrectionRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock/mock_attendance_correction_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository AttendanceCorrectionRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockAttendanceCorrectionRepository is a mock of AttendanceCorrectionRepository interface.
+type MockAttendanceCorrectionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttendanceCorrectionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAttendanceCorrectionRepositoryMockRecorder is the mock recorder for MockAttendanceCorrectionRepository.
+type MockAttendanceCorrectionRepositoryMockRecorder struct {
+	mock *MockAttendanceCorrectionRepository
+}
+
+// NewMockAttendanceCorrectionRepository creates a new mock instance.
+func NewMockAttendanceCorrectionRepository(ctrl *gomock.Controller) *MockAttendanceCorrectionRepository {
+	mock := &MockAttendanceCorrectionRepository{ctrl: ctrl}
+	mock.recorder = &MockAttendanceCorrectionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttendanceCorrectionRepository) EXPECT() *MockAttendanceCorrectionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockAttendanceCorrectionRepository) Apply(ctx context.Context, correction *entity.AttendanceCorrection, attendance *entity.Attendance, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", ctx, correction, attendance, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) Apply(ctx, correction, attendance, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).Apply), ctx, correction, attendance, tx)
+}
+
+// Count mocks base method.
+func (m *MockAttendanceCorrectionRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).Count), ctx, q, tx)
+}
+
+// Create mocks base method.
+func (m *MockAttendanceCorrectionRepository) Create(ctx context.Context, o *entity.AttendanceCorrection, tx *gorm.DB) (*entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, o, tx)
+	ret0, _ := ret[0].(*entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) Create(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).Create), ctx, o, tx)
+}
+
+// CreateAll mocks base method.
+func (m *MockAttendanceCorrectionRepository) CreateAll(ctx context.Context, records []entity.AttendanceCorrection, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// Delete mocks base method.
+func (m *MockAttendanceCorrectionRepository) Delete(ctx context.Context, o *entity.AttendanceCorrection, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).Delete), ctx, o, tx)
+}
+
+// Find mocks base method.
+func (m *MockAttendanceCorrectionRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).Find), ctx, q, tx)
+}
+
+// FindByID mocks base method.
+func (m *MockAttendanceCorrectionRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) FindByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).FindByID), ctx, i, tx)
+}
+
+// FindByTemplate mocks base method.
+func (m *MockAttendanceCorrectionRepository) FindByTemplate(ctx context.Context, t *entity.AttendanceCorrection, tx *gorm.DB) ([]entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTemplate", ctx, t, tx)
+	ret0, _ := ret[0].([]entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTemplate indicates an expected call of FindByTemplate.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) FindByTemplate(ctx, t, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).FindByTemplate), ctx, t, tx)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockAttendanceCorrectionRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
+// FindOneByTemplate mocks base method.
+func (m *MockAttendanceCorrectionRepository) FindOneByTemplate(ctx context.Context, o *entity.AttendanceCorrection, tx *gorm.DB) (*entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByTemplate", ctx, o, tx)
+	ret0, _ := ret[0].(*entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByTemplate indicates an expected call of FindOneByTemplate.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) FindOneByTemplate(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).FindOneByTemplate), ctx, o, tx)
+}
+
+// List mocks base method.
+func (m *MockAttendanceCorrectionRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.AttendanceCorrection], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.AttendanceCorrection])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).List), ctx, q, tx)
+}
+
+// Restore mocks base method.
+func (m *MockAttendanceCorrectionRepository) Restore(ctx context.Context, o *entity.AttendanceCorrection, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).Restore), ctx, o, tx)
+}
+
+// Save mocks base method.
+func (m *MockAttendanceCorrectionRepository) Save(ctx context.Context, o *entity.AttendanceCorrection, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) Save(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).Save), ctx, o, tx)
+}
+
+// Updates mocks base method.
+func (m *MockAttendanceCorrectionRepository) Updates(ctx context.Context, o *entity.AttendanceCorrection, u entity.AttendanceCorrection, tx *gorm.DB) (*entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Updates", ctx, o, u, tx)
+	ret0, _ := ret[0].(*entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Updates indicates an expected call of Updates.
+func (mr *MockAttendanceCorrectionRepositoryMockRecorder) Updates(ctx, o, u, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Updates", reflect.TypeOf((*MockAttendanceCorrectionRepository)(nil).Updates), ctx, o, u, tx)
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
 	gomock "go.uber.org/mock/gomock"
 	gorm "gorm.io/gorm"
 )
@@ -42,6 +43,21 @@ func (m *MockAttendancePeriodRepository) EXPECT() *MockAttendancePeriodRepositor
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockAttendancePeriodRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockAttendancePeriodRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAttendancePeriodRepository)(nil).Count), ctx, q, tx)
+}
+
 // Create mocks base method.
 func (m *MockAttendancePeriodRepository) Create(ctx context.Context, o *entity.AttendancePeriod, tx *gorm.DB) (*entity.AttendancePeriod, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +73,49 @@ func (mr *MockAttendancePeriodRepositoryMockRecorder) Create(ctx, o, tx any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttendancePeriodRepository)(nil).Create), ctx, o, tx)
 }
 
+// CreateAll mocks base method.
+func (m *MockAttendancePeriodRepository) CreateAll(ctx context.Context, records []entity.AttendancePeriod, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockAttendancePeriodRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockAttendancePeriodRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// Delete mocks base method.
+func (m *MockAttendancePeriodRepository) Delete(ctx context.Context, o *entity.AttendancePeriod, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttendancePeriodRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttendancePeriodRepository)(nil).Delete), ctx, o, tx)
+}
+
+// Find mocks base method.
+func (m *MockAttendancePeriodRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.AttendancePeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.AttendancePeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockAttendancePeriodRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockAttendancePeriodRepository)(nil).Find), ctx, q, tx)
+}
+
 // FindByID mocks base method.
 func (m *MockAttendancePeriodRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.AttendancePeriod, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +146,21 @@ func (mr *MockAttendancePeriodRepositoryMockRecorder) FindByTemplate(ctx, t, tx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockAttendancePeriodRepository)(nil).FindByTemplate), ctx, t, tx)
 }
 
+// FindDeletedByID mocks base method.
+func (m *MockAttendancePeriodRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.AttendancePeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.AttendancePeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockAttendancePeriodRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockAttendancePeriodRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
 // FindOneByTemplate mocks base method.
 func (m *MockAttendancePeriodRepository) FindOneByTemplate(ctx context.Context, o *entity.AttendancePeriod, tx *gorm.DB) (*entity.AttendancePeriod, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +176,35 @@ func (mr *MockAttendancePeriodRepositoryMockRecorder) FindOneByTemplate(ctx, o,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockAttendancePeriodRepository)(nil).FindOneByTemplate), ctx, o, tx)
 }
 
+// List mocks base method.
+func (m *MockAttendancePeriodRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.AttendancePeriod], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.AttendancePeriod])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAttendancePeriodRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAttendancePeriodRepository)(nil).List), ctx, q, tx)
+}
+
+// Restore mocks base method.
+func (m *MockAttendancePeriodRepository) Restore(ctx context.Context, o *entity.AttendancePeriod, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAttendancePeriodRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAttendancePeriodRepository)(nil).Restore), ctx, o, tx)
+}
+
 // Save mocks base method.
 func (m *MockAttendancePeriodRepository) Save(ctx context.Context, o *entity.AttendancePeriod, tx *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
 	gomock "go.uber.org/mock/gomock"
 	gorm "gorm.io/gorm"
 )
@@ -23,6 +24,7 @@ import (
 type MockAttendanceRepository struct {
 	ctrl     *gomock.Controller
 	recorder *MockAttendanceRepositoryMockRecorder
+	isgomock struct{}
 }
 
 // MockAttendanceRepositoryMockRecorder is the mock recorder for MockAttendanceRepository.
@@ -42,106 +44,267 @@ func (m *MockAttendanceRepository) EXPECT() *MockAttendanceRepositoryMockRecorde
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockAttendanceRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockAttendanceRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAttendanceRepository)(nil).Count), ctx, q, tx)
+}
+
 // CountAttendanceInPeriod mocks base method.
-func (m *MockAttendanceRepository) CountAttendanceInPeriod(arg0 context.Context, arg1 int64, arg2, arg3 time.Time, arg4 *gorm.DB) (int64, error) {
+func (m *MockAttendanceRepository) CountAttendanceInPeriod(ctx context.Context, employeeID int64, startDate, endDate time.Time, tx *gorm.DB) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountAttendanceInPeriod", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "CountAttendanceInPeriod", ctx, employeeID, startDate, endDate, tx)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountAttendanceInPeriod indicates an expected call of CountAttendanceInPeriod.
-func (mr *MockAttendanceRepositoryMockRecorder) CountAttendanceInPeriod(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+func (mr *MockAttendanceRepositoryMockRecorder) CountAttendanceInPeriod(ctx, employeeID, startDate, endDate, tx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAttendanceInPeriod", reflect.TypeOf((*MockAttendanceRepository)(nil).CountAttendanceInPeriod), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAttendanceInPeriod", reflect.TypeOf((*MockAttendanceRepository)(nil).CountAttendanceInPeriod), ctx, employeeID, startDate, endDate, tx)
 }
 
 // Create mocks base method.
-func (m *MockAttendanceRepository) Create(arg0 context.Context, arg1 *entity.Attendance, arg2 *gorm.DB) (*entity.Attendance, error) {
+func (m *MockAttendanceRepository) Create(ctx context.Context, o *entity.Attendance, tx *gorm.DB) (*entity.Attendance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Create", ctx, o, tx)
 	ret0, _ := ret[0].(*entity.Attendance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockAttendanceRepositoryMockRecorder) Create(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockAttendanceRepositoryMockRecorder) Create(ctx, o, tx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttendanceRepository)(nil).Create), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttendanceRepository)(nil).Create), ctx, o, tx)
+}
+
+// CreateAll mocks base method.
+func (m *MockAttendanceRepository) CreateAll(ctx context.Context, records []entity.Attendance, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockAttendanceRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockAttendanceRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// Delete mocks base method.
+func (m *MockAttendanceRepository) Delete(ctx context.Context, o *entity.Attendance, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttendanceRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttendanceRepository)(nil).Delete), ctx, o, tx)
+}
+
+// Find mocks base method.
+func (m *MockAttendanceRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockAttendanceRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockAttendanceRepository)(nil).Find), ctx, q, tx)
+}
+
+// FindByEmployee mocks base method.
+func (m *MockAttendanceRepository) FindByEmployee(ctx context.Context, employeeID int64, dates repository.DateRange, page repository.Pagination, tx *gorm.DB) ([]entity.Attendance, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmployee", ctx, employeeID, dates, page, tx)
+	ret0, _ := ret[0].([]entity.Attendance)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByEmployee indicates an expected call of FindByEmployee.
+func (mr *MockAttendanceRepositoryMockRecorder) FindByEmployee(ctx, employeeID, dates, page, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmployee", reflect.TypeOf((*MockAttendanceRepository)(nil).FindByEmployee), ctx, employeeID, dates, page, tx)
 }
 
 // FindByID mocks base method.
-func (m *MockAttendanceRepository) FindByID(arg0 context.Context, arg1 uint, arg2 *gorm.DB) (*entity.Attendance, error) {
+func (m *MockAttendanceRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Attendance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "FindByID", ctx, i, tx)
 	ret0, _ := ret[0].(*entity.Attendance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockAttendanceRepositoryMockRecorder) FindByID(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockAttendanceRepositoryMockRecorder) FindByID(ctx, i, tx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAttendanceRepository)(nil).FindByID), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAttendanceRepository)(nil).FindByID), ctx, i, tx)
 }
 
 // FindByTemplate mocks base method.
-func (m *MockAttendanceRepository) FindByTemplate(arg0 context.Context, arg1 *entity.Attendance, arg2 *gorm.DB) ([]entity.Attendance, error) {
+func (m *MockAttendanceRepository) FindByTemplate(ctx context.Context, t *entity.Attendance, tx *gorm.DB) ([]entity.Attendance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByTemplate", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "FindByTemplate", ctx, t, tx)
 	ret0, _ := ret[0].([]entity.Attendance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByTemplate indicates an expected call of FindByTemplate.
-func (mr *MockAttendanceRepositoryMockRecorder) FindByTemplate(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockAttendanceRepositoryMockRecorder) FindByTemplate(ctx, t, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockAttendanceRepository)(nil).FindByTemplate), ctx, t, tx)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockAttendanceRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockAttendanceRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockAttendanceRepository)(nil).FindByTemplate), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockAttendanceRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
+// FindIDsInPeriod mocks base method.
+func (m *MockAttendanceRepository) FindIDsInPeriod(ctx context.Context, employeeID int64, startDate, endDate time.Time, tx *gorm.DB) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIDsInPeriod", ctx, employeeID, startDate, endDate, tx)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIDsInPeriod indicates an expected call of FindIDsInPeriod.
+func (mr *MockAttendanceRepositoryMockRecorder) FindIDsInPeriod(ctx, employeeID, startDate, endDate, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIDsInPeriod", reflect.TypeOf((*MockAttendanceRepository)(nil).FindIDsInPeriod), ctx, employeeID, startDate, endDate, tx)
 }
 
 // FindOneByTemplate mocks base method.
-func (m *MockAttendanceRepository) FindOneByTemplate(arg0 context.Context, arg1 *entity.Attendance, arg2 *gorm.DB) (*entity.Attendance, error) {
+func (m *MockAttendanceRepository) FindOneByTemplate(ctx context.Context, o *entity.Attendance, tx *gorm.DB) (*entity.Attendance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByTemplate", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "FindOneByTemplate", ctx, o, tx)
 	ret0, _ := ret[0].(*entity.Attendance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneByTemplate indicates an expected call of FindOneByTemplate.
-func (mr *MockAttendanceRepositoryMockRecorder) FindOneByTemplate(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockAttendanceRepositoryMockRecorder) FindOneByTemplate(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockAttendanceRepository)(nil).FindOneByTemplate), ctx, o, tx)
+}
+
+// Import mocks base method.
+func (m *MockAttendanceRepository) Import(ctx context.Context, attendances []entity.Attendance, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, attendances, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockAttendanceRepositoryMockRecorder) Import(ctx, attendances, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockAttendanceRepository)(nil).Import), ctx, attendances, tx)
+}
+
+// List mocks base method.
+func (m *MockAttendanceRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.Attendance], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.Attendance])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAttendanceRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAttendanceRepository)(nil).List), ctx, q, tx)
+}
+
+// Record mocks base method.
+func (m *MockAttendanceRepository) Record(ctx context.Context, attendance *entity.Attendance, violations []entity.AttendanceViolation, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, attendance, violations, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAttendanceRepositoryMockRecorder) Record(ctx, attendance, violations, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAttendanceRepository)(nil).Record), ctx, attendance, violations, tx)
+}
+
+// Restore mocks base method.
+func (m *MockAttendanceRepository) Restore(ctx context.Context, o *entity.Attendance, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAttendanceRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockAttendanceRepository)(nil).FindOneByTemplate), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAttendanceRepository)(nil).Restore), ctx, o, tx)
 }
 
 // Save mocks base method.
-func (m *MockAttendanceRepository) Save(arg0 context.Context, arg1 *entity.Attendance, arg2 *gorm.DB) error {
+func (m *MockAttendanceRepository) Save(ctx context.Context, o *entity.Attendance, tx *gorm.DB) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Save", ctx, o, tx)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockAttendanceRepositoryMockRecorder) Save(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockAttendanceRepositoryMockRecorder) Save(ctx, o, tx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAttendanceRepository)(nil).Save), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAttendanceRepository)(nil).Save), ctx, o, tx)
 }
 
 // Updates mocks base method.
-func (m *MockAttendanceRepository) Updates(arg0 context.Context, arg1 *entity.Attendance, arg2 entity.Attendance, arg3 *gorm.DB) (*entity.Attendance, error) {
+func (m *MockAttendanceRepository) Updates(ctx context.Context, o *entity.Attendance, u entity.Attendance, tx *gorm.DB) (*entity.Attendance, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Updates", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "Updates", ctx, o, u, tx)
 	ret0, _ := ret[0].(*entity.Attendance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Updates indicates an expected call of Updates.
-func (mr *MockAttendanceRepositoryMockRecorder) Updates(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockAttendanceRepositoryMockRecorder) Updates(ctx, o, u, tx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Updates", reflect.TypeOf((*MockAttendanceRepository)(nil).Updates), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Updates", reflect.TypeOf((*MockAttendanceRepository)(nil).Updates), ctx, o, u, tx)
 }
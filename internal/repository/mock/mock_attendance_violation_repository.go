@@ -0,0 +1,235 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/asyauqi15/payslip-system/internal/repository (interfaces: AttendanceViolationRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock/mock_attendance_violation_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository AttendanceViolationRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockAttendanceViolationRepository is a mock of AttendanceViolationRepository interface.
+type MockAttendanceViolationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttendanceViolationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAttendanceViolationRepositoryMockRecorder is the mock recorder for MockAttendanceViolationRepository.
+type MockAttendanceViolationRepositoryMockRecorder struct {
+	mock *MockAttendanceViolationRepository
+}
+
+// NewMockAttendanceViolationRepository creates a new mock instance.
+func NewMockAttendanceViolationRepository(ctrl *gomock.Controller) *MockAttendanceViolationRepository {
+	mock := &MockAttendanceViolationRepository{ctrl: ctrl}
+	mock.recorder = &MockAttendanceViolationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttendanceViolationRepository) EXPECT() *MockAttendanceViolationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockAttendanceViolationRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).Count), ctx, q, tx)
+}
+
+// Create mocks base method.
+func (m *MockAttendanceViolationRepository) Create(ctx context.Context, o *entity.AttendanceViolation, tx *gorm.DB) (*entity.AttendanceViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, o, tx)
+	ret0, _ := ret[0].(*entity.AttendanceViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) Create(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).Create), ctx, o, tx)
+}
+
+// CreateAll mocks base method.
+func (m *MockAttendanceViolationRepository) CreateAll(ctx context.Context, records []entity.AttendanceViolation, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// Delete mocks base method.
+func (m *MockAttendanceViolationRepository) Delete(ctx context.Context, o *entity.AttendanceViolation, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).Delete), ctx, o, tx)
+}
+
+// Find mocks base method.
+func (m *MockAttendanceViolationRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.AttendanceViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.AttendanceViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).Find), ctx, q, tx)
+}
+
+// FindByID mocks base method.
+func (m *MockAttendanceViolationRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.AttendanceViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.AttendanceViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) FindByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).FindByID), ctx, i, tx)
+}
+
+// FindByTemplate mocks base method.
+func (m *MockAttendanceViolationRepository) FindByTemplate(ctx context.Context, t *entity.AttendanceViolation, tx *gorm.DB) ([]entity.AttendanceViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTemplate", ctx, t, tx)
+	ret0, _ := ret[0].([]entity.AttendanceViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTemplate indicates an expected call of FindByTemplate.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) FindByTemplate(ctx, t, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).FindByTemplate), ctx, t, tx)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockAttendanceViolationRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.AttendanceViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.AttendanceViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
+// FindOneByTemplate mocks base method.
+func (m *MockAttendanceViolationRepository) FindOneByTemplate(ctx context.Context, o *entity.AttendanceViolation, tx *gorm.DB) (*entity.AttendanceViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByTemplate", ctx, o, tx)
+	ret0, _ := ret[0].(*entity.AttendanceViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByTemplate indicates an expected call of FindOneByTemplate.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) FindOneByTemplate(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).FindOneByTemplate), ctx, o, tx)
+}
+
+// List mocks base method.
+func (m *MockAttendanceViolationRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.AttendanceViolation], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.AttendanceViolation])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).List), ctx, q, tx)
+}
+
+// Restore mocks base method.
+func (m *MockAttendanceViolationRepository) Restore(ctx context.Context, o *entity.AttendanceViolation, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).Restore), ctx, o, tx)
+}
+
+// Save mocks base method.
+func (m *MockAttendanceViolationRepository) Save(ctx context.Context, o *entity.AttendanceViolation, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) Save(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).Save), ctx, o, tx)
+}
+
+// Updates mocks base method.
+func (m *MockAttendanceViolationRepository) Updates(ctx context.Context, o *entity.AttendanceViolation, u entity.AttendanceViolation, tx *gorm.DB) (*entity.AttendanceViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Updates", ctx, o, u, tx)
+	ret0, _ := ret[0].(*entity.AttendanceViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Updates indicates an expected call of Updates.
+func (mr *MockAttendanceViolationRepositoryMockRecorder) Updates(ctx, o, u, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Updates", reflect.TypeOf((*MockAttendanceViolationRepository)(nil).Updates), ctx, o, u, tx)
+}
@@ -0,0 +1,235 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/asyauqi15/payslip-system/internal/repository (interfaces: DepartmentRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock/mock_department_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository DepartmentRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockDepartmentRepository is a mock of DepartmentRepository interface.
+type MockDepartmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepartmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDepartmentRepositoryMockRecorder is the mock recorder for MockDepartmentRepository.
+type MockDepartmentRepositoryMockRecorder struct {
+	mock *MockDepartmentRepository
+}
+
+// NewMockDepartmentRepository creates a new mock instance.
+func NewMockDepartmentRepository(ctrl *gomock.Controller) *MockDepartmentRepository {
+	mock := &MockDepartmentRepository{ctrl: ctrl}
+	mock.recorder = &MockDepartmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepartmentRepository) EXPECT() *MockDepartmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockDepartmentRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockDepartmentRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockDepartmentRepository)(nil).Count), ctx, q, tx)
+}
+
+// Create mocks base method.
+func (m *MockDepartmentRepository) Create(ctx context.Context, o *entity.Department, tx *gorm.DB) (*entity.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, o, tx)
+	ret0, _ := ret[0].(*entity.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDepartmentRepositoryMockRecorder) Create(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDepartmentRepository)(nil).Create), ctx, o, tx)
+}
+
+// CreateAll mocks base method.
+func (m *MockDepartmentRepository) CreateAll(ctx context.Context, records []entity.Department, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockDepartmentRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockDepartmentRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// Delete mocks base method.
+func (m *MockDepartmentRepository) Delete(ctx context.Context, o *entity.Department, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDepartmentRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDepartmentRepository)(nil).Delete), ctx, o, tx)
+}
+
+// Find mocks base method.
+func (m *MockDepartmentRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockDepartmentRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockDepartmentRepository)(nil).Find), ctx, q, tx)
+}
+
+// FindByID mocks base method.
+func (m *MockDepartmentRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockDepartmentRepositoryMockRecorder) FindByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDepartmentRepository)(nil).FindByID), ctx, i, tx)
+}
+
+// FindByTemplate mocks base method.
+func (m *MockDepartmentRepository) FindByTemplate(ctx context.Context, t *entity.Department, tx *gorm.DB) ([]entity.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTemplate", ctx, t, tx)
+	ret0, _ := ret[0].([]entity.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTemplate indicates an expected call of FindByTemplate.
+func (mr *MockDepartmentRepositoryMockRecorder) FindByTemplate(ctx, t, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockDepartmentRepository)(nil).FindByTemplate), ctx, t, tx)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockDepartmentRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockDepartmentRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockDepartmentRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
+// FindOneByTemplate mocks base method.
+func (m *MockDepartmentRepository) FindOneByTemplate(ctx context.Context, o *entity.Department, tx *gorm.DB) (*entity.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByTemplate", ctx, o, tx)
+	ret0, _ := ret[0].(*entity.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByTemplate indicates an expected call of FindOneByTemplate.
+func (mr *MockDepartmentRepositoryMockRecorder) FindOneByTemplate(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockDepartmentRepository)(nil).FindOneByTemplate), ctx, o, tx)
+}
+
+// List mocks base method.
+func (m *MockDepartmentRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.Department], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.Department])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockDepartmentRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDepartmentRepository)(nil).List), ctx, q, tx)
+}
+
+// Restore mocks base method.
+func (m *MockDepartmentRepository) Restore(ctx context.Context, o *entity.Department, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockDepartmentRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockDepartmentRepository)(nil).Restore), ctx, o, tx)
+}
+
+// Save mocks base method.
+func (m *MockDepartmentRepository) Save(ctx context.Context, o *entity.Department, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockDepartmentRepositoryMockRecorder) Save(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDepartmentRepository)(nil).Save), ctx, o, tx)
+}
+
+// Updates mocks base method.
+func (m *MockDepartmentRepository) Updates(ctx context.Context, o *entity.Department, u entity.Department, tx *gorm.DB) (*entity.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Updates", ctx, o, u, tx)
+	ret0, _ := ret[0].(*entity.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Updates indicates an expected call of Updates.
+func (mr *MockDepartmentRepositoryMockRecorder) Updates(ctx, o, u, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Updates", reflect.TypeOf((*MockDepartmentRepository)(nil).Updates), ctx, o, u, tx)
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
 	gomock "go.uber.org/mock/gomock"
 	gorm "gorm.io/gorm"
 )
@@ -42,6 +43,21 @@ func (m *MockEmployeeRepository) EXPECT() *MockEmployeeRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockEmployeeRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockEmployeeRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockEmployeeRepository)(nil).Count), ctx, q, tx)
+}
+
 // Create mocks base method.
 func (m *MockEmployeeRepository) Create(ctx context.Context, o *entity.Employee, tx *gorm.DB) (*entity.Employee, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +73,63 @@ func (mr *MockEmployeeRepositoryMockRecorder) Create(ctx, o, tx any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEmployeeRepository)(nil).Create), ctx, o, tx)
 }
 
+// CreateAll mocks base method.
+func (m *MockEmployeeRepository) CreateAll(ctx context.Context, records []entity.Employee, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockEmployeeRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockEmployeeRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// CreateWithUsers mocks base method.
+func (m *MockEmployeeRepository) CreateWithUsers(ctx context.Context, users []entity.User, employees []entity.Employee, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithUsers", ctx, users, employees, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithUsers indicates an expected call of CreateWithUsers.
+func (mr *MockEmployeeRepositoryMockRecorder) CreateWithUsers(ctx, users, employees, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithUsers", reflect.TypeOf((*MockEmployeeRepository)(nil).CreateWithUsers), ctx, users, employees, tx)
+}
+
+// Delete mocks base method.
+func (m *MockEmployeeRepository) Delete(ctx context.Context, o *entity.Employee, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockEmployeeRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEmployeeRepository)(nil).Delete), ctx, o, tx)
+}
+
+// Find mocks base method.
+func (m *MockEmployeeRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockEmployeeRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockEmployeeRepository)(nil).Find), ctx, q, tx)
+}
+
 // FindByID mocks base method.
 func (m *MockEmployeeRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Employee, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +160,21 @@ func (mr *MockEmployeeRepositoryMockRecorder) FindByTemplate(ctx, t, tx any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockEmployeeRepository)(nil).FindByTemplate), ctx, t, tx)
 }
 
+// FindDeletedByID mocks base method.
+func (m *MockEmployeeRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockEmployeeRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockEmployeeRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
 // FindOneByTemplate mocks base method.
 func (m *MockEmployeeRepository) FindOneByTemplate(ctx context.Context, o *entity.Employee, tx *gorm.DB) (*entity.Employee, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +190,35 @@ func (mr *MockEmployeeRepositoryMockRecorder) FindOneByTemplate(ctx, o, tx any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockEmployeeRepository)(nil).FindOneByTemplate), ctx, o, tx)
 }
 
+// List mocks base method.
+func (m *MockEmployeeRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.Employee], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.Employee])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockEmployeeRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEmployeeRepository)(nil).List), ctx, q, tx)
+}
+
+// Restore mocks base method.
+func (m *MockEmployeeRepository) Restore(ctx context.Context, o *entity.Employee, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockEmployeeRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockEmployeeRepository)(nil).Restore), ctx, o, tx)
+}
+
 // Save mocks base method.
 func (m *MockEmployeeRepository) Save(ctx context.Context, o *entity.Employee, tx *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,294 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/asyauqi15/payslip-system/internal/repository (interfaces: LocationRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock/mock_location_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository LocationRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockLocationRepository is a mock of LocationRepository interface.
+type MockLocationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLocationRepositoryMockRecorder is the mock recorder for MockLocationRepository.
+type MockLocationRepositoryMockRecorder struct {
+	mock *MockLocationRepository
+}
+
+// NewMockLocationRepository creates a new mock instance.
+func NewMockLocationRepository(ctrl *gomock.Controller) *MockLocationRepository {
+	mock := &MockLocationRepository{ctrl: ctrl}
+	mock.recorder = &MockLocationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocationRepository) EXPECT() *MockLocationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AttendancePolicyFor mocks base method.
+func (m *MockLocationRepository) AttendancePolicyFor(ctx context.Context, employee *entity.Employee, tx *gorm.DB) (*entity.AttendancePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttendancePolicyFor", ctx, employee, tx)
+	ret0, _ := ret[0].(*entity.AttendancePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttendancePolicyFor indicates an expected call of AttendancePolicyFor.
+func (mr *MockLocationRepositoryMockRecorder) AttendancePolicyFor(ctx, employee, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttendancePolicyFor", reflect.TypeOf((*MockLocationRepository)(nil).AttendancePolicyFor), ctx, employee, tx)
+}
+
+// Count mocks base method.
+func (m *MockLocationRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockLocationRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockLocationRepository)(nil).Count), ctx, q, tx)
+}
+
+// Create mocks base method.
+func (m *MockLocationRepository) Create(ctx context.Context, o *entity.Location, tx *gorm.DB) (*entity.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, o, tx)
+	ret0, _ := ret[0].(*entity.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLocationRepositoryMockRecorder) Create(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLocationRepository)(nil).Create), ctx, o, tx)
+}
+
+// CreateAll mocks base method.
+func (m *MockLocationRepository) CreateAll(ctx context.Context, records []entity.Location, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockLocationRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockLocationRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// Delete mocks base method.
+func (m *MockLocationRepository) Delete(ctx context.Context, o *entity.Location, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLocationRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLocationRepository)(nil).Delete), ctx, o, tx)
+}
+
+// Find mocks base method.
+func (m *MockLocationRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockLocationRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockLocationRepository)(nil).Find), ctx, q, tx)
+}
+
+// FindByID mocks base method.
+func (m *MockLocationRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockLocationRepositoryMockRecorder) FindByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockLocationRepository)(nil).FindByID), ctx, i, tx)
+}
+
+// FindByTemplate mocks base method.
+func (m *MockLocationRepository) FindByTemplate(ctx context.Context, t *entity.Location, tx *gorm.DB) ([]entity.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTemplate", ctx, t, tx)
+	ret0, _ := ret[0].([]entity.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTemplate indicates an expected call of FindByTemplate.
+func (mr *MockLocationRepositoryMockRecorder) FindByTemplate(ctx, t, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockLocationRepository)(nil).FindByTemplate), ctx, t, tx)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockLocationRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockLocationRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockLocationRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
+// FindOneByTemplate mocks base method.
+func (m *MockLocationRepository) FindOneByTemplate(ctx context.Context, o *entity.Location, tx *gorm.DB) (*entity.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByTemplate", ctx, o, tx)
+	ret0, _ := ret[0].(*entity.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByTemplate indicates an expected call of FindOneByTemplate.
+func (mr *MockLocationRepositoryMockRecorder) FindOneByTemplate(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockLocationRepository)(nil).FindOneByTemplate), ctx, o, tx)
+}
+
+// Geofences mocks base method.
+func (m *MockLocationRepository) Geofences(ctx context.Context, locationID int64, tx *gorm.DB) ([]entity.Geofence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Geofences", ctx, locationID, tx)
+	ret0, _ := ret[0].([]entity.Geofence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Geofences indicates an expected call of Geofences.
+func (mr *MockLocationRepositoryMockRecorder) Geofences(ctx, locationID, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Geofences", reflect.TypeOf((*MockLocationRepository)(nil).Geofences), ctx, locationID, tx)
+}
+
+// List mocks base method.
+func (m *MockLocationRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.Location], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.Location])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLocationRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLocationRepository)(nil).List), ctx, q, tx)
+}
+
+// Restore mocks base method.
+func (m *MockLocationRepository) Restore(ctx context.Context, o *entity.Location, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockLocationRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockLocationRepository)(nil).Restore), ctx, o, tx)
+}
+
+// Save mocks base method.
+func (m *MockLocationRepository) Save(ctx context.Context, o *entity.Location, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockLocationRepositoryMockRecorder) Save(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockLocationRepository)(nil).Save), ctx, o, tx)
+}
+
+// SaveAttendancePolicy mocks base method.
+func (m *MockLocationRepository) SaveAttendancePolicy(ctx context.Context, location *entity.Location, geofences []entity.Geofence, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAttendancePolicy", ctx, location, geofences, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAttendancePolicy indicates an expected call of SaveAttendancePolicy.
+func (mr *MockLocationRepositoryMockRecorder) SaveAttendancePolicy(ctx, location, geofences, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttendancePolicy", reflect.TypeOf((*MockLocationRepository)(nil).SaveAttendancePolicy), ctx, location, geofences, tx)
+}
+
+// ScheduleFor mocks base method.
+func (m *MockLocationRepository) ScheduleFor(ctx context.Context, employee *entity.Employee, dates repository.DateRange, tx *gorm.DB) (*entity.WorkSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleFor", ctx, employee, dates, tx)
+	ret0, _ := ret[0].(*entity.WorkSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleFor indicates an expected call of ScheduleFor.
+func (mr *MockLocationRepositoryMockRecorder) ScheduleFor(ctx, employee, dates, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleFor", reflect.TypeOf((*MockLocationRepository)(nil).ScheduleFor), ctx, employee, dates, tx)
+}
+
+// Updates mocks base method.
+func (m *MockLocationRepository) Updates(ctx context.Context, o *entity.Location, u entity.Location, tx *gorm.DB) (*entity.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Updates", ctx, o, u, tx)
+	ret0, _ := ret[0].(*entity.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Updates indicates an expected call of Updates.
+func (mr *MockLocationRepositoryMockRecorder) Updates(ctx, o, u, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Updates", reflect.TypeOf((*MockLocationRepository)(nil).Updates), ctx, o, u, tx)
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
 	gomock "go.uber.org/mock/gomock"
 	gorm "gorm.io/gorm"
 )
@@ -42,6 +43,21 @@ func (m *MockOvertimeRepository) EXPECT() *MockOvertimeRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockOvertimeRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockOvertimeRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockOvertimeRepository)(nil).Count), ctx, q, tx)
+}
+
 // Create mocks base method.
 func (m *MockOvertimeRepository) Create(ctx context.Context, o *entity.Overtime, tx *gorm.DB) (*entity.Overtime, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +73,65 @@ func (mr *MockOvertimeRepositoryMockRecorder) Create(ctx, o, tx any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOvertimeRepository)(nil).Create), ctx, o, tx)
 }
 
+// CreateAll mocks base method.
+func (m *MockOvertimeRepository) CreateAll(ctx context.Context, records []entity.Overtime, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockOvertimeRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockOvertimeRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// Delete mocks base method.
+func (m *MockOvertimeRepository) Delete(ctx context.Context, o *entity.Overtime, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOvertimeRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOvertimeRepository)(nil).Delete), ctx, o, tx)
+}
+
+// Find mocks base method.
+func (m *MockOvertimeRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockOvertimeRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockOvertimeRepository)(nil).Find), ctx, q, tx)
+}
+
+// FindByEmployee mocks base method.
+func (m *MockOvertimeRepository) FindByEmployee(ctx context.Context, employeeID int64, status string, dates repository.DateRange, page repository.Pagination, tx *gorm.DB) ([]entity.Overtime, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmployee", ctx, employeeID, status, dates, page, tx)
+	ret0, _ := ret[0].([]entity.Overtime)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByEmployee indicates an expected call of FindByEmployee.
+func (mr *MockOvertimeRepositoryMockRecorder) FindByEmployee(ctx, employeeID, status, dates, page, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmployee", reflect.TypeOf((*MockOvertimeRepository)(nil).FindByEmployee), ctx, employeeID, status, dates, page, tx)
+}
+
 // FindByID mocks base method.
 func (m *MockOvertimeRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Overtime, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +162,21 @@ func (mr *MockOvertimeRepositoryMockRecorder) FindByTemplate(ctx, t, tx any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockOvertimeRepository)(nil).FindByTemplate), ctx, t, tx)
 }
 
+// FindDeletedByID mocks base method.
+func (m *MockOvertimeRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockOvertimeRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockOvertimeRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
 // FindOneByTemplate mocks base method.
 func (m *MockOvertimeRepository) FindOneByTemplate(ctx context.Context, o *entity.Overtime, tx *gorm.DB) (*entity.Overtime, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +192,35 @@ func (mr *MockOvertimeRepositoryMockRecorder) FindOneByTemplate(ctx, o, tx any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockOvertimeRepository)(nil).FindOneByTemplate), ctx, o, tx)
 }
 
+// List mocks base method.
+func (m *MockOvertimeRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.Overtime], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.Overtime])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockOvertimeRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOvertimeRepository)(nil).List), ctx, q, tx)
+}
+
+// Restore mocks base method.
+func (m *MockOvertimeRepository) Restore(ctx context.Context, o *entity.Overtime, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockOvertimeRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockOvertimeRepository)(nil).Restore), ctx, o, tx)
+}
+
 // Save mocks base method.
 func (m *MockOvertimeRepository) Save(ctx context.Context, o *entity.Overtime, tx *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -12,8 +12,10 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
 	gomock "go.uber.org/mock/gomock"
 	gorm "gorm.io/gorm"
 )
@@ -42,6 +44,21 @@ func (m *MockPayrollRepository) EXPECT() *MockPayrollRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockPayrollRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockPayrollRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockPayrollRepository)(nil).Count), ctx, q, tx)
+}
+
 // Create mocks base method.
 func (m *MockPayrollRepository) Create(ctx context.Context, o *entity.Payroll, tx *gorm.DB) (*entity.Payroll, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +74,79 @@ func (mr *MockPayrollRepositoryMockRecorder) Create(ctx, o, tx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPayrollRepository)(nil).Create), ctx, o, tx)
 }
 
+// CreateAll mocks base method.
+func (m *MockPayrollRepository) CreateAll(ctx context.Context, records []entity.Payroll, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockPayrollRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockPayrollRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// Delete mocks base method.
+func (m *MockPayrollRepository) Delete(ctx context.Context, o *entity.Payroll, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPayrollRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPayrollRepository)(nil).Delete), ctx, o, tx)
+}
+
+// DeleteWithPayslips mocks base method.
+func (m *MockPayrollRepository) DeleteWithPayslips(ctx context.Context, payroll *entity.Payroll, tx *gorm.DB) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithPayslips", ctx, payroll, tx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWithPayslips indicates an expected call of DeleteWithPayslips.
+func (mr *MockPayrollRepositoryMockRecorder) DeleteWithPayslips(ctx, payroll, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithPayslips", reflect.TypeOf((*MockPayrollRepository)(nil).DeleteWithPayslips), ctx, payroll, tx)
+}
+
+// Finalize mocks base method.
+func (m *MockPayrollRepository) Finalize(ctx context.Context, payroll *entity.Payroll, finalizedBy int64, finalizedAt time.Time, tx *gorm.DB) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finalize", ctx, payroll, finalizedBy, finalizedAt, tx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Finalize indicates an expected call of Finalize.
+func (mr *MockPayrollRepositoryMockRecorder) Finalize(ctx, payroll, finalizedBy, finalizedAt, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finalize", reflect.TypeOf((*MockPayrollRepository)(nil).Finalize), ctx, payroll, finalizedBy, finalizedAt, tx)
+}
+
+// Find mocks base method.
+func (m *MockPayrollRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.Payroll, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.Payroll)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockPayrollRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockPayrollRepository)(nil).Find), ctx, q, tx)
+}
+
 // FindByID mocks base method.
 func (m *MockPayrollRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Payroll, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +177,21 @@ func (mr *MockPayrollRepositoryMockRecorder) FindByTemplate(ctx, t, tx any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockPayrollRepository)(nil).FindByTemplate), ctx, t, tx)
 }
 
+// FindDeletedByID mocks base method.
+func (m *MockPayrollRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Payroll, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.Payroll)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockPayrollRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockPayrollRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
 // FindOneByTemplate mocks base method.
 func (m *MockPayrollRepository) FindOneByTemplate(ctx context.Context, o *entity.Payroll, tx *gorm.DB) (*entity.Payroll, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +207,50 @@ func (mr *MockPayrollRepositoryMockRecorder) FindOneByTemplate(ctx, o, tx any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockPayrollRepository)(nil).FindOneByTemplate), ctx, o, tx)
 }
 
+// FindPrevious mocks base method.
+func (m *MockPayrollRepository) FindPrevious(ctx context.Context, before time.Time, tx *gorm.DB) (*entity.Payroll, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPrevious", ctx, before, tx)
+	ret0, _ := ret[0].(*entity.Payroll)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPrevious indicates an expected call of FindPrevious.
+func (mr *MockPayrollRepositoryMockRecorder) FindPrevious(ctx, before, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrevious", reflect.TypeOf((*MockPayrollRepository)(nil).FindPrevious), ctx, before, tx)
+}
+
+// List mocks base method.
+func (m *MockPayrollRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.Payroll], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.Payroll])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPayrollRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPayrollRepository)(nil).List), ctx, q, tx)
+}
+
+// Restore mocks base method.
+func (m *MockPayrollRepository) Restore(ctx context.Context, o *entity.Payroll, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockPayrollRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockPayrollRepository)(nil).Restore), ctx, o, tx)
+}
+
 // Save mocks base method.
 func (m *MockPayrollRepository) Save(ctx context.Context, o *entity.Payroll, tx *gorm.DB) error {
 	m.ctrl.T.Helper()
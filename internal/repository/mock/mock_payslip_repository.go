@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
 	gomock "go.uber.org/mock/gomock"
 	gorm "gorm.io/gorm"
 )
@@ -42,6 +43,21 @@ func (m *MockPayslipRepository) EXPECT() *MockPayslipRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockPayslipRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockPayslipRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockPayslipRepository)(nil).Count), ctx, q, tx)
+}
+
 // Create mocks base method.
 func (m *MockPayslipRepository) Create(ctx context.Context, o *entity.Payslip, tx *gorm.DB) (*entity.Payslip, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +73,65 @@ func (mr *MockPayslipRepositoryMockRecorder) Create(ctx, o, tx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPayslipRepository)(nil).Create), ctx, o, tx)
 }
 
+// CreateAll mocks base method.
+func (m *MockPayslipRepository) CreateAll(ctx context.Context, records []entity.Payslip, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockPayslipRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockPayslipRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// Delete mocks base method.
+func (m *MockPayslipRepository) Delete(ctx context.Context, o *entity.Payslip, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPayslipRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPayslipRepository)(nil).Delete), ctx, o, tx)
+}
+
+// Find mocks base method.
+func (m *MockPayslipRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.Payslip, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.Payslip)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockPayslipRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockPayslipRepository)(nil).Find), ctx, q, tx)
+}
+
+// FindByEmployee mocks base method.
+func (m *MockPayslipRepository) FindByEmployee(ctx context.Context, employeeID int64, dates repository.DateRange, page repository.Pagination, tx *gorm.DB) ([]repository.PayslipWithPeriod, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmployee", ctx, employeeID, dates, page, tx)
+	ret0, _ := ret[0].([]repository.PayslipWithPeriod)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByEmployee indicates an expected call of FindByEmployee.
+func (mr *MockPayslipRepositoryMockRecorder) FindByEmployee(ctx, employeeID, dates, page, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmployee", reflect.TypeOf((*MockPayslipRepository)(nil).FindByEmployee), ctx, employeeID, dates, page, tx)
+}
+
 // FindByID mocks base method.
 func (m *MockPayslipRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Payslip, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +162,21 @@ func (mr *MockPayslipRepositoryMockRecorder) FindByTemplate(ctx, t, tx any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockPayslipRepository)(nil).FindByTemplate), ctx, t, tx)
 }
 
+// FindDeletedByID mocks base method.
+func (m *MockPayslipRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Payslip, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.Payslip)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockPayslipRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockPayslipRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
 // FindOneByTemplate mocks base method.
 func (m *MockPayslipRepository) FindOneByTemplate(ctx context.Context, o *entity.Payslip, tx *gorm.DB) (*entity.Payslip, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +192,35 @@ func (mr *MockPayslipRepositoryMockRecorder) FindOneByTemplate(ctx, o, tx any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockPayslipRepository)(nil).FindOneByTemplate), ctx, o, tx)
 }
 
+// List mocks base method.
+func (m *MockPayslipRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.Payslip], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.Payslip])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPayslipRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPayslipRepository)(nil).List), ctx, q, tx)
+}
+
+// Restore mocks base method.
+func (m *MockPayslipRepository) Restore(ctx context.Context, o *entity.Payslip, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockPayslipRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockPayslipRepository)(nil).Restore), ctx, o, tx)
+}
+
 // Save mocks base method.
 func (m *MockPayslipRepository) Save(ctx context.Context, o *entity.Payslip, tx *gorm.DB) error {
 	m.ctrl.T.Helper()
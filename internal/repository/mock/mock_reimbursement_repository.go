@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
 	gomock "go.uber.org/mock/gomock"
 	gorm "gorm.io/gorm"
 )
@@ -42,6 +43,21 @@ func (m *MockReimbursementRepository) EXPECT() *MockReimbursementRepositoryMockR
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockReimbursementRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockReimbursementRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockReimbursementRepository)(nil).Count), ctx, q, tx)
+}
+
 // Create mocks base method.
 func (m *MockReimbursementRepository) Create(ctx context.Context, o *entity.Reimbursement, tx *gorm.DB) (*entity.Reimbursement, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +73,65 @@ func (mr *MockReimbursementRepositoryMockRecorder) Create(ctx, o, tx any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReimbursementRepository)(nil).Create), ctx, o, tx)
 }
 
+// CreateAll mocks base method.
+func (m *MockReimbursementRepository) CreateAll(ctx context.Context, records []entity.Reimbursement, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockReimbursementRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockReimbursementRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// Delete mocks base method.
+func (m *MockReimbursementRepository) Delete(ctx context.Context, o *entity.Reimbursement, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReimbursementRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReimbursementRepository)(nil).Delete), ctx, o, tx)
+}
+
+// Find mocks base method.
+func (m *MockReimbursementRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockReimbursementRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockReimbursementRepository)(nil).Find), ctx, q, tx)
+}
+
+// FindByEmployee mocks base method.
+func (m *MockReimbursementRepository) FindByEmployee(ctx context.Context, employeeID int64, status string, dates repository.DateRange, page repository.Pagination, tx *gorm.DB) ([]entity.Reimbursement, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmployee", ctx, employeeID, status, dates, page, tx)
+	ret0, _ := ret[0].([]entity.Reimbursement)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByEmployee indicates an expected call of FindByEmployee.
+func (mr *MockReimbursementRepositoryMockRecorder) FindByEmployee(ctx, employeeID, status, dates, page, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmployee", reflect.TypeOf((*MockReimbursementRepository)(nil).FindByEmployee), ctx, employeeID, status, dates, page, tx)
+}
+
 // FindByID mocks base method.
 func (m *MockReimbursementRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Reimbursement, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +162,21 @@ func (mr *MockReimbursementRepositoryMockRecorder) FindByTemplate(ctx, t, tx any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockReimbursementRepository)(nil).FindByTemplate), ctx, t, tx)
 }
 
+// FindDeletedByID mocks base method.
+func (m *MockReimbursementRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockReimbursementRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockReimbursementRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
 // FindOneByTemplate mocks base method.
 func (m *MockReimbursementRepository) FindOneByTemplate(ctx context.Context, o *entity.Reimbursement, tx *gorm.DB) (*entity.Reimbursement, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +192,35 @@ func (mr *MockReimbursementRepositoryMockRecorder) FindOneByTemplate(ctx, o, tx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockReimbursementRepository)(nil).FindOneByTemplate), ctx, o, tx)
 }
 
+// List mocks base method.
+func (m *MockReimbursementRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.Reimbursement], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.Reimbursement])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReimbursementRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReimbursementRepository)(nil).List), ctx, q, tx)
+}
+
+// Restore mocks base method.
+func (m *MockReimbursementRepository) Restore(ctx context.Context, o *entity.Reimbursement, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockReimbursementRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockReimbursementRepository)(nil).Restore), ctx, o, tx)
+}
+
 // Save mocks base method.
 func (m *MockReimbursementRepository) Save(ctx context.Context, o *entity.Reimbursement, tx *gorm.DB) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,295 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/asyauqi15/payslip-system/internal/repository (interfaces: UserTwoFactorRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock/mock_user_two_factor_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository UserTwoFactorRepository
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	repository "github.com/asyauqi15/payslip-system/internal/repository"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockUserTwoFactorRepository is a mock of UserTwoFactorRepository interface.
+type MockUserTwoFactorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserTwoFactorRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserTwoFactorRepositoryMockRecorder is the mock recorder for MockUserTwoFactorRepository.
+type MockUserTwoFactorRepositoryMockRecorder struct {
+	mock *MockUserTwoFactorRepository
+}
+
+// NewMockUserTwoFactorRepository creates a new mock instance.
+func NewMockUserTwoFactorRepository(ctrl *gomock.Controller) *MockUserTwoFactorRepository {
+	mock := &MockUserTwoFactorRepository{ctrl: ctrl}
+	mock.recorder = &MockUserTwoFactorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserTwoFactorRepository) EXPECT() *MockUserTwoFactorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Activate mocks base method.
+func (m *MockUserTwoFactorRepository) Activate(ctx context.Context, twoFactor *entity.UserTwoFactor, step int64, recoveryCodes []entity.TwoFactorRecoveryCode, activatedAt time.Time, tx *gorm.DB) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activate", ctx, twoFactor, step, recoveryCodes, activatedAt, tx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Activate indicates an expected call of Activate.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) Activate(ctx, twoFactor, step, recoveryCodes, activatedAt, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).Activate), ctx, twoFactor, step, recoveryCodes, activatedAt, tx)
+}
+
+// Count mocks base method.
+func (m *MockUserTwoFactorRepository) Count(ctx context.Context, q *repository.Query, tx *gorm.DB) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, q, tx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) Count(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).Count), ctx, q, tx)
+}
+
+// Create mocks base method.
+func (m *MockUserTwoFactorRepository) Create(ctx context.Context, o *entity.UserTwoFactor, tx *gorm.DB) (*entity.UserTwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, o, tx)
+	ret0, _ := ret[0].(*entity.UserTwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) Create(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).Create), ctx, o, tx)
+}
+
+// CreateAll mocks base method.
+func (m *MockUserTwoFactorRepository) CreateAll(ctx context.Context, records []entity.UserTwoFactor, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, records, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) CreateAll(ctx, records, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).CreateAll), ctx, records, tx)
+}
+
+// Delete mocks base method.
+func (m *MockUserTwoFactorRepository) Delete(ctx context.Context, o *entity.UserTwoFactor, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) Delete(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).Delete), ctx, o, tx)
+}
+
+// DeleteByUserID mocks base method.
+func (m *MockUserTwoFactorRepository) DeleteByUserID(ctx context.Context, userID int64, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserID", ctx, userID, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByUserID indicates an expected call of DeleteByUserID.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) DeleteByUserID(ctx, userID, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserID", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).DeleteByUserID), ctx, userID, tx)
+}
+
+// Enroll mocks base method.
+func (m *MockUserTwoFactorRepository) Enroll(ctx context.Context, userID int64, secretEncrypted string, enrolledAt time.Time, tx *gorm.DB) (*entity.UserTwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enroll", ctx, userID, secretEncrypted, enrolledAt, tx)
+	ret0, _ := ret[0].(*entity.UserTwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enroll indicates an expected call of Enroll.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) Enroll(ctx, userID, secretEncrypted, enrolledAt, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enroll", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).Enroll), ctx, userID, secretEncrypted, enrolledAt, tx)
+}
+
+// Find mocks base method.
+func (m *MockUserTwoFactorRepository) Find(ctx context.Context, q *repository.Query, tx *gorm.DB) ([]entity.UserTwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, q, tx)
+	ret0, _ := ret[0].([]entity.UserTwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) Find(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).Find), ctx, q, tx)
+}
+
+// FindByID mocks base method.
+func (m *MockUserTwoFactorRepository) FindByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.UserTwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.UserTwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) FindByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).FindByID), ctx, i, tx)
+}
+
+// FindByTemplate mocks base method.
+func (m *MockUserTwoFactorRepository) FindByTemplate(ctx context.Context, t *entity.UserTwoFactor, tx *gorm.DB) ([]entity.UserTwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTemplate", ctx, t, tx)
+	ret0, _ := ret[0].([]entity.UserTwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTemplate indicates an expected call of FindByTemplate.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) FindByTemplate(ctx, t, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTemplate", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).FindByTemplate), ctx, t, tx)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockUserTwoFactorRepository) FindDeletedByID(ctx context.Context, i uint, tx *gorm.DB) (*entity.UserTwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, i, tx)
+	ret0, _ := ret[0].(*entity.UserTwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) FindDeletedByID(ctx, i, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).FindDeletedByID), ctx, i, tx)
+}
+
+// FindOneByTemplate mocks base method.
+func (m *MockUserTwoFactorRepository) FindOneByTemplate(ctx context.Context, o *entity.UserTwoFactor, tx *gorm.DB) (*entity.UserTwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByTemplate", ctx, o, tx)
+	ret0, _ := ret[0].(*entity.UserTwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByTemplate indicates an expected call of FindOneByTemplate.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) FindOneByTemplate(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByTemplate", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).FindOneByTemplate), ctx, o, tx)
+}
+
+// List mocks base method.
+func (m *MockUserTwoFactorRepository) List(ctx context.Context, q *repository.Query, tx *gorm.DB) (*repository.CursorPage[entity.UserTwoFactor], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q, tx)
+	ret0, _ := ret[0].(*repository.CursorPage[entity.UserTwoFactor])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) List(ctx, q, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).List), ctx, q, tx)
+}
+
+// MarkStepUsed mocks base method.
+func (m *MockUserTwoFactorRepository) MarkStepUsed(ctx context.Context, id, step int64, usedAt time.Time, tx *gorm.DB) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkStepUsed", ctx, id, step, usedAt, tx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkStepUsed indicates an expected call of MarkStepUsed.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) MarkStepUsed(ctx, id, step, usedAt, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkStepUsed", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).MarkStepUsed), ctx, id, step, usedAt, tx)
+}
+
+// Restore mocks base method.
+func (m *MockUserTwoFactorRepository) Restore(ctx context.Context, o *entity.UserTwoFactor, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) Restore(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).Restore), ctx, o, tx)
+}
+
+// Save mocks base method.
+func (m *MockUserTwoFactorRepository) Save(ctx context.Context, o *entity.UserTwoFactor, tx *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, o, tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) Save(ctx, o, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).Save), ctx, o, tx)
+}
+
+// Updates mocks base method.
+func (m *MockUserTwoFactorRepository) Updates(ctx context.Context, o *entity.UserTwoFactor, u entity.UserTwoFactor, tx *gorm.DB) (*entity.UserTwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Updates", ctx, o, u, tx)
+	ret0, _ := ret[0].(*entity.UserTwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Updates indicates an expected call of Updates.
+func (mr *MockUserTwoFactorRepositoryMockRecorder) Updates(ctx, o, u, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Updates", reflect.TypeOf((*MockUserTwoFactorRepository)(nil).Updates), ctx, o, u, tx)
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_oidc_login_state_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository OIDCLoginStateRepository
+type OIDCLoginStateRepository interface {
+	BaseRepository[entity.OIDCLoginState]
+	Consume(ctx context.Context, id int64, usedAt time.Time, tx *gorm.DB) (bool, error)
+}
+
+type OIDCLoginStateRepositoryImpl struct {
+	BaseRepositoryImpl[entity.OIDCLoginState]
+}
+
+func NewOIDCLoginStateRepository(db *BaseRepositoryImpl[entity.OIDCLoginState]) OIDCLoginStateRepository {
+	return &OIDCLoginStateRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// Consume marks a login state as used. It returns false when the state was
+// already used or has expired, so every state completes at most one login.
+func (r *OIDCLoginStateRepositoryImpl) Consume(ctx context.Context, id int64, usedAt time.Time, tx *gorm.DB) (bool, error) {
+	conn := r.UseTransaction(tx)
+
+	result := conn.WithContext(ctx).Model(&entity.OIDCLoginState{}).
+		Where("id = ? AND used_at IS NULL AND expires_at > ?", id, usedAt).
+		UpdateColumns(map[string]interface{}{
+			"used_at":    usedAt,
+			"updated_at": usedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
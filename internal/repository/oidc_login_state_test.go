@@ -0,0 +1,111 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupOIDCLoginStateRepoTest() (*gorm.DB, sqlmock.Sqlmock, repository.OIDCLoginStateRepository) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       db,
+		DriverName: "postgres",
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	baseRepo := &repository.BaseRepositoryImpl[entity.OIDCLoginState]{DB: gormDB}
+	repo := repository.NewOIDCLoginStateRepository(baseRepo)
+
+	return gormDB, mock, repo
+}
+
+func TestOIDCLoginStateRepository_Consume(t *testing.T) {
+	_, mock, repo := setupOIDCLoginStateRepoTest()
+
+	query := `UPDATE "oidc_login_states" SET "updated_at"=$1,"used_at"=$2 WHERE id = $3 AND used_at IS NULL AND expires_at > $4`
+	usedAt := time.Date(2025, 6, 19, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectError    bool
+		expectConsumed bool
+	}{
+		{
+			name: "unused state",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(usedAt, usedAt, int64(11), usedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectConsumed: true,
+		},
+		{
+			name: "used or expired state",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(usedAt, usedAt, int64(11), usedAt).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			expectConsumed: false,
+		},
+		{
+			name: "database error",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(usedAt, usedAt, int64(11), usedAt).
+					WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			consumed, err := repo.Consume(context.Background(), 11, usedAt, nil)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+
+			if consumed != tt.expectConsumed {
+				t.Errorf("Expected consumed %v but got %v", tt.expectConsumed, consumed)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("There were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -1,10 +1,16 @@
 package repository
 
-import "github.com/asyauqi15/payslip-system/internal/entity"
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
 
 //go:generate mockgen -destination=./mock/mock_overtime_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository OvertimeRepository
 type OvertimeRepository interface {
 	BaseRepository[entity.Overtime]
+	FindByEmployee(ctx context.Context, employeeID int64, status string, dates DateRange, page Pagination, tx *gorm.DB) ([]entity.Overtime, int64, error)
 }
 
 type OvertimeRepositoryImpl struct {
@@ -16,3 +22,16 @@ func NewOvertimeRepository(db *BaseRepositoryImpl[entity.Overtime]) OvertimeRepo
 		BaseRepositoryImpl: *db,
 	}
 }
+
+// FindByEmployee returns one page of an employee's overtime submissions that
+// start within the date range, newest first, and the total number of
+// matches. An empty status matches every status.
+func (r *OvertimeRepositoryImpl) FindByEmployee(ctx context.Context, employeeID int64, status string, dates DateRange, page Pagination, tx *gorm.DB) ([]entity.Overtime, int64, error) {
+	query := NewQuery().Where("employee_id", OpEqual, employeeID)
+	if status != "" {
+		query.Where("status", OpEqual, status)
+	}
+	query.WhereDateRange("start_at", dates).OrderBy("start_at", true)
+
+	return findPage(ctx, &r.BaseRepositoryImpl, query, page, tx)
+}
@@ -49,6 +49,7 @@ func TestOvertimeRepository_Create(t *testing.T) {
 		StartAt:     startTime,
 		EndAt:       endTime,
 		Description: "Working on urgent project",
+		Status:      entity.ApprovalStatusPending,
 	}
 
 	tests := []struct {
@@ -60,8 +61,8 @@ func TestOvertimeRepository_Create(t *testing.T) {
 			name: "successful creation",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "overtimes" ("created_at","updated_at","employee_id","start_at","end_at","description") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), startTime, endTime, "Working on urgent project").
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "overtimes" ("created_at","updated_at","employee_id","start_at","end_at","description","status","reviewed_by","reviewed_at","review_note","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), startTime, endTime, "Working on urgent project", "pending", nil, nil, "", nil).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 				mock.ExpectCommit()
 			},
@@ -201,7 +202,7 @@ func TestOvertimeRepository_Updates(t *testing.T) {
 			name: "successful description update",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "overtimes" SET "updated_at"=$1,"description"=$2 WHERE "id" = $3`)).
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "overtimes" SET "updated_at"=$1,"description"=$2 WHERE "overtimes"."deleted_at" IS NULL AND "id" = $3`)).
 					WithArgs(sqlmock.AnyArg(), "Updated description", 1).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
@@ -245,3 +246,84 @@ func TestOvertimeRepository_Updates(t *testing.T) {
 		})
 	}
 }
+
+func TestOvertimeRepository_FindByEmployee(t *testing.T) {
+	_, mock, repo := setupOvertimeRepoTest()
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	dates := repository.DateRange{From: &from, To: &to}
+	page := repository.Pagination{Page: 2, PageSize: 10}
+
+	countQuery := `SELECT count(*) FROM "overtimes" WHERE employee_id = $1 AND status = $2 AND start_at >= $3 AND start_at < $4 AND "overtimes"."deleted_at" IS NULL`
+	selectQuery := `SELECT * FROM "overtimes" WHERE employee_id = $1 AND status = $2 AND start_at >= $3 AND start_at < $4 AND "overtimes"."deleted_at" IS NULL ORDER BY start_at DESC, id DESC LIMIT $5 OFFSET $6`
+
+	tests := []struct {
+		name          string
+		setupMock     func()
+		expectError   bool
+		expectedCount int
+		expectedTotal int64
+	}{
+		{
+			name: "second page",
+			setupMock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(countQuery)).
+					WithArgs(int64(2), entity.ApprovalStatusApproved, from, to).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(11))
+				mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
+					WithArgs(int64(2), entity.ApprovalStatusApproved, from, to, 10, 10).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "start_at", "end_at", "status"}).
+						AddRow(1, 2, from.Add(18*time.Hour), from.Add(20*time.Hour), entity.ApprovalStatusApproved))
+			},
+			expectedCount: 1,
+			expectedTotal: 11,
+		},
+		{
+			name: "no matches skips the page query",
+			setupMock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(countQuery)).
+					WithArgs(int64(2), entity.ApprovalStatusApproved, from, to).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			},
+			expectedCount: 0,
+			expectedTotal: 0,
+		},
+		{
+			name: "database error",
+			setupMock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(countQuery)).
+					WillReturnError(gorm.ErrInvalidDB)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			overtimes, total, err := repo.FindByEmployee(context.Background(), 2, entity.ApprovalStatusApproved, dates, page, nil)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				if len(overtimes) != tt.expectedCount {
+					t.Errorf("Expected %d overtimes but got %d", tt.expectedCount, len(overtimes))
+				}
+				if total != tt.expectedTotal {
+					t.Errorf("Expected total %d but got %d", tt.expectedTotal, total)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Pagination selects one page of an ordered result. Page starts at 1.
+type Pagination struct {
+	Page     int
+	PageSize int
+}
+
+func (p Pagination) Offset() int {
+	return (p.Page - 1) * p.PageSize
+}
+
+// findPage counts the rows matching the query and loads the requested page.
+func findPage[T Entity](ctx context.Context, repo *BaseRepositoryImpl[T], q *Query, page Pagination, tx *gorm.DB) ([]T, int64, error) {
+	total, err := repo.Count(ctx, q, tx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows := make([]T, 0)
+	if total == 0 {
+		return rows, 0, nil
+	}
+
+	rows, err = repo.Find(ctx, q.Limit(page.PageSize).Offset(page.Offset()), tx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return rows, total, nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_password_history_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository PasswordHistoryRepository
+type PasswordHistoryRepository interface {
+	BaseRepository[entity.PasswordHistory]
+	FindRecentHashes(ctx context.Context, userID int64, limit int, tx *gorm.DB) ([]string, error)
+}
+
+type PasswordHistoryRepositoryImpl struct {
+	BaseRepositoryImpl[entity.PasswordHistory]
+}
+
+func NewPasswordHistoryRepository(db *BaseRepositoryImpl[entity.PasswordHistory]) PasswordHistoryRepository {
+	return &PasswordHistoryRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// FindRecentHashes returns the newest password hashes of a user, newest first.
+func (r *PasswordHistoryRepositoryImpl) FindRecentHashes(ctx context.Context, userID int64, limit int, tx *gorm.DB) ([]string, error) {
+	conn := r.UseTransaction(tx)
+	var hashes []string
+
+	err := conn.WithContext(ctx).Model(&entity.PasswordHistory{}).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Pluck("password_hash", &hashes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_password_reset_token_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository PasswordResetTokenRepository
+type PasswordResetTokenRepository interface {
+	BaseRepository[entity.PasswordResetToken]
+	MarkUsed(ctx context.Context, id int64, usedAt time.Time, tx *gorm.DB) (bool, error)
+	InvalidateUnused(ctx context.Context, userID int64, invalidatedAt time.Time, tx *gorm.DB) error
+}
+
+type PasswordResetTokenRepositoryImpl struct {
+	BaseRepositoryImpl[entity.PasswordResetToken]
+}
+
+func NewPasswordResetTokenRepository(db *BaseRepositoryImpl[entity.PasswordResetToken]) PasswordResetTokenRepository {
+	return &PasswordResetTokenRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// MarkUsed consumes a token. It returns false when the token was already used,
+// so a token can only ever reset a password once.
+func (r *PasswordResetTokenRepositoryImpl) MarkUsed(ctx context.Context, id int64, usedAt time.Time, tx *gorm.DB) (bool, error) {
+	conn := r.UseTransaction(tx)
+
+	result := conn.WithContext(ctx).Model(&entity.PasswordResetToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		UpdateColumns(map[string]interface{}{
+			"used_at":    usedAt,
+			"updated_at": usedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// InvalidateUnused marks every outstanding token of a user as used so only the
+// most recently issued token works.
+func (r *PasswordResetTokenRepositoryImpl) InvalidateUnused(ctx context.Context, userID int64, invalidatedAt time.Time, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Model(&entity.PasswordResetToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		UpdateColumns(map[string]interface{}{
+			"used_at":    invalidatedAt,
+			"updated_at": invalidatedAt,
+		}).Error
+}
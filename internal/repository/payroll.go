@@ -1,12 +1,25 @@
 package repository
 
-import "github.com/asyauqi15/payslip-system/internal/entity"
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
 
 //go:generate mockgen -destination=./mock/mock_payroll_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository PayrollRepository
 type PayrollRepository interface {
 	BaseRepository[entity.Payroll]
+	Finalize(ctx context.Context, payroll *entity.Payroll, finalizedBy int64, finalizedAt time.Time, tx *gorm.DB) (bool, error)
+	DeleteWithPayslips(ctx context.Context, payroll *entity.Payroll, tx *gorm.DB) (bool, error)
+	FindPrevious(ctx context.Context, before time.Time, tx *gorm.DB) (*entity.Payroll, error)
 }
 
+// errPayrollNotDraft rolls back removing a payroll that is no longer a draft.
+var errPayrollNotDraft = errors.New("payroll is not a draft")
+
 type PayrollRepositoryImpl struct {
 	BaseRepositoryImpl[entity.Payroll]
 }
@@ -16,3 +29,111 @@ func NewPayrollRepository(db *BaseRepositoryImpl[entity.Payroll]) PayrollReposit
 		BaseRepositoryImpl: *db,
 	}
 }
+
+// Finalize marks a draft payroll as finalized. It returns false when the
+// payroll is no longer a draft, because it was finalized or removed
+// concurrently.
+func (r *PayrollRepositoryImpl) Finalize(ctx context.Context, payroll *entity.Payroll, finalizedBy int64, finalizedAt time.Time, tx *gorm.DB) (bool, error) {
+	conn := r.UseTransaction(tx)
+
+	result := conn.WithContext(ctx).Model(payroll).
+		Where("status = ?", entity.PayrollStatusDraft).
+		Updates(map[string]interface{}{
+			"status":       entity.PayrollStatusFinalized,
+			"finalized_by": finalizedBy,
+			"finalized_at": finalizedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// DeleteWithPayslips soft deletes a draft payroll and its payslips in one
+// transaction, and removes the payslip traces, exchange rates and roundings
+// recorded for it. Records are deleted one by one so each gets its own audit
+// entry. It returns false when the payroll is no longer a draft, because it
+// was finalized or removed concurrently.
+func (r *PayrollRepositoryImpl) DeleteWithPayslips(ctx context.Context, payroll *entity.Payroll, tx *gorm.DB) (bool, error) {
+	conn := r.UseTransaction(tx)
+
+	err := conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("status = ?", entity.PayrollStatusDraft).Delete(payroll)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return errPayrollNotDraft
+		}
+
+		var payslips []entity.Payslip
+		if err := tx.Where("payroll_id = ?", payroll.ID).Find(&payslips).Error; err != nil {
+			return err
+		}
+
+		payslipIDs := make([]int64, 0, len(payslips))
+		for i := range payslips {
+			if err := tx.Delete(&payslips[i]).Error; err != nil {
+				return err
+			}
+			payslipIDs = append(payslipIDs, payslips[i].ID)
+		}
+
+		if len(payslipIDs) > 0 {
+			if err := deleteEach[entity.PayslipTrace](tx, "payslip_id IN ?", payslipIDs); err != nil {
+				return err
+			}
+		}
+		if err := deleteEach[entity.PayrollExchangeRate](tx, "payroll_id = ?", payroll.ID); err != nil {
+			return err
+		}
+		return deleteEach[entity.PayrollRounding](tx, "payroll_id = ?", payroll.ID)
+	})
+	if errors.Is(err, errPayrollNotDraft) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// deleteEach deletes the records of T matching a condition one by one, so
+// each gets its own audit entry.
+func deleteEach[T any](tx *gorm.DB, query string, args ...interface{}) error {
+	var records []T
+	if err := tx.Where(query, args...).Find(&records).Error; err != nil {
+		return err
+	}
+
+	for i := range records {
+		if err := tx.Delete(&records[i]).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindPrevious returns the payroll of the latest attendance period that ended
+// before a date, or nil when there is none.
+func (r *PayrollRepositoryImpl) FindPrevious(ctx context.Context, before time.Time, tx *gorm.DB) (*entity.Payroll, error) {
+	conn := r.UseTransaction(tx)
+
+	var payroll entity.Payroll
+	err := conn.WithContext(ctx).
+		Joins("JOIN attendance_periods ON attendance_periods.id = payrolls.attendance_period_id AND attendance_periods.deleted_at IS NULL").
+		Where("attendance_periods.end_date < ?", before).
+		Order("attendance_periods.end_date DESC").
+		First(&payroll).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &payroll, nil
+}
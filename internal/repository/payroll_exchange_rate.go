@@ -0,0 +1,18 @@
+package repository
+
+import "github.com/asyauqi15/payslip-system/internal/entity"
+
+//go:generate mockgen -destination=./mock/mock_payroll_exchange_rate_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository PayrollExchangeRateRepository
+type PayrollExchangeRateRepository interface {
+	BaseRepository[entity.PayrollExchangeRate]
+}
+
+type PayrollExchangeRateRepositoryImpl struct {
+	BaseRepositoryImpl[entity.PayrollExchangeRate]
+}
+
+func NewPayrollExchangeRateRepository(db *BaseRepositoryImpl[entity.PayrollExchangeRate]) PayrollExchangeRateRepository {
+	return &PayrollExchangeRateRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
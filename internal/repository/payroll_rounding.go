@@ -0,0 +1,18 @@
+package repository
+
+import "github.com/asyauqi15/payslip-system/internal/entity"
+
+//go:generate mockgen -destination=./mock/mock_payroll_rounding_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository PayrollRoundingRepository
+type PayrollRoundingRepository interface {
+	BaseRepository[entity.PayrollRounding]
+}
+
+type PayrollRoundingRepositoryImpl struct {
+	BaseRepositoryImpl[entity.PayrollRounding]
+}
+
+func NewPayrollRoundingRepository(db *BaseRepositoryImpl[entity.PayrollRounding]) PayrollRoundingRepository {
+	return &PayrollRoundingRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
@@ -53,8 +53,8 @@ func TestPayrollRepository_Create(t *testing.T) {
 			name: "successful creation",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "payrolls" ("created_at","updated_at","attendance_period_id","employees_count","total_reimbursement","total_overtime","total_payroll") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(10), int64(500000), int64(200000), int64(5000000)).
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "payrolls" ("created_at","updated_at","attendance_period_id","employees_count","total_reimbursement","total_overtime","total_payroll","reporting_currency","status","finalized_by","finalized_at","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(10), int64(500000), int64(200000), int64(5000000), entity.DefaultCurrency, entity.PayrollStatusDraft, nil, nil, nil).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 				mock.ExpectCommit()
 			},
@@ -134,7 +134,7 @@ func TestPayrollRepository_FindByID(t *testing.T) {
 			setupMock: func() {
 				rows := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "attendance_period_id", "employees_count", "total_reimbursement", "total_overtime", "total_payroll"}).
 					AddRow(1, time.Now(), time.Now(), 1, 10, 500000, 200000, 5000000)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payrolls" WHERE "payrolls"."id" = $1 ORDER BY "payrolls"."id" LIMIT $2`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payrolls" WHERE "payrolls"."id" = $1 AND "payrolls"."deleted_at" IS NULL ORDER BY "payrolls"."id" LIMIT $2`)).
 					WithArgs(1, 1).
 					WillReturnRows(rows)
 			},
@@ -145,7 +145,7 @@ func TestPayrollRepository_FindByID(t *testing.T) {
 			name:      "not found",
 			payrollID: 999,
 			setupMock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payrolls" WHERE "payrolls"."id" = $1 ORDER BY "payrolls"."id" LIMIT $2`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payrolls" WHERE "payrolls"."id" = $1 AND "payrolls"."deleted_at" IS NULL ORDER BY "payrolls"."id" LIMIT $2`)).
 					WithArgs(999, 1).
 					WillReturnError(gorm.ErrRecordNotFound)
 			},
@@ -267,3 +267,203 @@ func TestPayrollRepository_FindByTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestPayrollRepository_Finalize(t *testing.T) {
+	finalizedAt := time.Date(2025, 2, 3, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		expected     bool
+	}{
+		{name: "draft payroll is finalized", rowsAffected: 1, expected: true},
+		{name: "payroll no longer a draft", rowsAffected: 0, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupMockDB(t)
+			defer func() {
+				sqlDB, _ := db.DB()
+				sqlDB.Close()
+			}()
+
+			repo := repository.NewPayrollRepository(&repository.BaseRepositoryImpl[entity.Payroll]{DB: db})
+
+			payroll := &entity.Payroll{Base: entity.Base{ID: 4}, AttendancePeriodID: 1, Status: entity.PayrollStatusDraft}
+			ctx := context.WithValue(context.Background(), "skip_audit", true)
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payrolls" WHERE id = $1`)).
+				WithArgs(int64(4), 1).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "status"}).AddRow(4, entity.PayrollStatusDraft))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payrolls" SET "finalized_at"=$1,"finalized_by"=$2,"status"=$3,"updated_at"=$4 WHERE status = $5 AND "payrolls"."deleted_at" IS NULL AND "id" = $6`)).
+				WithArgs(finalizedAt, int64(5), entity.PayrollStatusFinalized, sqlmock.AnyArg(), entity.PayrollStatusDraft, int64(4)).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			mock.ExpectCommit()
+
+			finalized, err := repo.Finalize(ctx, payroll, 5, finalizedAt, nil)
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if finalized != tt.expected {
+				t.Errorf("Expected finalized %v but got %v", tt.expected, finalized)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestPayrollRepository_DeleteWithPayslips(t *testing.T) {
+	t.Run("draft payroll is removed with its payslips and what was recorded for it", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		defer func() {
+			sqlDB, _ := db.DB()
+			sqlDB.Close()
+		}()
+
+		repo := repository.NewPayrollRepository(&repository.BaseRepositoryImpl[entity.Payroll]{DB: db})
+
+		payroll := &entity.Payroll{Base: entity.Base{ID: 4}, AttendancePeriodID: 1, Status: entity.PayrollStatusDraft}
+		ctx := context.WithValue(context.Background(), "skip_audit", true)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payrolls" WHERE id = $1`)).
+			WithArgs(int64(4), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "attendance_period_id"}).AddRow(4, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payrolls" SET "deleted_at"=$1 WHERE status = $2 AND "payrolls"."id" = $3 AND "payrolls"."deleted_at" IS NULL`)).
+			WithArgs(sqlmock.AnyArg(), entity.PayrollStatusDraft, int64(4)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payslips" WHERE payroll_id = $1 AND "payslips"."deleted_at" IS NULL`)).
+			WithArgs(int64(4)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "payroll_id", "employee_id"}).AddRow(10, 4, 1).AddRow(11, 4, 2))
+		for _, payslipID := range []int64{10, 11} {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payslips" WHERE id = $1`)).
+				WithArgs(payslipID, 1).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "payroll_id"}).AddRow(payslipID, 4))
+			mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payslips" SET "deleted_at"=$1 WHERE "payslips"."id" = $2 AND "payslips"."deleted_at" IS NULL`)).
+				WithArgs(sqlmock.AnyArg(), payslipID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payslip_traces" WHERE payslip_id IN ($1,$2)`)).
+			WithArgs(int64(10), int64(11)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "payslip_id"}).AddRow(20, 10).AddRow(21, 11))
+		for _, traceID := range []int64{20, 21} {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payslip_traces" WHERE id = $1`)).
+				WithArgs(traceID, 1).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(traceID))
+			mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "payslip_traces" WHERE "payslip_traces"."id" = $1`)).
+				WithArgs(traceID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payroll_exchange_rates" WHERE payroll_id = $1`)).
+			WithArgs(int64(4)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "payroll_id"}).AddRow(30, 4))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payroll_exchange_rates" WHERE id = $1`)).
+			WithArgs(int64(30), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(30))
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "payroll_exchange_rates" WHERE "payroll_exchange_rates"."id" = $1`)).
+			WithArgs(int64(30)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payroll_roundings" WHERE payroll_id = $1`)).
+			WithArgs(int64(4)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "payroll_id"}).AddRow(40, 4))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payroll_roundings" WHERE id = $1`)).
+			WithArgs(int64(40), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(40))
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "payroll_roundings" WHERE "payroll_roundings"."id" = $1`)).
+			WithArgs(int64(40)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		deleted, err := repo.DeleteWithPayslips(ctx, payroll, nil)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %v", err)
+		}
+		if !deleted {
+			t.Error("Expected payroll to be deleted")
+		}
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("Unfulfilled expectations: %v", err)
+		}
+	})
+
+	t.Run("payroll no longer a draft is kept", func(t *testing.T) {
+		db, mock := setupMockDB(t)
+		defer func() {
+			sqlDB, _ := db.DB()
+			sqlDB.Close()
+		}()
+
+		repo := repository.NewPayrollRepository(&repository.BaseRepositoryImpl[entity.Payroll]{DB: db})
+
+		payroll := &entity.Payroll{Base: entity.Base{ID: 4}, AttendancePeriodID: 1, Status: entity.PayrollStatusDraft}
+		ctx := context.WithValue(context.Background(), "skip_audit", true)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payrolls" WHERE id = $1`)).
+			WithArgs(int64(4), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "attendance_period_id"}).AddRow(4, 1))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "payrolls" SET "deleted_at"=$1 WHERE status = $2 AND "payrolls"."id" = $3 AND "payrolls"."deleted_at" IS NULL`)).
+			WithArgs(sqlmock.AnyArg(), entity.PayrollStatusDraft, int64(4)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		deleted, err := repo.DeleteWithPayslips(ctx, payroll, nil)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %v", err)
+		}
+		if deleted {
+			t.Error("Expected payroll to be kept")
+		}
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("Unfulfilled expectations: %v", err)
+		}
+	})
+}
+
+func TestPayrollRepository_FindPrevious(t *testing.T) {
+	db, mock := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := db.DB()
+		sqlDB.Close()
+	}()
+
+	repo := repository.NewPayrollRepository(&repository.BaseRepositoryImpl[entity.Payroll]{DB: db})
+
+	before := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	query := regexp.QuoteMeta(`SELECT "payrolls"."id","payrolls"."created_at","payrolls"."updated_at","payrolls"."attendance_period_id","payrolls"."employees_count","payrolls"."total_reimbursement","payrolls"."total_overtime","payrolls"."total_payroll","payrolls"."reporting_currency","payrolls"."status","payrolls"."finalized_by","payrolls"."finalized_at","payrolls"."deleted_at" FROM "payrolls" JOIN attendance_periods ON attendance_periods.id = payrolls.attendance_period_id AND attendance_periods.deleted_at IS NULL WHERE attendance_periods.end_date < $1 AND "payrolls"."deleted_at" IS NULL ORDER BY attendance_periods.end_date DESC,"payrolls"."id" LIMIT $2`)
+
+	mock.ExpectQuery(query).
+		WithArgs(before, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "attendance_period_id"}).AddRow(3, 2))
+
+	payroll, err := repo.FindPrevious(context.Background(), before, nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if payroll == nil || payroll.ID != 3 || payroll.AttendancePeriodID != 2 {
+		t.Errorf("Expected payroll 3 but got %+v", payroll)
+	}
+
+	mock.ExpectQuery(query).
+		WithArgs(before, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	payroll, err = repo.FindPrevious(context.Background(), before, nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if payroll != nil {
+		t.Errorf("Expected no previous payroll but got %+v", payroll)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
@@ -1,10 +1,26 @@
 package repository
 
-import "github.com/asyauqi15/payslip-system/internal/entity"
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
 
 //go:generate mockgen -destination=./mock/mock_payslip_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository PayslipRepository
 type PayslipRepository interface {
 	BaseRepository[entity.Payslip]
+	FindByEmployee(ctx context.Context, employeeID int64, dates DateRange, page Pagination, tx *gorm.DB) ([]PayslipWithPeriod, int64, error)
+}
+
+// PayslipWithPeriod is a payslip together with the attendance period its
+// payroll was run for.
+type PayslipWithPeriod struct {
+	entity.Payslip
+	AttendancePeriodID int64
+	PeriodStartDate    time.Time
+	PeriodEndDate      time.Time
 }
 
 type PayslipRepositoryImpl struct {
@@ -16,3 +32,44 @@ func NewPayslipRepository(db *BaseRepositoryImpl[entity.Payslip]) PayslipReposit
 		BaseRepositoryImpl: *db,
 	}
 }
+
+// FindByEmployee returns one page of an employee's payslips whose attendance
+// period overlaps the date range, latest period first, and the total number
+// of matches.
+func (r *PayslipRepositoryImpl) FindByEmployee(ctx context.Context, employeeID int64, dates DateRange, page Pagination, tx *gorm.DB) ([]PayslipWithPeriod, int64, error) {
+	conn := r.UseTransaction(tx)
+
+	query := conn.WithContext(ctx).Model(&entity.Payslip{}).
+		Joins("JOIN payrolls ON payrolls.id = payslips.payroll_id").
+		Joins("JOIN attendance_periods ON attendance_periods.id = payrolls.attendance_period_id").
+		Where("payslips.employee_id = ?", employeeID)
+	if dates.From != nil {
+		query = query.Where("attendance_periods.end_date >= ?", *dates.From)
+	}
+	if dates.To != nil {
+		query = query.Where("attendance_periods.start_date < ?", *dates.To)
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	payslips := make([]PayslipWithPeriod, 0)
+	if total == 0 {
+		return payslips, 0, nil
+	}
+
+	err := query.
+		Select("payslips.*, payrolls.attendance_period_id, attendance_periods.start_date AS period_start_date, attendance_periods.end_date AS period_end_date").
+		Order("attendance_periods.start_date DESC, payslips.id DESC").
+		Offset(page.Offset()).
+		Limit(page.PageSize).
+		Scan(&payslips).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return payslips, total, nil
+}
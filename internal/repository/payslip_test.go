@@ -58,8 +58,8 @@ func TestPayslipRepository_Create(t *testing.T) {
 			name: "successful creation",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "payslips" ("created_at","updated_at","employee_id","payroll_id","base_salary","attendance_count","total_working_days","prorated_salary","overtime_total_hours","overtime_total_amount","reimbursement_total","total_take_home") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(1), int64(5000000), 20, 22, int64(4545454), 10, int64(500000), int64(100000), int64(5145454)).
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "payslips" ("created_at","updated_at","employee_id","payroll_id","base_salary","attendance_count","total_working_days","prorated_salary","overtime_total_hours","overtime_total_amount","reimbursement_total","total_take_home","currency","department_id","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(1), int64(5000000), 20, 22, int64(4545454), 10, int64(500000), int64(100000), int64(5145454), entity.DefaultCurrency, nil, nil).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 				mock.ExpectCommit()
 			},
@@ -245,7 +245,7 @@ func TestPayslipRepository_FindOneByTemplate(t *testing.T) {
 			setupMock: func() {
 				rows := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "employee_id", "payroll_id", "base_salary", "attendance_count", "total_working_days", "prorated_salary", "overtime_total_hours", "overtime_total_amount", "reimbursement_total", "total_take_home"}).
 					AddRow(1, time.Now(), time.Now(), 1, 1, 5000000, 20, 22, 4545454, 10, 500000, 100000, 5145454)
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payslips" WHERE "payslips"."employee_id" = $1 AND "payslips"."payroll_id" = $2 ORDER BY "payslips"."id" LIMIT $3`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payslips" WHERE ("payslips"."employee_id" = $1 AND "payslips"."payroll_id" = $2) AND "payslips"."deleted_at" IS NULL ORDER BY "payslips"."id" LIMIT $3`)).
 					WithArgs(int64(1), int64(1), 1).
 					WillReturnRows(rows)
 			},
@@ -255,7 +255,7 @@ func TestPayslipRepository_FindOneByTemplate(t *testing.T) {
 		{
 			name: "not found",
 			setupMock: func() {
-				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payslips" WHERE "payslips"."employee_id" = $1 AND "payslips"."payroll_id" = $2 ORDER BY "payslips"."id" LIMIT $3`)).
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "payslips" WHERE ("payslips"."employee_id" = $1 AND "payslips"."payroll_id" = $2) AND "payslips"."deleted_at" IS NULL ORDER BY "payslips"."id" LIMIT $3`)).
 					WithArgs(int64(1), int64(1), 1).
 					WillReturnError(gorm.ErrRecordNotFound)
 			},
@@ -294,3 +294,58 @@ func TestPayslipRepository_FindOneByTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestPayslipRepository_FindByEmployee(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("Failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       db,
+		DriverName: "postgres",
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("Failed to open gorm DB: %v", err)
+	}
+
+	baseRepo := &repository.BaseRepositoryImpl[entity.Payslip]{DB: gormDB}
+	repo := repository.NewPayslipRepository(baseRepo)
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "payslips" JOIN payrolls ON payrolls.id = payslips.payroll_id JOIN attendance_periods ON attendance_periods.id = payrolls.attendance_period_id WHERE payslips.employee_id = $1 AND attendance_periods.end_date >= $2 AND attendance_periods.start_date < $3 AND "payslips"."deleted_at" IS NULL`)).
+		WithArgs(int64(2), from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT payslips.*, payrolls.attendance_period_id, attendance_periods.start_date AS period_start_date, attendance_periods.end_date AS period_end_date FROM "payslips" JOIN payrolls ON payrolls.id = payslips.payroll_id JOIN attendance_periods ON attendance_periods.id = payrolls.attendance_period_id WHERE payslips.employee_id = $1 AND attendance_periods.end_date >= $2 AND attendance_periods.start_date < $3 AND "payslips"."deleted_at" IS NULL ORDER BY attendance_periods.start_date DESC, payslips.id DESC LIMIT $4`)).
+		WithArgs(int64(2), from, to, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "payroll_id", "total_take_home", "attendance_period_id", "period_start_date", "period_end_date"}).
+			AddRow(9, 2, 4, 5000000, 3, from, periodEnd))
+
+	payslips, total, err := repo.FindByEmployee(context.Background(), 2, repository.DateRange{From: &from, To: &to}, repository.Pagination{Page: 1, PageSize: 20}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if total != 1 || len(payslips) != 1 {
+		t.Fatalf("Expected 1 payslip but got %d (total %d)", len(payslips), total)
+	}
+
+	payslip := payslips[0]
+	if payslip.ID != 9 || payslip.PayrollID != 4 || payslip.TotalTakeHome != 5000000 {
+		t.Errorf("Unexpected payslip: %+v", payslip.Payslip)
+	}
+	if payslip.AttendancePeriodID != 3 || !payslip.PeriodEndDate.Equal(periodEnd) {
+		t.Errorf("Unexpected attendance period: %d %s", payslip.AttendancePeriodID, payslip.PeriodEndDate)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
@@ -0,0 +1,18 @@
+package repository
+
+import "github.com/asyauqi15/payslip-system/internal/entity"
+
+//go:generate mockgen -destination=./mock/mock_payslip_trace_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository PayslipTraceRepository
+type PayslipTraceRepository interface {
+	BaseRepository[entity.PayslipTrace]
+}
+
+type PayslipTraceRepositoryImpl struct {
+	BaseRepositoryImpl[entity.PayslipTrace]
+}
+
+func NewPayslipTraceRepository(db *BaseRepositoryImpl[entity.PayslipTrace]) PayslipTraceRepository {
+	return &PayslipTraceRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
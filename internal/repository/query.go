@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ErrInvalidCursor is returned when a cursor cannot be decoded or was issued
+// for a different sort order.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Operator compares a column with a value.
+type Operator string
+
+const (
+	OpEqual          Operator = "="
+	OpNotEqual       Operator = "<>"
+	OpGreater        Operator = ">"
+	OpGreaterOrEqual Operator = ">="
+	OpLess           Operator = "<"
+	OpLessOrEqual    Operator = "<="
+	OpIn             Operator = "IN"
+)
+
+var columnPattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// Range bounds a column by values of its type. From is inclusive and To is
+// exclusive; a nil bound leaves that side open.
+type Range[V any] struct {
+	From *V
+	To   *V
+}
+
+// DateRange bounds a date or timestamp column.
+type DateRange = Range[time.Time]
+
+// DaysBetween covers every day from the first to the last date.
+func DaysBetween(first, last time.Time) DateRange {
+	end := last.AddDate(0, 0, 1)
+	return DateRange{From: &first, To: &end}
+}
+
+// DaysAround covers two days before to the day after an instant, which
+// includes the day it falls on and the day before that in every timezone.
+func DaysAround(t time.Time) DateRange {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return DaysBetween(day.AddDate(0, 0, -2), day.AddDate(0, 0, 1))
+}
+
+type condition struct {
+	column string
+	op     Operator
+	value  interface{}
+}
+
+// Query describes a filtered selection ordered by one column. Rows are
+// ordered by the sort column and then by id, so pages stay stable when
+// several rows share a sort value. A query pages either by offset or, with
+// List, by an opaque cursor.
+//
+// Column names are interpolated into SQL and must come from code, never from
+// request input; values are always bound as parameters.
+type Query struct {
+	conditions []condition
+	orderBy    string
+	desc       bool
+	cursor     string
+	limit      int
+	offset     int
+}
+
+// CursorPage is one page of a Query. NextCursor is empty on the last page.
+type CursorPage[T any] struct {
+	Items      []T
+	NextCursor string
+}
+
+// defaultListLimit is the page size of List when the query sets no limit.
+const defaultListLimit = 20
+
+// NewQuery returns an unlimited query ordered by id, newest first.
+func NewQuery() *Query {
+	return &Query{orderBy: "id", desc: true}
+}
+
+// Where adds a condition. Conditions are combined with AND.
+func (q *Query) Where(column string, op Operator, value interface{}) *Query {
+	q.conditions = append(q.conditions, condition{column: column, op: op, value: value})
+	return q
+}
+
+// WhereDateRange bounds a column by a date range.
+func (q *Query) WhereDateRange(column string, dates DateRange) *Query {
+	return WhereRange(q, column, dates)
+}
+
+// WhereRange bounds a column by a range. The type parameter keeps both
+// bounds of the same type.
+func WhereRange[V any](q *Query, column string, r Range[V]) *Query {
+	if r.From != nil {
+		q.Where(column, OpGreaterOrEqual, *r.From)
+	}
+	if r.To != nil {
+		q.Where(column, OpLess, *r.To)
+	}
+	return q
+}
+
+// OrderBy sets the sort column and direction.
+func (q *Query) OrderBy(column string, desc bool) *Query {
+	q.orderBy = column
+	q.desc = desc
+	return q
+}
+
+// After continues the listing from a cursor returned with a previous page.
+func (q *Query) After(cursor string) *Query {
+	q.cursor = cursor
+	return q
+}
+
+// Limit sets the page size. Zero means no limit, except for List.
+func (q *Query) Limit(limit int) *Query {
+	q.limit = limit
+	return q
+}
+
+// Offset skips rows. It cannot be combined with a cursor.
+func (q *Query) Offset(offset int) *Query {
+	q.offset = offset
+	return q
+}
+
+func (q *Query) validate() error {
+	if !columnPattern.MatchString(q.orderBy) {
+		return fmt.Errorf("invalid sort column %q", q.orderBy)
+	}
+	for _, c := range q.conditions {
+		if !columnPattern.MatchString(c.column) {
+			return fmt.Errorf("invalid filter column %q", c.column)
+		}
+		switch c.op {
+		case OpEqual, OpNotEqual, OpGreater, OpGreaterOrEqual, OpLess, OpLessOrEqual, OpIn:
+		default:
+			return fmt.Errorf("invalid operator %q", c.op)
+		}
+	}
+	if q.limit < 0 {
+		return fmt.Errorf("invalid limit %d", q.limit)
+	}
+	if q.offset < 0 {
+		return fmt.Errorf("invalid offset %d", q.offset)
+	}
+	if q.offset > 0 && q.cursor != "" {
+		return errors.New("offset cannot be combined with a cursor")
+	}
+	return nil
+}
+
+// filter applies the conditions and the cursor position of the query.
+func (q *Query) filter(db *gorm.DB, model interface{}) (*gorm.DB, error) {
+	db = q.applyConditions(db)
+	if q.cursor == "" {
+		return db, nil
+	}
+
+	value, id, err := q.decodeCursor(db, model)
+	if err != nil {
+		return nil, err
+	}
+	return db.Where(q.keysetCondition(), value, id), nil
+}
+
+// page applies the ordering, limit and offset of the query.
+func (q *Query) page(db *gorm.DB, limit int) *gorm.DB {
+	db = db.Order(q.order())
+	if limit > 0 {
+		db = db.Limit(limit)
+	}
+	if q.offset > 0 {
+		db = db.Offset(q.offset)
+	}
+	return db
+}
+
+func (q *Query) applyConditions(db *gorm.DB) *gorm.DB {
+	for _, c := range q.conditions {
+		if c.op == OpIn {
+			db = db.Where(fmt.Sprintf("%s IN ?", c.column), c.value)
+			continue
+		}
+		db = db.Where(fmt.Sprintf("%s %s ?", c.column, c.op), c.value)
+	}
+	return db
+}
+
+// sortKey identifies the ordering a cursor was issued for.
+func (q *Query) sortKey() string {
+	if q.desc {
+		return q.orderBy + " desc"
+	}
+	return q.orderBy + " asc"
+}
+
+func (q *Query) order() string {
+	direction := "ASC"
+	if q.desc {
+		direction = "DESC"
+	}
+	return fmt.Sprintf("%s %s, id %s", q.orderBy, direction, direction)
+}
+
+// keysetCondition selects the rows after the cursor position.
+func (q *Query) keysetCondition() string {
+	if q.desc {
+		return fmt.Sprintf("(%s, id) < (?, ?)", q.orderBy)
+	}
+	return fmt.Sprintf("(%s, id) > (?, ?)", q.orderBy)
+}
+
+type cursorPayload struct {
+	Sort  string          `json:"s"`
+	Value json.RawMessage `json:"v"`
+	ID    int64           `json:"id"`
+}
+
+// encodeCursor captures the sort value and id of the last row of a page.
+func (q *Query) encodeCursor(ctx context.Context, db *gorm.DB, last interface{}) (string, error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(last); err != nil {
+		return "", err
+	}
+
+	sortField := stmt.Schema.LookUpField(q.orderBy)
+	idField := stmt.Schema.LookUpField("id")
+	if sortField == nil || idField == nil {
+		return "", fmt.Errorf("unknown sort column %q", q.orderBy)
+	}
+
+	row := reflect.ValueOf(last).Elem()
+	sortValue, _ := sortField.ValueOf(ctx, row)
+	idValue, _ := idField.ValueOf(ctx, row)
+	id, ok := idValue.(int64)
+	if !ok {
+		return "", fmt.Errorf("unsupported id type %T", idValue)
+	}
+
+	value, err := json.Marshal(sortValue)
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(cursorPayload{Sort: q.sortKey(), Value: value, ID: id})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload), nil
+}
+
+// decodeCursor returns the sort value, typed like the sort column of model,
+// and the id stored in the cursor.
+func (q *Query) decodeCursor(db *gorm.DB, model interface{}) (interface{}, int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(q.cursor)
+	if err != nil {
+		return nil, 0, ErrInvalidCursor
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, 0, ErrInvalidCursor
+	}
+	if payload.Sort != q.sortKey() {
+		return nil, 0, ErrInvalidCursor
+	}
+
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return nil, 0, err
+	}
+	sortField := stmt.Schema.LookUpField(q.orderBy)
+	if sortField == nil {
+		return nil, 0, fmt.Errorf("unknown sort column %q", q.orderBy)
+	}
+
+	value := reflect.New(sortField.FieldType)
+	if err := json.Unmarshal(payload.Value, value.Interface()); err != nil {
+		return nil, 0, ErrInvalidCursor
+	}
+
+	return value.Elem().Interface(), payload.ID, nil
+}
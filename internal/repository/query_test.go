@@ -0,0 +1,197 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+)
+
+func TestBaseRepository_List(t *testing.T) {
+	db, mock := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := db.DB()
+		sqlDB.Close()
+	}()
+
+	repo := &repository.BaseRepositoryImpl[entity.Overtime]{DB: db}
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := time.Date(2025, 1, 12, 18, 0, 0, 0, time.UTC)
+	second := time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC)
+	third := time.Date(2025, 1, 8, 18, 0, 0, 0, time.UTC)
+	columns := []string{"id", "employee_id", "start_at", "end_at", "status"}
+
+	newQuery := func() *repository.Query {
+		return repository.NewQuery().
+			Where("employee_id", repository.OpEqual, int64(2)).
+			Where("status", repository.OpIn, []string{entity.ApprovalStatusPending, entity.ApprovalStatusApproved}).
+			WhereDateRange("start_at", repository.DateRange{From: &from}).
+			OrderBy("start_at", true).
+			Limit(2)
+	}
+
+	// First page: a third row is fetched only to detect the next page
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "overtimes" WHERE employee_id = $1 AND status IN ($2,$3) AND start_at >= $4 AND "overtimes"."deleted_at" IS NULL ORDER BY start_at DESC, id DESC LIMIT $5`)).
+		WithArgs(int64(2), entity.ApprovalStatusPending, entity.ApprovalStatusApproved, from, 3).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(5, 2, first, first.Add(time.Hour), entity.ApprovalStatusPending).
+			AddRow(4, 2, second, second.Add(time.Hour), entity.ApprovalStatusApproved).
+			AddRow(3, 2, third, third.Add(time.Hour), entity.ApprovalStatusApproved))
+
+	page, err := repo.List(context.Background(), newQuery(), nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if len(page.Items) != 2 || page.Items[1].ID != 4 {
+		t.Fatalf("Unexpected first page: %+v", page.Items)
+	}
+	if page.NextCursor == "" {
+		t.Fatal("Expected a cursor for the next page")
+	}
+
+	// Second page continues after the last row of the first
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "overtimes" WHERE employee_id = $1 AND status IN ($2,$3) AND start_at >= $4 AND (start_at, id) < ($5, $6) AND "overtimes"."deleted_at" IS NULL ORDER BY start_at DESC, id DESC LIMIT $7`)).
+		WithArgs(int64(2), entity.ApprovalStatusPending, entity.ApprovalStatusApproved, from, second, int64(4), 3).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(3, 2, third, third.Add(time.Hour), entity.ApprovalStatusApproved))
+
+	page, err = repo.List(context.Background(), newQuery().After(page.NextCursor), nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if len(page.Items) != 1 || page.NextCursor != "" {
+		t.Errorf("Expected a single last row but got %d rows and cursor %q", len(page.Items), page.NextCursor)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
+
+func TestBaseRepository_List_InvalidCursor(t *testing.T) {
+	db, mock := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := db.DB()
+		sqlDB.Close()
+	}()
+
+	repo := &repository.BaseRepositoryImpl[entity.Overtime]{DB: db}
+
+	first := time.Date(2025, 1, 12, 18, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "overtimes" WHERE "overtimes"."deleted_at" IS NULL ORDER BY start_at DESC, id DESC LIMIT $1`)).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "start_at"}).AddRow(5, first).AddRow(4, first))
+
+	page, err := repo.List(context.Background(), repository.NewQuery().OrderBy("start_at", true).Limit(1), nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query *repository.Query
+	}{
+		{
+			name:  "malformed cursor",
+			query: repository.NewQuery().OrderBy("start_at", true).After("not-a-cursor"),
+		},
+		{
+			name:  "cursor issued for another sort order",
+			query: repository.NewQuery().OrderBy("start_at", false).After(page.NextCursor),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := repo.List(context.Background(), tt.query, nil)
+			if !errors.Is(err, repository.ErrInvalidCursor) {
+				t.Errorf("Expected ErrInvalidCursor but got: %v", err)
+			}
+		})
+	}
+
+	if _, err := repo.List(context.Background(), repository.NewQuery().OrderBy("start_at; DROP TABLE users", true), nil); err == nil {
+		t.Error("Expected an unsafe sort column to be rejected")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
+
+func TestBaseRepository_Find(t *testing.T) {
+	db, mock := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := db.DB()
+		sqlDB.Close()
+	}()
+
+	repo := &repository.BaseRepositoryImpl[entity.Reimbursement]{DB: db}
+
+	first := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	query := repository.NewQuery().
+		Where("employee_id", repository.OpEqual, int64(2)).
+		WhereDateRange("date", repository.DaysBetween(first, last)).
+		OrderBy("date", false).
+		Limit(10).
+		Offset(20)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "reimbursements" WHERE employee_id = $1 AND date >= $2 AND date < $3 AND "reimbursements"."deleted_at" IS NULL ORDER BY date ASC, id ASC LIMIT $4 OFFSET $5`)).
+		WithArgs(int64(2), first, last.AddDate(0, 0, 1), 10, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "amount", "date"}).
+			AddRow(7, 2, 50000, time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)))
+
+	results, err := repo.Find(context.Background(), query, nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if len(results) != 1 || results[0].ID != 7 {
+		t.Errorf("Unexpected results: %+v", results)
+	}
+
+	if _, err := repo.Find(context.Background(), repository.NewQuery().Offset(-1), nil); err == nil {
+		t.Error("Expected a negative offset to be rejected")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
+
+func TestBaseRepository_Count(t *testing.T) {
+	db, mock := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := db.DB()
+		sqlDB.Close()
+	}()
+
+	repo := &repository.BaseRepositoryImpl[entity.Attendance]{DB: db}
+
+	day := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	query := repository.NewQuery().Where("employee_id", repository.OpEqual, int64(2)).Limit(1)
+	query = repository.WhereRange(query, "clock_in_time", repository.ClockInRange(repository.DaysBetween(day, day)))
+
+	// Ordering and paging do not apply to a count
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attendances" WHERE employee_id = $1 AND clock_in_time >= $2 AND clock_in_time < $3`)).
+		WithArgs(int64(2), "2025-01-15", "2025-01-16").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	count, err := repo.Count(context.Background(), query, nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected count 1 but got %d", count)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
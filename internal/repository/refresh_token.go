@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_refresh_token_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository RefreshTokenRepository
+type RefreshTokenRepository interface {
+	BaseRepository[entity.RefreshToken]
+	RevokeFamily(ctx context.Context, familyID string, revokedAt time.Time, tx *gorm.DB) error
+	RevokeByUserID(ctx context.Context, userID int64, revokedAt time.Time, tx *gorm.DB) (int64, error)
+	MarkRotated(ctx context.Context, id int64, rotatedAt time.Time, tx *gorm.DB) (bool, error)
+}
+
+type RefreshTokenRepositoryImpl struct {
+	BaseRepositoryImpl[entity.RefreshToken]
+}
+
+func NewRefreshTokenRepository(db *BaseRepositoryImpl[entity.RefreshToken]) RefreshTokenRepository {
+	return &RefreshTokenRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// RevokeFamily revokes every not yet revoked token of a token family.
+func (r *RefreshTokenRepositoryImpl) RevokeFamily(ctx context.Context, familyID string, revokedAt time.Time, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Model(&entity.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		UpdateColumns(map[string]interface{}{
+			"revoked_at": revokedAt,
+			"updated_at": revokedAt,
+		}).Error
+}
+
+// RevokeByUserID revokes every not yet revoked token of a user and returns
+// how many tokens were revoked.
+func (r *RefreshTokenRepositoryImpl) RevokeByUserID(ctx context.Context, userID int64, revokedAt time.Time, tx *gorm.DB) (int64, error) {
+	conn := r.UseTransaction(tx)
+
+	result := conn.WithContext(ctx).Model(&entity.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		UpdateColumns(map[string]interface{}{
+			"revoked_at": revokedAt,
+			"updated_at": revokedAt,
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// MarkRotated flags a token as used. It returns false when the token was
+// already rotated or revoked, which lets concurrent refreshes with the same
+// token be detected as reuse.
+func (r *RefreshTokenRepositoryImpl) MarkRotated(ctx context.Context, id int64, rotatedAt time.Time, tx *gorm.DB) (bool, error) {
+	conn := r.UseTransaction(tx)
+
+	result := conn.WithContext(ctx).Model(&entity.RefreshToken{}).
+		Where("id = ? AND rotated_at IS NULL AND revoked_at IS NULL", id).
+		UpdateColumns(map[string]interface{}{
+			"rotated_at": rotatedAt,
+			"updated_at": rotatedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,136 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupRefreshTokenRepoTest() (*gorm.DB, sqlmock.Sqlmock, repository.RefreshTokenRepository) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       db,
+		DriverName: "postgres",
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	baseRepo := &repository.BaseRepositoryImpl[entity.RefreshToken]{DB: gormDB}
+	repo := repository.NewRefreshTokenRepository(baseRepo)
+
+	return gormDB, mock, repo
+}
+
+func TestRefreshTokenRepository_MarkRotated(t *testing.T) {
+	_, mock, repo := setupRefreshTokenRepoTest()
+
+	query := `UPDATE "refresh_tokens" SET "rotated_at"=$1,"updated_at"=$2 WHERE id = $3 AND rotated_at IS NULL AND revoked_at IS NULL`
+	rotatedAt := time.Date(2025, 6, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		setupMock     func()
+		expectError   bool
+		expectRotated bool
+	}{
+		{
+			name: "first use rotates token",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(rotatedAt, rotatedAt, int64(10)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectRotated: true,
+		},
+		{
+			name: "already rotated token",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(rotatedAt, rotatedAt, int64(10)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			expectRotated: false,
+		},
+		{
+			name: "database error",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(rotatedAt, rotatedAt, int64(10)).
+					WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			rotated, err := repo.MarkRotated(context.Background(), 10, rotatedAt, nil)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+
+			if rotated != tt.expectRotated {
+				t.Errorf("Expected rotated %v but got %v", tt.expectRotated, rotated)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("There were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestRefreshTokenRepository_RevokeByUserID(t *testing.T) {
+	_, mock, repo := setupRefreshTokenRepoTest()
+
+	query := `UPDATE "refresh_tokens" SET "revoked_at"=$1,"updated_at"=$2 WHERE user_id = $3 AND revoked_at IS NULL`
+	revokedAt := time.Date(2025, 6, 15, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(query)).
+		WithArgs(revokedAt, revokedAt, int64(5)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	revoked, err := repo.RevokeByUserID(context.Background(), 5, revokedAt, nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if revoked != 3 {
+		t.Errorf("Expected 3 revoked tokens but got %d", revoked)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("There were unfulfilled expectations: %s", err)
+	}
+}
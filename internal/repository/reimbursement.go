@@ -1,10 +1,16 @@
 package repository
 
-import "github.com/asyauqi15/payslip-system/internal/entity"
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
 
 //go:generate mockgen -destination=./mock/mock_reimbursement_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository ReimbursementRepository
 type ReimbursementRepository interface {
 	BaseRepository[entity.Reimbursement]
+	FindByEmployee(ctx context.Context, employeeID int64, status string, dates DateRange, page Pagination, tx *gorm.DB) ([]entity.Reimbursement, int64, error)
 }
 
 type ReimbursementRepositoryImpl struct {
@@ -16,3 +22,16 @@ func NewReimbursementRepository(db *BaseRepositoryImpl[entity.Reimbursement]) Re
 		BaseRepositoryImpl: *db,
 	}
 }
+
+// FindByEmployee returns one page of an employee's reimbursements dated within
+// the date range, newest first, and the total number of matches. An empty
+// status matches every status.
+func (r *ReimbursementRepositoryImpl) FindByEmployee(ctx context.Context, employeeID int64, status string, dates DateRange, page Pagination, tx *gorm.DB) ([]entity.Reimbursement, int64, error) {
+	query := NewQuery().Where("employee_id", OpEqual, employeeID)
+	if status != "" {
+		query.Where("status", OpEqual, status)
+	}
+	query.WhereDateRange("date", dates).OrderBy("date", true)
+
+	return findPage(ctx, &r.BaseRepositoryImpl, query, page, tx)
+}
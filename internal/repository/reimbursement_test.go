@@ -43,6 +43,7 @@ func TestReimbursementRepository_Create(t *testing.T) {
 		Amount:      50000,
 		Date:        reimbursementDate,
 		Description: "Business travel expenses",
+		Status:      entity.ApprovalStatusPending,
 	}
 
 	tests := []struct {
@@ -54,8 +55,8 @@ func TestReimbursementRepository_Create(t *testing.T) {
 			name: "successful creation",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "reimbursements" ("created_at","updated_at","employee_id","amount","date","description") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(50000), reimbursementDate, "Business travel expenses").
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "reimbursements" ("created_at","updated_at","employee_id","amount","currency","date","description","status","reviewed_by","reviewed_at","review_note","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(1), int64(50000), entity.DefaultCurrency, reimbursementDate, "Business travel expenses", "pending", nil, nil, "", nil).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 				mock.ExpectCommit()
 			},
@@ -232,7 +233,7 @@ func TestReimbursementRepository_Updates(t *testing.T) {
 			name: "successful amount and description update",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "reimbursements" SET "updated_at"=$1,"amount"=$2,"description"=$3 WHERE "id" = $4`)).
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "reimbursements" SET "updated_at"=$1,"amount"=$2,"description"=$3 WHERE "reimbursements"."deleted_at" IS NULL AND "id" = $4`)).
 					WithArgs(sqlmock.AnyArg(), int64(60000), "Updated business travel expenses", 1).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 				mock.ExpectCommit()
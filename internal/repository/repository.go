@@ -6,25 +6,74 @@ import (
 )
 
 type Registry struct {
-	UserRepository             UserRepository
-	EmployeeRepository         EmployeeRepository
-	AttendanceRepository       AttendanceRepository
-	AttendancePeriodRepository AttendancePeriodRepository
-	OvertimeRepository         OvertimeRepository
-	PayrollRepository          PayrollRepository
-	PayslipRepository          PayslipRepository
-	ReimbursementRepository    ReimbursementRepository
+	// DB runs transactions that span several repositories
+	DB                              *gorm.DB
+	UserRepository                  UserRepository
+	EmployeeRepository              EmployeeRepository
+	AttendanceRepository            AttendanceRepository
+	AttendancePeriodRepository      AttendancePeriodRepository
+	AttendanceCorrectionRepository  AttendanceCorrectionRepository
+	AttendanceViolationRepository   AttendanceViolationRepository
+	OvertimeRepository              OvertimeRepository
+	PayrollRepository               PayrollRepository
+	PayslipRepository               PayslipRepository
+	ReimbursementRepository         ReimbursementRepository
+	DepartmentRepository            DepartmentRepository
+	LocationRepository              LocationRepository
+	ShiftRepository                 ShiftRepository
+	RosterEntryRepository           RosterEntryRepository
+	ExchangeRateRepository          ExchangeRateRepository
+	PayrollExchangeRateRepository   PayrollExchangeRateRepository
+	PayrollRoundingRepository       PayrollRoundingRepository
+	PayslipTraceRepository          PayslipTraceRepository
+	RoleRepository                  RoleRepository
+	RolePermissionRepository        RolePermissionRepository
+	RefreshTokenRepository          RefreshTokenRepository
+	LoginThrottleRepository         LoginThrottleRepository
+	AuditLogRepository              AuditLogRepository
+	PasswordHistoryRepository       PasswordHistoryRepository
+	PasswordResetTokenRepository    PasswordResetTokenRepository
+	UserTwoFactorRepository         UserTwoFactorRepository
+	TwoFactorRecoveryCodeRepository TwoFactorRecoveryCodeRepository
+	LoginChallengeRepository        LoginChallengeRepository
+	UserIdentityRepository          UserIdentityRepository
+	OIDCLoginStateRepository        OIDCLoginStateRepository
+	APIKeyRepository                APIKeyRepository
 }
 
 func InitializeRepository(db *gorm.DB) *Registry {
 	return &Registry{
-		UserRepository:             NewUserRepository(&BaseRepositoryImpl[entity.User]{DB: db}),
-		EmployeeRepository:         NewEmployeeRepository(&BaseRepositoryImpl[entity.Employee]{DB: db}),
-		AttendanceRepository:       NewAttendanceRepository(&BaseRepositoryImpl[entity.Attendance]{DB: db}),
-		AttendancePeriodRepository: NewAttendancePeriodRepository(&BaseRepositoryImpl[entity.AttendancePeriod]{DB: db}),
-		OvertimeRepository:         NewOvertimeRepository(&BaseRepositoryImpl[entity.Overtime]{DB: db}),
-		PayrollRepository:          NewPayrollRepository(&BaseRepositoryImpl[entity.Payroll]{DB: db}),
-		PayslipRepository:          NewPayslipRepository(&BaseRepositoryImpl[entity.Payslip]{DB: db}),
-		ReimbursementRepository:    NewReimbursementRepository(&BaseRepositoryImpl[entity.Reimbursement]{DB: db}),
+		DB:                              db,
+		UserRepository:                  NewUserRepository(&BaseRepositoryImpl[entity.User]{DB: db}),
+		EmployeeRepository:              NewEmployeeRepository(&BaseRepositoryImpl[entity.Employee]{DB: db}),
+		AttendanceRepository:            NewAttendanceRepository(&BaseRepositoryImpl[entity.Attendance]{DB: db}),
+		AttendancePeriodRepository:      NewAttendancePeriodRepository(&BaseRepositoryImpl[entity.AttendancePeriod]{DB: db}),
+		AttendanceCorrectionRepository:  NewAttendanceCorrectionRepository(&BaseRepositoryImpl[entity.AttendanceCorrection]{DB: db}),
+		AttendanceViolationRepository:   NewAttendanceViolationRepository(&BaseRepositoryImpl[entity.AttendanceViolation]{DB: db}),
+		OvertimeRepository:              NewOvertimeRepository(&BaseRepositoryImpl[entity.Overtime]{DB: db}),
+		PayrollRepository:               NewPayrollRepository(&BaseRepositoryImpl[entity.Payroll]{DB: db}),
+		PayslipRepository:               NewPayslipRepository(&BaseRepositoryImpl[entity.Payslip]{DB: db}),
+		ReimbursementRepository:         NewReimbursementRepository(&BaseRepositoryImpl[entity.Reimbursement]{DB: db}),
+		DepartmentRepository:            NewDepartmentRepository(&BaseRepositoryImpl[entity.Department]{DB: db}),
+		LocationRepository:              NewLocationRepository(&BaseRepositoryImpl[entity.Location]{DB: db}),
+		ShiftRepository:                 NewShiftRepository(&BaseRepositoryImpl[entity.Shift]{DB: db}),
+		RosterEntryRepository:           NewRosterEntryRepository(&BaseRepositoryImpl[entity.RosterEntry]{DB: db}),
+		ExchangeRateRepository:          NewExchangeRateRepository(&BaseRepositoryImpl[entity.ExchangeRate]{DB: db}),
+		PayrollExchangeRateRepository:   NewPayrollExchangeRateRepository(&BaseRepositoryImpl[entity.PayrollExchangeRate]{DB: db}),
+		PayrollRoundingRepository:       NewPayrollRoundingRepository(&BaseRepositoryImpl[entity.PayrollRounding]{DB: db}),
+		PayslipTraceRepository:          NewPayslipTraceRepository(&BaseRepositoryImpl[entity.PayslipTrace]{DB: db}),
+		RoleRepository:                  NewRoleRepository(&BaseRepositoryImpl[entity.Role]{DB: db}),
+		RolePermissionRepository:        NewRolePermissionRepository(&BaseRepositoryImpl[entity.RolePermission]{DB: db}),
+		RefreshTokenRepository:          NewRefreshTokenRepository(&BaseRepositoryImpl[entity.RefreshToken]{DB: db}),
+		LoginThrottleRepository:         NewLoginThrottleRepository(&BaseRepositoryImpl[entity.LoginThrottle]{DB: db}),
+		AuditLogRepository:              NewAuditLogRepository(db),
+		PasswordHistoryRepository:       NewPasswordHistoryRepository(&BaseRepositoryImpl[entity.PasswordHistory]{DB: db}),
+		PasswordResetTokenRepository:    NewPasswordResetTokenRepository(&BaseRepositoryImpl[entity.PasswordResetToken]{DB: db}),
+		UserTwoFactorRepository:         NewUserTwoFactorRepository(&BaseRepositoryImpl[entity.UserTwoFactor]{DB: db}),
+		TwoFactorRecoveryCodeRepository: NewTwoFactorRecoveryCodeRepository(&BaseRepositoryImpl[entity.TwoFactorRecoveryCode]{DB: db}),
+		LoginChallengeRepository:        NewLoginChallengeRepository(&BaseRepositoryImpl[entity.LoginChallenge]{DB: db}),
+		UserIdentityRepository:          NewUserIdentityRepository(&BaseRepositoryImpl[entity.UserIdentity]{DB: db}),
+		OIDCLoginStateRepository:        NewOIDCLoginStateRepository(&BaseRepositoryImpl[entity.OIDCLoginState]{DB: db}),
+		APIKeyRepository:                NewAPIKeyRepository(&BaseRepositoryImpl[entity.APIKey]{DB: db}),
 	}
 }
@@ -0,0 +1,18 @@
+package repository
+
+import "github.com/asyauqi15/payslip-system/internal/entity"
+
+//go:generate mockgen -destination=./mock/mock_role_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository RoleRepository
+type RoleRepository interface {
+	BaseRepository[entity.Role]
+}
+
+type RoleRepositoryImpl struct {
+	BaseRepositoryImpl[entity.Role]
+}
+
+func NewRoleRepository(db *BaseRepositoryImpl[entity.Role]) RoleRepository {
+	return &RoleRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_role_permission_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository RolePermissionRepository
+type RolePermissionRepository interface {
+	BaseRepository[entity.RolePermission]
+	FindPermissionsByRoleName(ctx context.Context, roleName string, tx *gorm.DB) ([]string, error)
+	ReplacePermissions(ctx context.Context, roleID int64, permissions []string, tx *gorm.DB) error
+}
+
+type RolePermissionRepositoryImpl struct {
+	BaseRepositoryImpl[entity.RolePermission]
+}
+
+func NewRolePermissionRepository(db *BaseRepositoryImpl[entity.RolePermission]) RolePermissionRepository {
+	return &RolePermissionRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+func (r *RolePermissionRepositoryImpl) FindPermissionsByRoleName(ctx context.Context, roleName string, tx *gorm.DB) ([]string, error) {
+	conn := r.UseTransaction(tx)
+	var permissions []string
+
+	err := conn.WithContext(ctx).Model(&entity.RolePermission{}).
+		Joins("JOIN roles ON roles.id = role_permissions.role_id").
+		Where("roles.name = ?", roleName).
+		Order("role_permissions.permission").
+		Pluck("role_permissions.permission", &permissions).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+// ReplacePermissions swaps the full permission set of a role in one transaction.
+func (r *RolePermissionRepositoryImpl) ReplacePermissions(ctx context.Context, roleID int64, permissions []string, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("role_id = ?", roleID).Delete(&entity.RolePermission{}).Error; err != nil {
+			return err
+		}
+
+		if len(permissions) == 0 {
+			return nil
+		}
+
+		rolePermissions := make([]entity.RolePermission, 0, len(permissions))
+		for _, permission := range permissions {
+			rolePermissions = append(rolePermissions, entity.RolePermission{
+				RoleID:     roleID,
+				Permission: permission,
+			})
+		}
+
+		return tx.Create(&rolePermissions).Error
+	})
+}
@@ -0,0 +1,171 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupRolePermissionRepoTest() (*gorm.DB, sqlmock.Sqlmock, repository.RolePermissionRepository) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       db,
+		DriverName: "postgres",
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	baseRepo := &repository.BaseRepositoryImpl[entity.RolePermission]{DB: gormDB}
+	repo := repository.NewRolePermissionRepository(baseRepo)
+
+	return gormDB, mock, repo
+}
+
+func TestRolePermissionRepository_FindPermissionsByRoleName(t *testing.T) {
+	_, mock, repo := setupRolePermissionRepoTest()
+
+	query := `SELECT "role_permissions"."permission" FROM "role_permissions" JOIN roles ON roles.id = role_permissions.role_id WHERE roles.name = $1 ORDER BY role_permissions.permission`
+
+	tests := []struct {
+		name          string
+		setupMock     func()
+		expectError   bool
+		expectedCount int
+	}{
+		{
+			name: "successful lookup",
+			setupMock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(entity.UserRoleManager).
+					WillReturnRows(sqlmock.NewRows([]string{"permission"}).
+						AddRow(entity.PermissionAttendanceSubmit).
+						AddRow(entity.PermissionSubmissionReviewTeam))
+			},
+			expectError:   false,
+			expectedCount: 2,
+		},
+		{
+			name: "database error",
+			setupMock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(entity.UserRoleManager).
+					WillReturnError(gorm.ErrInvalidDB)
+			},
+			expectError:   true,
+			expectedCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			permissions, err := repo.FindPermissionsByRoleName(context.Background(), entity.UserRoleManager, nil)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				if len(permissions) != tt.expectedCount {
+					t.Errorf("Expected %d permissions but got %d", tt.expectedCount, len(permissions))
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRolePermissionRepository_ReplacePermissions(t *testing.T) {
+	_, mock, repo := setupRolePermissionRepoTest()
+
+	ctx := context.WithValue(context.Background(), "skip_audit", true)
+
+	tests := []struct {
+		name        string
+		permissions []string
+		setupMock   func()
+		expectError bool
+	}{
+		{
+			name:        "replace with new set",
+			permissions: []string{entity.PermissionPayrollRead},
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "role_permissions" WHERE role_id = $1`)).
+					WithArgs(int64(2)).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "role_permissions" ("created_at","updated_at","role_id","permission") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), entity.PermissionPayrollRead).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:        "clear all permissions",
+			permissions: nil,
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "role_permissions" WHERE role_id = $1`)).
+					WithArgs(int64(2)).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:        "delete fails",
+			permissions: []string{entity.PermissionPayrollRead},
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "role_permissions" WHERE role_id = $1`)).
+					WithArgs(int64(2)).
+					WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := repo.ReplacePermissions(ctx, 2, tt.permissions, nil)
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_roster_entry_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository RosterEntryRepository
+type RosterEntryRepository interface {
+	BaseRepository[entity.RosterEntry]
+	Replace(ctx context.Context, entries []entity.RosterEntry, tx *gorm.DB) error
+}
+
+type RosterEntryRepositoryImpl struct {
+	BaseRepositoryImpl[entity.RosterEntry]
+}
+
+func NewRosterEntryRepository(db *BaseRepositoryImpl[entity.RosterEntry]) RosterEntryRepository {
+	return &RosterEntryRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// Replace stores the entries in one transaction, each replacing the shift the
+// employee had on that date. An entry without a shift id clears the date
+// instead.
+func (r *RosterEntryRepositoryImpl) Replace(ctx context.Context, entries []entity.RosterEntry, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range entries {
+			entry := &entries[i]
+
+			var existing entity.RosterEntry
+			err := tx.Where("employee_id = ? AND date = ?", entry.EmployeeID, entry.Date.Format("2006-01-02")).
+				Take(&existing).Error
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			found := err == nil
+
+			switch {
+			case entry.ShiftID == 0:
+				if found {
+					if err := tx.Delete(&existing).Error; err != nil {
+						return err
+					}
+				}
+			case found:
+				entry.ID = existing.ID
+				entry.CreatedAt = existing.CreatedAt
+				if err := tx.Save(entry).Error; err != nil {
+					return err
+				}
+			default:
+				if err := tx.Create(entry).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
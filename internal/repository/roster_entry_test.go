@@ -0,0 +1,62 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+)
+
+func TestRosterEntryRepository_Replace(t *testing.T) {
+	db, mock := setupMockDB(t)
+	defer func() {
+		sqlDB, _ := db.DB()
+		sqlDB.Close()
+	}()
+
+	repo := repository.NewRosterEntryRepository(&repository.BaseRepositoryImpl[entity.RosterEntry]{DB: db})
+
+	saturday := time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)
+	sunday := time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)
+	entries := []entity.RosterEntry{
+		{EmployeeID: 2, Date: saturday, ShiftID: 3},
+		{EmployeeID: 2, Date: sunday},
+	}
+	ctx := context.WithValue(context.Background(), "skip_audit", true)
+	selectEntry := regexp.QuoteMeta(`SELECT * FROM "roster_entries" WHERE employee_id = $1 AND date = $2 LIMIT $3`)
+
+	mock.ExpectBegin()
+	// Saturday has no shift yet and is created
+	mock.ExpectQuery(selectEntry).
+		WithArgs(int64(2), "2025-01-04", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "roster_entries"`)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(2), saturday, int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	// Sunday had a shift and is cleared
+	mock.ExpectQuery(selectEntry).
+		WithArgs(int64(2), "2025-01-05", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "date", "shift_id"}).AddRow(7, 2, sunday, 3))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "roster_entries" WHERE id = $1`)).
+		WithArgs(int64(7), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "employee_id", "date", "shift_id"}).AddRow(7, 2, sunday, 3))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "roster_entries" WHERE "roster_entries"."id" = $1`)).
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := repo.Replace(ctx, entries, nil); err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+
+	if entries[0].ID != 10 {
+		t.Errorf("Expected created entry to get id 10 but got %d", entries[0].ID)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled expectations: %v", err)
+	}
+}
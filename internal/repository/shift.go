@@ -0,0 +1,18 @@
+package repository
+
+import "github.com/asyauqi15/payslip-system/internal/entity"
+
+//go:generate mockgen -destination=./mock/mock_shift_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository ShiftRepository
+type ShiftRepository interface {
+	BaseRepository[entity.Shift]
+}
+
+type ShiftRepositoryImpl struct {
+	BaseRepositoryImpl[entity.Shift]
+}
+
+func NewShiftRepository(db *BaseRepositoryImpl[entity.Shift]) ShiftRepository {
+	return &ShiftRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
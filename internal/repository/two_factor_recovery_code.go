@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_two_factor_recovery_code_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository TwoFactorRecoveryCodeRepository
+type TwoFactorRecoveryCodeRepository interface {
+	BaseRepository[entity.TwoFactorRecoveryCode]
+	MarkUsed(ctx context.Context, id int64, usedAt time.Time, tx *gorm.DB) (bool, error)
+}
+
+type TwoFactorRecoveryCodeRepositoryImpl struct {
+	BaseRepositoryImpl[entity.TwoFactorRecoveryCode]
+}
+
+func NewTwoFactorRecoveryCodeRepository(db *BaseRepositoryImpl[entity.TwoFactorRecoveryCode]) TwoFactorRecoveryCodeRepository {
+	return &TwoFactorRecoveryCodeRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// MarkUsed consumes a recovery code. It returns false when the code was
+// already used.
+func (r *TwoFactorRecoveryCodeRepositoryImpl) MarkUsed(ctx context.Context, id int64, usedAt time.Time, tx *gorm.DB) (bool, error) {
+	conn := r.UseTransaction(tx)
+
+	result := conn.WithContext(ctx).Model(&entity.TwoFactorRecoveryCode{}).
+		Where("id = ? AND used_at IS NULL", id).
+		UpdateColumns(map[string]interface{}{
+			"used_at":    usedAt,
+			"updated_at": usedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
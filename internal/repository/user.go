@@ -1,10 +1,17 @@
 package repository
 
-import "github.com/asyauqi15/payslip-system/internal/entity"
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
 
 //go:generate mockgen -destination=./mock/mock_user_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository UserRepository
 type UserRepository interface {
 	BaseRepository[entity.User]
+	UpdatePassword(ctx context.Context, user *entity.User, passwordHash string, changedAt time.Time, tx *gorm.DB) error
 }
 
 type UserRepositoryImpl struct {
@@ -16,3 +23,29 @@ func NewUserRepository(db *BaseRepositoryImpl[entity.User]) UserRepository {
 		BaseRepositoryImpl: *db,
 	}
 }
+
+// UpdatePassword stores a new password hash, clears the forced change flag and
+// moves the previous hash into the password history in one transaction. The
+// columns are updated directly so password hashes never reach the audit log.
+func (r *UserRepositoryImpl) UpdatePassword(ctx context.Context, user *entity.User, passwordHash string, changedAt time.Time, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		history := &entity.PasswordHistory{
+			UserID:       user.ID,
+			PasswordHash: user.PasswordHash,
+		}
+		if err := tx.Create(history).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.User{}).
+			Where("id = ?", user.ID).
+			UpdateColumns(map[string]interface{}{
+				"password_hash":        passwordHash,
+				"must_change_password": false,
+				"password_changed_at":  changedAt,
+				"updated_at":           changedAt,
+			}).Error
+	})
+}
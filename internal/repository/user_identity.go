@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_user_identity_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository UserIdentityRepository
+type UserIdentityRepository interface {
+	BaseRepository[entity.UserIdentity]
+	Link(ctx context.Context, user *entity.User, identity *entity.UserIdentity, tx *gorm.DB) error
+}
+
+type UserIdentityRepositoryImpl struct {
+	BaseRepositoryImpl[entity.UserIdentity]
+}
+
+func NewUserIdentityRepository(db *BaseRepositoryImpl[entity.UserIdentity]) UserIdentityRepository {
+	return &UserIdentityRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// Link stores an identity of the user. A user without an ID is created first,
+// in the same transaction, so a provisioned user never exists without the
+// identity it was created for.
+func (r *UserIdentityRepositoryImpl) Link(ctx context.Context, user *entity.User, identity *entity.UserIdentity, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if user.ID == 0 {
+			if err := tx.Create(user).Error; err != nil {
+				return err
+			}
+		}
+
+		identity.UserID = user.ID
+		return tx.Create(identity).Error
+	})
+}
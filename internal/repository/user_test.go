@@ -56,8 +56,8 @@ func TestUserRepository_Create(t *testing.T) {
 			name: "successful creation",
 			setupMock: func() {
 				mock.ExpectBegin()
-				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users" ("created_at","updated_at","username","password_hash","role") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "testuser", "hashedpassword", entity.UserRoleAdmin).
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users" ("created_at","updated_at","username","password_hash","role","must_change_password","password_changed_at","is_service_account") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "testuser", "hashedpassword", entity.UserRoleAdmin, false, nil, false).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 				mock.ExpectCommit()
 			},
@@ -359,3 +359,65 @@ func TestUserRepository_Updates(t *testing.T) {
 		})
 	}
 }
+
+func TestUserRepository_UpdatePassword(t *testing.T) {
+	_, mock, repo := setupUserRepoTest()
+
+	ctx := context.WithValue(context.Background(), "skip_audit", true)
+	changedAt := time.Date(2025, 6, 17, 9, 0, 0, 0, time.UTC)
+	user := &entity.User{
+		Base:         entity.Base{ID: 7},
+		Username:     "employee006",
+		PasswordHash: "old-hash",
+	}
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		expectError bool
+	}{
+		{
+			name: "moves old hash to history and updates password",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "password_histories" ("created_at","updated_at","user_id","password_hash") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), int64(7), "old-hash").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "must_change_password"=$1,"password_changed_at"=$2,"password_hash"=$3,"updated_at"=$4 WHERE id = $5`)).
+					WithArgs(false, changedAt, "new-hash", changedAt, int64(7)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name: "history insert error rolls back",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "password_histories"`)).
+					WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := repo.UpdatePassword(ctx, user, "new-hash", changedAt, nil)
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_user_two_factor_repository.go -package=mock github.com/asyauqi15/payslip-system/internal/repository UserTwoFactorRepository
+type UserTwoFactorRepository interface {
+	BaseRepository[entity.UserTwoFactor]
+	Enroll(ctx context.Context, userID int64, secretEncrypted string, enrolledAt time.Time, tx *gorm.DB) (*entity.UserTwoFactor, error)
+	Activate(ctx context.Context, twoFactor *entity.UserTwoFactor, step int64, recoveryCodes []entity.TwoFactorRecoveryCode, activatedAt time.Time, tx *gorm.DB) (bool, error)
+	MarkStepUsed(ctx context.Context, id int64, step int64, usedAt time.Time, tx *gorm.DB) (bool, error)
+	DeleteByUserID(ctx context.Context, userID int64, tx *gorm.DB) error
+}
+
+type UserTwoFactorRepositoryImpl struct {
+	BaseRepositoryImpl[entity.UserTwoFactor]
+}
+
+func NewUserTwoFactorRepository(db *BaseRepositoryImpl[entity.UserTwoFactor]) UserTwoFactorRepository {
+	return &UserTwoFactorRepositoryImpl{
+		BaseRepositoryImpl: *db,
+	}
+}
+
+// Enroll stores a new pending secret for the user, replacing an earlier
+// enrollment that was never activated. It returns nil when the user already
+// has two-factor authentication enabled.
+func (r *UserTwoFactorRepositoryImpl) Enroll(ctx context.Context, userID int64, secretEncrypted string, enrolledAt time.Time, tx *gorm.DB) (*entity.UserTwoFactor, error) {
+	conn := r.UseTransaction(tx)
+	var twoFactor entity.UserTwoFactor
+
+	err := conn.WithContext(ctx).Raw(`INSERT INTO user_two_factors (user_id, secret_encrypted, last_used_step, created_at, updated_at)
+VALUES (?, ?, 0, ?, ?)
+ON CONFLICT (user_id) DO UPDATE SET
+secret_encrypted = EXCLUDED.secret_encrypted,
+last_used_step = 0,
+updated_at = EXCLUDED.updated_at
+WHERE user_two_factors.enabled_at IS NULL
+RETURNING *`, userID, secretEncrypted, enrolledAt, enrolledAt).Scan(&twoFactor).Error
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor.ID == 0 {
+		return nil, nil
+	}
+
+	return &twoFactor, nil
+}
+
+// Activate enables a pending enrollment with the code of the given time step
+// and replaces the user's recovery codes in one transaction. It returns false
+// when the enrollment was activated concurrently or the step was already used.
+func (r *UserTwoFactorRepositoryImpl) Activate(ctx context.Context, twoFactor *entity.UserTwoFactor, step int64, recoveryCodes []entity.TwoFactorRecoveryCode, activatedAt time.Time, tx *gorm.DB) (bool, error) {
+	conn := r.UseTransaction(tx)
+	activated := false
+
+	err := conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.UserTwoFactor{}).
+			Where("id = ? AND enabled_at IS NULL AND last_used_step < ?", twoFactor.ID, step).
+			UpdateColumns(map[string]interface{}{
+				"enabled_at":     activatedAt,
+				"last_used_step": step,
+				"updated_at":     activatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return nil
+		}
+
+		if err := tx.Where("user_id = ?", twoFactor.UserID).Delete(&entity.TwoFactorRecoveryCode{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&recoveryCodes).Error; err != nil {
+			return err
+		}
+
+		activated = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return activated, nil
+}
+
+// MarkStepUsed records the time step of an accepted code. It returns false
+// when a code of the same or a later step was already accepted, so every
+// code can only be used once.
+func (r *UserTwoFactorRepositoryImpl) MarkStepUsed(ctx context.Context, id int64, step int64, usedAt time.Time, tx *gorm.DB) (bool, error) {
+	conn := r.UseTransaction(tx)
+
+	result := conn.WithContext(ctx).Model(&entity.UserTwoFactor{}).
+		Where("id = ? AND last_used_step < ?", id, step).
+		UpdateColumns(map[string]interface{}{
+			"last_used_step": step,
+			"updated_at":     usedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// DeleteByUserID removes the user's secret and recovery codes.
+func (r *UserTwoFactorRepositoryImpl) DeleteByUserID(ctx context.Context, userID int64, tx *gorm.DB) error {
+	conn := r.UseTransaction(tx)
+
+	return conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&entity.TwoFactorRecoveryCode{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("user_id = ?", userID).Delete(&entity.UserTwoFactor{}).Error
+	})
+}
@@ -0,0 +1,186 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func setupUserTwoFactorRepoTest() (*gorm.DB, sqlmock.Sqlmock, repository.UserTwoFactorRepository) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       db,
+		DriverName: "postgres",
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	baseRepo := &repository.BaseRepositoryImpl[entity.UserTwoFactor]{DB: gormDB}
+	repo := repository.NewUserTwoFactorRepository(baseRepo)
+
+	return gormDB, mock, repo
+}
+
+func TestUserTwoFactorRepository_Enroll(t *testing.T) {
+	_, mock, repo := setupUserTwoFactorRepoTest()
+
+	query := `INSERT INTO user_two_factors (user_id, secret_encrypted, last_used_step, created_at, updated_at)
+VALUES ($1, $2, 0, $3, $4)
+ON CONFLICT (user_id) DO UPDATE SET
+secret_encrypted = EXCLUDED.secret_encrypted,
+last_used_step = 0,
+updated_at = EXCLUDED.updated_at
+WHERE user_two_factors.enabled_at IS NULL
+RETURNING *`
+	enrolledAt := time.Date(2025, 6, 18, 9, 0, 0, 0, time.UTC)
+	columns := []string{"id", "user_id", "secret_encrypted", "enabled_at", "last_used_step", "created_at", "updated_at"}
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectError    bool
+		expectEnrolled bool
+	}{
+		{
+			name: "stores pending secret",
+			setupMock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(int64(1), "encrypted", enrolledAt, enrolledAt).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(7, 1, "encrypted", nil, 0, enrolledAt, enrolledAt))
+			},
+			expectEnrolled: true,
+		},
+		{
+			name: "already enabled",
+			setupMock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(int64(1), "encrypted", enrolledAt, enrolledAt).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			expectEnrolled: false,
+		},
+		{
+			name: "database error",
+			setupMock: func() {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(int64(1), "encrypted", enrolledAt, enrolledAt).
+					WillReturnError(gorm.ErrInvalidDB)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			twoFactor, err := repo.Enroll(context.Background(), 1, "encrypted", enrolledAt, nil)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+
+			if (twoFactor != nil) != tt.expectEnrolled {
+				t.Errorf("Expected enrolled %v but got %+v", tt.expectEnrolled, twoFactor)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("There were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestUserTwoFactorRepository_MarkStepUsed(t *testing.T) {
+	_, mock, repo := setupUserTwoFactorRepoTest()
+
+	query := `UPDATE "user_two_factors" SET "last_used_step"=$1,"updated_at"=$2 WHERE id = $3 AND last_used_step < $4`
+	usedAt := time.Date(2025, 6, 18, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		expectError bool
+		expectUsed  bool
+	}{
+		{
+			name: "first use of step",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(int64(58333333), usedAt, int64(7), int64(58333333)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectUsed: true,
+		},
+		{
+			name: "replayed step",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(int64(58333333), usedAt, int64(7), int64(58333333)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+			expectUsed: false,
+		},
+		{
+			name: "database error",
+			setupMock: func() {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(query)).
+					WithArgs(int64(58333333), usedAt, int64(7), int64(58333333)).
+					WillReturnError(gorm.ErrInvalidDB)
+				mock.ExpectRollback()
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			used, err := repo.MarkStepUsed(context.Background(), 7, 58333333, usedAt, nil)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+
+			if used != tt.expectUsed {
+				t.Errorf("Expected used %v but got %v", tt.expectUsed, used)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("There were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/usecase/service_account"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/render"
+)
+
+const apiKeyHeader = "X-API-Key"
+
+// APIKeyAuthenticator resolves the service account an API key belongs to.
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string, method string, path string) (*service_account.Principal, error)
+}
+
+// Authenticator authenticates requests carrying an X-API-Key header as the
+// key's service account and leaves every other request to the given bearer
+// token authenticator. The context is filled with the same keys either way,
+// so permission checks and audit logs do not depend on how the caller
+// authenticated.
+func Authenticator(apiKeys APIKeyAuthenticator, bearer func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		bearerNext := bearer(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(apiKeyHeader)
+			if key == "" {
+				bearerNext.ServeHTTP(w, r)
+				return
+			}
+
+			principal, err := apiKeys.AuthenticateAPIKey(r.Context(), key, r.Method, r.URL.Path)
+			if err != nil {
+				resp := &v1.DefaultErrorResponse{}
+				resp.Error.Message = err.Error()
+
+				if httpErr, ok := err.(interface{ HTTPStatus() int }); ok {
+					render.Status(r, httpErr.HTTPStatus())
+				} else {
+					render.Status(r, http.StatusInternalServerError)
+				}
+
+				render.JSON(w, r, resp)
+				return
+			}
+
+			ctx := r.Context()
+			{
+				ctx = context.WithValue(ctx, constant.ContextKeyUserID, strconv.FormatInt(principal.UserID, 10))
+				ctx = context.WithValue(ctx, constant.ContextKeyUsername, principal.Username)
+				ctx = context.WithValue(ctx, constant.ContextKeyUserRole, principal.Role)
+				ctx = context.WithValue(ctx, constant.ContextKeyUserPermissions, principal.Permissions)
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/go-chi/render"
+	"github.com/spf13/cast"
+)
+
+// RequirePermission allows the request only when the authenticated user has
+// every one of the given permissions.
+func RequirePermission(permissions ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			granted, ok := userPermissions(w, r)
+			if !ok {
+				return
+			}
+
+			for _, permission := range permissions {
+				if !entity.HasPermission(granted, permission) {
+					denyPermission(w, r, permissions)
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireAnyPermission allows the request when the authenticated user has at
+// least one of the given permissions.
+func RequireAnyPermission(permissions ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			granted, ok := userPermissions(w, r)
+			if !ok {
+				return
+			}
+
+			for _, permission := range permissions {
+				if entity.HasPermission(granted, permission) {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			denyPermission(w, r, permissions)
+		})
+	}
+}
+
+func userPermissions(w http.ResponseWriter, r *http.Request) ([]string, bool) {
+	if r.Context().Value(constant.ContextKeyUserID) == nil {
+		resp := &v1.DefaultErrorResponse{}
+		resp.Error.Message = "access denied: authentication required"
+		render.Status(r, http.StatusUnauthorized)
+		render.JSON(w, r, resp)
+		return nil, false
+	}
+
+	return cast.ToStringSlice(r.Context().Value(constant.ContextKeyUserPermissions)), true
+}
+
+func denyPermission(w http.ResponseWriter, r *http.Request, permissions []string) {
+	resp := &v1.DefaultErrorResponse{}
+	resp.Error.Message = "access denied: permission required: " + strings.Join(permissions, ", ")
+	render.Status(r, http.StatusForbidden)
+	render.JSON(w, r, resp)
+}
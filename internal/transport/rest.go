@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/asyauqi15/payslip-system/internal"
+	"github.com/asyauqi15/payslip-system/internal/entity"
 	"github.com/asyauqi15/payslip-system/internal/handler"
 	"github.com/asyauqi15/payslip-system/internal/transport/middleware"
 	jwtauth "github.com/asyauqi15/payslip-system/pkg/jwt-auth"
@@ -20,6 +21,7 @@ func NewRESTServer(
 	config internal.Config,
 	h *handler.Registry,
 	jwt *jwtauth.JWTAuthentication,
+	apiKeys middleware.APIKeyAuthenticator,
 ) (*RESTServer, error) {
 	routes := chi.NewRouter()
 	routes.Use(middleware.RequestID)
@@ -29,28 +31,141 @@ func NewRESTServer(
 
 	// Public routes
 	routes.Post("/auth/login", h.Auth.Login)
+	routes.Post("/auth/login/2fa", h.Auth.LoginTwoFactor)
+	routes.Post("/auth/oidc/login", h.Auth.StartOIDCLogin)
+	routes.Post("/auth/oidc/callback", h.Auth.CompleteOIDCLogin)
+	routes.Post("/auth/refresh", h.Auth.Refresh)
+	routes.Post("/auth/logout", h.Auth.Logout)
+	routes.Post("/auth/password/reset", h.Auth.ResetPassword)
+
+	// Password change and two-factor setup only need a valid token; users that
+	// must change their password or enroll a second factor get a token
+	// without permissions
+	routes.Group(func(r chi.Router) {
+		r.Use(jwt.Authenticator)
+
+		r.Post("/auth/password", h.Auth.ChangePassword)
+		r.Post("/auth/2fa/enroll", h.Auth.EnrollTwoFactor)
+		r.Post("/auth/2fa/activate", h.Auth.ActivateTwoFactor)
+		r.Post("/auth/2fa/disable", h.Auth.DisableTwoFactor)
+	})
 	routes.Get("/ping", pingHandler)
+	routes.Get("/.well-known/jwks.json", jwt.JWKS)
 	swaggerRoutes(routes)
 
-	// Admin routes (require authentication and admin role)
+	// Service accounts can use an API key instead of a bearer token on the
+	// admin, manager and employee routes
+	authenticator := middleware.Authenticator(apiKeys, jwt.Authenticator)
+
+	// Admin routes (require authentication and per-route permissions)
 	routes.Route("/admin", func(r chi.Router) {
-		r.Use(jwt.Authenticator)
-		r.Use(middleware.RequireAdminRole)
+		r.Use(authenticator)
+
+		r.With(middleware.RequirePermission(entity.PermissionAttendancePeriodRead)).Get("/attendance-periods", h.Admin.ListAttendancePeriods)
+		r.With(middleware.RequirePermission(entity.PermissionAttendancePeriodWrite)).Post("/attendance-periods", h.Admin.CreateAttendancePeriod)
+		r.With(middleware.RequirePermission(entity.PermissionAttendancePeriodWrite)).Delete("/attendance-periods/{id}", h.Admin.DeleteAttendancePeriod)
+		r.With(middleware.RequirePermission(entity.PermissionPayrollRead)).Get("/payrolls", h.Admin.ListPayrolls)
+		r.With(middleware.RequirePermission(entity.PermissionPayrollRun)).Post("/payrolls", h.Admin.RunPayroll)
+		r.With(middleware.RequirePermission(entity.PermissionPayrollRead)).Get("/payrolls/{id}", h.Admin.GetPayrollSummary)
+		r.With(middleware.RequirePermission(entity.PermissionPayrollRun)).Delete("/payrolls/{id}", h.Admin.DeletePayroll)
+		r.With(middleware.RequirePermission(entity.PermissionPayrollApprove)).Post("/payrolls/{id}/finalize", h.Admin.FinalizePayroll)
+		r.With(middleware.RequirePermission(entity.PermissionPayrollRead)).Get("/payroll-comparison", h.Admin.ComparePayrolls)
+		r.With(middleware.RequirePermission(entity.PermissionPayrollRead)).Get("/payslips/{id}/explanation", h.Admin.ExplainPayslip)
+		r.With(middleware.RequirePermission(entity.PermissionDepartmentRead)).Get("/departments", h.Admin.ListDepartments)
+		r.With(middleware.RequirePermission(entity.PermissionDepartmentWrite)).Post("/departments", h.Admin.CreateDepartment)
+		r.With(middleware.RequirePermission(entity.PermissionDepartmentWrite)).Put("/departments/{id}", h.Admin.UpdateDepartment)
+		r.With(middleware.RequirePermission(entity.PermissionLocationRead)).Get("/locations", h.Admin.ListLocations)
+		r.With(middleware.RequirePermission(entity.PermissionLocationWrite)).Post("/locations", h.Admin.CreateLocation)
+		r.With(middleware.RequirePermission(entity.PermissionLocationWrite)).Put("/locations/{id}", h.Admin.UpdateLocation)
+		r.With(middleware.RequirePermission(entity.PermissionLocationRead)).Get("/locations/{id}/attendance-policy", h.Admin.GetAttendancePolicy)
+		r.With(middleware.RequirePermission(entity.PermissionLocationWrite)).Put("/locations/{id}/attendance-policy", h.Admin.UpdateAttendancePolicy)
+		r.With(middleware.RequirePermission(entity.PermissionRosterRead)).Get("/shifts", h.Admin.ListShifts)
+		r.With(middleware.RequirePermission(entity.PermissionRosterWrite)).Post("/shifts", h.Admin.CreateShift)
+		r.With(middleware.RequirePermission(entity.PermissionRosterRead)).Get("/rosters", h.Admin.ListRoster)
+		r.With(middleware.RequirePermission(entity.PermissionRosterWrite)).Post("/rosters", h.Admin.UploadRoster)
+		r.With(middleware.RequirePermission(entity.PermissionEmployeeWrite)).Put("/employees/{id}/organization", h.Admin.AssignEmployeeOrganization)
+		r.With(middleware.RequirePermission(entity.PermissionEmployeeWrite)).Put("/employees/{id}/location", h.Admin.AssignEmployeeLocation)
+		r.With(middleware.RequirePermission(entity.PermissionEmployeeWrite)).Delete("/employees/{id}/device", h.Admin.UnbindEmployeeDevice)
+		r.With(middleware.RequirePermission(entity.PermissionEmployeeWrite)).Put("/employees/{id}/code", h.Admin.AssignEmployeeCode)
+		r.With(middleware.RequirePermission(entity.PermissionEmployeeWrite)).Put("/employees/{id}/currency", h.Admin.AssignEmployeeCurrency)
+		r.With(middleware.RequirePermission(entity.PermissionExchangeRateManage)).Get("/exchange-rates", h.Admin.ListExchangeRates)
+		r.With(middleware.RequirePermission(entity.PermissionExchangeRateManage)).Post("/exchange-rates", h.Admin.CreateExchangeRate)
+		r.With(middleware.RequirePermission(entity.PermissionAttendanceImport)).Post("/attendances/import", h.Admin.ImportAttendance)
+		r.With(middleware.RequirePermission(entity.PermissionDataImport)).Post("/imports/{kind}", h.Admin.Import)
+		r.With(middleware.RequirePermission(entity.PermissionSessionRevoke)).Delete("/users/{id}/sessions", h.Admin.RevokeUserSessions)
+		r.With(middleware.RequirePermission(entity.PermissionLoginUnlock)).Post("/users/{id}/unlock", h.Admin.UnlockUser)
+		r.With(middleware.RequirePermission(entity.PermissionPasswordReset)).Post("/users/{id}/password-reset", h.Admin.IssuePasswordReset)
+		r.With(middleware.RequirePermission(entity.PermissionTwoFactorReset)).Delete("/users/{id}/2fa", h.Admin.ResetTwoFactor)
+
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.RequirePermission(entity.PermissionSubmissionReadAny))
+
+			r.Get("/attendances", h.Admin.ListAttendances)
+			r.Get("/overtimes", h.Admin.ListOvertimes)
+			r.Get("/reimbursements", h.Admin.ListReimbursements)
+		})
+
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.RequirePermission(entity.PermissionSubmissionVoid))
 
-		r.Post("/attendance-periods", h.Admin.CreateAttendancePeriod)
-		r.Post("/payrolls", h.Admin.RunPayroll)
-		r.Get("/payrolls/{id}", h.Admin.GetPayrollSummary)
+			r.Delete("/overtimes/{id}", h.Admin.VoidOvertime)
+			r.Delete("/reimbursements/{id}", h.Admin.VoidReimbursement)
+			r.Post("/overtimes/{id}/restore", h.Admin.RestoreOvertime)
+			r.Post("/reimbursements/{id}/restore", h.Admin.RestoreReimbursement)
+		})
+
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.RequirePermission(entity.PermissionRoleManage))
+
+			r.Get("/permissions", h.Admin.ListPermissions)
+			r.Get("/roles", h.Admin.ListRoles)
+			r.Post("/roles", h.Admin.CreateRole)
+			r.Put("/roles/{id}", h.Admin.UpdateRole)
+			r.Put("/users/{id}/role", h.Admin.AssignUserRole)
+		})
+
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.RequirePermission(entity.PermissionServiceAccountManage))
+
+			r.Get("/service-accounts", h.Admin.ListServiceAccounts)
+			r.Post("/service-accounts", h.Admin.CreateServiceAccount)
+			r.Get("/service-accounts/{id}/api-keys", h.Admin.ListAPIKeys)
+			r.Post("/service-accounts/{id}/api-keys", h.Admin.CreateAPIKey)
+			r.Delete("/service-accounts/{id}/api-keys/{keyId}", h.Admin.RevokeAPIKey)
+		})
 	})
 
-	// Employee routes (require authentication)
+	// Manager routes (require authentication and a review permission;
+	// the approval usecase scopes team reviewers to their direct reports)
+	routes.Route("/manager", func(r chi.Router) {
+		r.Use(authenticator)
+		r.Use(middleware.RequireAnyPermission(entity.PermissionSubmissionReviewTeam, entity.PermissionSubmissionReviewAny))
+
+		r.Get("/overtimes", h.Manager.ListOvertimes)
+		r.Post("/overtimes/{id}/review", h.Manager.ReviewOvertime)
+		r.Get("/reimbursements", h.Manager.ListReimbursements)
+		r.Post("/reimbursements/{id}/review", h.Manager.ReviewReimbursement)
+		r.Get("/attendance-corrections", h.Manager.ListAttendanceCorrections)
+		r.Post("/attendance-corrections/{id}/review", h.Manager.ReviewAttendanceCorrection)
+		r.Get("/attendance-violations", h.Manager.ListAttendanceViolations)
+		r.Post("/attendance-violations/{id}/review", h.Manager.ReviewAttendanceViolation)
+	})
+
+	// Employee routes (require authentication and self-service permissions)
 	routes.Route("/employee", func(r chi.Router) {
-		r.Use(jwt.Authenticator)
-		r.Use(middleware.RequireEmployeeRole)
+		r.Use(authenticator)
+
+		r.With(middleware.RequirePermission(entity.PermissionAttendanceSubmit)).Post("/attendance", h.Employee.SubmitAttendance)
+		r.With(middleware.RequirePermission(entity.PermissionAttendanceSubmit)).Post("/attendance/corrections", h.Employee.SubmitAttendanceCorrection)
+		r.With(middleware.RequirePermission(entity.PermissionOvertimeSubmit)).Post("/overtime", h.Employee.SubmitOvertime)
+		r.With(middleware.RequirePermission(entity.PermissionReimbursementSubmit)).Post("/reimbursement", h.Employee.SubmitReimbursement)
+		r.With(middleware.RequirePermission(entity.PermissionPayslipReadOwn)).Get("/payroll/{id}", h.Employee.GetPayslip)
 
-		r.Post("/attendance", h.Employee.SubmitAttendance)
-		r.Post("/overtime", h.Employee.SubmitOvertime)
-		r.Post("/reimbursement", h.Employee.SubmitReimbursement)
-		r.Get("/payroll/{id}", h.Employee.GetPayslip)
+		// Self-service history, always scoped to the caller's own records
+		r.With(middleware.RequirePermission(entity.PermissionAttendanceSubmit)).Get("/attendance", h.Employee.ListAttendance)
+		r.With(middleware.RequirePermission(entity.PermissionOvertimeSubmit)).Get("/overtime", h.Employee.ListOvertime)
+		r.With(middleware.RequirePermission(entity.PermissionReimbursementSubmit)).Get("/reimbursement", h.Employee.ListReimbursements)
+		r.With(middleware.RequirePermission(entity.PermissionPayslipReadOwn)).Get("/payslips", h.Employee.ListPayslips)
 	})
 
 	return &RESTServer{
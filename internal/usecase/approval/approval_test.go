@@ -0,0 +1,919 @@
+package approval_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/approval"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func reviewerContext(userID string, permissions ...string) context.Context {
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, userID)
+	return context.WithValue(ctx, constant.ContextKeyUserPermissions, permissions)
+}
+
+// periodsOn is the query for the attendance periods that include a day.
+func periodsOn(day time.Time) *repository.Query {
+	return repository.NewQuery().
+		Where("start_date", repository.OpLessOrEqual, day).
+		Where("end_date", repository.OpGreaterOrEqual, day)
+}
+
+func TestApprovalUsecase_ReviewOvertime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockAttendanceCorrectionRepo := mock.NewMockAttendanceCorrectionRepository(ctrl)
+	mockAttendanceViolationRepo := mock.NewMockAttendanceViolationRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := approval.NewUsecase(mockOvertimeRepo, mockReimbursementRepo, mockEmployeeRepo, mockAttendancePeriodRepo, mockPayrollRepo, mockAttendanceRepo, mockAttendanceCorrectionRepo, mockAttendanceViolationRepo, mockLocationRepo)
+
+	managerEmployeeID := int64(10)
+	otherManagerID := int64(20)
+	startTime := time.Date(2025, 1, 1, 18, 0, 0, 0, time.UTC)
+
+	approveRequest := v1.ReviewRequest{Decision: v1.ReviewRequestDecisionApproved}
+
+	pendingOvertime := func(employeeID int64) *entity.Overtime {
+		return &entity.Overtime{
+			Base:        entity.Base{ID: 1},
+			EmployeeID:  employeeID,
+			StartAt:     startTime,
+			EndAt:       startTime.Add(2 * time.Hour),
+			Description: "Release",
+			Status:      entity.ApprovalStatusPending,
+		}
+	}
+
+	expectManager := func() {
+		mockEmployeeRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(5)}, nil).
+			Return(&entity.Employee{Base: entity.Base{ID: managerEmployeeID}, UserID: 5}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		request        v1.ReviewRequest
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name:    "manager approves direct report",
+			ctx:     reviewerContext("5", entity.PermissionSubmissionReviewTeam),
+			request: approveRequest,
+			setupMock: func() {
+				expectManager()
+				mockOvertimeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(pendingOvertime(2), nil)
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: 2}, ManagerID: &managerEmployeeID}, nil)
+				mockOvertimeRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, o *entity.Overtime, _ interface{}) error {
+						if o.Status != entity.ApprovalStatusApproved {
+							t.Errorf("Expected status approved but got %s", o.Status)
+						}
+						if o.ReviewedBy == nil || *o.ReviewedBy != 5 {
+							t.Error("Expected reviewer to be recorded")
+						}
+						return nil
+					})
+			},
+			expectError: false,
+		},
+		{
+			name:    "manager cannot review other team",
+			ctx:     reviewerContext("5", entity.PermissionSubmissionReviewTeam),
+			request: approveRequest,
+			setupMock: func() {
+				expectManager()
+				mockOvertimeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(pendingOvertime(3), nil)
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(3), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: 3}, ManagerID: &otherManagerID}, nil)
+			},
+			expectError:    true,
+			expectedStatus: 403,
+		},
+		{
+			name:    "manager cannot review own submission",
+			ctx:     reviewerContext("5", entity.PermissionSubmissionReviewTeam),
+			request: approveRequest,
+			setupMock: func() {
+				expectManager()
+				mockOvertimeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(pendingOvertime(managerEmployeeID), nil)
+			},
+			expectError:    true,
+			expectedStatus: 403,
+		},
+		{
+			name:    "admin reviews any employee",
+			ctx:     reviewerContext("1", entity.PermissionSubmissionReviewAny),
+			request: v1.ReviewRequest{Decision: v1.ReviewRequestDecisionRejected},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(nil, nil)
+				mockOvertimeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(pendingOvertime(3), nil)
+				mockOvertimeRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:    "already reviewed",
+			ctx:     reviewerContext("1", entity.PermissionSubmissionReviewAny),
+			request: approveRequest,
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(nil, nil)
+				reviewed := pendingOvertime(3)
+				reviewed.Status = entity.ApprovalStatusApproved
+				mockOvertimeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(reviewed, nil)
+			},
+			expectError:    true,
+			expectedStatus: 409,
+		},
+		{
+			name:           "reviewer without review permission",
+			ctx:            reviewerContext("7", entity.PermissionOvertimeSubmit),
+			request:        approveRequest,
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 403,
+		},
+		{
+			name:    "overtime not found",
+			ctx:     reviewerContext("5", entity.PermissionSubmissionReviewTeam),
+			request: approveRequest,
+			setupMock: func() {
+				expectManager()
+				mockOvertimeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError:    true,
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.ReviewOvertime(tt.ctx, 1, tt.request)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if string(result.Status) != string(tt.request.Decision) {
+					t.Errorf("Expected status %s but got %s", tt.request.Decision, result.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestApprovalUsecase_ListReimbursements(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockAttendanceCorrectionRepo := mock.NewMockAttendanceCorrectionRepository(ctrl)
+	mockAttendanceViolationRepo := mock.NewMockAttendanceViolationRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := approval.NewUsecase(mockOvertimeRepo, mockReimbursementRepo, mockEmployeeRepo, mockAttendancePeriodRepo, mockPayrollRepo, mockAttendanceRepo, mockAttendanceCorrectionRepo, mockAttendanceViolationRepo, mockLocationRepo)
+
+	managerEmployeeID := int64(10)
+	date := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		status        string
+		setupMock     func()
+		expectError   bool
+		expectedCount int
+	}{
+		{
+			name:   "manager sees pending reimbursements of direct reports",
+			ctx:    reviewerContext("5", entity.PermissionSubmissionReviewTeam),
+			status: "",
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(5)}, nil).
+					Return(&entity.Employee{Base: entity.Base{ID: managerEmployeeID}, UserID: 5}, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.Employee{ManagerID: &managerEmployeeID}, nil).
+					Return([]entity.Employee{{Base: entity.Base{ID: 2}}, {Base: entity.Base{ID: 3}}}, nil)
+				mockReimbursementRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.Reimbursement{EmployeeID: 2, Status: entity.ApprovalStatusPending}, nil).
+					Return([]entity.Reimbursement{{Base: entity.Base{ID: 1}, EmployeeID: 2, Amount: 50000, Date: date, Status: entity.ApprovalStatusPending}}, nil)
+				mockReimbursementRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.Reimbursement{EmployeeID: 3, Status: entity.ApprovalStatusPending}, nil).
+					Return([]entity.Reimbursement{}, nil)
+			},
+			expectError:   false,
+			expectedCount: 1,
+		},
+		{
+			name:   "admin sees all",
+			ctx:    reviewerContext("1", entity.PermissionSubmissionReviewAny),
+			status: entity.ApprovalStatusApproved,
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(nil, nil)
+				mockReimbursementRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.Reimbursement{Status: entity.ApprovalStatusApproved}, nil).
+					Return([]entity.Reimbursement{
+						{Base: entity.Base{ID: 1}, EmployeeID: 2, Amount: 50000, Date: date, Status: entity.ApprovalStatusApproved},
+						{Base: entity.Base{ID: 2}, EmployeeID: 4, Amount: 75000, Date: date, Status: entity.ApprovalStatusApproved},
+					}, nil)
+			},
+			expectError:   false,
+			expectedCount: 2,
+		},
+		{
+			name:        "invalid status filter",
+			ctx:         reviewerContext("1", entity.PermissionSubmissionReviewAny),
+			status:      "unknown",
+			setupMock:   func() {},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.ListReimbursements(tt.ctx, tt.status)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if len(result) != tt.expectedCount {
+					t.Errorf("Expected %d reimbursements but got %d", tt.expectedCount, len(result))
+				}
+			}
+		})
+	}
+}
+
+func TestApprovalUsecase_VoidOvertime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockAttendanceCorrectionRepo := mock.NewMockAttendanceCorrectionRepository(ctrl)
+	mockAttendanceViolationRepo := mock.NewMockAttendanceViolationRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := approval.NewUsecase(mockOvertimeRepo, mockReimbursementRepo, mockEmployeeRepo, mockAttendancePeriodRepo, mockPayrollRepo, mockAttendanceRepo, mockAttendanceCorrectionRepo, mockAttendanceViolationRepo, mockLocationRepo)
+
+	overtime := &entity.Overtime{
+		Base:       entity.Base{ID: 7},
+		EmployeeID: 1,
+		StartAt:    time.Date(2025, 1, 31, 18, 0, 0, 0, time.UTC),
+		Status:     entity.ApprovalStatusApproved,
+	}
+	period := entity.AttendancePeriod{Base: entity.Base{ID: 3}}
+	locationID := int64(2)
+	employee := &entity.Employee{Base: entity.Base{ID: 1}, LocationID: &locationID}
+	utc := &entity.WorkSchedule{Zone: time.UTC, Workdays: entity.DefaultWorkSchedule().Workdays}
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "overtime in an open period is voided",
+			setupMock: func() {
+				mockOvertimeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(7), nil).
+					Return(overtime, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(utc, nil)
+				mockAttendancePeriodRepo.EXPECT().
+					Find(gomock.Any(), periodsOn(time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)), nil).
+					Return([]entity.AttendancePeriod{period}, nil)
+				mockPayrollRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: 3}, nil).
+					Return(&entity.Payroll{Base: entity.Base{ID: 4}, Status: entity.PayrollStatusDraft}, nil)
+				mockOvertimeRepo.EXPECT().
+					Delete(gomock.Any(), overtime, nil).
+					Return(nil)
+			},
+		},
+		{
+			name: "overtime already paid out",
+			setupMock: func() {
+				mockOvertimeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(7), nil).
+					Return(overtime, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(utc, nil)
+				mockAttendancePeriodRepo.EXPECT().
+					Find(gomock.Any(), periodsOn(time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)), nil).
+					Return([]entity.AttendancePeriod{period}, nil)
+				mockPayrollRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: 3}, nil).
+					Return(&entity.Payroll{Base: entity.Base{ID: 4}, Status: entity.PayrollStatusFinalized}, nil)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name: "overtime dated in the employee's zone",
+			setupMock: func() {
+				mockOvertimeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(7), nil).
+					Return(overtime, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(&entity.WorkSchedule{Zone: time.FixedZone("UTC+7", 7*60*60), Workdays: entity.DefaultWorkSchedule().Workdays}, nil)
+				// Friday 18:00 UTC is already Saturday in UTC+7
+				mockAttendancePeriodRepo.EXPECT().
+					Find(gomock.Any(), periodsOn(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)), nil).
+					Return(nil, nil)
+				mockOvertimeRepo.EXPECT().
+					Delete(gomock.Any(), overtime, nil).
+					Return(nil)
+			},
+		},
+		{
+			name: "overtime not found",
+			setupMock: func() {
+				mockOvertimeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(7), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.VoidOvertime(context.Background(), 7)
+
+			if tt.expectedStatus == 0 {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				return
+			}
+			var httpErr *httppkg.ErrorWrapper
+			if !errors.As(err, &httpErr) || httpErr.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status %d but got: %v", tt.expectedStatus, err)
+			}
+		})
+	}
+}
+
+func TestApprovalUsecase_RestoreOvertime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockAttendanceCorrectionRepo := mock.NewMockAttendanceCorrectionRepository(ctrl)
+	mockAttendanceViolationRepo := mock.NewMockAttendanceViolationRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := approval.NewUsecase(mockOvertimeRepo, mockReimbursementRepo, mockEmployeeRepo, mockAttendancePeriodRepo, mockPayrollRepo, mockAttendanceRepo, mockAttendanceCorrectionRepo, mockAttendanceViolationRepo, mockLocationRepo)
+
+	overtime := func(status string) *entity.Overtime {
+		return &entity.Overtime{
+			Base:       entity.Base{ID: 7},
+			EmployeeID: 1,
+			StartAt:    time.Date(2025, 1, 31, 18, 0, 0, 0, time.UTC),
+			EndAt:      time.Date(2025, 1, 31, 20, 0, 0, 0, time.UTC),
+			Status:     status,
+		}
+	}
+	period := entity.AttendancePeriod{Base: entity.Base{ID: 3}}
+	locationID := int64(2)
+	employee := &entity.Employee{Base: entity.Base{ID: 1}, LocationID: &locationID}
+	utc := &entity.WorkSchedule{Zone: time.UTC, Workdays: entity.DefaultWorkSchedule().Workdays}
+	workDay := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	otherOvertimes := repository.NewQuery().
+		Where("employee_id", repository.OpEqual, int64(1)).
+		Where("status", repository.OpNotEqual, entity.ApprovalStatusRejected).
+		WhereDateRange("start_at", repository.DaysBetween(workDay.AddDate(0, 0, -1), workDay.AddDate(0, 0, 1)))
+
+	expectOpenPeriod := func(found *entity.Overtime, payrollStatus string) {
+		mockOvertimeRepo.EXPECT().
+			FindDeletedByID(gomock.Any(), uint(7), nil).
+			Return(found, nil)
+		mockEmployeeRepo.EXPECT().
+			FindByID(gomock.Any(), uint(1), nil).
+			Return(employee, nil)
+		mockLocationRepo.EXPECT().
+			ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+			Return(utc, nil)
+		mockAttendancePeriodRepo.EXPECT().
+			Find(gomock.Any(), periodsOn(workDay), nil).
+			Return([]entity.AttendancePeriod{period}, nil)
+		mockPayrollRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: 3}, nil).
+			Return(&entity.Payroll{Base: entity.Base{ID: 4}, Status: payrollStatus}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "voided overtime in an open period is restored",
+			setupMock: func() {
+				restored := overtime(entity.ApprovalStatusApproved)
+				expectOpenPeriod(restored, entity.PayrollStatusDraft)
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), otherOvertimes, nil).
+					Return([]entity.Overtime{{
+						StartAt: time.Date(2025, 1, 31, 20, 0, 0, 0, time.UTC),
+						EndAt:   time.Date(2025, 1, 31, 21, 0, 0, 0, time.UTC),
+					}}, nil)
+				mockOvertimeRepo.EXPECT().
+					Restore(gomock.Any(), restored, nil).
+					Return(nil)
+			},
+		},
+		{
+			name: "rejected overtime is restored without checking its time slot",
+			setupMock: func() {
+				restored := overtime(entity.ApprovalStatusRejected)
+				expectOpenPeriod(restored, entity.PayrollStatusDraft)
+				mockOvertimeRepo.EXPECT().
+					Restore(gomock.Any(), restored, nil).
+					Return(nil)
+			},
+		},
+		{
+			name: "overtime overlapping another overtime",
+			setupMock: func() {
+				expectOpenPeriod(overtime(entity.ApprovalStatusPending), entity.PayrollStatusDraft)
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), otherOvertimes, nil).
+					Return([]entity.Overtime{{
+						StartAt: time.Date(2025, 1, 31, 19, 0, 0, 0, time.UTC),
+						EndAt:   time.Date(2025, 1, 31, 20, 0, 0, 0, time.UTC),
+					}}, nil)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name: "overtime taking its day past 3 hours",
+			setupMock: func() {
+				expectOpenPeriod(overtime(entity.ApprovalStatusApproved), entity.PayrollStatusDraft)
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), otherOvertimes, nil).
+					Return([]entity.Overtime{{
+						StartAt: time.Date(2025, 1, 31, 10, 0, 0, 0, time.UTC),
+						EndAt:   time.Date(2025, 1, 31, 11, 30, 0, 0, time.UTC),
+					}}, nil)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name: "overtime already paid out",
+			setupMock: func() {
+				expectOpenPeriod(overtime(entity.ApprovalStatusApproved), entity.PayrollStatusFinalized)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name: "no voided overtime",
+			setupMock: func() {
+				mockOvertimeRepo.EXPECT().
+					FindDeletedByID(gomock.Any(), uint(7), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.RestoreOvertime(context.Background(), 7)
+
+			if tt.expectedStatus == 0 {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				return
+			}
+			var httpErr *httppkg.ErrorWrapper
+			if !errors.As(err, &httpErr) || httpErr.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status %d but got: %v", tt.expectedStatus, err)
+			}
+		})
+	}
+}
+
+func TestApprovalUsecase_ReviewAttendanceCorrection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockAttendanceCorrectionRepo := mock.NewMockAttendanceCorrectionRepository(ctrl)
+	mockAttendanceViolationRepo := mock.NewMockAttendanceViolationRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := approval.NewUsecase(mockOvertimeRepo, mockReimbursementRepo, mockEmployeeRepo, mockAttendancePeriodRepo, mockPayrollRepo, mockAttendanceRepo, mockAttendanceCorrectionRepo, mockAttendanceViolationRepo, mockLocationRepo)
+
+	attendanceID := int64(30)
+	clockIn := "2025-01-06T09:00:00+07:00"
+	clockOut := "2025-01-06T17:30:00+07:00"
+
+	missingCheckOut := func() *entity.AttendanceCorrection {
+		return &entity.AttendanceCorrection{
+			Base:                entity.Base{ID: 8},
+			EmployeeID:          2,
+			AttendanceID:        &attendanceID,
+			Date:                time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+			ClockOutTime:        clockOut,
+			OriginalClockInTime: clockIn,
+			Reason:              "App was down",
+			Status:              entity.ApprovalStatusPending,
+		}
+	}
+	missingDay := func() *entity.AttendanceCorrection {
+		return &entity.AttendanceCorrection{
+			Base:         entity.Base{ID: 8},
+			EmployeeID:   2,
+			Date:         time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+			ClockInTime:  clockIn,
+			ClockOutTime: clockOut,
+			Reason:       "Forgot to check in",
+			Status:       entity.ApprovalStatusPending,
+		}
+	}
+
+	expectAdmin := func() {
+		mockEmployeeRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+			Return(nil, nil)
+	}
+	expectOpenPeriod := func() {
+		mockAttendancePeriodRepo.EXPECT().
+			Find(gomock.Any(), gomock.Any(), nil).
+			Return([]entity.AttendancePeriod{}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		request        v1.ReviewRequest
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:    "approval completes existing attendance",
+			request: v1.ReviewRequest{Decision: v1.ReviewRequestDecisionApproved},
+			setupMock: func() {
+				expectAdmin()
+				mockAttendanceCorrectionRepo.EXPECT().
+					FindByID(gomock.Any(), uint(8), nil).
+					Return(missingCheckOut(), nil)
+				expectOpenPeriod()
+				mockAttendanceRepo.EXPECT().
+					FindByID(gomock.Any(), uint(attendanceID), nil).
+					Return(&entity.Attendance{Base: entity.Base{ID: attendanceID}, EmployeeID: 2, ClockInTime: clockIn}, nil)
+				mockAttendanceCorrectionRepo.EXPECT().
+					Apply(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, c *entity.AttendanceCorrection, a *entity.Attendance, _ interface{}) error {
+						if c.Status != entity.ApprovalStatusApproved || c.ReviewedBy == nil {
+							t.Error("Expected correction to be approved by the reviewer")
+						}
+						if a.ID != attendanceID || a.ClockInTime != clockIn || a.ClockOutTime != clockOut {
+							t.Errorf("Expected attendance %d to get check-out %s but got %+v", attendanceID, clockOut, a)
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name:    "approval creates missing attendance",
+			request: v1.ReviewRequest{Decision: v1.ReviewRequestDecisionApproved},
+			setupMock: func() {
+				expectAdmin()
+				mockAttendanceCorrectionRepo.EXPECT().
+					FindByID(gomock.Any(), uint(8), nil).
+					Return(missingDay(), nil)
+				expectOpenPeriod()
+				mockAttendanceRepo.EXPECT().
+					Count(gomock.Any(), gomock.Any(), nil).
+					Return(int64(0), nil)
+				mockAttendanceCorrectionRepo.EXPECT().
+					Apply(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _ *entity.AttendanceCorrection, a *entity.Attendance, _ interface{}) error {
+						if a.ID != 0 || a.EmployeeID != 2 || a.ClockInTime != clockIn || a.ClockOutTime != clockOut {
+							t.Errorf("Expected a new attendance with the corrected times but got %+v", a)
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name:    "rejection leaves attendance untouched",
+			request: v1.ReviewRequest{Decision: v1.ReviewRequestDecisionRejected},
+			setupMock: func() {
+				expectAdmin()
+				mockAttendanceCorrectionRepo.EXPECT().
+					FindByID(gomock.Any(), uint(8), nil).
+					Return(missingDay(), nil)
+				mockAttendanceCorrectionRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), nil).
+					Return(nil)
+			},
+		},
+		{
+			name:    "attendance changed since submission",
+			request: v1.ReviewRequest{Decision: v1.ReviewRequestDecisionApproved},
+			setupMock: func() {
+				expectAdmin()
+				mockAttendanceCorrectionRepo.EXPECT().
+					FindByID(gomock.Any(), uint(8), nil).
+					Return(missingDay(), nil)
+				expectOpenPeriod()
+				mockAttendanceRepo.EXPECT().
+					Count(gomock.Any(), gomock.Any(), nil).
+					Return(int64(1), nil)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name:    "day already paid out",
+			request: v1.ReviewRequest{Decision: v1.ReviewRequestDecisionApproved},
+			setupMock: func() {
+				expectAdmin()
+				mockAttendanceCorrectionRepo.EXPECT().
+					FindByID(gomock.Any(), uint(8), nil).
+					Return(missingCheckOut(), nil)
+				mockAttendancePeriodRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.AttendancePeriod{{Base: entity.Base{ID: 3}}}, nil)
+				mockPayrollRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: 3}, nil).
+					Return(&entity.Payroll{Base: entity.Base{ID: 4}, Status: entity.PayrollStatusFinalized}, nil)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name:    "correction not found",
+			request: v1.ReviewRequest{Decision: v1.ReviewRequestDecisionApproved},
+			setupMock: func() {
+				expectAdmin()
+				mockAttendanceCorrectionRepo.EXPECT().
+					FindByID(gomock.Any(), uint(8), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.ReviewAttendanceCorrection(reviewerContext("1", entity.PermissionSubmissionReviewAny), 8, tt.request)
+
+			if tt.expectedStatus == 0 {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if string(result.Status) != string(tt.request.Decision) {
+					t.Errorf("Expected status %s but got %s", tt.request.Decision, result.Status)
+				}
+				return
+			}
+			var httpErr *httppkg.ErrorWrapper
+			if !errors.As(err, &httpErr) || httpErr.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status %d but got: %v", tt.expectedStatus, err)
+			}
+		})
+	}
+}
+
+func TestApprovalUsecase_ReviewAttendanceViolation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockAttendanceCorrectionRepo := mock.NewMockAttendanceCorrectionRepository(ctrl)
+	mockAttendanceViolationRepo := mock.NewMockAttendanceViolationRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := approval.NewUsecase(mockOvertimeRepo, mockReimbursementRepo, mockEmployeeRepo, mockAttendancePeriodRepo, mockPayrollRepo, mockAttendanceRepo, mockAttendanceCorrectionRepo, mockAttendanceViolationRepo, mockLocationRepo)
+
+	pendingViolation := func() *entity.AttendanceViolation {
+		return &entity.AttendanceViolation{
+			Base:           entity.Base{ID: 5},
+			AttendanceID:   30,
+			EmployeeID:     2,
+			AttendanceType: "check_in",
+			Kind:           entity.ViolationOutsideGeofence,
+			Detail:         "position is 1500 m outside the nearest geofence",
+			Status:         entity.ApprovalStatusPending,
+		}
+	}
+
+	expectAdmin := func() {
+		mockEmployeeRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+			Return(nil, nil)
+	}
+	expectViolation := func(violation *entity.AttendanceViolation) {
+		mockAttendanceViolationRepo.EXPECT().
+			FindByID(gomock.Any(), uint(5), nil).
+			Return(violation, nil)
+	}
+	expectAttendance := func() {
+		mockAttendanceRepo.EXPECT().
+			FindByID(gomock.Any(), uint(30), nil).
+			Return(&entity.Attendance{Base: entity.Base{ID: 30}, EmployeeID: 2, ClockInTime: "2025-01-06T09:00:00+07:00"}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		request        v1.ReviewRequest
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:    "approval accepts the attendance",
+			request: v1.ReviewRequest{Decision: v1.ReviewRequestDecisionApproved},
+			setupMock: func() {
+				expectAdmin()
+				expectViolation(pendingViolation())
+				mockAttendanceViolationRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, v *entity.AttendanceViolation, _ interface{}) error {
+						if v.ReviewedBy == nil || *v.ReviewedBy != 1 {
+							t.Error("Expected violation to be reviewed by user 1")
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name:    "rejection in an open period",
+			request: v1.ReviewRequest{Decision: v1.ReviewRequestDecisionRejected},
+			setupMock: func() {
+				expectAdmin()
+				expectViolation(pendingViolation())
+				expectAttendance()
+				mockAttendancePeriodRepo.EXPECT().
+					Find(gomock.Any(), periodsOn(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)), nil).
+					Return([]entity.AttendancePeriod{}, nil)
+				mockAttendanceViolationRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), nil).
+					Return(nil)
+			},
+		},
+		{
+			name:    "rejection after the day is paid out",
+			request: v1.ReviewRequest{Decision: v1.ReviewRequestDecisionRejected},
+			setupMock: func() {
+				expectAdmin()
+				expectViolation(pendingViolation())
+				expectAttendance()
+				mockAttendancePeriodRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.AttendancePeriod{{Base: entity.Base{ID: 3}}}, nil)
+				mockPayrollRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: 3}, nil).
+					Return(&entity.Payroll{Base: entity.Base{ID: 4}, Status: entity.PayrollStatusFinalized}, nil)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name:    "already reviewed",
+			request: v1.ReviewRequest{Decision: v1.ReviewRequestDecisionRejected},
+			setupMock: func() {
+				expectAdmin()
+				violation := pendingViolation()
+				violation.Status = entity.ApprovalStatusApproved
+				expectViolation(violation)
+			},
+			expectedStatus: 409,
+		},
+		{
+			name:    "violation not found",
+			request: v1.ReviewRequest{Decision: v1.ReviewRequestDecisionApproved},
+			setupMock: func() {
+				expectAdmin()
+				expectViolation(nil)
+			},
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.ReviewAttendanceViolation(reviewerContext("1", entity.PermissionSubmissionReviewAny), 5, tt.request)
+
+			if tt.expectedStatus == 0 {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if string(result.Status) != string(tt.request.Decision) {
+					t.Errorf("Expected status %s but got %s", tt.request.Decision, result.Status)
+				}
+				return
+			}
+			var httpErr *httppkg.ErrorWrapper
+			if !errors.As(err, &httpErr) || httpErr.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status %d but got: %v", tt.expectedStatus, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package approval
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) ListAttendanceCorrections(ctx context.Context, status string) ([]v1.AttendanceCorrectionSubmission, error) {
+	status, err := validateStatusFilter(status)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := u.getReviewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := repository.NewQuery().Where("status", repository.OpEqual, status)
+	if !r.isUnscoped() {
+		reportIDs, err := u.getDirectReportIDs(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		if len(reportIDs) == 0 {
+			return []v1.AttendanceCorrectionSubmission{}, nil
+		}
+		query.Where("employee_id", repository.OpIn, reportIDs)
+	}
+
+	corrections, err := u.attendanceCorrectionRepo.Find(ctx, query, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find attendance corrections", "status", status, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find attendance corrections")
+	}
+
+	response := make([]v1.AttendanceCorrectionSubmission, 0, len(corrections))
+	for _, correction := range corrections {
+		response = append(response, toCorrectionSubmission(&correction))
+	}
+	return response, nil
+}
+
+// ReviewAttendanceCorrection decides on a correction. Approving it writes
+// the corrected times to the attendance of that day, creating the record
+// when the employee never checked in.
+func (u *UsecaseImpl) ReviewAttendanceCorrection(ctx context.Context, correctionID int64, req v1.ReviewRequest) (*v1.AttendanceCorrectionSubmission, error) {
+	r, err := u.getReviewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	correction, err := u.attendanceCorrectionRepo.FindByID(ctx, uint(correctionID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find attendance correction", "correction_id", correctionID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find attendance correction")
+	}
+	if correction == nil {
+		return nil, httppkg.NewNotFoundError("attendance correction not found")
+	}
+
+	if err := u.authorizeReview(ctx, r, correction.EmployeeID); err != nil {
+		return nil, err
+	}
+
+	if err := validateReviewRequest(correction.Status, req); err != nil {
+		return nil, err
+	}
+
+	correction.Status = string(req.Decision)
+	correction.ReviewedBy = &r.userID
+	correction.ReviewedAt = reviewedNow()
+	correction.ReviewNote = reviewNote(req)
+
+	if req.Decision == v1.ReviewRequestDecisionApproved {
+		if err := u.applyCorrection(ctx, correction); err != nil {
+			return nil, err
+		}
+	} else if err := u.attendanceCorrectionRepo.Save(ctx, correction, nil); err != nil {
+		logger.Error(ctx, "failed to review attendance correction", "correction_id", correctionID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to review attendance correction")
+	}
+
+	logger.Info(ctx, "attendance correction reviewed",
+		"correction_id", correctionID,
+		"employee_id", correction.EmployeeID,
+		"attendance_id", correction.AttendanceID,
+		"decision", req.Decision,
+		"reviewed_by", r.userID)
+
+	response := toCorrectionSubmission(correction)
+	return &response, nil
+}
+
+// applyCorrection stores an approved correction and the attendance it
+// produces. The attendance must still match what the employee saw when
+// submitting, and a finalized payroll has already paid out the day.
+func (u *UsecaseImpl) applyCorrection(ctx context.Context, correction *entity.AttendanceCorrection) error {
+	if err := u.ensureNotPaidOut(ctx, calendarDay(correction.Date.UTC())); err != nil {
+		return err
+	}
+
+	attendance := &entity.Attendance{EmployeeID: correction.EmployeeID}
+	if correction.AttendanceID != nil {
+		current, err := u.attendanceRepo.FindByID(ctx, uint(*correction.AttendanceID), nil)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Error(ctx, "failed to find attendance", "attendance_id", *correction.AttendanceID, "error", err)
+			return httppkg.NewInternalServerError("failed to find attendance")
+		}
+		if current == nil ||
+			current.ClockInTime != correction.OriginalClockInTime ||
+			current.ClockOutTime != correction.OriginalClockOutTime {
+			return httppkg.NewConflictError("attendance changed since the correction was submitted")
+		}
+		attendance = current
+	} else {
+		day := correction.Date
+		query := repository.NewQuery().Where("employee_id", repository.OpEqual, correction.EmployeeID)
+		repository.WhereRange(query, "clock_in_time", repository.ClockInRange(repository.DaysBetween(day, day)))
+		count, err := u.attendanceRepo.Count(ctx, query, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to count attendances", "employee_id", correction.EmployeeID, "error", err)
+			return httppkg.NewInternalServerError("failed to check existing attendance")
+		}
+		if count > 0 {
+			return httppkg.NewConflictError("attendance changed since the correction was submitted")
+		}
+	}
+
+	attendance.ClockInTime, attendance.ClockOutTime = correction.CorrectedTimes()
+
+	if err := u.attendanceCorrectionRepo.Apply(ctx, correction, attendance, nil); err != nil {
+		logger.Error(ctx, "failed to apply attendance correction", "correction_id", correction.ID, "error", err)
+		return httppkg.NewInternalServerError("failed to apply attendance correction")
+	}
+	return nil
+}
+
+func toCorrectionSubmission(correction *entity.AttendanceCorrection) v1.AttendanceCorrectionSubmission {
+	submission := v1.AttendanceCorrectionSubmission{
+		Id:           correction.ID,
+		EmployeeId:   correction.EmployeeID,
+		AttendanceId: correction.AttendanceID,
+		Date:         openapi_types.Date{Time: correction.Date},
+		Reason:       correction.Reason,
+		Status:       v1.AttendanceCorrectionSubmissionStatus(correction.Status),
+		ReviewedBy:   correction.ReviewedBy,
+		ReviewedAt:   correction.ReviewedAt,
+	}
+	if correction.ClockInTime != "" {
+		submission.ClockInTime = &correction.ClockInTime
+	}
+	if correction.ClockOutTime != "" {
+		submission.ClockOutTime = &correction.ClockOutTime
+	}
+	if correction.OriginalClockInTime != "" {
+		submission.OriginalClockInTime = &correction.OriginalClockInTime
+	}
+	if correction.OriginalClockOutTime != "" {
+		submission.OriginalClockOutTime = &correction.OriginalClockOutTime
+	}
+	if correction.ReviewNote != "" {
+		submission.ReviewNote = &correction.ReviewNote
+	}
+	return submission
+}
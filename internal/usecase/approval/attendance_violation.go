@@ -0,0 +1,151 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) ListAttendanceViolations(ctx context.Context, status string) ([]v1.AttendanceViolationSubmission, error) {
+	status, err := validateStatusFilter(status)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := u.getReviewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := repository.NewQuery().Where("status", repository.OpEqual, status)
+	if !r.isUnscoped() {
+		reportIDs, err := u.getDirectReportIDs(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		if len(reportIDs) == 0 {
+			return []v1.AttendanceViolationSubmission{}, nil
+		}
+		query.Where("employee_id", repository.OpIn, reportIDs)
+	}
+
+	violations, err := u.attendanceViolationRepo.Find(ctx, query, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find attendance violations", "status", status, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find attendance violations")
+	}
+
+	response := make([]v1.AttendanceViolationSubmission, 0, len(violations))
+	for _, violation := range violations {
+		response = append(response, toViolationSubmission(&violation))
+	}
+	return response, nil
+}
+
+// ReviewAttendanceViolation decides on a flagged attendance. Approving the
+// violation accepts the attendance as it is; rejecting it keeps the
+// attendance out of payroll, so it is refused once the day is paid out.
+func (u *UsecaseImpl) ReviewAttendanceViolation(ctx context.Context, violationID int64, req v1.ReviewRequest) (*v1.AttendanceViolationSubmission, error) {
+	r, err := u.getReviewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	violation, err := u.attendanceViolationRepo.FindByID(ctx, uint(violationID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find attendance violation", "violation_id", violationID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find attendance violation")
+	}
+	if violation == nil {
+		return nil, httppkg.NewNotFoundError("attendance violation not found")
+	}
+
+	if err := u.authorizeReview(ctx, r, violation.EmployeeID); err != nil {
+		return nil, err
+	}
+
+	if err := validateReviewRequest(violation.Status, req); err != nil {
+		return nil, err
+	}
+
+	if req.Decision == v1.ReviewRequestDecisionRejected {
+		if err := u.ensureAttendanceNotPaidOut(ctx, violation.AttendanceID); err != nil {
+			return nil, err
+		}
+	}
+
+	violation.Status = string(req.Decision)
+	violation.ReviewedBy = &r.userID
+	violation.ReviewedAt = reviewedNow()
+	violation.ReviewNote = reviewNote(req)
+
+	if err := u.attendanceViolationRepo.Save(ctx, violation, nil); err != nil {
+		logger.Error(ctx, "failed to review attendance violation", "violation_id", violationID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to review attendance violation")
+	}
+
+	logger.Info(ctx, "attendance violation reviewed",
+		"violation_id", violationID,
+		"employee_id", violation.EmployeeID,
+		"attendance_id", violation.AttendanceID,
+		"decision", req.Decision,
+		"reviewed_by", r.userID)
+
+	response := toViolationSubmission(violation)
+	return &response, nil
+}
+
+// ensureAttendanceNotPaidOut rejects taking an attendance out of payroll
+// when the day it was clocked in on is in a finalized payroll.
+func (u *UsecaseImpl) ensureAttendanceNotPaidOut(ctx context.Context, attendanceID int64) error {
+	attendance, err := u.attendanceRepo.FindByID(ctx, uint(attendanceID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find attendance", "attendance_id", attendanceID, "error", err)
+		return httppkg.NewInternalServerError("failed to find attendance")
+	}
+	if attendance == nil {
+		return nil
+	}
+
+	clockIn, err := time.Parse(time.RFC3339, attendance.ClockInTime)
+	if err != nil {
+		logger.Error(ctx, "invalid clock-in time", "attendance_id", attendanceID, "error", err)
+		return httppkg.NewInternalServerError("failed to read attendance")
+	}
+	return u.ensureNotPaidOut(ctx, calendarDay(clockIn))
+}
+
+func toViolationSubmission(violation *entity.AttendanceViolation) v1.AttendanceViolationSubmission {
+	submission := v1.AttendanceViolationSubmission{
+		Id:             violation.ID,
+		AttendanceId:   violation.AttendanceID,
+		EmployeeId:     violation.EmployeeID,
+		AttendanceType: v1.AttendanceViolationSubmissionAttendanceType(violation.AttendanceType),
+		Kind:           v1.AttendanceViolationKind(violation.Kind),
+		Detail:         violation.Detail,
+		Latitude:       violation.Latitude,
+		Longitude:      violation.Longitude,
+		Accuracy:       violation.Accuracy,
+		Status:         v1.AttendanceViolationSubmissionStatus(violation.Status),
+		ReviewedBy:     violation.ReviewedBy,
+		ReviewedAt:     violation.ReviewedAt,
+		CreatedAt:      violation.CreatedAt,
+	}
+	if violation.IPAddress != "" {
+		submission.IpAddress = &violation.IPAddress
+	}
+	if violation.DeviceID != "" {
+		submission.DeviceId = &violation.DeviceID
+	}
+	if violation.ReviewNote != "" {
+		submission.ReviewNote = &violation.ReviewNote
+	}
+	return submission
+}
@@ -0,0 +1,218 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/asyauqi15/payslip-system/internal/usecase/approval (interfaces: Usecase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/approval Usecase
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ListAttendanceCorrections mocks base method.
+func (m *MockUsecase) ListAttendanceCorrections(ctx context.Context, status string) ([]v1.AttendanceCorrectionSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttendanceCorrections", ctx, status)
+	ret0, _ := ret[0].([]v1.AttendanceCorrectionSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttendanceCorrections indicates an expected call of ListAttendanceCorrections.
+func (mr *MockUsecaseMockRecorder) ListAttendanceCorrections(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttendanceCorrections", reflect.TypeOf((*MockUsecase)(nil).ListAttendanceCorrections), ctx, status)
+}
+
+// ListAttendanceViolations mocks base method.
+func (m *MockUsecase) ListAttendanceViolations(ctx context.Context, status string) ([]v1.AttendanceViolationSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttendanceViolations", ctx, status)
+	ret0, _ := ret[0].([]v1.AttendanceViolationSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttendanceViolations indicates an expected call of ListAttendanceViolations.
+func (mr *MockUsecaseMockRecorder) ListAttendanceViolations(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttendanceViolations", reflect.TypeOf((*MockUsecase)(nil).ListAttendanceViolations), ctx, status)
+}
+
+// ListOvertimes mocks base method.
+func (m *MockUsecase) ListOvertimes(ctx context.Context, status string) ([]v1.OvertimeSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOvertimes", ctx, status)
+	ret0, _ := ret[0].([]v1.OvertimeSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOvertimes indicates an expected call of ListOvertimes.
+func (mr *MockUsecaseMockRecorder) ListOvertimes(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOvertimes", reflect.TypeOf((*MockUsecase)(nil).ListOvertimes), ctx, status)
+}
+
+// ListReimbursements mocks base method.
+func (m *MockUsecase) ListReimbursements(ctx context.Context, status string) ([]v1.ReimbursementSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReimbursements", ctx, status)
+	ret0, _ := ret[0].([]v1.ReimbursementSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReimbursements indicates an expected call of ListReimbursements.
+func (mr *MockUsecaseMockRecorder) ListReimbursements(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReimbursements", reflect.TypeOf((*MockUsecase)(nil).ListReimbursements), ctx, status)
+}
+
+// RestoreOvertime mocks base method.
+func (m *MockUsecase) RestoreOvertime(ctx context.Context, overtimeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreOvertime", ctx, overtimeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreOvertime indicates an expected call of RestoreOvertime.
+func (mr *MockUsecaseMockRecorder) RestoreOvertime(ctx, overtimeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreOvertime", reflect.TypeOf((*MockUsecase)(nil).RestoreOvertime), ctx, overtimeID)
+}
+
+// RestoreReimbursement mocks base method.
+func (m *MockUsecase) RestoreReimbursement(ctx context.Context, reimbursementID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreReimbursement", ctx, reimbursementID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreReimbursement indicates an expected call of RestoreReimbursement.
+func (mr *MockUsecaseMockRecorder) RestoreReimbursement(ctx, reimbursementID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreReimbursement", reflect.TypeOf((*MockUsecase)(nil).RestoreReimbursement), ctx, reimbursementID)
+}
+
+// ReviewAttendanceCorrection mocks base method.
+func (m *MockUsecase) ReviewAttendanceCorrection(ctx context.Context, correctionID int64, req v1.ReviewRequest) (*v1.AttendanceCorrectionSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewAttendanceCorrection", ctx, correctionID, req)
+	ret0, _ := ret[0].(*v1.AttendanceCorrectionSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewAttendanceCorrection indicates an expected call of ReviewAttendanceCorrection.
+func (mr *MockUsecaseMockRecorder) ReviewAttendanceCorrection(ctx, correctionID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewAttendanceCorrection", reflect.TypeOf((*MockUsecase)(nil).ReviewAttendanceCorrection), ctx, correctionID, req)
+}
+
+// ReviewAttendanceViolation mocks base method.
+func (m *MockUsecase) ReviewAttendanceViolation(ctx context.Context, violationID int64, req v1.ReviewRequest) (*v1.AttendanceViolationSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewAttendanceViolation", ctx, violationID, req)
+	ret0, _ := ret[0].(*v1.AttendanceViolationSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewAttendanceViolation indicates an expected call of ReviewAttendanceViolation.
+func (mr *MockUsecaseMockRecorder) ReviewAttendanceViolation(ctx, violationID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewAttendanceViolation", reflect.TypeOf((*MockUsecase)(nil).ReviewAttendanceViolation), ctx, violationID, req)
+}
+
+// ReviewOvertime mocks base method.
+func (m *MockUsecase) ReviewOvertime(ctx context.Context, overtimeID int64, req v1.ReviewRequest) (*v1.OvertimeSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewOvertime", ctx, overtimeID, req)
+	ret0, _ := ret[0].(*v1.OvertimeSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewOvertime indicates an expected call of ReviewOvertime.
+func (mr *MockUsecaseMockRecorder) ReviewOvertime(ctx, overtimeID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewOvertime", reflect.TypeOf((*MockUsecase)(nil).ReviewOvertime), ctx, overtimeID, req)
+}
+
+// ReviewReimbursement mocks base method.
+func (m *MockUsecase) ReviewReimbursement(ctx context.Context, reimbursementID int64, req v1.ReviewRequest) (*v1.ReimbursementSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewReimbursement", ctx, reimbursementID, req)
+	ret0, _ := ret[0].(*v1.ReimbursementSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewReimbursement indicates an expected call of ReviewReimbursement.
+func (mr *MockUsecaseMockRecorder) ReviewReimbursement(ctx, reimbursementID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewReimbursement", reflect.TypeOf((*MockUsecase)(nil).ReviewReimbursement), ctx, reimbursementID, req)
+}
+
+// VoidOvertime mocks base method.
+func (m *MockUsecase) VoidOvertime(ctx context.Context, overtimeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidOvertime", ctx, overtimeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidOvertime indicates an expected call of VoidOvertime.
+func (mr *MockUsecaseMockRecorder) VoidOvertime(ctx, overtimeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidOvertime", reflect.TypeOf((*MockUsecase)(nil).VoidOvertime), ctx, overtimeID)
+}
+
+// VoidReimbursement mocks base method.
+func (m *MockUsecase) VoidReimbursement(ctx context.Context, reimbursementID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidReimbursement", ctx, reimbursementID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidReimbursement indicates an expected call of VoidReimbursement.
+func (mr *MockUsecaseMockRecorder) VoidReimbursement(ctx, reimbursementID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidReimbursement", reflect.TypeOf((*MockUsecase)(nil).VoidReimbursement), ctx, reimbursementID)
+}
@@ -0,0 +1,112 @@
+package approval
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) ListOvertimes(ctx context.Context, status string) ([]v1.OvertimeSubmission, error) {
+	status, err := validateStatusFilter(status)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := u.getReviewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var overtimes []entity.Overtime
+	if r.isUnscoped() {
+		overtimes, err = u.overtimeRepo.FindByTemplate(ctx, &entity.Overtime{Status: status}, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to find overtimes", "status", status, "error", err)
+			return nil, httppkg.NewInternalServerError("failed to find overtimes")
+		}
+	} else {
+		reportIDs, err := u.getDirectReportIDs(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		for _, employeeID := range reportIDs {
+			employeeOvertimes, err := u.overtimeRepo.FindByTemplate(ctx, &entity.Overtime{EmployeeID: employeeID, Status: status}, nil)
+			if err != nil {
+				logger.Error(ctx, "failed to find overtimes", "employee_id", employeeID, "status", status, "error", err)
+				return nil, httppkg.NewInternalServerError("failed to find overtimes")
+			}
+			overtimes = append(overtimes, employeeOvertimes...)
+		}
+	}
+
+	response := make([]v1.OvertimeSubmission, 0, len(overtimes))
+	for _, overtime := range overtimes {
+		response = append(response, toOvertimeSubmission(&overtime))
+	}
+	return response, nil
+}
+
+func (u *UsecaseImpl) ReviewOvertime(ctx context.Context, overtimeID int64, req v1.ReviewRequest) (*v1.OvertimeSubmission, error) {
+	r, err := u.getReviewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	overtime, err := u.overtimeRepo.FindByID(ctx, uint(overtimeID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find overtime", "overtime_id", overtimeID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find overtime")
+	}
+	if overtime == nil {
+		return nil, httppkg.NewNotFoundError("overtime not found")
+	}
+
+	if err := u.authorizeReview(ctx, r, overtime.EmployeeID); err != nil {
+		return nil, err
+	}
+
+	if err := validateReviewRequest(overtime.Status, req); err != nil {
+		return nil, err
+	}
+
+	overtime.Status = string(req.Decision)
+	overtime.ReviewedBy = &r.userID
+	overtime.ReviewedAt = reviewedNow()
+	overtime.ReviewNote = reviewNote(req)
+
+	if err := u.overtimeRepo.Save(ctx, overtime, nil); err != nil {
+		logger.Error(ctx, "failed to review overtime", "overtime_id", overtimeID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to review overtime")
+	}
+
+	logger.Info(ctx, "overtime reviewed",
+		"overtime_id", overtimeID,
+		"employee_id", overtime.EmployeeID,
+		"decision", req.Decision,
+		"reviewed_by", r.userID)
+
+	response := toOvertimeSubmission(overtime)
+	return &response, nil
+}
+
+func toOvertimeSubmission(overtime *entity.Overtime) v1.OvertimeSubmission {
+	submission := v1.OvertimeSubmission{
+		Id:          overtime.ID,
+		EmployeeId:  overtime.EmployeeID,
+		StartTime:   overtime.StartAt,
+		EndTime:     overtime.EndAt,
+		Description: overtime.Description,
+		Status:      v1.OvertimeSubmissionStatus(overtime.Status),
+		ReviewedBy:  overtime.ReviewedBy,
+		ReviewedAt:  overtime.ReviewedAt,
+	}
+	if overtime.ReviewNote != "" {
+		submission.ReviewNote = &overtime.ReviewNote
+	}
+	return submission
+}
@@ -0,0 +1,114 @@
+package approval
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) ListReimbursements(ctx context.Context, status string) ([]v1.ReimbursementSubmission, error) {
+	status, err := validateStatusFilter(status)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := u.getReviewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reimbursements []entity.Reimbursement
+	if r.isUnscoped() {
+		reimbursements, err = u.reimbursementRepo.FindByTemplate(ctx, &entity.Reimbursement{Status: status}, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to find reimbursements", "status", status, "error", err)
+			return nil, httppkg.NewInternalServerError("failed to find reimbursements")
+		}
+	} else {
+		reportIDs, err := u.getDirectReportIDs(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		for _, employeeID := range reportIDs {
+			employeeReimbursements, err := u.reimbursementRepo.FindByTemplate(ctx, &entity.Reimbursement{EmployeeID: employeeID, Status: status}, nil)
+			if err != nil {
+				logger.Error(ctx, "failed to find reimbursements", "employee_id", employeeID, "status", status, "error", err)
+				return nil, httppkg.NewInternalServerError("failed to find reimbursements")
+			}
+			reimbursements = append(reimbursements, employeeReimbursements...)
+		}
+	}
+
+	response := make([]v1.ReimbursementSubmission, 0, len(reimbursements))
+	for _, reimbursement := range reimbursements {
+		response = append(response, toReimbursementSubmission(&reimbursement))
+	}
+	return response, nil
+}
+
+func (u *UsecaseImpl) ReviewReimbursement(ctx context.Context, reimbursementID int64, req v1.ReviewRequest) (*v1.ReimbursementSubmission, error) {
+	r, err := u.getReviewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reimbursement, err := u.reimbursementRepo.FindByID(ctx, uint(reimbursementID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find reimbursement", "reimbursement_id", reimbursementID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find reimbursement")
+	}
+	if reimbursement == nil {
+		return nil, httppkg.NewNotFoundError("reimbursement not found")
+	}
+
+	if err := u.authorizeReview(ctx, r, reimbursement.EmployeeID); err != nil {
+		return nil, err
+	}
+
+	if err := validateReviewRequest(reimbursement.Status, req); err != nil {
+		return nil, err
+	}
+
+	reimbursement.Status = string(req.Decision)
+	reimbursement.ReviewedBy = &r.userID
+	reimbursement.ReviewedAt = reviewedNow()
+	reimbursement.ReviewNote = reviewNote(req)
+
+	if err := u.reimbursementRepo.Save(ctx, reimbursement, nil); err != nil {
+		logger.Error(ctx, "failed to review reimbursement", "reimbursement_id", reimbursementID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to review reimbursement")
+	}
+
+	logger.Info(ctx, "reimbursement reviewed",
+		"reimbursement_id", reimbursementID,
+		"employee_id", reimbursement.EmployeeID,
+		"decision", req.Decision,
+		"reviewed_by", r.userID)
+
+	response := toReimbursementSubmission(reimbursement)
+	return &response, nil
+}
+
+func toReimbursementSubmission(reimbursement *entity.Reimbursement) v1.ReimbursementSubmission {
+	submission := v1.ReimbursementSubmission{
+		Id:          reimbursement.ID,
+		EmployeeId:  reimbursement.EmployeeID,
+		Date:        openapi_types.Date{Time: reimbursement.Date},
+		Amount:      reimbursement.Amount,
+		Currency:    reimbursement.Currency,
+		Description: reimbursement.Description,
+		Status:      v1.ReimbursementSubmissionStatus(reimbursement.Status),
+		ReviewedBy:  reimbursement.ReviewedBy,
+		ReviewedAt:  reimbursement.ReviewedAt,
+	}
+	if reimbursement.ReviewNote != "" {
+		submission.ReviewNote = &reimbursement.ReviewNote
+	}
+	return submission
+}
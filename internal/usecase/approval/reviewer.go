@@ -0,0 +1,135 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+// reviewer is the authenticated user acting on submissions. Users who may
+// review any submission can have no employee record; team reviewers always
+// need one to resolve their direct reports.
+type reviewer struct {
+	userID    int64
+	reviewAny bool
+	employee  *entity.Employee
+}
+
+func (r *reviewer) isUnscoped() bool {
+	return r.reviewAny
+}
+
+func (u *UsecaseImpl) getReviewer(ctx context.Context) (*reviewer, error) {
+	userIDStr := ctx.Value(constant.ContextKeyUserID)
+	if userIDStr == nil {
+		return nil, httppkg.NewUnauthorizedError("user not authenticated")
+	}
+
+	userID := cast.ToInt64(userIDStr)
+	permissions := cast.ToStringSlice(ctx.Value(constant.ContextKeyUserPermissions))
+	reviewAny := entity.HasPermission(permissions, entity.PermissionSubmissionReviewAny)
+	if !reviewAny && !entity.HasPermission(permissions, entity.PermissionSubmissionReviewTeam) {
+		return nil, httppkg.NewForbiddenError("not allowed to review submissions")
+	}
+
+	employee, err := u.employeeRepo.FindOneByTemplate(ctx, &entity.Employee{UserID: userID}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find reviewer employee", "user_id", userID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find employee")
+	}
+	if employee == nil && !reviewAny {
+		return nil, httppkg.NewForbiddenError("reviewer has no employee record")
+	}
+
+	return &reviewer{
+		userID:    userID,
+		reviewAny: reviewAny,
+		employee:  employee,
+	}, nil
+}
+
+// getDirectReportIDs returns the employees whose submissions a team reviewer
+// may see. Unscoped reviewers get nil.
+func (u *UsecaseImpl) getDirectReportIDs(ctx context.Context, r *reviewer) ([]int64, error) {
+	if r.isUnscoped() {
+		return nil, nil
+	}
+
+	reports, err := u.employeeRepo.FindByTemplate(ctx, &entity.Employee{ManagerID: &r.employee.ID}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find direct reports", "manager_id", r.employee.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find direct reports")
+	}
+
+	ids := make([]int64, 0, len(reports))
+	for _, report := range reports {
+		ids = append(ids, report.ID)
+	}
+	return ids, nil
+}
+
+// authorizeReview checks that the reviewer may decide on a submission of the
+// given employee: nobody reviews their own submissions, and team reviewers
+// are limited to their direct reports.
+func (u *UsecaseImpl) authorizeReview(ctx context.Context, r *reviewer, employeeID int64) error {
+	if r.employee != nil && r.employee.ID == employeeID {
+		return httppkg.NewForbiddenError("cannot review own submission")
+	}
+
+	if r.isUnscoped() {
+		return nil
+	}
+
+	employee, err := u.employeeRepo.FindByID(ctx, uint(employeeID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find employee", "employee_id", employeeID, "error", err)
+		return httppkg.NewInternalServerError("failed to find employee")
+	}
+	if employee == nil || employee.ManagerID == nil || *employee.ManagerID != r.employee.ID {
+		return httppkg.NewForbiddenError("can only review submissions of direct reports")
+	}
+
+	return nil
+}
+
+// validateStatusFilter returns the status to list, pending when none is
+// given.
+func validateStatusFilter(status string) (string, error) {
+	if err := entity.ValidateApprovalStatusFilter(status); err != nil {
+		return "", httppkg.NewBadRequestError(err.Error())
+	}
+	if status == "" {
+		return entity.ApprovalStatusPending, nil
+	}
+	return status, nil
+}
+
+func validateReviewRequest(currentStatus string, req v1.ReviewRequest) error {
+	if req.Decision != v1.ReviewRequestDecisionApproved && req.Decision != v1.ReviewRequestDecisionRejected {
+		return httppkg.NewBadRequestError("decision must be approved or rejected")
+	}
+	if currentStatus != entity.ApprovalStatusPending {
+		return httppkg.NewConflictError("submission has already been reviewed")
+	}
+	return nil
+}
+
+func reviewNote(req v1.ReviewRequest) string {
+	if req.Note == nil {
+		return ""
+	}
+	return *req.Note
+}
+
+func reviewedNow() *time.Time {
+	now := time.Now()
+	return &now
+}
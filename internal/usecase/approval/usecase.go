@@ -0,0 +1,60 @@
+package approval
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+//go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/approval Usecase
+type Usecase interface {
+	ListOvertimes(ctx context.Context, status string) ([]v1.OvertimeSubmission, error)
+	ReviewOvertime(ctx context.Context, overtimeID int64, req v1.ReviewRequest) (*v1.OvertimeSubmission, error)
+	ListReimbursements(ctx context.Context, status string) ([]v1.ReimbursementSubmission, error)
+	ReviewReimbursement(ctx context.Context, reimbursementID int64, req v1.ReviewRequest) (*v1.ReimbursementSubmission, error)
+	VoidOvertime(ctx context.Context, overtimeID int64) error
+	VoidReimbursement(ctx context.Context, reimbursementID int64) error
+	RestoreOvertime(ctx context.Context, overtimeID int64) error
+	RestoreReimbursement(ctx context.Context, reimbursementID int64) error
+	ListAttendanceCorrections(ctx context.Context, status string) ([]v1.AttendanceCorrectionSubmission, error)
+	ReviewAttendanceCorrection(ctx context.Context, correctionID int64, req v1.ReviewRequest) (*v1.AttendanceCorrectionSubmission, error)
+	ListAttendanceViolations(ctx context.Context, status string) ([]v1.AttendanceViolationSubmission, error)
+	ReviewAttendanceViolation(ctx context.Context, violationID int64, req v1.ReviewRequest) (*v1.AttendanceViolationSubmission, error)
+}
+
+type UsecaseImpl struct {
+	overtimeRepo             repository.OvertimeRepository
+	reimbursementRepo        repository.ReimbursementRepository
+	employeeRepo             repository.EmployeeRepository
+	attendancePeriodRepo     repository.AttendancePeriodRepository
+	payrollRepo              repository.PayrollRepository
+	attendanceRepo           repository.AttendanceRepository
+	attendanceCorrectionRepo repository.AttendanceCorrectionRepository
+	attendanceViolationRepo  repository.AttendanceViolationRepository
+	locationRepo             repository.LocationRepository
+}
+
+func NewUsecase(
+	overtimeRepo repository.OvertimeRepository,
+	reimbursementRepo repository.ReimbursementRepository,
+	employeeRepo repository.EmployeeRepository,
+	attendancePeriodRepo repository.AttendancePeriodRepository,
+	payrollRepo repository.PayrollRepository,
+	attendanceRepo repository.AttendanceRepository,
+	attendanceCorrectionRepo repository.AttendanceCorrectionRepository,
+	attendanceViolationRepo repository.AttendanceViolationRepository,
+	locationRepo repository.LocationRepository,
+) Usecase {
+	return &UsecaseImpl{
+		overtimeRepo:             overtimeRepo,
+		reimbursementRepo:        reimbursementRepo,
+		employeeRepo:             employeeRepo,
+		attendancePeriodRepo:     attendancePeriodRepo,
+		payrollRepo:              payrollRepo,
+		attendanceRepo:           attendanceRepo,
+		attendanceCorrectionRepo: attendanceCorrectionRepo,
+		attendanceViolationRepo:  attendanceViolationRepo,
+		locationRepo:             locationRepo,
+	}
+}
@@ -0,0 +1,211 @@
+package approval
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"gorm.io/gorm"
+)
+
+// VoidOvertime soft deletes an erroneous overtime submission, whatever its
+// review status, so payroll no longer counts it.
+func (u *UsecaseImpl) VoidOvertime(ctx context.Context, overtimeID int64) error {
+	overtime, err := u.overtimeRepo.FindByID(ctx, uint(overtimeID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find overtime", "overtime_id", overtimeID, "error", err)
+		return httppkg.NewInternalServerError("failed to find overtime")
+	}
+	if overtime == nil {
+		return httppkg.NewNotFoundError("overtime not found")
+	}
+
+	schedule, err := u.overtimeSchedule(ctx, overtime)
+	if err != nil {
+		return err
+	}
+	if err := u.ensureNotPaidOut(ctx, calendarDay(schedule.WorkDay(overtime.StartAt))); err != nil {
+		return err
+	}
+
+	if err := u.overtimeRepo.Delete(ctx, overtime, nil); err != nil {
+		logger.Error(ctx, "failed to void overtime", "overtime_id", overtimeID, "error", err)
+		return httppkg.NewInternalServerError("failed to void overtime")
+	}
+
+	logger.Info(ctx, "overtime voided", "overtime_id", overtimeID, "employee_id", overtime.EmployeeID)
+	return nil
+}
+
+// VoidReimbursement soft deletes an erroneous reimbursement submission,
+// whatever its review status, so payroll no longer counts it.
+func (u *UsecaseImpl) VoidReimbursement(ctx context.Context, reimbursementID int64) error {
+	reimbursement, err := u.reimbursementRepo.FindByID(ctx, uint(reimbursementID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find reimbursement", "reimbursement_id", reimbursementID, "error", err)
+		return httppkg.NewInternalServerError("failed to find reimbursement")
+	}
+	if reimbursement == nil {
+		return httppkg.NewNotFoundError("reimbursement not found")
+	}
+
+	// Reimbursements are dated by calendar date, stored at midnight UTC
+	if err := u.ensureNotPaidOut(ctx, calendarDay(reimbursement.Date.UTC())); err != nil {
+		return err
+	}
+
+	if err := u.reimbursementRepo.Delete(ctx, reimbursement, nil); err != nil {
+		logger.Error(ctx, "failed to void reimbursement", "reimbursement_id", reimbursementID, "error", err)
+		return httppkg.NewInternalServerError("failed to void reimbursement")
+	}
+
+	logger.Info(ctx, "reimbursement voided", "reimbursement_id", reimbursementID, "employee_id", reimbursement.EmployeeID)
+	return nil
+}
+
+// RestoreOvertime brings back a voided overtime submission with the review
+// status it had. It is rejected when the period's payroll is finalized or
+// when the overtime no longer fits the employee's other overtime.
+func (u *UsecaseImpl) RestoreOvertime(ctx context.Context, overtimeID int64) error {
+	overtime, err := u.overtimeRepo.FindDeletedByID(ctx, uint(overtimeID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find voided overtime", "overtime_id", overtimeID, "error", err)
+		return httppkg.NewInternalServerError("failed to find voided overtime")
+	}
+	if overtime == nil {
+		return httppkg.NewNotFoundError("voided overtime not found")
+	}
+
+	schedule, err := u.overtimeSchedule(ctx, overtime)
+	if err != nil {
+		return err
+	}
+	workDay := calendarDay(schedule.WorkDay(overtime.StartAt))
+	if err := u.ensureNotPaidOut(ctx, workDay); err != nil {
+		return err
+	}
+	// Rejected overtime does not occupy its time slot
+	if overtime.Status != entity.ApprovalStatusRejected {
+		if err := u.ensureOvertimeFits(ctx, overtime, schedule, workDay); err != nil {
+			return err
+		}
+	}
+
+	if err := u.overtimeRepo.Restore(ctx, overtime, nil); err != nil {
+		logger.Error(ctx, "failed to restore overtime", "overtime_id", overtimeID, "error", err)
+		return httppkg.NewInternalServerError("failed to restore overtime")
+	}
+
+	logger.Info(ctx, "overtime restored", "overtime_id", overtimeID, "employee_id", overtime.EmployeeID)
+	return nil
+}
+
+// RestoreReimbursement brings back a voided reimbursement submission with
+// the review status it had, unless the period's payroll is finalized.
+func (u *UsecaseImpl) RestoreReimbursement(ctx context.Context, reimbursementID int64) error {
+	reimbursement, err := u.reimbursementRepo.FindDeletedByID(ctx, uint(reimbursementID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find voided reimbursement", "reimbursement_id", reimbursementID, "error", err)
+		return httppkg.NewInternalServerError("failed to find voided reimbursement")
+	}
+	if reimbursement == nil {
+		return httppkg.NewNotFoundError("voided reimbursement not found")
+	}
+
+	if err := u.ensureNotPaidOut(ctx, calendarDay(reimbursement.Date.UTC())); err != nil {
+		return err
+	}
+
+	if err := u.reimbursementRepo.Restore(ctx, reimbursement, nil); err != nil {
+		logger.Error(ctx, "failed to restore reimbursement", "reimbursement_id", reimbursementID, "error", err)
+		return httppkg.NewInternalServerError("failed to restore reimbursement")
+	}
+
+	logger.Info(ctx, "reimbursement restored", "reimbursement_id", reimbursementID, "employee_id", reimbursement.EmployeeID)
+	return nil
+}
+
+// overtimeSchedule returns the work schedule of the employee's location
+// around an overtime, which dates the overtime to its work day.
+func (u *UsecaseImpl) overtimeSchedule(ctx context.Context, overtime *entity.Overtime) (*entity.WorkSchedule, error) {
+	employee, err := u.employeeRepo.FindByID(ctx, uint(overtime.EmployeeID), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find employee", "employee_id", overtime.EmployeeID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find employee")
+	}
+
+	schedule, err := u.locationRepo.ScheduleFor(ctx, employee, repository.DaysAround(overtime.StartAt), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find work schedule", "employee_id", employee.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find work schedule")
+	}
+
+	return schedule, nil
+}
+
+// ensureOvertimeFits rejects restoring an overtime that overlaps the
+// employee's other overtime, or takes its work day past 3 hours, as
+// submitting it again would be.
+func (u *UsecaseImpl) ensureOvertimeFits(ctx context.Context, overtime *entity.Overtime, schedule *entity.WorkSchedule, workDay time.Time) error {
+	query := repository.NewQuery().
+		Where("employee_id", repository.OpEqual, overtime.EmployeeID).
+		Where("status", repository.OpNotEqual, entity.ApprovalStatusRejected).
+		WhereDateRange("start_at", repository.DaysBetween(workDay.AddDate(0, 0, -1), workDay.AddDate(0, 0, 1)))
+	existingOvertimes, err := u.overtimeRepo.Find(ctx, query, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find existing overtimes", "employee_id", overtime.EmployeeID, "error", err)
+		return httppkg.NewInternalServerError("failed to find existing overtimes")
+	}
+
+	date := schedule.Date(schedule.WorkDay(overtime.StartAt))
+	total := overtime.EndAt.Sub(overtime.StartAt)
+	for _, existing := range existingOvertimes {
+		if overtime.StartAt.Before(existing.EndAt) && overtime.EndAt.After(existing.StartAt) {
+			return httppkg.NewConflictError("overtime overlaps with existing overtime")
+		}
+		if schedule.Date(schedule.WorkDay(existing.StartAt)) == date {
+			total += existing.EndAt.Sub(existing.StartAt)
+		}
+	}
+	if total > 3*time.Hour {
+		return httppkg.NewConflictError("total overtime for the day would exceed 3 hours")
+	}
+
+	return nil
+}
+
+// calendarDay returns the date of a time in its own zone, at midnight UTC.
+func calendarDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// ensureNotPaidOut rejects voiding or restoring a submission dated in a
+// period whose payroll is finalized; its payslips are already paid out. The
+// day is a calendar date, as attendance periods store them.
+func (u *UsecaseImpl) ensureNotPaidOut(ctx context.Context, day time.Time) error {
+	query := repository.NewQuery().
+		Where("start_date", repository.OpLessOrEqual, day).
+		Where("end_date", repository.OpGreaterOrEqual, day)
+	periods, err := u.attendancePeriodRepo.Find(ctx, query, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find attendance periods", "date", day, "error", err)
+		return httppkg.NewInternalServerError("failed to find attendance periods")
+	}
+
+	for _, period := range periods {
+		payroll, err := u.payrollRepo.FindOneByTemplate(ctx, &entity.Payroll{AttendancePeriodID: period.ID}, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to find payroll", "attendance_period_id", period.ID, "error", err)
+			return httppkg.NewInternalServerError("failed to find payroll")
+		}
+		if payroll != nil && payroll.Status == entity.PayrollStatusFinalized {
+			return httppkg.NewConflictError("payroll for this period is already finalized")
+		}
+	}
+
+	return nil
+}
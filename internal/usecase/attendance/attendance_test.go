@@ -10,6 +10,7 @@ import (
 	"github.com/asyauqi15/payslip-system/internal/repository/mock"
 	"github.com/asyauqi15/payslip-system/internal/usecase/attendance"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 )
@@ -19,20 +20,57 @@ func TestAttendanceUsecase_SubmitAttendance(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockAttendanceCorrectionRepo := mock.NewMockAttendanceCorrectionRepository(ctrl)
 	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
 
-	usecase := attendance.NewUsecase(mockAttendanceRepo, mockEmployeeRepo)
+	usecase := attendance.NewUsecase(mockAttendanceRepo, mockAttendanceCorrectionRepo, mockEmployeeRepo, mockLocationRepo)
+
+	// Every day is a workday so the result does not depend on when tests run
+	everyDay := entity.DefaultWorkSchedule()
+	everyDay.Workdays = [7]bool{true, true, true, true, true, true, true}
+
+	checkIn := v1.AttendanceRequest{AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn}
+	checkOut := v1.AttendanceRequest{AttendanceType: v1.AttendanceRequestAttendanceTypeCheckOut}
+
+	// The office geofence is in central Jakarta; the position below is about
+	// 1.7 km away from it
+	office := entity.Geofence{Name: "HQ", Latitude: -6.2000, Longitude: 106.8166, RadiusMeters: 150}
+	farLatitude, farLongitude, accuracy := -6.2150, 106.8166, 20.0
+	nearLatitude, nearLongitude := -6.2005, 106.8166
+	allowed, _ := entity.ParseNetworks("10.0.0.0/8")
+	phone := "phone-1"
+
+	expectEmployee := func() *entity.Employee {
+		employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}
+		mockEmployeeRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+			Return(employee, nil)
+		mockLocationRepo.EXPECT().
+			ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+			Return(everyDay, nil)
+		mockAttendanceRepo.EXPECT().
+			Find(gomock.Any(), gomock.Any(), nil).
+			Return([]entity.Attendance{}, nil)
+		return employee
+	}
+	expectPolicy := func(employee *entity.Employee, policy *entity.AttendancePolicy) {
+		mockLocationRepo.EXPECT().
+			AttendancePolicyFor(gomock.Any(), employee, nil).
+			Return(policy, nil)
+	}
 
 	tests := []struct {
-		name           string
-		attendanceType v1.PostEmployeeAttendanceJSONBodyAttendanceType
-		setupContext   func() context.Context
-		setupMock      func()
-		expectError    bool
+		name          string
+		request       v1.AttendanceRequest
+		setupContext  func() context.Context
+		setupMock     func()
+		expectError   bool
+		expectFlagged bool
 	}{
 		{
-			name:           "successful check-in",
-			attendanceType: v1.CheckIn,
+			name:    "successful check-in",
+			request: checkIn,
 			setupContext: func() context.Context {
 				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
 			},
@@ -46,26 +84,25 @@ func TestAttendanceUsecase_SubmitAttendance(t *testing.T) {
 				mockEmployeeRepo.EXPECT().
 					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
 					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(everyDay, nil)
 
-				// Mock finding existing attendances (empty for check-in)
+				// Mock finding the latest session (none for check-in)
 				mockAttendanceRepo.EXPECT().
-					FindByTemplate(gomock.Any(), &entity.Attendance{EmployeeID: int64(1)}, nil).
+					Find(gomock.Any(), gomock.Any(), nil).
 					Return([]entity.Attendance{}, nil)
 
-				// Mock creating new attendance
+				expectPolicy(employee, &entity.AttendancePolicy{})
 				mockAttendanceRepo.EXPECT().
-					Create(gomock.Any(), gomock.Any(), nil).
-					Return(&entity.Attendance{
-						Base:        entity.Base{ID: 1},
-						EmployeeID:  1,
-						ClockInTime: time.Now().Format(time.RFC3339),
-					}, nil)
+					Record(gomock.Any(), gomock.Any(), gomock.Len(0), nil).
+					Return(nil)
 			},
 			expectError: false,
 		},
 		{
-			name:           "successful check-out",
-			attendanceType: v1.CheckOut,
+			name:    "successful check-out",
+			request: checkOut,
 			setupContext: func() context.Context {
 				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
 			},
@@ -79,6 +116,9 @@ func TestAttendanceUsecase_SubmitAttendance(t *testing.T) {
 				mockEmployeeRepo.EXPECT().
 					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
 					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(everyDay, nil)
 
 				// Mock finding existing attendance for today
 				existingAttendance := entity.Attendance{
@@ -88,19 +128,19 @@ func TestAttendanceUsecase_SubmitAttendance(t *testing.T) {
 					ClockOutTime: "",
 				}
 				mockAttendanceRepo.EXPECT().
-					FindByTemplate(gomock.Any(), &entity.Attendance{EmployeeID: int64(1)}, nil).
+					Find(gomock.Any(), gomock.Any(), nil).
 					Return([]entity.Attendance{existingAttendance}, nil)
 
-				// Mock updating attendance with check-out time
+				expectPolicy(employee, &entity.AttendancePolicy{})
 				mockAttendanceRepo.EXPECT().
-					Updates(gomock.Any(), &existingAttendance, gomock.Any(), nil).
-					Return(&existingAttendance, nil)
+					Record(gomock.Any(), gomock.Any(), gomock.Len(0), nil).
+					Return(nil)
 			},
 			expectError: false,
 		},
 		{
-			name:           "user not authenticated",
-			attendanceType: v1.CheckIn,
+			name:    "user not authenticated",
+			request: checkIn,
 			setupContext: func() context.Context {
 				return context.Background() // No user ID in context
 			},
@@ -108,8 +148,8 @@ func TestAttendanceUsecase_SubmitAttendance(t *testing.T) {
 			expectError: true,
 		},
 		{
-			name:           "employee not found",
-			attendanceType: v1.CheckIn,
+			name:    "employee not found",
+			request: checkIn,
 			setupContext: func() context.Context {
 				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(999))
 			},
@@ -121,8 +161,8 @@ func TestAttendanceUsecase_SubmitAttendance(t *testing.T) {
 			expectError: true,
 		},
 		{
-			name:           "already checked in today",
-			attendanceType: v1.CheckIn,
+			name:    "already checked in today",
+			request: checkIn,
 			setupContext: func() context.Context {
 				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
 			},
@@ -136,22 +176,25 @@ func TestAttendanceUsecase_SubmitAttendance(t *testing.T) {
 				mockEmployeeRepo.EXPECT().
 					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
 					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(everyDay, nil)
 
-				// Mock finding existing attendance for today
-				existingAttendance := entity.Attendance{
-					Base:        entity.Base{ID: 1},
-					EmployeeID:  1,
-					ClockInTime: time.Now().Format(time.RFC3339),
-				}
+				// Mock finding the existing attendance for today
 				mockAttendanceRepo.EXPECT().
-					FindByTemplate(gomock.Any(), &entity.Attendance{EmployeeID: int64(1)}, nil).
-					Return([]entity.Attendance{existingAttendance}, nil)
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Attendance{{
+						Base:         entity.Base{ID: 1},
+						EmployeeID:   1,
+						ClockInTime:  time.Now().In(everyDay.Zone).Format(time.RFC3339),
+						ClockOutTime: time.Now().In(everyDay.Zone).Format(time.RFC3339),
+					}}, nil)
 			},
 			expectError: true,
 		},
 		{
-			name:           "check out without check in",
-			attendanceType: v1.CheckOut,
+			name:    "check out without check in",
+			request: checkOut,
 			setupContext: func() context.Context {
 				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
 			},
@@ -165,14 +208,266 @@ func TestAttendanceUsecase_SubmitAttendance(t *testing.T) {
 				mockEmployeeRepo.EXPECT().
 					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
 					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(everyDay, nil)
 
 				// Mock finding no existing attendance for today
 				mockAttendanceRepo.EXPECT().
-					FindByTemplate(gomock.Any(), &entity.Attendance{EmployeeID: int64(1)}, nil).
+					Find(gomock.Any(), gomock.Any(), nil).
 					Return([]entity.Attendance{}, nil)
 			},
 			expectError: true,
 		},
+		{
+			name:    "day off in the employee's schedule",
+			request: checkIn,
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := &entity.Employee{
+					Base:       entity.Base{ID: 1},
+					UserID:     1,
+					BaseSalary: 5000000,
+				}
+
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(&entity.WorkSchedule{Zone: time.UTC}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name:    "rostered shift today",
+			request: checkIn,
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}
+
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(&entity.WorkSchedule{
+						Zone:     time.UTC,
+						Rostered: true,
+						Roster: map[string]entity.ShiftHours{
+							time.Now().UTC().Format("2006-01-02"): {Start: 22 * time.Hour, End: 23 * time.Hour},
+						},
+					}, nil)
+				mockAttendanceRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Attendance{}, nil)
+				expectPolicy(employee, &entity.AttendancePolicy{})
+				mockAttendanceRepo.EXPECT().
+					Record(gomock.Any(), gomock.Any(), gomock.Len(0), nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:    "no rostered shift today",
+			request: checkIn,
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}
+
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(employee, nil)
+				// Every weekday is a workday, but the roster decides
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(&entity.WorkSchedule{Zone: time.UTC, Workdays: everyDay.Workdays, Rostered: true}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name:    "check-out of a session that crossed midnight into a day off",
+			request: checkOut,
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}
+
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(&entity.WorkSchedule{Zone: time.UTC}, nil)
+
+				session := entity.Attendance{
+					Base:        entity.Base{ID: 1},
+					EmployeeID:  1,
+					ClockInTime: time.Now().UTC().Add(-8 * time.Hour).Format(time.RFC3339),
+				}
+				mockAttendanceRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Attendance{session}, nil)
+				expectPolicy(employee, &entity.AttendancePolicy{})
+				mockAttendanceRepo.EXPECT().
+					Record(gomock.Any(), gomock.Any(), gomock.Len(0), nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:    "check-out too long after check-in",
+			request: checkOut,
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}
+
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(everyDay, nil)
+				mockAttendanceRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Attendance{{
+						Base:        entity.Base{ID: 1},
+						EmployeeID:  1,
+						ClockInTime: time.Now().Add(-20 * time.Hour).Format(time.RFC3339),
+					}}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "check-in inside the geofence",
+			request: v1.AttendanceRequest{
+				AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn,
+				Latitude:       &nearLatitude,
+				Longitude:      &nearLongitude,
+				Accuracy:       &accuracy,
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := expectEmployee()
+				expectPolicy(employee, &entity.AttendancePolicy{Geofences: []entity.Geofence{office}})
+				mockAttendanceRepo.EXPECT().
+					Record(gomock.Any(), gomock.Any(), gomock.Len(0), nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "check-in outside the geofence is flagged",
+			request: v1.AttendanceRequest{
+				AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn,
+				Latitude:       &farLatitude,
+				Longitude:      &farLongitude,
+				Accuracy:       &accuracy,
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := expectEmployee()
+				expectPolicy(employee, &entity.AttendancePolicy{Geofences: []entity.Geofence{office}})
+				mockAttendanceRepo.EXPECT().
+					Record(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _ *entity.Attendance, violations []entity.AttendanceViolation, _ interface{}) error {
+						if len(violations) != 1 || violations[0].Kind != entity.ViolationOutsideGeofence {
+							t.Errorf("Expected an outside_geofence violation but got %+v", violations)
+						} else if violations[0].Status != entity.ApprovalStatusPending || violations[0].EmployeeID != 1 {
+							t.Errorf("Expected a pending violation of employee 1 but got %+v", violations[0])
+						}
+						return nil
+					})
+			},
+			expectError:   false,
+			expectFlagged: true,
+		},
+		{
+			name:    "check-in without position at a geofenced location is flagged",
+			request: checkIn,
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := expectEmployee()
+				expectPolicy(employee, &entity.AttendancePolicy{Geofences: []entity.Geofence{office}})
+				mockAttendanceRepo.EXPECT().
+					Record(gomock.Any(), gomock.Any(), gomock.Len(1), nil).
+					Return(nil)
+			},
+			expectError:   false,
+			expectFlagged: true,
+		},
+		{
+			name: "first device is bound to the employee",
+			request: v1.AttendanceRequest{
+				AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn,
+				DeviceId:       &phone,
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := expectEmployee()
+				expectPolicy(employee, &entity.AttendancePolicy{})
+				mockEmployeeRepo.EXPECT().
+					Updates(gomock.Any(), employee, entity.Employee{DeviceID: phone}, nil).
+					Return(employee, nil)
+				mockAttendanceRepo.EXPECT().
+					Record(gomock.Any(), gomock.Any(), gomock.Len(0), nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:    "check-in from another device and network is flagged",
+			request: checkIn,
+			setupContext: func() context.Context {
+				ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+				return context.WithValue(ctx, constant.ContextKeyIPAddress, "203.0.113.5")
+			},
+			setupMock: func() {
+				employee := expectEmployee()
+				expectPolicy(employee, &entity.AttendancePolicy{AllowedNetworks: allowed, DeviceID: phone})
+				mockAttendanceRepo.EXPECT().
+					Record(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _ *entity.Attendance, violations []entity.AttendanceViolation, _ interface{}) error {
+						if len(violations) != 2 || violations[0].Kind != entity.ViolationIPNotAllowed || violations[1].Kind != entity.ViolationDeviceMismatch {
+							t.Errorf("Expected ip_not_allowed and device_mismatch violations but got %+v", violations)
+						} else if violations[0].IPAddress != "203.0.113.5" {
+							t.Errorf("Expected the IP address to be kept but got %q", violations[0].IPAddress)
+						}
+						return nil
+					})
+			},
+			expectError:   false,
+			expectFlagged: true,
+		},
+		{
+			name: "latitude without longitude",
+			request: v1.AttendanceRequest{
+				AttendanceType: v1.AttendanceRequestAttendanceTypeCheckIn,
+				Latitude:       &nearLatitude,
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock:   func() {},
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -180,7 +475,7 @@ func TestAttendanceUsecase_SubmitAttendance(t *testing.T) {
 			tt.setupMock()
 			ctx := tt.setupContext()
 
-			err := usecase.SubmitAttendance(ctx, tt.attendanceType)
+			result, err := usecase.SubmitAttendance(ctx, tt.request)
 
 			if tt.expectError {
 				if err == nil {
@@ -189,8 +484,181 @@ func TestAttendanceUsecase_SubmitAttendance(t *testing.T) {
 			} else {
 				if err != nil {
 					t.Errorf("Expected no error but got: %v", err)
+				} else if result.Flagged != tt.expectFlagged {
+					t.Errorf("Expected flagged %v but got %v", tt.expectFlagged, result.Flagged)
 				}
 			}
 		})
 	}
 }
+
+func TestAttendanceUsecase_SubmitCorrection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockAttendanceCorrectionRepo := mock.NewMockAttendanceCorrectionRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := attendance.NewUsecase(mockAttendanceRepo, mockAttendanceCorrectionRepo, mockEmployeeRepo, mockLocationRepo)
+
+	zone := time.FixedZone("WIB", 7*60*60)
+	monday := openapi_types.Date{Time: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)}
+	clockIn := time.Date(2025, 1, 6, 9, 0, 0, 0, zone)
+	clockOut := time.Date(2025, 1, 6, 17, 30, 0, 0, zone)
+	twoDaysLater := clockOut.AddDate(0, 0, 2)
+	nightIn := time.Date(2025, 1, 6, 22, 0, 0, 0, zone)
+	nightOut := time.Date(2025, 1, 7, 6, 0, 0, 0, zone)
+	recorded := entity.Attendance{
+		Base:        entity.Base{ID: 30},
+		EmployeeID:  1,
+		ClockInTime: clockIn.Format(time.RFC3339),
+	}
+
+	expectEmployee := func() {
+		employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}
+		mockEmployeeRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+			Return(employee, nil)
+		mockLocationRepo.EXPECT().
+			ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+			Return(&entity.WorkSchedule{Zone: zone, Workdays: entity.DefaultWorkSchedule().Workdays}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		request        v1.AttendanceCorrectionRequest
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:    "missing check-out links the recorded attendance",
+			request: v1.AttendanceCorrectionRequest{Date: monday, ClockOutTime: &clockOut, Reason: "App was down"},
+			setupMock: func() {
+				expectEmployee()
+				mockAttendanceRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Attendance{recorded}, nil)
+				mockAttendanceCorrectionRepo.EXPECT().
+					Count(gomock.Any(), gomock.Any(), nil).
+					Return(int64(0), nil)
+				mockAttendanceCorrectionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, c *entity.AttendanceCorrection, _ interface{}) (*entity.AttendanceCorrection, error) {
+						if c.AttendanceID == nil || *c.AttendanceID != recorded.ID {
+							t.Error("Expected correction to link the recorded attendance")
+						}
+						if c.OriginalClockInTime != recorded.ClockInTime || c.Status != entity.ApprovalStatusPending {
+							t.Errorf("Expected pending correction keeping the original times but got %+v", c)
+						}
+						return c, nil
+					})
+			},
+		},
+		{
+			name:    "missing day needs a check-in",
+			request: v1.AttendanceCorrectionRequest{Date: monday, ClockOutTime: &clockOut, Reason: "Forgot"},
+			setupMock: func() {
+				expectEmployee()
+				mockAttendanceRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Attendance{}, nil)
+			},
+			expectedStatus: 400,
+		},
+		{
+			name:    "check-out before check-in",
+			request: v1.AttendanceCorrectionRequest{Date: monday, ClockInTime: &clockOut, ClockOutTime: &clockIn, Reason: "Forgot"},
+			setupMock: func() {
+				expectEmployee()
+				mockAttendanceRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Attendance{}, nil)
+			},
+			expectedStatus: 400,
+		},
+		{
+			name:    "night session ending the next day",
+			request: v1.AttendanceCorrectionRequest{Date: monday, ClockInTime: &nightIn, ClockOutTime: &nightOut, Reason: "Forgot"},
+			setupMock: func() {
+				expectEmployee()
+				mockAttendanceRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Attendance{}, nil)
+				mockAttendanceCorrectionRepo.EXPECT().
+					Count(gomock.Any(), gomock.Any(), nil).
+					Return(int64(0), nil)
+				mockAttendanceCorrectionRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, c *entity.AttendanceCorrection, _ interface{}) (*entity.AttendanceCorrection, error) {
+						return c, nil
+					})
+			},
+		},
+		{
+			name:    "session longer than allowed",
+			request: v1.AttendanceCorrectionRequest{Date: monday, ClockInTime: &clockIn, ClockOutTime: &nightOut, Reason: "Forgot"},
+			setupMock: func() {
+				expectEmployee()
+				mockAttendanceRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Attendance{}, nil)
+			},
+			expectedStatus: 400,
+		},
+		{
+			name:    "time on another date",
+			request: v1.AttendanceCorrectionRequest{Date: monday, ClockInTime: &clockIn, ClockOutTime: &twoDaysLater, Reason: "Forgot"},
+			setupMock: func() {
+				expectEmployee()
+			},
+			expectedStatus: 400,
+		},
+		{
+			name:    "weekend date",
+			request: v1.AttendanceCorrectionRequest{Date: openapi_types.Date{Time: time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)}, ClockInTime: &clockIn, Reason: "Forgot"},
+			setupMock: func() {
+				expectEmployee()
+			},
+			expectedStatus: 400,
+		},
+		{
+			name:    "correction already pending",
+			request: v1.AttendanceCorrectionRequest{Date: monday, ClockInTime: &clockIn, ClockOutTime: &clockOut, Reason: "Forgot"},
+			setupMock: func() {
+				expectEmployee()
+				mockAttendanceRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Attendance{}, nil)
+				mockAttendanceCorrectionRepo.EXPECT().
+					Count(gomock.Any(), gomock.Any(), nil).
+					Return(int64(1), nil)
+			},
+			expectedStatus: 409,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+
+			result, err := usecase.SubmitCorrection(ctx, tt.request)
+
+			if tt.expectedStatus == 0 {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if result.Status != v1.AttendanceCorrectionSubmissionStatusPending {
+					t.Errorf("Expected pending status but got %s", result.Status)
+				}
+				return
+			}
+			httpErr, ok := err.(interface{ HTTPStatus() int })
+			if !ok || httpErr.HTTPStatus() != tt.expectedStatus {
+				t.Errorf("Expected status %d but got: %v", tt.expectedStatus, err)
+			}
+		})
+	}
+}
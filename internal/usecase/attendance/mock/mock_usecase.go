@@ -21,6 +21,7 @@ import (
 type MockUsecase struct {
 	ctrl     *gomock.Controller
 	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
 }
 
 // MockUsecaseMockRecorder is the mock recorder for MockUsecase.
@@ -41,15 +42,31 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 }
 
 // SubmitAttendance mocks base method.
-func (m *MockUsecase) SubmitAttendance(arg0 context.Context, arg1 v1.PostEmployeeAttendanceJSONBodyAttendanceType) error {
+func (m *MockUsecase) SubmitAttendance(ctx context.Context, req v1.AttendanceRequest) (*v1.AttendanceResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubmitAttendance", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SubmitAttendance", ctx, req)
+	ret0, _ := ret[0].(*v1.AttendanceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // SubmitAttendance indicates an expected call of SubmitAttendance.
-func (mr *MockUsecaseMockRecorder) SubmitAttendance(arg0, arg1 any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) SubmitAttendance(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAttendance", reflect.TypeOf((*MockUsecase)(nil).SubmitAttendance), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAttendance", reflect.TypeOf((*MockUsecase)(nil).SubmitAttendance), ctx, req)
+}
+
+// SubmitCorrection mocks base method.
+func (m *MockUsecase) SubmitCorrection(ctx context.Context, req v1.AttendanceCorrectionRequest) (*v1.AttendanceCorrectionSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitCorrection", ctx, req)
+	ret0, _ := ret[0].(*v1.AttendanceCorrectionSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitCorrection indicates an expected call of SubmitCorrection.
+func (mr *MockUsecaseMockRecorder) SubmitCorrection(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitCorrection", reflect.TypeOf((*MockUsecase)(nil).SubmitCorrection), ctx, req)
 }
@@ -2,122 +2,253 @@ package attendance
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/asyauqi15/payslip-system/internal/constant"
 	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
 	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
 	"github.com/asyauqi15/payslip-system/pkg/logger"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 	"github.com/spf13/cast"
 )
 
-func (u *UsecaseImpl) SubmitAttendance(ctx context.Context, attendanceType v1.PostEmployeeAttendanceJSONBodyAttendanceType) error {
+func (u *UsecaseImpl) SubmitAttendance(ctx context.Context, req v1.AttendanceRequest) (*v1.AttendanceResult, error) {
 	// Get the user ID from context
 	userIDStr := ctx.Value(constant.ContextKeyUserID)
 	if userIDStr == nil {
-		return httppkg.NewUnauthorizedError("user not authenticated")
+		return nil, httppkg.NewUnauthorizedError("user not authenticated")
 	}
 
 	userID := cast.ToInt64(userIDStr)
 
+	evidence, err := toAttendanceEvidence(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	// Find the employee by user ID
 	employee, err := u.employeeRepo.FindOneByTemplate(ctx, &entity.Employee{UserID: userID}, nil)
 	if err != nil {
 		logger.Error(ctx, "failed to find employee", "user_id", userID, "error", err)
-		return httppkg.NewInternalServerError("failed to find employee")
+		return nil, httppkg.NewInternalServerError("failed to find employee")
 	}
 	if employee == nil {
-		return httppkg.NewNotFoundError("employee not found")
+		return nil, httppkg.NewNotFoundError("employee not found")
 	}
 
-	currentTime := time.Now().Format(time.RFC3339)
-	today := time.Now().Format("2006-01-02")
-	now := time.Now()
-
-	// Check if today is a weekday (Monday to Friday)
-	if now.Weekday() == time.Saturday || now.Weekday() == time.Sunday {
-		return httppkg.NewBadRequestError("attendance can only be submitted on weekdays")
+	// Days follow the employee's location, so times are recorded in its zone
+	schedule, err := u.locationRepo.ScheduleFor(ctx, employee, repository.DaysAround(time.Now()), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find work schedule", "employee_id", employee.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find work schedule")
 	}
 
-	switch attendanceType {
-	case v1.CheckIn:
-		return u.handleCheckIn(ctx, employee.ID, currentTime, today)
-	case v1.CheckOut:
-		return u.handleCheckOut(ctx, employee.ID, currentTime, today)
+	now := schedule.Now()
+
+	var attendance *entity.Attendance
+	switch req.AttendanceType {
+	case v1.AttendanceRequestAttendanceTypeCheckIn:
+		// Check if today is a workday of the employee's schedule
+		if !schedule.IsWorkday(now) {
+			if schedule.Rostered {
+				return nil, httppkg.NewBadRequestError("attendance can only be submitted on days with a rostered shift")
+			}
+			return nil, httppkg.NewBadRequestError("attendance can only be submitted on workdays")
+		}
+		attendance, err = u.handleCheckIn(ctx, employee.ID, now)
+	case v1.AttendanceRequestAttendanceTypeCheckOut:
+		// A check-out belongs to the day of its check-in, which may be a
+		// workday before midnight
+		attendance, err = u.handleCheckOut(ctx, employee.ID, now)
 	default:
-		return httppkg.NewBadRequestError("invalid attendance type")
+		return nil, httppkg.NewBadRequestError("invalid attendance type")
+	}
+	if err != nil {
+		return nil, err
 	}
-}
 
-func (u *UsecaseImpl) handleCheckIn(ctx context.Context, employeeID int64, currentTime, today string) error {
-	// Check if there's already a check-in for today
-	existingAttendances, err := u.attendanceRepo.FindByTemplate(ctx, &entity.Attendance{EmployeeID: employeeID}, nil)
+	violations, err := u.checkAttendancePolicy(ctx, employee, string(req.AttendanceType), evidence)
 	if err != nil {
-		logger.Error(ctx, "failed to find existing attendances", "employee_id", employeeID, "error", err)
-		return httppkg.NewInternalServerError("failed to check existing attendance")
+		return nil, err
 	}
 
-	// Check if there's already a check-in for today
-	for _, attendance := range existingAttendances {
-		if attendance.ClockInTime != "" {
-			// Parse the clock-in time to check if it's today
-			clockInTime, parseErr := time.Parse(time.RFC3339, attendance.ClockInTime)
-			if parseErr == nil && clockInTime.Format("2006-01-02") == today {
-				return httppkg.NewConflictError("already checked in today")
-			}
+	// The attendance is recorded even when it fails a check; the violations
+	// are stored with it for review
+	if err := u.attendanceRepo.Record(ctx, attendance, violations, nil); err != nil {
+		logger.Error(ctx, "failed to record attendance", "employee_id", employee.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to record attendance")
+	}
+
+	result := &v1.AttendanceResult{Flagged: len(violations) > 0}
+	if result.Flagged {
+		kinds := make([]v1.AttendanceViolationKind, 0, len(violations))
+		for _, violation := range violations {
+			kinds = append(kinds, v1.AttendanceViolationKind(violation.Kind))
 		}
+		result.Violations = &kinds
+
+		logger.Warn(ctx, "attendance flagged for review",
+			"employee_id", employee.ID,
+			"attendance_id", attendance.ID,
+			"violations", kinds)
 	}
 
-	// Create new attendance record with check-in
-	attendance := &entity.Attendance{
-		EmployeeID:  employeeID,
-		ClockInTime: currentTime,
+	return result, nil
+}
+
+// toAttendanceEvidence validates the position and device sent with a
+// submission and adds the address it came from.
+func toAttendanceEvidence(ctx context.Context, req v1.AttendanceRequest) (entity.AttendanceEvidence, error) {
+	evidence := entity.AttendanceEvidence{
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Accuracy:  req.Accuracy,
+		IPAddress: cast.ToString(ctx.Value(constant.ContextKeyIPAddress)),
+	}
+	if req.DeviceId != nil {
+		evidence.DeviceID = strings.TrimSpace(*req.DeviceId)
+	}
+
+	if (req.Latitude == nil) != (req.Longitude == nil) {
+		return evidence, httppkg.NewBadRequestError("latitude and longitude must be sent together")
+	}
+	if req.Latitude != nil && (*req.Latitude < -90 || *req.Latitude > 90) {
+		return evidence, httppkg.NewBadRequestError("latitude must be between -90 and 90")
+	}
+	if req.Longitude != nil && (*req.Longitude < -180 || *req.Longitude > 180) {
+		return evidence, httppkg.NewBadRequestError("longitude must be between -180 and 180")
+	}
+	if req.Accuracy != nil && *req.Accuracy < 0 {
+		return evidence, httppkg.NewBadRequestError("accuracy cannot be negative")
 	}
+	if len(evidence.DeviceID) > 255 {
+		return evidence, httppkg.NewBadRequestError("device ID cannot be longer than 255 characters")
+	}
+
+	return evidence, nil
+}
 
-	_, err = u.attendanceRepo.Create(ctx, attendance, nil)
+// checkAttendancePolicy holds a submission against the employee's attendance
+// policy and returns the violations to record with it. An employee without a
+// bound device is bound to the one sent.
+func (u *UsecaseImpl) checkAttendancePolicy(ctx context.Context, employee *entity.Employee, attendanceType string, evidence entity.AttendanceEvidence) ([]entity.AttendanceViolation, error) {
+	policy, err := u.locationRepo.AttendancePolicyFor(ctx, employee, nil)
 	if err != nil {
-		logger.Error(ctx, "failed to create attendance record", "employee_id", employeeID, "error", err)
-		return httppkg.NewInternalServerError("failed to create attendance record")
+		logger.Error(ctx, "failed to find attendance policy", "employee_id", employee.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find attendance policy")
 	}
 
-	return nil
+	if policy.DeviceID == "" && evidence.DeviceID != "" {
+		if _, err := u.employeeRepo.Updates(ctx, employee, entity.Employee{DeviceID: evidence.DeviceID}, nil); err != nil {
+			logger.Error(ctx, "failed to bind device", "employee_id", employee.ID, "error", err)
+			return nil, httppkg.NewInternalServerError("failed to bind device")
+		}
+		logger.Info(ctx, "device bound", "employee_id", employee.ID, "device_id", evidence.DeviceID)
+	}
+
+	violations := policy.Check(evidence)
+	for i := range violations {
+		violations[i].EmployeeID = employee.ID
+		violations[i].AttendanceType = attendanceType
+		violations[i].Latitude = evidence.Latitude
+		violations[i].Longitude = evidence.Longitude
+		violations[i].Accuracy = evidence.Accuracy
+		violations[i].IPAddress = evidence.IPAddress
+		violations[i].DeviceID = evidence.DeviceID
+		violations[i].Status = entity.ApprovalStatusPending
+	}
+	return violations, nil
 }
 
-func (u *UsecaseImpl) handleCheckOut(ctx context.Context, employeeID int64, currentTime, today string) error {
-	// Find today's attendance record that has check-in but no check-out
-	existingAttendances, err := u.attendanceRepo.FindByTemplate(ctx, &entity.Attendance{EmployeeID: employeeID}, nil)
+// handleCheckIn returns the attendance a check-in at now creates.
+func (u *UsecaseImpl) handleCheckIn(ctx context.Context, employeeID int64, now time.Time) (*entity.Attendance, error) {
+	latest, err := u.findLatestSession(ctx, employeeID, now)
 	if err != nil {
-		logger.Error(ctx, "failed to find existing attendances", "employee_id", employeeID, "error", err)
-		return httppkg.NewInternalServerError("failed to check existing attendance")
+		return nil, err
 	}
 
-	var todayAttendance *entity.Attendance
-	for _, attendance := range existingAttendances {
-		if attendance.ClockInTime != "" {
-			// Parse the clock-in time to check if it's today
-			clockInTime, parseErr := time.Parse(time.RFC3339, attendance.ClockInTime)
-			if parseErr == nil && clockInTime.Format("2006-01-02") == today {
-				todayAttendance = &attendance
-				break
-			}
+	if latest != nil {
+		// Check if there's already a check-in for today
+		if strings.HasPrefix(latest.ClockInTime, now.Format("2006-01-02")) {
+			return nil, httppkg.NewConflictError("already checked in today")
+		}
+		// A session from yesterday that crossed midnight must be closed first
+		if isOpenSession(latest, now) {
+			return nil, httppkg.NewConflictError("check out of the session that started yesterday first")
 		}
 	}
 
-	if todayAttendance == nil {
-		return httppkg.NewBadRequestError("cannot check out without checking in first")
+	return &entity.Attendance{
+		EmployeeID:  employeeID,
+		ClockInTime: now.Format(time.RFC3339),
+	}, nil
+}
+
+// handleCheckOut returns the open session a check-out at now closes, with
+// the check-out time set.
+func (u *UsecaseImpl) handleCheckOut(ctx context.Context, employeeID int64, now time.Time) (*entity.Attendance, error) {
+	// The session to close is the latest one, clocked in today or, for a
+	// shift that crossed midnight, yesterday
+	latest, err := u.findLatestSession(ctx, employeeID, now)
+	if err != nil {
+		return nil, err
 	}
 
-	if todayAttendance.ClockOutTime != "" {
-		return httppkg.NewConflictError("already checked out today")
+	if latest == nil {
+		return nil, httppkg.NewBadRequestError("cannot check out without checking in first")
+	}
+	if latest.ClockOutTime != "" {
+		if strings.HasPrefix(latest.ClockInTime, now.Format("2006-01-02")) {
+			return nil, httppkg.NewConflictError("already checked out today")
+		}
+		return nil, httppkg.NewBadRequestError("cannot check out without checking in first")
 	}
+	if !isOpenSession(latest, now) {
+		return nil, httppkg.NewBadRequestError(fmt.Sprintf("the check-in is more than %d hours ago, submit an attendance correction instead", int(maxSessionLength.Hours())))
+	}
+
+	latest.ClockOutTime = now.Format(time.RFC3339)
+	return latest, nil
+}
 
-	// Update the attendance record with check-out time
-	_, err = u.attendanceRepo.Updates(ctx, todayAttendance, entity.Attendance{ClockOutTime: currentTime}, nil)
+// maxSessionLength is the longest an attendance session can last. A
+// check-out later than this after the check-in is refused, so a forgotten
+// check-out is fixed by a correction instead of stretching into a session of
+// the next day.
+const maxSessionLength = 16 * time.Hour
+
+// findLatestSession returns the attendance the employee clocked in last,
+// yesterday or today in the zone of now, or nil if there is none.
+func (u *UsecaseImpl) findLatestSession(ctx context.Context, employeeID int64, now time.Time) (*entity.Attendance, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	query := repository.NewQuery().Where("employee_id", repository.OpEqual, employeeID)
+	repository.WhereRange(query, "clock_in_time", repository.ClockInRange(repository.DaysBetween(today.AddDate(0, 0, -1), today))).
+		OrderBy("clock_in_time", true).
+		Limit(1)
+
+	attendances, err := u.attendanceRepo.Find(ctx, query, nil)
 	if err != nil {
-		logger.Error(ctx, "failed to update attendance record", "attendance_id", todayAttendance.ID, "error", err)
-		return httppkg.NewInternalServerError("failed to update attendance record")
+		logger.Error(ctx, "failed to find existing attendances", "employee_id", employeeID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to check existing attendance")
+	}
+	if len(attendances) == 0 {
+		return nil, nil
 	}
+	return &attendances[0], nil
+}
 
-	return nil
+// isOpenSession reports whether an attendance is checked in but not out, and
+// can still be checked out at now.
+func isOpenSession(attendance *entity.Attendance, now time.Time) bool {
+	if attendance.ClockOutTime != "" {
+		return false
+	}
+	clockIn, err := time.Parse(time.RFC3339, attendance.ClockInTime)
+	if err != nil {
+		return false
+	}
+	return now.Sub(clockIn) <= maxSessionLength
 }
@@ -0,0 +1,197 @@
+package attendance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/spf13/cast"
+)
+
+// SubmitCorrection records a request to fill in or fix the check-in or
+// check-out of a past weekday. The attendance itself only changes once a
+// reviewer approves the correction.
+func (u *UsecaseImpl) SubmitCorrection(ctx context.Context, req v1.AttendanceCorrectionRequest) (*v1.AttendanceCorrectionSubmission, error) {
+	userIDStr := ctx.Value(constant.ContextKeyUserID)
+	if userIDStr == nil {
+		return nil, httppkg.NewUnauthorizedError("user not authenticated")
+	}
+
+	userID := cast.ToInt64(userIDStr)
+
+	employee, err := u.employeeRepo.FindOneByTemplate(ctx, &entity.Employee{UserID: userID}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find employee", "user_id", userID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find employee")
+	}
+	if employee == nil {
+		return nil, httppkg.NewNotFoundError("employee not found")
+	}
+
+	schedule, err := u.locationRepo.ScheduleFor(ctx, employee, repository.DaysBetween(req.Date.Time, req.Date.Time), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find work schedule", "employee_id", employee.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find work schedule")
+	}
+
+	day := req.Date.Format("2006-01-02")
+	if err := validateCorrectionRequest(req, schedule, day); err != nil {
+		return nil, err
+	}
+
+	existing, err := u.attendanceRepo.Find(ctx, todayQuery(employee.ID, day).Limit(1), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find existing attendances", "employee_id", employee.ID, "date", day, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to check existing attendance")
+	}
+
+	correction := &entity.AttendanceCorrection{
+		EmployeeID: employee.ID,
+		Date:       req.Date.Time,
+		Reason:     strings.TrimSpace(req.Reason),
+		Status:     entity.ApprovalStatusPending,
+	}
+	if req.ClockInTime != nil {
+		correction.ClockInTime = req.ClockInTime.In(schedule.Zone).Format(time.RFC3339)
+	}
+	if req.ClockOutTime != nil {
+		correction.ClockOutTime = req.ClockOutTime.In(schedule.Zone).Format(time.RFC3339)
+	}
+	if len(existing) > 0 {
+		correction.AttendanceID = &existing[0].ID
+		correction.OriginalClockInTime = existing[0].ClockInTime
+		correction.OriginalClockOutTime = existing[0].ClockOutTime
+	}
+
+	if err := validateCorrectedTimes(correction); err != nil {
+		return nil, err
+	}
+
+	pending, err := u.attendanceCorrectionRepo.Count(ctx, repository.NewQuery().
+		Where("employee_id", repository.OpEqual, employee.ID).
+		Where("date", repository.OpEqual, day).
+		Where("status", repository.OpEqual, entity.ApprovalStatusPending), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to count pending corrections", "employee_id", employee.ID, "date", day, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to check pending corrections")
+	}
+	if pending > 0 {
+		return nil, httppkg.NewConflictError("a correction for this date is already pending")
+	}
+
+	if _, err := u.attendanceCorrectionRepo.Create(ctx, correction, nil); err != nil {
+		logger.Error(ctx, "failed to create attendance correction", "employee_id", employee.ID, "date", day, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to submit attendance correction")
+	}
+
+	logger.Info(ctx, "attendance correction submitted",
+		"correction_id", correction.ID,
+		"employee_id", employee.ID,
+		"date", day)
+
+	response := toCorrectionSubmission(correction)
+	return &response, nil
+}
+
+// validateCorrectionRequest checks the request against the employee's
+// schedule: the date must be a past workday, the check-in must fall on it in
+// the schedule's timezone and the check-out on it or, for a session that
+// crossed midnight, on the next day.
+func validateCorrectionRequest(req v1.AttendanceCorrectionRequest, schedule *entity.WorkSchedule, day string) error {
+	if strings.TrimSpace(req.Reason) == "" {
+		return httppkg.NewBadRequestError("reason is required")
+	}
+	if req.ClockInTime == nil && req.ClockOutTime == nil {
+		return httppkg.NewBadRequestError("clock-in or clock-out time is required")
+	}
+	if day >= schedule.Date(time.Now()) {
+		return httppkg.NewBadRequestError("only past dates can be corrected")
+	}
+	if !schedule.IsWorkday(req.Date.Time) {
+		return httppkg.NewBadRequestError("attendance can only be corrected on workdays")
+	}
+	if req.ClockInTime != nil && schedule.Date(*req.ClockInTime) != day {
+		return httppkg.NewBadRequestError("corrected check-in must fall on the corrected date")
+	}
+	nextDay := req.Date.AddDate(0, 0, 1).Format("2006-01-02")
+	if req.ClockOutTime != nil {
+		if date := schedule.Date(*req.ClockOutTime); date != day && date != nextDay {
+			return httppkg.NewBadRequestError("corrected check-out must fall on the corrected date or the day after")
+		}
+	}
+	return nil
+}
+
+// validateCorrectedTimes checks that applying the correction leaves a
+// check-in, followed by the check-out if there is one within the longest
+// session allowed.
+func validateCorrectedTimes(correction *entity.AttendanceCorrection) error {
+	clockIn, clockOut := correction.CorrectedTimes()
+	if clockIn == "" {
+		return httppkg.NewBadRequestError("clock-in time is required when no attendance was recorded")
+	}
+	if clockOut == "" {
+		return nil
+	}
+
+	in, err := time.Parse(time.RFC3339, clockIn)
+	if err != nil {
+		return httppkg.NewBadRequestError("invalid clock-in time")
+	}
+	out, err := time.Parse(time.RFC3339, clockOut)
+	if err != nil {
+		return httppkg.NewBadRequestError("invalid clock-out time")
+	}
+	if !out.After(in) {
+		return httppkg.NewBadRequestError("clock-out time must be after clock-in time")
+	}
+	if out.Sub(in) > maxSessionLength {
+		return httppkg.NewBadRequestError(fmt.Sprintf("an attendance session cannot last more than %d hours", int(maxSessionLength.Hours())))
+	}
+	return nil
+}
+
+// todayQuery selects the attendance an employee clocked in on the given
+// day, formatted as YYYY-MM-DD.
+func todayQuery(employeeID int64, today string) *repository.Query {
+	day, _ := time.Parse("2006-01-02", today)
+	query := repository.NewQuery().Where("employee_id", repository.OpEqual, employeeID)
+	return repository.WhereRange(query, "clock_in_time", repository.ClockInRange(repository.DaysBetween(day, day)))
+}
+
+func toCorrectionSubmission(correction *entity.AttendanceCorrection) v1.AttendanceCorrectionSubmission {
+	submission := v1.AttendanceCorrectionSubmission{
+		Id:           correction.ID,
+		EmployeeId:   correction.EmployeeID,
+		AttendanceId: correction.AttendanceID,
+		Date:         openapi_types.Date{Time: correction.Date},
+		Reason:       correction.Reason,
+		Status:       v1.AttendanceCorrectionSubmissionStatus(correction.Status),
+		ReviewedBy:   correction.ReviewedBy,
+		ReviewedAt:   correction.ReviewedAt,
+	}
+	if correction.ClockInTime != "" {
+		submission.ClockInTime = &correction.ClockInTime
+	}
+	if correction.ClockOutTime != "" {
+		submission.ClockOutTime = &correction.ClockOutTime
+	}
+	if correction.OriginalClockInTime != "" {
+		submission.OriginalClockInTime = &correction.OriginalClockInTime
+	}
+	if correction.OriginalClockOutTime != "" {
+		submission.OriginalClockOutTime = &correction.OriginalClockOutTime
+	}
+	if correction.ReviewNote != "" {
+		submission.ReviewNote = &correction.ReviewNote
+	}
+	return submission
+}
@@ -9,20 +9,27 @@ import (
 
 //go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/attendance Usecase
 type Usecase interface {
-	SubmitAttendance(ctx context.Context, attendanceType v1.PostEmployeeAttendanceJSONBodyAttendanceType) error
+	SubmitAttendance(ctx context.Context, req v1.AttendanceRequest) (*v1.AttendanceResult, error)
+	SubmitCorrection(ctx context.Context, req v1.AttendanceCorrectionRequest) (*v1.AttendanceCorrectionSubmission, error)
 }
 
 type UsecaseImpl struct {
-	attendanceRepo repository.AttendanceRepository
-	employeeRepo   repository.EmployeeRepository
+	attendanceRepo           repository.AttendanceRepository
+	attendanceCorrectionRepo repository.AttendanceCorrectionRepository
+	employeeRepo             repository.EmployeeRepository
+	locationRepo             repository.LocationRepository
 }
 
 func NewUsecase(
 	attendanceRepo repository.AttendanceRepository,
+	attendanceCorrectionRepo repository.AttendanceCorrectionRepository,
 	employeeRepo repository.EmployeeRepository,
+	locationRepo repository.LocationRepository,
 ) Usecase {
 	return &UsecaseImpl{
-		attendanceRepo: attendanceRepo,
-		employeeRepo:   employeeRepo,
+		attendanceRepo:           attendanceRepo,
+		attendanceCorrectionRepo: attendanceCorrectionRepo,
+		employeeRepo:             employeeRepo,
+		locationRepo:             locationRepo,
 	}
 }
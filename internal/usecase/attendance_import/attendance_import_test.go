@@ -0,0 +1,271 @@
+package attendance_import_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/attendance_import"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAttendanceImportUsecase_ImportAttendance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+
+	usecase := attendance_import.NewUsecase(mockAttendanceRepo, mockEmployeeRepo, mockLocationRepo, mockAttendancePeriodRepo, mockPayrollRepo)
+
+	code := "0042"
+	employee := entity.Employee{Base: entity.Base{ID: 1}, Code: &code}
+	schedule := &entity.WorkSchedule{Zone: time.FixedZone("WIB", 7*60*60)}
+
+	expectEmployee := func() {
+		mockEmployeeRepo.EXPECT().Find(gomock.Any(), gomock.Any(), nil).Return([]entity.Employee{employee}, nil)
+		mockLocationRepo.EXPECT().ScheduleFor(gomock.Any(), &employee, gomock.Any(), nil).Return(schedule, nil)
+	}
+	expectRecorded := func(attendances ...entity.Attendance) {
+		mockAttendanceRepo.EXPECT().Find(gomock.Any(), gomock.Any(), nil).Return(attendances, nil)
+	}
+	expectNoPayroll := func() {
+		mockAttendancePeriodRepo.EXPECT().Find(gomock.Any(), gomock.Any(), nil).Return(nil, nil)
+	}
+
+	tests := []struct {
+		name           string
+		file           string
+		dryRun         bool
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+		expected       *v1.AttendanceImportResult
+	}{
+		{
+			name: "pairs punches into a new attendance",
+			file: "employee_code,timestamp,direction\n" +
+				"0042,2025-01-06 08:58:00,in\n" +
+				"0042,2025-01-06 09:01:00,in\n" +
+				"0042,2025-01-06 17:05:00,out\n",
+			setupMock: func() {
+				expectEmployee()
+				expectRecorded()
+				expectNoPayroll()
+				mockAttendanceRepo.EXPECT().
+					Import(gomock.Any(), []entity.Attendance{{
+						EmployeeID:   1,
+						ClockInTime:  "2025-01-06T08:58:00+07:00",
+						ClockOutTime: "2025-01-06T17:05:00+07:00",
+					}}, nil).
+					Return(nil)
+			},
+			expected: &v1.AttendanceImportResult{Applied: true, Rows: 3, Created: 1},
+		},
+		{
+			name: "night shift check-out on the next day",
+			file: "employee_code,timestamp,direction\n" +
+				"0042,2025-01-06T15:00:00Z,in\n" +
+				"0042,2025-01-07 06:00:00,out\n",
+			setupMock: func() {
+				expectEmployee()
+				expectRecorded()
+				expectNoPayroll()
+				mockAttendanceRepo.EXPECT().
+					Import(gomock.Any(), []entity.Attendance{{
+						EmployeeID:   1,
+						ClockInTime:  "2025-01-06T22:00:00+07:00",
+						ClockOutTime: "2025-01-07T06:00:00+07:00",
+					}}, nil).
+					Return(nil)
+			},
+			expected: &v1.AttendanceImportResult{Applied: true, Rows: 2, Created: 1},
+		},
+		{
+			name: "check-out extends attendance checked in through the app",
+			file: "employee_code,timestamp,direction\n" +
+				"0042,2025-01-06 17:30:00,out\n",
+			setupMock: func() {
+				expectEmployee()
+				expectRecorded(entity.Attendance{Base: entity.Base{ID: 9}, EmployeeID: 1, ClockInTime: "2025-01-06T09:00:00+07:00"})
+				expectNoPayroll()
+				mockAttendanceRepo.EXPECT().
+					Import(gomock.Any(), []entity.Attendance{{
+						Base:         entity.Base{ID: 9},
+						EmployeeID:   1,
+						ClockInTime:  "2025-01-06T09:00:00+07:00",
+						ClockOutTime: "2025-01-06T17:30:00+07:00",
+					}}, nil).
+					Return(nil)
+			},
+			expected: &v1.AttendanceImportResult{Applied: true, Rows: 1, Updated: 1},
+		},
+		{
+			name: "re-import changes nothing",
+			file: "employee_code,timestamp,direction\n" +
+				"0042,2025-01-06 08:58:00,in\n" +
+				"0042,2025-01-06 17:05:00,out\n",
+			setupMock: func() {
+				expectEmployee()
+				expectRecorded(entity.Attendance{Base: entity.Base{ID: 9}, EmployeeID: 1, ClockInTime: "2025-01-06T08:58:00+07:00", ClockOutTime: "2025-01-06T17:05:00+07:00"})
+			},
+			expected: &v1.AttendanceImportResult{Applied: true, Rows: 2, Unchanged: 1},
+		},
+		{
+			name: "corrected attendance is skipped",
+			file: "employee_code,timestamp,direction\n" +
+				"0042,2025-01-06 08:30:00,in\n",
+			setupMock: func() {
+				expectEmployee()
+				expectRecorded(entity.Attendance{Base: entity.Base{ID: 9}, EmployeeID: 1, ClockInTime: "2025-01-06T09:00:00+07:00", ClockOutTime: "2025-01-06T17:00:00+07:00", Corrected: true})
+			},
+			expected: &v1.AttendanceImportResult{Applied: true, Rows: 1, Skipped: 1},
+		},
+		{
+			name:   "dry run stores nothing",
+			dryRun: true,
+			file: "employee_code,timestamp,direction\n" +
+				"0042,2025-01-06 08:58:00,in\n",
+			setupMock: func() {
+				expectEmployee()
+				expectRecorded()
+				expectNoPayroll()
+			},
+			expected: &v1.AttendanceImportResult{DryRun: true, Rows: 1, Created: 1},
+		},
+		{
+			name: "row errors keep the file from being applied",
+			file: "employee_code,timestamp,direction\n" +
+				"0042,2025-01-06 08:58:00,in\n" +
+				"0099,2025-01-06 08:59:00,in\n" +
+				"0042,yesterday,out\n" +
+				"0042,2025-01-06 17:00:00,sideways\n" +
+				"0042,2025-01-07 17:00:00,out\n",
+			setupMock: func() {
+				expectEmployee()
+				expectRecorded()
+				expectNoPayroll()
+			},
+			expected: &v1.AttendanceImportResult{
+				Rows:    5,
+				Created: 1,
+				Errors: []v1.AttendanceImportError{
+					{Row: 3, Message: `no employee has code "0099"`},
+					{Row: 4, Message: `invalid timestamp "yesterday"`},
+					{Row: 5, Message: `unknown direction "sideways", expected in or out`},
+					{Row: 6, Message: "check-out without a check-in in the 16 hours before"},
+				},
+			},
+		},
+		{
+			name: "day in a finalized payroll",
+			file: "employee_code,timestamp,direction\n" +
+				"0042,2025-01-06 08:58:00,in\n",
+			setupMock: func() {
+				expectEmployee()
+				expectRecorded()
+				mockAttendancePeriodRepo.EXPECT().Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.AttendancePeriod{{
+						Base:      entity.Base{ID: 3},
+						StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						EndDate:   time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+					}}, nil)
+				mockPayrollRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: 3}, nil).
+					Return(&entity.Payroll{AttendancePeriodID: 3, Status: entity.PayrollStatusFinalized}, nil)
+			},
+			expected: &v1.AttendanceImportResult{
+				Rows:   1,
+				Errors: []v1.AttendanceImportError{{Row: 2, Message: "attendance on 2025-01-06 is in a finalized payroll"}},
+			},
+		},
+		{
+			name: "day in a draft payroll",
+			file: "employee_code,timestamp,direction\n" +
+				"0042,2025-01-06 08:58:00,in\n" +
+				"0042,2025-01-06 17:05:00,out\n",
+			setupMock: func() {
+				expectEmployee()
+				expectRecorded()
+				mockAttendancePeriodRepo.EXPECT().Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.AttendancePeriod{{
+						Base:      entity.Base{ID: 3},
+						StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						EndDate:   time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+					}}, nil)
+				mockPayrollRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: 3}, nil).
+					Return(&entity.Payroll{AttendancePeriodID: 3, Status: entity.PayrollStatusDraft}, nil)
+			},
+			expected: &v1.AttendanceImportResult{
+				Rows: 2,
+				Errors: []v1.AttendanceImportError{
+					{Row: 2, Message: "attendance on 2025-01-06 is in a draft payroll, remove it before importing"},
+					{Row: 3, Message: "attendance on 2025-01-06 is in a draft payroll, remove it before importing"},
+				},
+			},
+		},
+		{
+			name:           "missing column",
+			file:           "employee_code,timestamp\n0042,2025-01-06 08:58:00\n",
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:           "no punches",
+			file:           "employee_code,timestamp,direction\n",
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.ImportAttendance(context.Background(), strings.NewReader(tt.file), tt.dryRun)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+
+			if result.Applied != tt.expected.Applied || result.DryRun != tt.expected.DryRun || result.Rows != tt.expected.Rows {
+				t.Errorf("Expected applied %v, dry run %v, rows %d but got %v, %v, %d",
+					tt.expected.Applied, tt.expected.DryRun, tt.expected.Rows, result.Applied, result.DryRun, result.Rows)
+			}
+			if result.Created != tt.expected.Created || result.Updated != tt.expected.Updated ||
+				result.Unchanged != tt.expected.Unchanged || result.Skipped != tt.expected.Skipped {
+				t.Errorf("Expected created %d, updated %d, unchanged %d, skipped %d but got %d, %d, %d, %d",
+					tt.expected.Created, tt.expected.Updated, tt.expected.Unchanged, tt.expected.Skipped,
+					result.Created, result.Updated, result.Unchanged, result.Skipped)
+			}
+			if len(result.Errors) != len(tt.expected.Errors) {
+				t.Fatalf("Expected %d errors but got %v", len(tt.expected.Errors), result.Errors)
+			}
+			for i, expected := range tt.expected.Errors {
+				if result.Errors[i] != expected {
+					t.Errorf("Expected error %v but got %v", expected, result.Errors[i])
+				}
+			}
+		})
+	}
+}
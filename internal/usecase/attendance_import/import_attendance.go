@@ -0,0 +1,491 @@
+package attendance_import
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"github.com/asyauqi15/payslip-system/pkg/csvimport"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+// maxImportRows bounds one import.
+const maxImportRows = 10000
+
+// maxSessionLength is the longest a check-out can follow the check-in it
+// closes, as for attendance submitted in the app.
+const maxSessionLength = 16 * time.Hour
+
+// localTimestampLayouts are the timestamps without an offset that time clocks
+// export. They are read in the timezone of the employee's location.
+var localTimestampLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+}
+
+// punch is one row of a time-clock export.
+type punch struct {
+	row   int
+	code  string
+	at    time.Time
+	local bool // at holds the wall clock as UTC until placed in the employee's zone
+	in    bool
+}
+
+// session is the attendance of one employee on one day as the punches leave
+// it: from the first check-in to the last check-out.
+type session struct {
+	employeeID int64
+	day        string
+	recorded   *entity.Attendance // nil when the day has no attendance yet
+	clockIn    time.Time
+	clockOut   time.Time // zero while only checked in
+	rows       []int
+}
+
+// ImportAttendance reads punches from a time-clock export and pairs them into
+// attendance, one per employee and day. The punches are merged with the
+// attendance already recorded, widening it to the earliest check-in and the
+// latest check-out, so importing the same file again changes nothing.
+// Corrected attendance is left as it is. A file with errors is not applied,
+// and neither is a dry run; the result reports what the import does either
+// way.
+func (u *UsecaseImpl) ImportAttendance(ctx context.Context, file io.Reader, dryRun bool) (*v1.AttendanceImportResult, error) {
+	punches, rows, rowErrors, err := readPunches(file)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1.AttendanceImportResult{
+		DryRun: dryRun,
+		Rows:   rows,
+		Errors: rowErrors,
+	}
+
+	employees, byEmployee, err := u.resolveEmployees(ctx, punches, result)
+	if err != nil {
+		return nil, err
+	}
+
+	employeeIDs := make([]int64, 0, len(byEmployee))
+	for id := range byEmployee {
+		employeeIDs = append(employeeIDs, id)
+	}
+	sort.Slice(employeeIDs, func(i, j int) bool { return employeeIDs[i] < employeeIDs[j] })
+
+	var sessions []*session
+	for _, id := range employeeIDs {
+		paired, err := u.pairPunches(ctx, employees[id], byEmployee[id], result)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, paired...)
+	}
+
+	attendances, err := u.planAttendances(ctx, sessions, result)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result.Errors, func(i, j int) bool { return result.Errors[i].Row < result.Errors[j].Row })
+
+	if len(result.Errors) > 0 || dryRun {
+		logger.Info(ctx, "attendance import not applied",
+			"dry_run", dryRun,
+			"rows", result.Rows,
+			"errors", len(result.Errors))
+		return result, nil
+	}
+
+	if len(attendances) > 0 {
+		if err := u.attendanceRepo.Import(ctx, attendances, nil); err != nil {
+			logger.Error(ctx, "failed to import attendance", "attendances", len(attendances), "error", err)
+			return nil, httppkg.NewInternalServerError("failed to import attendance")
+		}
+	}
+	result.Applied = true
+
+	logger.Info(ctx, "attendance imported",
+		"rows", result.Rows,
+		"created", result.Created,
+		"updated", result.Updated,
+		"unchanged", result.Unchanged,
+		"skipped", result.Skipped)
+
+	return result, nil
+}
+
+// readPunches parses an export with the employee_code, timestamp and
+// direction columns. Rows that cannot be read are returned as errors with the
+// line they start on; a file that cannot be read at all is refused.
+func readPunches(file io.Reader) ([]punch, int, []v1.AttendanceImportError, error) {
+	rows, err := csvimport.Read(file, []string{"employee_code", "timestamp", "direction"}, maxImportRows)
+	if err != nil {
+		return nil, 0, nil, httppkg.NewBadRequestError(err.Error())
+	}
+
+	var punches []punch
+	var rowErrors []v1.AttendanceImportError
+	for _, row := range rows {
+		p, message := parsePunch(row.Get("employee_code"), row.Get("timestamp"), row.Get("direction"))
+		if message != "" {
+			rowErrors = append(rowErrors, v1.AttendanceImportError{Row: row.Line, Message: message})
+			continue
+		}
+		p.row = row.Line
+		punches = append(punches, p)
+	}
+
+	return punches, len(rows), rowErrors, nil
+}
+
+// parsePunch reads the fields of one row, or describes why it cannot.
+func parsePunch(code, timestamp, direction string) (punch, string) {
+	p := punch{code: code}
+	if code == "" {
+		return p, "employee code is required"
+	}
+
+	switch strings.ToLower(direction) {
+	case "in", "i", "check_in":
+		p.in = true
+	case "out", "o", "check_out":
+		p.in = false
+	default:
+		return p, fmt.Sprintf("unknown direction %q, expected in or out", direction)
+	}
+
+	if at, err := time.Parse(time.RFC3339, timestamp); err == nil {
+		p.at = at
+		return p, ""
+	}
+	for _, layout := range localTimestampLayouts {
+		if at, err := time.Parse(layout, timestamp); err == nil {
+			p.at = at
+			p.local = true
+			return p, ""
+		}
+	}
+	return p, fmt.Sprintf("invalid timestamp %q", timestamp)
+}
+
+// resolveEmployees finds the employees the punches are for by their code and
+// groups the punches per employee. Punches with an unknown code are reported.
+func (u *UsecaseImpl) resolveEmployees(ctx context.Context, punches []punch, result *v1.AttendanceImportResult) (map[int64]*entity.Employee, map[int64][]punch, error) {
+	employees := make(map[int64]*entity.Employee)
+	byEmployee := make(map[int64][]punch)
+	if len(punches) == 0 {
+		return employees, byEmployee, nil
+	}
+
+	codeSet := make(map[string]bool)
+	for _, p := range punches {
+		codeSet[p.code] = true
+	}
+	codes := make([]string, 0, len(codeSet))
+	for code := range codeSet {
+		codes = append(codes, code)
+	}
+
+	found, err := u.employeeRepo.Find(ctx, repository.NewQuery().Where("code", repository.OpIn, codes), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find employees", "codes", len(codes), "error", err)
+		return nil, nil, httppkg.NewInternalServerError("failed to find employees")
+	}
+
+	byCode := make(map[string]*entity.Employee, len(found))
+	for i := range found {
+		if found[i].Code != nil {
+			byCode[*found[i].Code] = &found[i]
+		}
+	}
+
+	for _, p := range punches {
+		employee, ok := byCode[p.code]
+		if !ok {
+			result.Errors = append(result.Errors, v1.AttendanceImportError{
+				Row:     p.row,
+				Message: fmt.Sprintf("no employee has code %q", p.code),
+			})
+			continue
+		}
+		employees[employee.ID] = employee
+		byEmployee[employee.ID] = append(byEmployee[employee.ID], p)
+	}
+
+	return employees, byEmployee, nil
+}
+
+// pairPunches turns the punches of one employee into sessions. A check-in
+// opens the session of its day, or moves an existing one earlier. A check-out
+// closes the latest session that started at most maxSessionLength before it,
+// on its day or, for a shift that crossed midnight, the day before.
+func (u *UsecaseImpl) pairPunches(ctx context.Context, employee *entity.Employee, punches []punch, result *v1.AttendanceImportResult) ([]*session, error) {
+	first, last := punches[0].at, punches[0].at
+	for _, p := range punches {
+		if p.at.Before(first) {
+			first = p.at
+		}
+		if p.at.After(last) {
+			last = p.at
+		}
+	}
+
+	// The span is widened by a day so it holds every punch in any timezone
+	schedule, err := u.locationRepo.ScheduleFor(ctx, employee, repository.DaysBetween(utcDay(first).AddDate(0, 0, -1), utcDay(last).AddDate(0, 0, 1)), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find work schedule", "employee_id", employee.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find work schedule")
+	}
+
+	for i := range punches {
+		if punches[i].local {
+			at := punches[i].at
+			punches[i].at = time.Date(at.Year(), at.Month(), at.Day(), at.Hour(), at.Minute(), at.Second(), 0, schedule.Zone)
+		} else {
+			punches[i].at = punches[i].at.In(schedule.Zone)
+		}
+	}
+	sort.SliceStable(punches, func(i, j int) bool { return punches[i].at.Before(punches[j].at) })
+
+	recorded, err := u.findRecorded(ctx, employee.ID, punches[0].at, punches[len(punches)-1].at)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make(map[string]*session)
+	var ordered []*session
+	sessionOn := func(day string) (*session, error) {
+		if s, ok := sessions[day]; ok {
+			return s, nil
+		}
+		attendance, ok := recorded[day]
+		if !ok {
+			return nil, nil
+		}
+
+		var err error
+		s := &session{employeeID: employee.ID, day: day, recorded: attendance}
+		if s.clockIn, err = time.Parse(time.RFC3339, attendance.ClockInTime); err != nil {
+			logger.Error(ctx, "invalid clock-in time", "attendance_id", attendance.ID, "error", err)
+			return nil, httppkg.NewInternalServerError("failed to read attendance")
+		}
+		if attendance.ClockOutTime != "" {
+			if s.clockOut, err = time.Parse(time.RFC3339, attendance.ClockOutTime); err != nil {
+				logger.Error(ctx, "invalid clock-out time", "attendance_id", attendance.ID, "error", err)
+				return nil, httppkg.NewInternalServerError("failed to read attendance")
+			}
+		}
+		sessions[day] = s
+		ordered = append(ordered, s)
+		return s, nil
+	}
+
+	for _, p := range punches {
+		day := schedule.Date(p.at)
+
+		if p.in {
+			s, err := sessionOn(day)
+			if err != nil {
+				return nil, err
+			}
+			if s == nil {
+				s = &session{employeeID: employee.ID, day: day, clockIn: p.at}
+				sessions[day] = s
+				ordered = append(ordered, s)
+			} else if p.at.Before(s.clockIn) {
+				s.clockIn = p.at
+			}
+			s.rows = append(s.rows, p.row)
+			continue
+		}
+
+		var closes *session
+		for _, d := range []string{day, schedule.Date(p.at.AddDate(0, 0, -1))} {
+			s, err := sessionOn(d)
+			if err != nil {
+				return nil, err
+			}
+			if s != nil && !p.at.Before(s.clockIn) && p.at.Sub(s.clockIn) <= maxSessionLength {
+				closes = s
+				break
+			}
+		}
+		if closes == nil {
+			result.Errors = append(result.Errors, v1.AttendanceImportError{
+				Row:     p.row,
+				Message: fmt.Sprintf("check-out without a check-in in the %d hours before", int(maxSessionLength.Hours())),
+			})
+			continue
+		}
+		if p.at.After(closes.clockOut) {
+			closes.clockOut = p.at
+		}
+		closes.rows = append(closes.rows, p.row)
+	}
+
+	// Sessions only looked at to place a check-out are not part of the import
+	touched := ordered[:0]
+	for _, s := range ordered {
+		if len(s.rows) > 0 {
+			touched = append(touched, s)
+		}
+	}
+	return touched, nil
+}
+
+// findRecorded loads the attendance an employee clocked in from the day
+// before the first punch to the day of the last, keyed by day.
+func (u *UsecaseImpl) findRecorded(ctx context.Context, employeeID int64, first, last time.Time) (map[string]*entity.Attendance, error) {
+	query := repository.NewQuery().Where("employee_id", repository.OpEqual, employeeID)
+	repository.WhereRange(query, "clock_in_time", repository.ClockInRange(repository.DaysBetween(localDay(first).AddDate(0, 0, -1), localDay(last))))
+
+	attendances, err := u.attendanceRepo.Find(ctx, query, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find existing attendances", "employee_id", employeeID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find existing attendance")
+	}
+
+	recorded := make(map[string]*entity.Attendance, len(attendances))
+	for i := range attendances {
+		if len(attendances[i].ClockInTime) >= len("2006-01-02") {
+			recorded[attendances[i].ClockInTime[:len("2006-01-02")]] = &attendances[i]
+		}
+	}
+	return recorded, nil
+}
+
+// planAttendances works out the attendance each session creates or updates
+// and counts the outcome. Sessions on a day in a period that already has a
+// payroll are reported instead: a finalized payroll's payslips already count
+// the day, and a draft payroll has to be removed and run again to count it.
+func (u *UsecaseImpl) planAttendances(ctx context.Context, sessions []*session, result *v1.AttendanceImportResult) ([]entity.Attendance, error) {
+	var changed []*session
+	for _, s := range sessions {
+		switch {
+		case s.recorded == nil:
+			changed = append(changed, s)
+		case s.clockIn.Equal(recordedTime(s.recorded.ClockInTime)) && s.clockOut.Equal(recordedTime(s.recorded.ClockOutTime)):
+			result.Unchanged++
+		case s.recorded.Corrected:
+			result.Skipped++
+		default:
+			changed = append(changed, s)
+		}
+	}
+	if len(changed) == 0 {
+		return nil, nil
+	}
+
+	payrolled, err := u.payrolledPeriods(ctx, changed)
+	if err != nil {
+		return nil, err
+	}
+
+	attendances := make([]entity.Attendance, 0, len(changed))
+	for _, s := range changed {
+		day, _ := time.Parse("2006-01-02", s.day)
+		if status := payrollStatusOn(payrolled, day); status != "" {
+			message := fmt.Sprintf("attendance on %s is in a finalized payroll", s.day)
+			if status == entity.PayrollStatusDraft {
+				message = fmt.Sprintf("attendance on %s is in a draft payroll, remove it before importing", s.day)
+			}
+			for _, row := range s.rows {
+				result.Errors = append(result.Errors, v1.AttendanceImportError{Row: row, Message: message})
+			}
+			continue
+		}
+
+		attendance := entity.Attendance{EmployeeID: s.employeeID}
+		if s.recorded != nil {
+			attendance = *s.recorded
+			result.Updated++
+		} else {
+			result.Created++
+		}
+		attendance.ClockInTime = s.clockIn.Format(time.RFC3339)
+		if !s.clockOut.IsZero() {
+			attendance.ClockOutTime = s.clockOut.Format(time.RFC3339)
+		}
+		attendances = append(attendances, attendance)
+	}
+
+	return attendances, nil
+}
+
+// payrolledPeriod is an attendance period with the status of its payroll.
+type payrolledPeriod struct {
+	period entity.AttendancePeriod
+	status string
+}
+
+// payrolledPeriods returns the attendance periods overlapping the days of
+// the sessions that have a payroll, draft or finalized.
+func (u *UsecaseImpl) payrolledPeriods(ctx context.Context, sessions []*session) ([]payrolledPeriod, error) {
+	first, last := sessions[0].day, sessions[0].day
+	for _, s := range sessions {
+		first = min(first, s.day)
+		last = max(last, s.day)
+	}
+	firstDay, _ := time.Parse("2006-01-02", first)
+	lastDay, _ := time.Parse("2006-01-02", last)
+
+	query := repository.NewQuery().
+		Where("start_date", repository.OpLessOrEqual, lastDay).
+		Where("end_date", repository.OpGreaterOrEqual, firstDay)
+	periods, err := u.attendancePeriodRepo.Find(ctx, query, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find attendance periods", "from", first, "to", last, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find attendance periods")
+	}
+
+	var payrolled []payrolledPeriod
+	for _, period := range periods {
+		payroll, err := u.payrollRepo.FindOneByTemplate(ctx, &entity.Payroll{AttendancePeriodID: period.ID}, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to find payroll", "attendance_period_id", period.ID, "error", err)
+			return nil, httppkg.NewInternalServerError("failed to find payroll")
+		}
+		if payroll != nil {
+			payrolled = append(payrolled, payrolledPeriod{period: period, status: payroll.Status})
+		}
+	}
+	return payrolled, nil
+}
+
+// payrollStatusOn returns the status of the payroll whose period contains a
+// day, or "" when there is none.
+func payrollStatusOn(periods []payrolledPeriod, day time.Time) string {
+	for _, p := range periods {
+		if !day.Before(utcDay(p.period.StartDate)) && !day.After(utcDay(p.period.EndDate)) {
+			return p.status
+		}
+	}
+	return ""
+}
+
+// recordedTime parses a stored clock time, the zero time when it is unset.
+func recordedTime(value string) time.Time {
+	t, _ := time.Parse(time.RFC3339, value)
+	return t
+}
+
+// utcDay is the midnight, in UTC, of the day t falls on in UTC.
+func utcDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// localDay is the midnight, in UTC, of the day t falls on in its own zone.
+func localDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,38 @@
+package attendance_import
+
+import (
+	"context"
+	"io"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+//go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/attendance_import Usecase
+type Usecase interface {
+	ImportAttendance(ctx context.Context, file io.Reader, dryRun bool) (*v1.AttendanceImportResult, error)
+}
+
+type UsecaseImpl struct {
+	attendanceRepo       repository.AttendanceRepository
+	employeeRepo         repository.EmployeeRepository
+	locationRepo         repository.LocationRepository
+	attendancePeriodRepo repository.AttendancePeriodRepository
+	payrollRepo          repository.PayrollRepository
+}
+
+func NewUsecase(
+	attendanceRepo repository.AttendanceRepository,
+	employeeRepo repository.EmployeeRepository,
+	locationRepo repository.LocationRepository,
+	attendancePeriodRepo repository.AttendancePeriodRepository,
+	payrollRepo repository.PayrollRepository,
+) Usecase {
+	return &UsecaseImpl{
+		attendanceRepo:       attendanceRepo,
+		employeeRepo:         employeeRepo,
+		locationRepo:         locationRepo,
+		attendancePeriodRepo: attendancePeriodRepo,
+		payrollRepo:          payrollRepo,
+	}
+}
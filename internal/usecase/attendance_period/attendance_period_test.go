@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
 	"github.com/asyauqi15/payslip-system/internal/repository/mock"
 	"github.com/asyauqi15/payslip-system/internal/usecase/attendance_period"
 	"go.uber.org/mock/gomock"
@@ -17,8 +18,10 @@ func TestAttendancePeriodUsecase_CreateAttendancePeriod(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
 
-	usecase := attendance_period.NewUsecase(mockAttendancePeriodRepo)
+	usecase := attendance_period.NewUsecase(mockAttendancePeriodRepo, mockAttendanceRepo, mockPayrollRepo)
 
 	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	endDate := time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC)
@@ -139,3 +142,98 @@ func TestAttendancePeriodUsecase_CreateAttendancePeriod(t *testing.T) {
 		})
 	}
 }
+
+func TestAttendancePeriodUsecase_DeleteAttendancePeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+
+	usecase := attendance_period.NewUsecase(mockAttendancePeriodRepo, mockAttendanceRepo, mockPayrollRepo)
+
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	period := &entity.AttendancePeriod{Base: entity.Base{ID: 1}, StartDate: startDate, EndDate: endDate}
+	attendanceQuery := repository.WhereRange(repository.NewQuery(), "clock_in_time", repository.ClockInRange(repository.DaysBetween(startDate, endDate)))
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		expectError bool
+	}{
+		{
+			name: "empty period is deleted",
+			setupMock: func() {
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(period, nil)
+				mockPayrollRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: 1}, nil).
+					Return(nil, nil)
+				mockAttendanceRepo.EXPECT().
+					Count(gomock.Any(), attendanceQuery, nil).
+					Return(int64(0), nil)
+				mockAttendancePeriodRepo.EXPECT().
+					Delete(gomock.Any(), period, nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "period with a payroll is kept",
+			setupMock: func() {
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(period, nil)
+				mockPayrollRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: 1}, nil).
+					Return(&entity.Payroll{Base: entity.Base{ID: 2}, AttendancePeriodID: 1}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "period with recorded attendance is kept",
+			setupMock: func() {
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(period, nil)
+				mockPayrollRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: 1}, nil).
+					Return(nil, nil)
+				mockAttendanceRepo.EXPECT().
+					Count(gomock.Any(), attendanceQuery, nil).
+					Return(int64(12), nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "period not found",
+			setupMock: func() {
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.DeleteAttendancePeriod(context.Background(), 1)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package attendance_period
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"gorm.io/gorm"
+)
+
+// DeleteAttendancePeriod removes a period that is still empty: it has no
+// payroll and no attendance was recorded in it.
+func (u *UsecaseImpl) DeleteAttendancePeriod(ctx context.Context, attendancePeriodID int64) error {
+	attendancePeriod, err := u.attendancePeriodRepo.FindByID(ctx, uint(attendancePeriodID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find attendance period", "attendance_period_id", attendancePeriodID, "error", err)
+		return httppkg.NewInternalServerError("failed to find attendance period")
+	}
+	if attendancePeriod == nil {
+		return httppkg.NewNotFoundError("attendance period not found")
+	}
+
+	payroll, err := u.payrollRepo.FindOneByTemplate(ctx, &entity.Payroll{AttendancePeriodID: attendancePeriodID}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find payroll", "attendance_period_id", attendancePeriodID, "error", err)
+		return httppkg.NewInternalServerError("failed to find payroll")
+	}
+	if payroll != nil {
+		return httppkg.NewConflictError("attendance period has a payroll")
+	}
+
+	dates := repository.DaysBetween(attendancePeriod.StartDate, attendancePeriod.EndDate)
+	query := repository.WhereRange(repository.NewQuery(), "clock_in_time", repository.ClockInRange(dates))
+	attendanceCount, err := u.attendanceRepo.Count(ctx, query, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to count attendances", "attendance_period_id", attendancePeriodID, "error", err)
+		return httppkg.NewInternalServerError("failed to count attendances")
+	}
+	if attendanceCount > 0 {
+		return httppkg.NewConflictError("attendance period has recorded attendance")
+	}
+
+	if err := u.attendancePeriodRepo.Delete(ctx, attendancePeriod, nil); err != nil {
+		logger.Error(ctx, "failed to delete attendance period", "attendance_period_id", attendancePeriodID, "error", err)
+		return httppkg.NewInternalServerError("failed to delete attendance period")
+	}
+
+	logger.Info(ctx, "attendance period deleted", "attendance_period_id", attendancePeriodID)
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 type MockUsecase struct {
 	ctrl     *gomock.Controller
 	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
 }
 
 // MockUsecaseMockRecorder is the mock recorder for MockUsecase.
@@ -42,16 +43,30 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 }
 
 // CreateAttendancePeriod mocks base method.
-func (m *MockUsecase) CreateAttendancePeriod(arg0 context.Context, arg1, arg2 time.Time) (*entity.AttendancePeriod, error) {
+func (m *MockUsecase) CreateAttendancePeriod(ctx context.Context, startDate, endDate time.Time) (*entity.AttendancePeriod, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAttendancePeriod", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "CreateAttendancePeriod", ctx, startDate, endDate)
 	ret0, _ := ret[0].(*entity.AttendancePeriod)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAttendancePeriod indicates an expected call of CreateAttendancePeriod.
-func (mr *MockUsecaseMockRecorder) CreateAttendancePeriod(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) CreateAttendancePeriod(ctx, startDate, endDate any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttendancePeriod", reflect.TypeOf((*MockUsecase)(nil).CreateAttendancePeriod), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttendancePeriod", reflect.TypeOf((*MockUsecase)(nil).CreateAttendancePeriod), ctx, startDate, endDate)
+}
+
+// DeleteAttendancePeriod mocks base method.
+func (m *MockUsecase) DeleteAttendancePeriod(ctx context.Context, attendancePeriodID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttendancePeriod", ctx, attendancePeriodID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttendancePeriod indicates an expected call of DeleteAttendancePeriod.
+func (mr *MockUsecaseMockRecorder) DeleteAttendancePeriod(ctx, attendancePeriodID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttendancePeriod", reflect.TypeOf((*MockUsecase)(nil).DeleteAttendancePeriod), ctx, attendancePeriodID)
 }
@@ -11,14 +11,23 @@ import (
 //go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/attendance_period Usecase
 type Usecase interface {
 	CreateAttendancePeriod(ctx context.Context, startDate, endDate time.Time) (*entity.AttendancePeriod, error)
+	DeleteAttendancePeriod(ctx context.Context, attendancePeriodID int64) error
 }
 
 type UsecaseImpl struct {
 	attendancePeriodRepo repository.AttendancePeriodRepository
+	attendanceRepo       repository.AttendanceRepository
+	payrollRepo          repository.PayrollRepository
 }
 
-func NewUsecase(attendancePeriodRepo repository.AttendancePeriodRepository) Usecase {
+func NewUsecase(
+	attendancePeriodRepo repository.AttendancePeriodRepository,
+	attendanceRepo repository.AttendanceRepository,
+	payrollRepo repository.PayrollRepository,
+) Usecase {
 	return &UsecaseImpl{
 		attendancePeriodRepo: attendancePeriodRepo,
+		attendanceRepo:       attendanceRepo,
+		payrollRepo:          payrollRepo,
 	}
 }
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/asyauqi15/payslip-system/internal/entity"
 	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
@@ -9,40 +10,87 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// Result carries either a token pair or, for users with two-factor
+// authentication enabled, the challenge token the second factor has to be
+// submitted with.
 type Result struct {
-	AccessToken  string
-	RefreshToken string
+	AccessToken                 string
+	RefreshToken                string
+	PasswordChangeRequired      bool
+	TwoFactorEnrollmentRequired bool
+	ChallengeToken              string
+	ChallengeExpiresAt          time.Time
 }
 
 func (u *UsecaseImpl) Auth(ctx context.Context, username string, password string) (*Result, error) {
+	now := time.Now()
+	ip := clientIP(ctx)
+
+	wait, err := u.loginWait(ctx, username, ip, now)
+	if err != nil {
+		return nil, err
+	}
+	if wait > 0 {
+		u.auditLoginFailure(ctx, username, ip, nil, loginFailureThrottled, nil)
+		return nil, httppkg.NewTooManyRequestsError("too many failed login attempts, try again later", wait)
+	}
+
 	user, err := u.userRepo.FindOneByTemplate(ctx, &entity.User{Username: username}, nil)
 	if err != nil {
 		logger.Error(ctx, "failed to find user by username", "username", username, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find user")
+	}
+
+	// Unknown usernames, and users provisioned through single sign-on that
+	// have no password, get the same bcrypt cost and response as wrong
+	// passwords so the endpoint cannot be used to enumerate users.
+	passwordHash := dummyPasswordHash
+	if user != nil && user.PasswordHash != "" {
+		passwordHash = user.PasswordHash
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password))
+	if err != nil || user == nil || user.PasswordHash == "" {
+		u.recordLoginFailure(ctx, username, ip, user, loginFailureInvalidCredentials, now)
+		return nil, httppkg.NewUnauthorizedError("username or password is incorrect")
+	}
+
+	twoFactor, err := u.findTwoFactor(ctx, user.ID)
+	if err != nil {
 		return nil, err
 	}
-	if user == nil {
-		return nil, httppkg.NewNotFoundError("username or password is incorrect")
+
+	// The username's failure counter is kept until the second factor is
+	// verified so a known password does not reset it
+	if twoFactor.Enabled() {
+		return u.startChallenge(ctx, user, now)
+	}
+
+	if err := u.loginThrottleRepo.Reset(ctx, entity.LoginThrottleScopeUsername, username, nil); err != nil {
+		logger.Error(ctx, "failed to reset login throttle", "user_id", user.ID, "error", err)
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password))
+	enrollmentRequired, err := u.enrollmentRequired(ctx, user, twoFactor)
 	if err != nil {
-		return nil, httppkg.NewUnauthorizedError("username or password is incorrect")
+		return nil, err
 	}
 
-	accessToken, _, err := u.jwtAuth.GenerateAccessToken(ctx, user)
+	return u.startSession(ctx, user, enrollmentRequired)
+}
+
+// startSession issues the tokens of a new login, which starts a new token
+// family.
+func (u *UsecaseImpl) startSession(ctx context.Context, user *entity.User, enrollmentRequired bool) (*Result, error) {
+	familyID, err := newTokenID()
 	if err != nil {
-		logger.Error(ctx, "failed to generate access token", "user_id", user.ID, "error", err)
+		logger.Error(ctx, "failed to generate token family", "user_id", user.ID, "error", err)
 		return nil, err
 	}
 
-	refreshToken, _, err := u.jwtAuth.GenerateRefreshToken(ctx, user)
+	result, _, err := u.issueTokens(ctx, user, familyID, enrollmentRequired)
 	if err != nil {
-		logger.Error(ctx, "failed to generate refresh token", "user_id", user.ID, "error", err)
 		return nil, err
 	}
 
-	return &Result{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}, nil
+	return result, nil
 }
@@ -20,13 +20,23 @@ func TestAuthUsecase_Auth(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserRepo := authmock.NewMockUserRepository(ctrl)
+	mockRolePermissionRepo := authmock.NewMockRolePermissionRepository(ctrl)
+	mockRefreshTokenRepo := authmock.NewMockRefreshTokenRepository(ctrl)
+	mockLoginThrottleRepo := authmock.NewMockLoginThrottleRepository(ctrl)
+	mockAuditLogRepo := authmock.NewMockAuditLogRepository(ctrl)
+	mockRoleRepo := authmock.NewMockRoleRepository(ctrl)
+	mockUserTwoFactorRepo := authmock.NewMockUserTwoFactorRepository(ctrl)
+	mockRecoveryCodeRepo := authmock.NewMockTwoFactorRecoveryCodeRepository(ctrl)
+	mockLoginChallengeRepo := authmock.NewMockLoginChallengeRepository(ctrl)
+	mockUserIdentityRepo := authmock.NewMockUserIdentityRepository(ctrl)
+	mockOIDCLoginStateRepo := authmock.NewMockOIDCLoginStateRepository(ctrl)
 
 	// Create a properly initialized JWT auth for testing using the constructor
 	testConfig := internal.HTTPServerConfig{
-		AccessTokenSecretEncoded:  "dGVzdC1hY2Nlc3Mtc2VjcmV0LWtleS1mb3ItdGVzdGluZy1wdXJwb3Nlcw==", // base64 encoded test secret
-		RefreshTokenSecretEncoded: "dGVzdC1yZWZyZXNoLXNlY3JldC1rZXktZm9yLXRlc3RpbmctcHVycG9zZXM=", // base64 encoded test secret
-		AccessTokenDuration:       time.Hour,                                                      // 1 hour
-		RefreshTokenDuration:      time.Hour * 24,                                                 // 24 hours
+		AccessTokenSecret:    []byte("test-access-secret-key-for-testing-purposes"),
+		RefreshTokenSecret:   []byte("test-refresh-secret-key-for-testing-purposes"),
+		AccessTokenDuration:  time.Hour,      // 1 hour
+		RefreshTokenDuration: time.Hour * 24, // 24 hours
 	}
 
 	jwtAuth, err := jwtauth_pkg.NewJWTAuthentication(testConfig)
@@ -34,12 +44,26 @@ func TestAuthUsecase_Auth(t *testing.T) {
 		t.Fatalf("Failed to create JWT auth: %v", err)
 	}
 
-	usecase := auth.NewUsecase(mockUserRepo, jwtAuth)
+	usecase := auth.NewUsecase(mockUserRepo, mockRolePermissionRepo, mockRefreshTokenRepo, mockLoginThrottleRepo, mockAuditLogRepo, mockRoleRepo, mockUserTwoFactorRepo, mockRecoveryCodeRepo, mockLoginChallengeRepo, mockUserIdentityRepo, mockOIDCLoginStateRepo, jwtAuth, nil, testThrottleConfig, testTwoFactorConfig, internal.OIDCConfig{})
 
 	// Create a test password hash
 	testPassword := "password123"
 	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.DefaultCost)
 
+	expectNoThrottle := func(username string) {
+		mockLoginThrottleRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.LoginThrottle{Scope: entity.LoginThrottleScopeUsername, Identifier: username}, nil).
+			Return(nil, nil)
+	}
+	expectFailureRecorded := func(username string) {
+		mockLoginThrottleRepo.EXPECT().
+			RecordFailure(gomock.Any(), entity.LoginThrottleScopeUsername, username, gomock.Any(), gomock.Any(), nil).
+			Return(&entity.LoginThrottle{Scope: entity.LoginThrottleScopeUsername, Identifier: username, FailedAttempts: 1}, nil)
+		mockAuditLogRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), nil).
+			Return(nil)
+	}
+
 	tests := []struct {
 		name        string
 		email       string
@@ -58,9 +82,25 @@ func TestAuthUsecase_Auth(t *testing.T) {
 					PasswordHash: string(hashedPassword),
 					Role:         entity.UserRoleAdmin,
 				}
+				expectNoThrottle("admin@example.com")
 				mockUserRepo.EXPECT().
 					FindOneByTemplate(gomock.Any(), &entity.User{Username: "admin@example.com"}, nil).
 					Return(user, nil)
+				mockLoginThrottleRepo.EXPECT().
+					Reset(gomock.Any(), entity.LoginThrottleScopeUsername, "admin@example.com", nil).
+					Return(nil)
+				expectNoTwoFactor(mockUserTwoFactorRepo, mockRoleRepo, 1, entity.UserRoleAdmin)
+				mockRolePermissionRepo.EXPECT().
+					FindPermissionsByRoleName(gomock.Any(), entity.UserRoleAdmin, nil).
+					Return([]string{entity.PermissionPayrollRun, entity.PermissionRoleManage}, nil)
+				mockRefreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, token *entity.RefreshToken, _ *gorm.DB) (*entity.RefreshToken, error) {
+						if token.UserID != 1 || token.JTI == "" || token.FamilyID == "" || token.TokenHash == "" {
+							t.Errorf("Unexpected refresh token record: %+v", token)
+						}
+						return token, nil
+					})
 			},
 			expectError: false, // Changed back to false since JWT auth is now properly initialized
 		},
@@ -69,9 +109,11 @@ func TestAuthUsecase_Auth(t *testing.T) {
 			email:    "nonexistent@example.com",
 			password: testPassword,
 			setupMock: func() {
+				expectNoThrottle("nonexistent@example.com")
 				mockUserRepo.EXPECT().
 					FindOneByTemplate(gomock.Any(), &entity.User{Username: "nonexistent@example.com"}, nil).
-					Return(nil, gorm.ErrRecordNotFound)
+					Return(nil, nil)
+				expectFailureRecorded("nonexistent@example.com")
 			},
 			expectError: true,
 		},
@@ -86,9 +128,49 @@ func TestAuthUsecase_Auth(t *testing.T) {
 					PasswordHash: string(hashedPassword),
 					Role:         entity.UserRoleAdmin,
 				}
+				expectNoThrottle("admin@example.com")
 				mockUserRepo.EXPECT().
 					FindOneByTemplate(gomock.Any(), &entity.User{Username: "admin@example.com"}, nil).
 					Return(user, nil)
+				expectFailureRecorded("admin@example.com")
+			},
+			expectError: true,
+		},
+		{
+			name:     "user provisioned through single sign-on has no password",
+			email:    "jane@example.com",
+			password: "",
+			setupMock: func() {
+				expectNoThrottle("jane@example.com")
+				mockUserRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.User{Username: "jane@example.com"}, nil).
+					Return(&entity.User{Base: entity.Base{ID: 9}, Username: "jane@example.com", Role: entity.UserRoleDefault}, nil)
+				expectFailureRecorded("jane@example.com")
+			},
+			expectError: true,
+		},
+		{
+			name:     "role permissions lookup error",
+			email:    "admin@example.com",
+			password: testPassword,
+			setupMock: func() {
+				user := &entity.User{
+					Base:         entity.Base{ID: 1},
+					Username:     "admin@example.com",
+					PasswordHash: string(hashedPassword),
+					Role:         entity.UserRoleAdmin,
+				}
+				expectNoThrottle("admin@example.com")
+				mockUserRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.User{Username: "admin@example.com"}, nil).
+					Return(user, nil)
+				mockLoginThrottleRepo.EXPECT().
+					Reset(gomock.Any(), entity.LoginThrottleScopeUsername, "admin@example.com", nil).
+					Return(nil)
+				expectNoTwoFactor(mockUserTwoFactorRepo, mockRoleRepo, 1, entity.UserRoleAdmin)
+				mockRolePermissionRepo.EXPECT().
+					FindPermissionsByRoleName(gomock.Any(), entity.UserRoleAdmin, nil).
+					Return(nil, gorm.ErrInvalidDB)
 			},
 			expectError: true,
 		},
@@ -97,6 +179,7 @@ func TestAuthUsecase_Auth(t *testing.T) {
 			email:    "admin@example.com",
 			password: testPassword,
 			setupMock: func() {
+				expectNoThrottle("admin@example.com")
 				mockUserRepo.EXPECT().
 					FindOneByTemplate(gomock.Any(), &entity.User{Username: "admin@example.com"}, nil).
 					Return(nil, gorm.ErrInvalidDB)
@@ -129,3 +212,65 @@ func TestAuthUsecase_Auth(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthUsecase_Auth_PasswordChangeRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := authmock.NewMockUserRepository(ctrl)
+	mockRolePermissionRepo := authmock.NewMockRolePermissionRepository(ctrl)
+	mockRefreshTokenRepo := authmock.NewMockRefreshTokenRepository(ctrl)
+	mockLoginThrottleRepo := authmock.NewMockLoginThrottleRepository(ctrl)
+	mockAuditLogRepo := authmock.NewMockAuditLogRepository(ctrl)
+	mockRoleRepo := authmock.NewMockRoleRepository(ctrl)
+	mockUserTwoFactorRepo := authmock.NewMockUserTwoFactorRepository(ctrl)
+	mockRecoveryCodeRepo := authmock.NewMockTwoFactorRecoveryCodeRepository(ctrl)
+	mockLoginChallengeRepo := authmock.NewMockLoginChallengeRepository(ctrl)
+	mockUserIdentityRepo := authmock.NewMockUserIdentityRepository(ctrl)
+	mockOIDCLoginStateRepo := authmock.NewMockOIDCLoginStateRepository(ctrl)
+
+	jwtAuth := newTestJWTAuth(t)
+	usecase := auth.NewUsecase(mockUserRepo, mockRolePermissionRepo, mockRefreshTokenRepo, mockLoginThrottleRepo, mockAuditLogRepo, mockRoleRepo, mockUserTwoFactorRepo, mockRecoveryCodeRepo, mockLoginChallengeRepo, mockUserIdentityRepo, mockOIDCLoginStateRepo, jwtAuth, nil, testThrottleConfig, testTwoFactorConfig, internal.OIDCConfig{})
+
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	user := &entity.User{
+		Base:               entity.Base{ID: 1},
+		Username:           "admin",
+		PasswordHash:       string(hashedPassword),
+		Role:               entity.UserRoleAdmin,
+		MustChangePassword: true,
+	}
+
+	mockLoginThrottleRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.LoginThrottle{Scope: entity.LoginThrottleScopeUsername, Identifier: "admin"}, nil).
+		Return(nil, nil)
+	mockUserRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.User{Username: "admin"}, nil).
+		Return(user, nil)
+	mockLoginThrottleRepo.EXPECT().
+		Reset(gomock.Any(), entity.LoginThrottleScopeUsername, "admin", nil).
+		Return(nil)
+	expectNoTwoFactor(mockUserTwoFactorRepo, mockRoleRepo, 1, entity.UserRoleAdmin)
+	// No permission lookup: the token must not grant anything until the password is changed
+	mockRefreshTokenRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any(), nil).
+		DoAndReturn(func(_ context.Context, token *entity.RefreshToken, _ *gorm.DB) (*entity.RefreshToken, error) {
+			return token, nil
+		})
+
+	result, err := usecase.Auth(context.Background(), "admin", "password")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if !result.PasswordChangeRequired {
+		t.Error("Expected password change to be required")
+	}
+
+	claims, err := jwtAuth.ParseAccessToken(context.Background(), result.AccessToken)
+	if err != nil {
+		t.Fatalf("Failed to parse access token: %v", err)
+	}
+	if len(claims.Permissions) != 0 {
+		t.Errorf("Expected no permissions but got %v", claims.Permissions)
+	}
+}
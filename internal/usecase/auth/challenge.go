@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"gorm.io/gorm"
+)
+
+const loginFailureInvalidSecondFactor = "invalid_second_factor"
+
+// startChallenge stores a login challenge for a user who passed the password
+// check and returns its token. No access or refresh token is issued until the
+// challenge is completed with VerifyLoginChallenge.
+func (u *UsecaseImpl) startChallenge(ctx context.Context, user *entity.User, now time.Time) (*Result, error) {
+	token, err := newTokenID()
+	if err != nil {
+		logger.Error(ctx, "failed to generate login challenge", "user_id", user.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to start two-factor challenge")
+	}
+
+	expiresAt := now.Add(u.twoFactorConfig.ChallengeDuration)
+	_, err = u.loginChallengeRepo.Create(ctx, &entity.LoginChallenge{
+		UserID:    user.ID,
+		TokenHash: hashToken(token),
+		ExpiresAt: expiresAt,
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to store login challenge", "user_id", user.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to start two-factor challenge")
+	}
+
+	logger.Info(ctx, "two-factor challenge started", "user_id", user.ID)
+
+	return &Result{
+		ChallengeToken:     token,
+		ChallengeExpiresAt: expiresAt,
+	}, nil
+}
+
+// VerifyLoginChallenge completes a login with a TOTP or recovery code. Wrong
+// codes count against both the challenge and the username's login throttle,
+// so starting new challenges does not give an attacker more guesses.
+func (u *UsecaseImpl) VerifyLoginChallenge(ctx context.Context, challengeToken string, code string) (*Result, error) {
+	now := time.Now()
+	ip := clientIP(ctx)
+
+	challenge, err := u.loginChallengeRepo.FindOneByTemplate(ctx, &entity.LoginChallenge{TokenHash: hashToken(challengeToken)}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find login challenge", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to verify two-factor code")
+	}
+	if challenge == nil || challenge.CompletedAt != nil || now.After(challenge.ExpiresAt) {
+		return nil, httppkg.NewUnauthorizedError("invalid or expired challenge")
+	}
+	if maxAttempts := u.twoFactorConfig.MaxChallengeAttempts; maxAttempts > 0 && challenge.FailedAttempts >= maxAttempts {
+		return nil, httppkg.NewUnauthorizedError("too many failed attempts, log in again")
+	}
+
+	user, err := u.userRepo.FindByID(ctx, uint(challenge.UserID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find user", "user_id", challenge.UserID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find user")
+	}
+	if user == nil {
+		return nil, httppkg.NewUnauthorizedError("invalid or expired challenge")
+	}
+
+	wait, err := u.loginWait(ctx, user.Username, ip, now)
+	if err != nil {
+		return nil, err
+	}
+	if wait > 0 {
+		u.auditLoginFailure(ctx, user.Username, ip, user, loginFailureThrottled, nil)
+		return nil, httppkg.NewTooManyRequestsError("too many failed login attempts, try again later", wait)
+	}
+
+	twoFactor, err := u.findTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !twoFactor.Enabled() {
+		// Two-factor authentication was reset after the challenge started
+		return nil, httppkg.NewUnauthorizedError("invalid or expired challenge")
+	}
+
+	ok, err := u.verifySecondFactor(ctx, twoFactor, code, now)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err := u.loginChallengeRepo.RecordFailure(ctx, challenge.ID, now, nil); err != nil {
+			logger.Error(ctx, "failed to record challenge failure", "challenge_id", challenge.ID, "error", err)
+		}
+		u.recordLoginFailure(ctx, user.Username, ip, user, loginFailureInvalidSecondFactor, now)
+		return nil, httppkg.NewUnauthorizedError("invalid two-factor code")
+	}
+
+	completed, err := u.loginChallengeRepo.Complete(ctx, challenge.ID, now, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to complete login challenge", "challenge_id", challenge.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to verify two-factor code")
+	}
+	if !completed {
+		return nil, httppkg.NewUnauthorizedError("invalid or expired challenge")
+	}
+
+	if err := u.loginThrottleRepo.Reset(ctx, entity.LoginThrottleScopeUsername, user.Username, nil); err != nil {
+		logger.Error(ctx, "failed to reset login throttle", "user_id", user.ID, "error", err)
+	}
+
+	return u.startSession(ctx, user, false)
+}
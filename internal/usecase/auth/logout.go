@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"gorm.io/gorm"
+)
+
+// Logout ends the session the refresh token belongs to by revoking its whole
+// token family. Logging out an already revoked session is not an error.
+func (u *UsecaseImpl) Logout(ctx context.Context, refreshToken string) error {
+	stored, err := u.findRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+	if stored == nil {
+		return httppkg.NewUnauthorizedError("invalid refresh token")
+	}
+
+	if err := u.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID, time.Now(), nil); err != nil {
+		logger.Error(ctx, "failed to revoke token family", "family_id", stored.FamilyID, "error", err)
+		return httppkg.NewInternalServerError("failed to logout")
+	}
+
+	logger.Info(ctx, "user logged out", "user_id", stored.UserID, "family_id", stored.FamilyID)
+
+	return nil
+}
+
+func (u *UsecaseImpl) RevokeUserSessions(ctx context.Context, userID int64) error {
+	user, err := u.userRepo.FindByID(ctx, uint(userID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find user", "user_id", userID, "error", err)
+		return httppkg.NewInternalServerError("failed to find user")
+	}
+	if user == nil {
+		return httppkg.NewNotFoundError("user not found")
+	}
+
+	revoked, err := u.refreshTokenRepo.RevokeByUserID(ctx, userID, time.Now(), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to revoke user sessions", "user_id", userID, "error", err)
+		return httppkg.NewInternalServerError("failed to revoke user sessions")
+	}
+
+	logger.Info(ctx, "user sessions revoked", "user_id", userID, "revoked_tokens", revoked)
+
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 type MockUsecase struct {
 	ctrl     *gomock.Controller
 	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
 }
 
 // MockUsecaseMockRecorder is the mock recorder for MockUsecase.
@@ -40,17 +41,177 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ActivateTwoFactor mocks base method.
+func (m *MockUsecase) ActivateTwoFactor(ctx context.Context, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateTwoFactor", ctx, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateTwoFactor indicates an expected call of ActivateTwoFactor.
+func (mr *MockUsecaseMockRecorder) ActivateTwoFactor(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateTwoFactor", reflect.TypeOf((*MockUsecase)(nil).ActivateTwoFactor), ctx, code)
+}
+
 // Auth mocks base method.
-func (m *MockUsecase) Auth(arg0 context.Context, arg1, arg2 string) (*auth.Result, error) {
+func (m *MockUsecase) Auth(ctx context.Context, email, password string) (*auth.Result, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Auth", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Auth", ctx, email, password)
 	ret0, _ := ret[0].(*auth.Result)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Auth indicates an expected call of Auth.
-func (mr *MockUsecaseMockRecorder) Auth(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) Auth(ctx, email, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockUsecase)(nil).Auth), ctx, email, password)
+}
+
+// CompleteOIDCLogin mocks base method.
+func (m *MockUsecase) CompleteOIDCLogin(ctx context.Context, code, state string) (*auth.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOIDCLogin", ctx, code, state)
+	ret0, _ := ret[0].(*auth.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteOIDCLogin indicates an expected call of CompleteOIDCLogin.
+func (mr *MockUsecaseMockRecorder) CompleteOIDCLogin(ctx, code, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOIDCLogin", reflect.TypeOf((*MockUsecase)(nil).CompleteOIDCLogin), ctx, code, state)
+}
+
+// DisableTwoFactor mocks base method.
+func (m *MockUsecase) DisableTwoFactor(ctx context.Context, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", ctx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor.
+func (mr *MockUsecaseMockRecorder) DisableTwoFactor(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockUsecase)(nil).DisableTwoFactor), ctx, code)
+}
+
+// EnrollTwoFactor mocks base method.
+func (m *MockUsecase) EnrollTwoFactor(ctx context.Context) (*auth.TwoFactorEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTwoFactor", ctx)
+	ret0, _ := ret[0].(*auth.TwoFactorEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTwoFactor indicates an expected call of EnrollTwoFactor.
+func (mr *MockUsecaseMockRecorder) EnrollTwoFactor(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTwoFactor", reflect.TypeOf((*MockUsecase)(nil).EnrollTwoFactor), ctx)
+}
+
+// Logout mocks base method.
+func (m *MockUsecase) Logout(ctx context.Context, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockUsecaseMockRecorder) Logout(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUsecase)(nil).Logout), ctx, refreshToken)
+}
+
+// Refresh mocks base method.
+func (m *MockUsecase) Refresh(ctx context.Context, refreshToken string) (*auth.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(*auth.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockUsecaseMockRecorder) Refresh(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockUsecase)(nil).Refresh), ctx, refreshToken)
+}
+
+// ResetTwoFactor mocks base method.
+func (m *MockUsecase) ResetTwoFactor(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetTwoFactor", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetTwoFactor indicates an expected call of ResetTwoFactor.
+func (mr *MockUsecaseMockRecorder) ResetTwoFactor(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetTwoFactor", reflect.TypeOf((*MockUsecase)(nil).ResetTwoFactor), ctx, userID)
+}
+
+// RevokeUserSessions mocks base method.
+func (m *MockUsecase) RevokeUserSessions(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserSessions indicates an expected call of RevokeUserSessions.
+func (mr *MockUsecaseMockRecorder) RevokeUserSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSessions", reflect.TypeOf((*MockUsecase)(nil).RevokeUserSessions), ctx, userID)
+}
+
+// StartOIDCLogin mocks base method.
+func (m *MockUsecase) StartOIDCLogin(ctx context.Context) (*auth.OIDCAuthorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartOIDCLogin", ctx)
+	ret0, _ := ret[0].(*auth.OIDCAuthorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartOIDCLogin indicates an expected call of StartOIDCLogin.
+func (mr *MockUsecaseMockRecorder) StartOIDCLogin(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartOIDCLogin", reflect.TypeOf((*MockUsecase)(nil).StartOIDCLogin), ctx)
+}
+
+// UnlockUser mocks base method.
+func (m *MockUsecase) UnlockUser(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockUser indicates an expected call of UnlockUser.
+func (mr *MockUsecaseMockRecorder) UnlockUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUser", reflect.TypeOf((*MockUsecase)(nil).UnlockUser), ctx, userID)
+}
+
+// VerifyLoginChallenge mocks base method.
+func (m *MockUsecase) VerifyLoginChallenge(ctx context.Context, challengeToken, code string) (*auth.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLoginChallenge", ctx, challengeToken, code)
+	ret0, _ := ret[0].(*auth.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyLoginChallenge indicates an expected call of VerifyLoginChallenge.
+func (mr *MockUsecaseMockRecorder) VerifyLoginChallenge(ctx, challengeToken, code any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockUsecase)(nil).Auth), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLoginChallenge", reflect.TypeOf((*MockUsecase)(nil).VerifyLoginChallenge), ctx, challengeToken, code)
 }
@@ -0,0 +1,237 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/oidc"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultOIDCUsernameClaim = "email"
+	defaultOIDCStateDuration = 10 * time.Minute
+)
+
+// OIDCAuthorization is the provider URL a single sign-on login starts at.
+type OIDCAuthorization struct {
+	AuthorizationURL string
+	ExpiresAt        time.Time
+}
+
+// StartOIDCLogin stores the state, nonce and PKCE code verifier of a new
+// single sign-on login and returns the provider's authorization URL.
+func (u *UsecaseImpl) StartOIDCLogin(ctx context.Context) (*OIDCAuthorization, error) {
+	if u.oidcClient == nil {
+		return nil, httppkg.NewNotFoundError("single sign-on is not enabled")
+	}
+
+	now := time.Now()
+
+	state, err := newTokenID()
+	if err != nil {
+		logger.Error(ctx, "failed to generate oidc state", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to start single sign-on")
+	}
+	nonce, err := newTokenID()
+	if err != nil {
+		logger.Error(ctx, "failed to generate oidc nonce", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to start single sign-on")
+	}
+	codeVerifier, err := oidc.NewCodeVerifier()
+	if err != nil {
+		logger.Error(ctx, "failed to generate pkce code verifier", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to start single sign-on")
+	}
+
+	authorizationURL, err := u.oidcClient.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		logger.Error(ctx, "failed to build oidc authorization url", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to start single sign-on")
+	}
+
+	stateDuration := u.oidcConfig.StateDuration
+	if stateDuration <= 0 {
+		stateDuration = defaultOIDCStateDuration
+	}
+	expiresAt := now.Add(stateDuration)
+
+	_, err = u.oidcLoginStateRepo.Create(ctx, &entity.OIDCLoginState{
+		StateHash:    hashToken(state),
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    expiresAt,
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to store oidc login state", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to start single sign-on")
+	}
+
+	return &OIDCAuthorization{
+		AuthorizationURL: authorizationURL,
+		ExpiresAt:        expiresAt,
+	}, nil
+}
+
+// CompleteOIDCLogin redeems the authorization code the provider redirected
+// back with and logs in the user the ID token belongs to. Users with
+// two-factor authentication enabled still get a login challenge.
+func (u *UsecaseImpl) CompleteOIDCLogin(ctx context.Context, code string, state string) (*Result, error) {
+	if u.oidcClient == nil {
+		return nil, httppkg.NewNotFoundError("single sign-on is not enabled")
+	}
+	if code == "" || state == "" {
+		return nil, httppkg.NewBadRequestError("code and state are required")
+	}
+
+	now := time.Now()
+
+	loginState, err := u.oidcLoginStateRepo.FindOneByTemplate(ctx, &entity.OIDCLoginState{StateHash: hashToken(state)}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find oidc login state", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to complete single sign-on")
+	}
+	if loginState == nil || loginState.UsedAt != nil || !now.Before(loginState.ExpiresAt) {
+		return nil, httppkg.NewUnauthorizedError("invalid or expired login state")
+	}
+
+	// The state is consumed before the code is redeemed so a failed attempt
+	// cannot be retried with the same state
+	consumed, err := u.oidcLoginStateRepo.Consume(ctx, loginState.ID, now, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to consume oidc login state", "state_id", loginState.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to complete single sign-on")
+	}
+	if !consumed {
+		return nil, httppkg.NewUnauthorizedError("invalid or expired login state")
+	}
+
+	idToken, err := u.oidcClient.Exchange(ctx, code, loginState.CodeVerifier)
+	if err != nil {
+		logger.Error(ctx, "failed to redeem oidc authorization code", "error", err)
+		return nil, httppkg.NewUnauthorizedError("single sign-on failed")
+	}
+
+	claims, err := u.oidcClient.VerifyIDToken(ctx, idToken, loginState.Nonce, now)
+	if err != nil {
+		logger.Error(ctx, "failed to verify oidc id token", "error", err)
+		return nil, httppkg.NewUnauthorizedError("single sign-on failed")
+	}
+
+	user, err := u.resolveOIDCUser(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := u.findTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor.Enabled() {
+		return u.startChallenge(ctx, user, now)
+	}
+
+	enrollmentRequired, err := u.enrollmentRequired(ctx, user, twoFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx, "single sign-on login", "user_id", user.ID)
+
+	return u.startSession(ctx, user, enrollmentRequired)
+}
+
+// resolveOIDCUser returns the user linked to the provider subject. The first
+// login of a subject links it to the user whose username equals the
+// configured claim, or provisions that user when just-in-time provisioning is
+// enabled. The email claim is only trusted when the provider verified it.
+func (u *UsecaseImpl) resolveOIDCUser(ctx context.Context, claims *oidc.Claims) (*entity.User, error) {
+	issuer := u.oidcClient.Issuer()
+
+	identity, err := u.userIdentityRepo.FindOneByTemplate(ctx, &entity.UserIdentity{Issuer: issuer, Subject: claims.Subject}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find user identity", "subject", claims.Subject, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to complete single sign-on")
+	}
+	if identity != nil {
+		user, err := u.userRepo.FindByID(ctx, uint(identity.UserID), nil)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Error(ctx, "failed to find user", "user_id", identity.UserID, "error", err)
+			return nil, httppkg.NewInternalServerError("failed to find user")
+		}
+		if user == nil || user.IsServiceAccount {
+			return nil, httppkg.NewForbiddenError("no account is linked to this identity")
+		}
+		return user, nil
+	}
+
+	claimName := u.oidcConfig.UsernameClaim
+	if claimName == "" {
+		claimName = defaultOIDCUsernameClaim
+	}
+	username := claims.String(claimName)
+	if claimName == defaultOIDCUsernameClaim && !claims.EmailVerified {
+		username = ""
+	}
+	if username == "" {
+		return nil, httppkg.NewForbiddenError("identity provider did not return a verified " + claimName)
+	}
+
+	user, err := u.userRepo.FindOneByTemplate(ctx, &entity.User{Username: username}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find user by username", "username", username, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find user")
+	}
+	if user == nil {
+		if !u.oidcConfig.JITProvisioning {
+			return nil, httppkg.NewForbiddenError("no account is linked to this identity")
+		}
+
+		user, err = u.newProvisionedUser(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if user.IsServiceAccount {
+		return nil, httppkg.NewForbiddenError("no account is linked to this identity")
+	}
+
+	// A provisioned user is created together with its identity
+	err = u.userIdentityRepo.Link(ctx, user, &entity.UserIdentity{
+		Issuer:  issuer,
+		Subject: claims.Subject,
+		Email:   claims.Email,
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to link user identity", "username", username, "subject", claims.Subject, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to complete single sign-on")
+	}
+
+	logger.Info(ctx, "user identity linked", "user_id", user.ID, "issuer", issuer, "subject", claims.Subject)
+
+	return user, nil
+}
+
+// newProvisionedUser prepares a user with the configured default role. It
+// has no password, so it can only log in through single sign-on until a
+// password reset is issued.
+func (u *UsecaseImpl) newProvisionedUser(ctx context.Context, username string) (*entity.User, error) {
+	role, err := u.roleRepo.FindOneByTemplate(ctx, &entity.Role{Name: u.oidcConfig.DefaultRole}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find role", "role", u.oidcConfig.DefaultRole, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find role")
+	}
+	if role == nil {
+		logger.Error(ctx, "oidc default role does not exist", "role", u.oidcConfig.DefaultRole)
+		return nil, httppkg.NewInternalServerError("failed to provision user")
+	}
+
+	return &entity.User{
+		Username: username,
+		Role:     role.Name,
+	}, nil
+}
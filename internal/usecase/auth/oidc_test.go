@@ -0,0 +1,523 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	authmock "github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/auth"
+	"github.com/asyauqi15/payslip-system/pkg/oidc"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/lestrrat-go/jwx/jwt"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+const (
+	testOIDCClientID     = "payslip-system"
+	testOIDCClientSecret = "client-secret"
+	testOIDCRedirectURL  = "http://localhost:3000/auth/oidc/callback"
+	testOIDCKeyID        = "provider-key"
+)
+
+// mockOIDCProvider is a minimal OpenID Connect provider serving discovery,
+// a key set and a token endpoint that enforces PKCE.
+type mockOIDCProvider struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	// signingKey replaces key when signing ID tokens, while the key set still
+	// publishes key
+	signingKey *rsa.PrivateKey
+
+	mu     sync.Mutex
+	grants map[string]mockOIDCGrant
+}
+
+type mockOIDCGrant struct {
+	codeChallenge string
+	claims        map[string]interface{}
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate provider key: %v", err)
+	}
+
+	p := &mockOIDCProvider{t: t, key: key, grants: map[string]mockOIDCGrant{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.server.URL,
+			"authorization_endpoint": p.server.URL + "/authorize",
+			"token_endpoint":         p.server.URL + "/token",
+			"jwks_uri":               p.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		publicKey, err := jwk.New(&p.key.PublicKey)
+		if err != nil {
+			t.Errorf("Failed to build provider key: %v", err)
+			return
+		}
+		_ = publicKey.Set(jwk.KeyIDKey, testOIDCKeyID)
+		_ = publicKey.Set(jwk.AlgorithmKey, jwa.RS256.String())
+
+		set := jwk.NewSet()
+		set.Add(publicKey)
+		_ = json.NewEncoder(w).Encode(set)
+	})
+	mux.HandleFunc("/token", p.handleToken)
+
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+
+	return p
+}
+
+func (p *mockOIDCProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok || clientID != testOIDCClientID || clientSecret != testOIDCClientSecret {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+		return
+	}
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "authorization_code" || r.PostForm.Get("redirect_uri") != testOIDCRedirectURL {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	p.mu.Lock()
+	grant, ok := p.grants[r.PostForm.Get("code")]
+	delete(p.grants, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	if !ok || oidc.CodeChallenge(r.PostForm.Get("code_verifier")) != grant.codeChallenge {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	signingKey := p.key
+	if p.signingKey != nil {
+		signingKey = p.signingKey
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"access_token": "provider-access-token",
+		"token_type":   "Bearer",
+		"id_token":     p.signIDToken(grant.claims, signingKey),
+	})
+}
+
+// authorize simulates the user signing in at the provider and returns the
+// authorization code. The claims override the defaults of the ID token.
+func (p *mockOIDCProvider) authorize(authorizationURL string, claims map[string]interface{}) string {
+	p.t.Helper()
+
+	parsed, err := url.Parse(authorizationURL)
+	if err != nil {
+		p.t.Fatalf("Invalid authorization URL: %v", err)
+	}
+	query := parsed.Query()
+
+	idClaims := map[string]interface{}{
+		jwt.IssuerKey:     p.server.URL,
+		jwt.SubjectKey:    "provider-user-1",
+		jwt.AudienceKey:   []string{testOIDCClientID},
+		jwt.IssuedAtKey:   time.Now().Unix(),
+		jwt.ExpirationKey: time.Now().Add(5 * time.Minute).Unix(),
+		"nonce":           query.Get("nonce"),
+		"email":           "jane@example.com",
+		"email_verified":  true,
+	}
+	for k, v := range claims {
+		idClaims[k] = v
+	}
+
+	code := hex.EncodeToString([]byte(query.Get("state")))[:16]
+	p.mu.Lock()
+	p.grants[code] = mockOIDCGrant{codeChallenge: query.Get("code_challenge"), claims: idClaims}
+	p.mu.Unlock()
+
+	return code
+}
+
+func (p *mockOIDCProvider) signIDToken(claims map[string]interface{}, key *rsa.PrivateKey) string {
+	p.t.Helper()
+
+	token := jwt.New()
+	for k, v := range claims {
+		if err := token.Set(k, v); err != nil {
+			p.t.Fatalf("Failed to set claim %s: %v", k, err)
+		}
+	}
+
+	headers := jws.NewHeaders()
+	_ = headers.Set(jws.KeyIDKey, testOIDCKeyID)
+	signed, err := jwt.Sign(token, jwa.RS256, key, jwt.WithHeaders(headers))
+	if err != nil {
+		p.t.Fatalf("Failed to sign ID token: %v", err)
+	}
+	return string(signed)
+}
+
+func setupOIDCTest(t *testing.T, provider *mockOIDCProvider, jitProvisioning bool) (auth.Usecase, *twoFactorMocks) {
+	ctrl := gomock.NewController(t)
+
+	m := &twoFactorMocks{
+		userRepo:           authmock.NewMockUserRepository(ctrl),
+		rolePermissionRepo: authmock.NewMockRolePermissionRepository(ctrl),
+		refreshTokenRepo:   authmock.NewMockRefreshTokenRepository(ctrl),
+		loginThrottleRepo:  authmock.NewMockLoginThrottleRepository(ctrl),
+		auditLogRepo:       authmock.NewMockAuditLogRepository(ctrl),
+		roleRepo:           authmock.NewMockRoleRepository(ctrl),
+		userTwoFactorRepo:  authmock.NewMockUserTwoFactorRepository(ctrl),
+		recoveryCodeRepo:   authmock.NewMockTwoFactorRecoveryCodeRepository(ctrl),
+		loginChallengeRepo: authmock.NewMockLoginChallengeRepository(ctrl),
+		userIdentityRepo:   authmock.NewMockUserIdentityRepository(ctrl),
+		oidcLoginStateRepo: authmock.NewMockOIDCLoginStateRepository(ctrl),
+	}
+
+	oidcConfig := internal.OIDCConfig{
+		Enabled:         true,
+		IssuerURL:       provider.server.URL,
+		ClientID:        testOIDCClientID,
+		ClientSecret:    testOIDCClientSecret,
+		RedirectURL:     testOIDCRedirectURL,
+		JITProvisioning: jitProvisioning,
+		DefaultRole:     entity.UserRoleDefault,
+		StateDuration:   10 * time.Minute,
+	}
+	oidcClient := oidc.NewClient(oidcConfig, provider.server.Client())
+
+	usecase := auth.NewUsecase(m.userRepo, m.rolePermissionRepo, m.refreshTokenRepo, m.loginThrottleRepo, m.auditLogRepo,
+		m.roleRepo, m.userTwoFactorRepo, m.recoveryCodeRepo, m.loginChallengeRepo, m.userIdentityRepo, m.oidcLoginStateRepo,
+		newTestJWTAuth(t), oidcClient, testThrottleConfig, testTwoFactorConfig, oidcConfig)
+
+	return usecase, m
+}
+
+// startTestOIDCLogin starts a login and returns the state parameter, the
+// authorization URL and the stored login state.
+func startTestOIDCLogin(t *testing.T, usecase auth.Usecase, m *twoFactorMocks) (string, string, *entity.OIDCLoginState) {
+	t.Helper()
+
+	var stored *entity.OIDCLoginState
+	m.oidcLoginStateRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any(), nil).
+		DoAndReturn(func(_ context.Context, s *entity.OIDCLoginState, _ *gorm.DB) (*entity.OIDCLoginState, error) {
+			s.ID = 11
+			stored = s
+			return s, nil
+		})
+
+	authorization, err := usecase.StartOIDCLogin(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+
+	parsed, err := url.Parse(authorization.AuthorizationURL)
+	if err != nil {
+		t.Fatalf("Invalid authorization URL: %v", err)
+	}
+	query := parsed.Query()
+	state := query.Get("state")
+
+	sum := sha256.Sum256([]byte(state))
+	if stored.StateHash != hex.EncodeToString(sum[:]) {
+		t.Error("Expected only the hash of the state to be stored")
+	}
+	if query.Get("nonce") != stored.Nonce || query.Get("code_challenge") != oidc.CodeChallenge(stored.CodeVerifier) {
+		t.Error("Expected the nonce and the code challenge of the stored login state")
+	}
+	if query.Get("code_challenge_method") != "S256" || query.Get("response_type") != "code" ||
+		query.Get("client_id") != testOIDCClientID || query.Get("redirect_uri") != testOIDCRedirectURL {
+		t.Errorf("Unexpected authorization request: %s", authorization.AuthorizationURL)
+	}
+
+	m.oidcLoginStateRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.OIDCLoginState{StateHash: stored.StateHash}, nil).
+		Return(stored, nil)
+
+	return state, authorization.AuthorizationURL, stored
+}
+
+func expectSession(m *twoFactorMocks, userID int64) {
+	m.rolePermissionRepo.EXPECT().
+		FindPermissionsByRoleName(gomock.Any(), gomock.Any(), nil).
+		Return([]string{entity.PermissionPayslipReadOwn}, nil)
+	m.refreshTokenRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any(), nil).
+		DoAndReturn(func(_ context.Context, rt *entity.RefreshToken, _ *gorm.DB) (*entity.RefreshToken, error) {
+			if rt.UserID != userID {
+				return nil, gorm.ErrInvalidData
+			}
+			return rt, nil
+		})
+}
+
+func TestAuthUsecase_OIDCLogin(t *testing.T) {
+	provider := newMockOIDCProvider(t)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	identity := &entity.UserIdentity{Base: entity.Base{ID: 3}, UserID: 5, Issuer: provider.server.URL, Subject: "provider-user-1"}
+	user := &entity.User{Base: entity.Base{ID: 5}, Username: "jane@example.com", Role: entity.UserRoleDefault}
+
+	tests := []struct {
+		name            string
+		jitProvisioning bool
+		claims          map[string]interface{}
+		// tamper changes the stored login state before the login is completed
+		tamper         func(stored *entity.OIDCLoginState)
+		setupMock      func(m *twoFactorMocks)
+		expectedStatus int
+		expectTokens   bool
+	}{
+		{
+			name: "linked identity",
+			setupMock: func(m *twoFactorMocks) {
+				expectConsume(m, true)
+				m.userIdentityRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.UserIdentity{Issuer: provider.server.URL, Subject: "provider-user-1"}, nil).
+					Return(identity, nil)
+				m.userRepo.EXPECT().
+					FindByID(gomock.Any(), uint(5), nil).
+					Return(user, nil)
+				expectNoTwoFactor(m.userTwoFactorRepo, m.roleRepo, 5, entity.UserRoleDefault)
+				expectSession(m, 5)
+			},
+			expectTokens: true,
+		},
+		{
+			name: "first login links user by verified email",
+			setupMock: func(m *twoFactorMocks) {
+				expectConsume(m, true)
+				m.userIdentityRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+				m.userRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.User{Username: "jane@example.com"}, nil).
+					Return(user, nil)
+				m.userIdentityRepo.EXPECT().
+					Link(gomock.Any(), user, &entity.UserIdentity{Issuer: provider.server.URL, Subject: "provider-user-1", Email: "jane@example.com"}, nil).
+					Return(nil)
+				expectNoTwoFactor(m.userTwoFactorRepo, m.roleRepo, 5, entity.UserRoleDefault)
+				expectSession(m, 5)
+			},
+			expectTokens: true,
+		},
+		{
+			name:            "just-in-time provisioning",
+			jitProvisioning: true,
+			claims:          map[string]interface{}{"email": "new.hire@example.com"},
+			setupMock: func(m *twoFactorMocks) {
+				expectConsume(m, true)
+				m.userIdentityRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+				m.userRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.User{Username: "new.hire@example.com"}, nil).
+					Return(nil, nil)
+				m.roleRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Role{Name: entity.UserRoleDefault}, nil).
+					Return(&entity.Role{Base: entity.Base{ID: 3}, Name: entity.UserRoleDefault}, nil)
+				// The user is created without a password together with the identity
+				m.userIdentityRepo.EXPECT().
+					Link(gomock.Any(), &entity.User{Username: "new.hire@example.com", Role: entity.UserRoleDefault}, gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, u *entity.User, i *entity.UserIdentity, _ *gorm.DB) error {
+						u.ID = 42
+						i.UserID = u.ID
+						return nil
+					})
+				expectNoTwoFactor(m.userTwoFactorRepo, m.roleRepo, 42, entity.UserRoleDefault)
+				expectSession(m, 42)
+			},
+			expectTokens: true,
+		},
+		{
+			name:   "unknown user without provisioning",
+			claims: map[string]interface{}{"email": "stranger@example.com"},
+			setupMock: func(m *twoFactorMocks) {
+				expectConsume(m, true)
+				m.userIdentityRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+				m.userRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.User{Username: "stranger@example.com"}, nil).
+					Return(nil, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "unverified email is not trusted",
+			claims: map[string]interface{}{"email_verified": false},
+			setupMock: func(m *twoFactorMocks) {
+				expectConsume(m, true)
+				m.userIdentityRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "two-factor authentication still applies",
+			setupMock: func(m *twoFactorMocks) {
+				expectConsume(m, true)
+				m.userIdentityRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), gomock.Any(), nil).
+					Return(identity, nil)
+				m.userRepo.EXPECT().
+					FindByID(gomock.Any(), uint(5), nil).
+					Return(user, nil)
+				enabledAt := time.Now().Add(-time.Hour)
+				m.userTwoFactorRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.UserTwoFactor{UserID: 5}, nil).
+					Return(&entity.UserTwoFactor{Base: entity.Base{ID: 7}, UserID: 5, EnabledAt: &enabledAt}, nil)
+				m.loginChallengeRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, c *entity.LoginChallenge, _ *gorm.DB) (*entity.LoginChallenge, error) {
+						return c, nil
+					})
+			},
+		},
+		{
+			name:   "nonce mismatch",
+			claims: map[string]interface{}{"nonce": "replayed-nonce"},
+			setupMock: func(m *twoFactorMocks) {
+				expectConsume(m, true)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "wrong audience",
+			claims: map[string]interface{}{jwt.AudienceKey: []string{"another-client"}},
+			setupMock: func(m *twoFactorMocks) {
+				expectConsume(m, true)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "wrong issuer",
+			claims: map[string]interface{}{jwt.IssuerKey: "https://evil.example.com"},
+			setupMock: func(m *twoFactorMocks) {
+				expectConsume(m, true)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "expired ID token",
+			claims: map[string]interface{}{jwt.ExpirationKey: time.Now().Add(-time.Hour).Unix()},
+			setupMock: func(m *twoFactorMocks) {
+				expectConsume(m, true)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "PKCE verifier mismatch",
+			tamper: func(stored *entity.OIDCLoginState) {
+				stored.CodeVerifier = "intercepted-verifier"
+			},
+			setupMock: func(m *twoFactorMocks) {
+				expectConsume(m, true)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "state already used",
+			setupMock: func(m *twoFactorMocks) {
+				expectConsume(m, false)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "expired state",
+			tamper: func(stored *entity.OIDCLoginState) {
+				stored.ExpiresAt = time.Now().Add(-time.Second)
+			},
+			setupMock:      func(m *twoFactorMocks) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, m := setupOIDCTest(t, provider, tt.jitProvisioning)
+
+			state, authorizationURL, stored := startTestOIDCLogin(t, usecase, m)
+			code := provider.authorize(authorizationURL, tt.claims)
+			if tt.tamper != nil {
+				tt.tamper(stored)
+			}
+			tt.setupMock(m)
+
+			result, err := usecase.CompleteOIDCLogin(context.Background(), code, state)
+
+			if tt.expectedStatus != 0 {
+				assertHTTPStatus(t, err, tt.expectedStatus)
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if tt.expectTokens && (result.AccessToken == "" || result.RefreshToken == "") {
+				t.Errorf("Expected a token pair but got: %+v", result)
+			}
+			if !tt.expectTokens && (result.ChallengeToken == "" || result.AccessToken != "") {
+				t.Errorf("Expected a login challenge but got: %+v", result)
+			}
+		})
+	}
+
+	t.Run("ID token signed with another key", func(t *testing.T) {
+		usecase, m := setupOIDCTest(t, provider, false)
+
+		state, authorizationURL, _ := startTestOIDCLogin(t, usecase, m)
+		code := provider.authorize(authorizationURL, nil)
+		expectConsume(m, true)
+
+		provider.signingKey = otherKey
+		defer func() { provider.signingKey = nil }()
+
+		_, err := usecase.CompleteOIDCLogin(context.Background(), code, state)
+		assertHTTPStatus(t, err, http.StatusUnauthorized)
+	})
+}
+
+func expectConsume(m *twoFactorMocks, consumed bool) {
+	m.oidcLoginStateRepo.EXPECT().
+		Consume(gomock.Any(), int64(11), gomock.Any(), nil).
+		Return(consumed, nil)
+}
+
+func TestAuthUsecase_OIDCLogin_Disabled(t *testing.T) {
+	usecase, _ := setupTwoFactorTest(t)
+
+	_, err := usecase.StartOIDCLogin(context.Background())
+	assertHTTPStatus(t, err, http.StatusNotFound)
+
+	_, err = usecase.CompleteOIDCLogin(context.Background(), "code", "state")
+	assertHTTPStatus(t, err, http.StatusNotFound)
+}
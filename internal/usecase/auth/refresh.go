@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) Refresh(ctx context.Context, refreshToken string) (*Result, error) {
+	stored, err := u.findRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		return nil, httppkg.NewUnauthorizedError("invalid refresh token")
+	}
+
+	if stored.RevokedAt != nil {
+		return nil, httppkg.NewUnauthorizedError("refresh token has been revoked")
+	}
+
+	now := time.Now()
+
+	// A rotated token being presented again means it was leaked or replayed,
+	// so every token issued from the same login is revoked.
+	if stored.RotatedAt != nil {
+		u.revokeFamilyOnReuse(ctx, stored, now)
+		return nil, httppkg.NewUnauthorizedError("refresh token reuse detected")
+	}
+
+	if now.After(stored.ExpiresAt) {
+		return nil, httppkg.NewUnauthorizedError("refresh token has expired")
+	}
+
+	user, err := u.userRepo.FindByID(ctx, uint(stored.UserID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find user", "user_id", stored.UserID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find user")
+	}
+	if user == nil {
+		return nil, httppkg.NewUnauthorizedError("invalid refresh token")
+	}
+
+	// A role may have started requiring two factors since the login
+	twoFactor, err := u.findTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollmentRequired, err := u.enrollmentRequired(ctx, user, twoFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	rotated, err := u.refreshTokenRepo.MarkRotated(ctx, stored.ID, now, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to rotate refresh token", "token_id", stored.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to refresh token")
+	}
+	if !rotated {
+		// Another request used this token first
+		u.revokeFamilyOnReuse(ctx, stored, now)
+		return nil, httppkg.NewUnauthorizedError("refresh token reuse detected")
+	}
+
+	result, replacement, err := u.issueTokens(ctx, user, stored.FamilyID, enrollmentRequired)
+	if err != nil {
+		return nil, httppkg.NewInternalServerError("failed to refresh token")
+	}
+
+	if _, err := u.refreshTokenRepo.Updates(ctx, stored, entity.RefreshToken{ReplacedByID: &replacement.ID}, nil); err != nil {
+		// The chain link is informational only; the rotation already happened
+		logger.Error(ctx, "failed to link rotated refresh token", "token_id", stored.ID, "replaced_by_id", replacement.ID, "error", err)
+	}
+
+	logger.Info(ctx, "refresh token rotated", "user_id", user.ID, "family_id", stored.FamilyID)
+
+	return result, nil
+}
+
+// findRefreshToken validates the token signature and returns the matching
+// server-side record, or nil when the token is unknown.
+func (u *UsecaseImpl) findRefreshToken(ctx context.Context, refreshToken string) (*entity.RefreshToken, error) {
+	claims, err := u.jwtAuth.ParseRefreshToken(ctx, refreshToken)
+	if err != nil || claims.JTI == "" {
+		return nil, httppkg.NewUnauthorizedError("invalid refresh token")
+	}
+
+	stored, err := u.refreshTokenRepo.FindOneByTemplate(ctx, &entity.RefreshToken{JTI: claims.JTI}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find refresh token", "jti", claims.JTI, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find refresh token")
+	}
+	if stored == nil || stored.TokenHash != hashToken(refreshToken) {
+		return nil, nil
+	}
+
+	return stored, nil
+}
+
+func (u *UsecaseImpl) revokeFamilyOnReuse(ctx context.Context, stored *entity.RefreshToken, now time.Time) {
+	logger.Warn(ctx, "refresh token reuse detected, revoking token family",
+		"user_id", stored.UserID,
+		"family_id", stored.FamilyID,
+		"token_id", stored.ID)
+
+	if err := u.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID, now, nil); err != nil {
+		logger.Error(ctx, "failed to revoke token family", "family_id", stored.FamilyID, "error", err)
+	}
+}
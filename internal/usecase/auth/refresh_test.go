@@ -0,0 +1,343 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	authmock "github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/auth"
+	jwtauth_pkg "github.com/asyauqi15/payslip-system/pkg/jwt-auth"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func newTestJWTAuth(t *testing.T) *jwtauth_pkg.JWTAuthentication {
+	t.Helper()
+
+	jwtAuth, err := jwtauth_pkg.NewJWTAuthentication(internal.HTTPServerConfig{
+		AccessTokenSecret:    []byte("test-access-secret-key-for-testing-purposes"),
+		RefreshTokenSecret:   []byte("test-refresh-secret-key-for-testing-purposes"),
+		AccessTokenDuration:  time.Hour,
+		RefreshTokenDuration: time.Hour * 24,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create JWT auth: %v", err)
+	}
+
+	return jwtAuth
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestAuthUsecase_Refresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := authmock.NewMockUserRepository(ctrl)
+	mockRolePermissionRepo := authmock.NewMockRolePermissionRepository(ctrl)
+	mockRefreshTokenRepo := authmock.NewMockRefreshTokenRepository(ctrl)
+	mockLoginThrottleRepo := authmock.NewMockLoginThrottleRepository(ctrl)
+	mockAuditLogRepo := authmock.NewMockAuditLogRepository(ctrl)
+	mockRoleRepo := authmock.NewMockRoleRepository(ctrl)
+	mockUserTwoFactorRepo := authmock.NewMockUserTwoFactorRepository(ctrl)
+	mockRecoveryCodeRepo := authmock.NewMockTwoFactorRecoveryCodeRepository(ctrl)
+	mockLoginChallengeRepo := authmock.NewMockLoginChallengeRepository(ctrl)
+	mockUserIdentityRepo := authmock.NewMockUserIdentityRepository(ctrl)
+	mockOIDCLoginStateRepo := authmock.NewMockOIDCLoginStateRepository(ctrl)
+
+	jwtAuth := newTestJWTAuth(t)
+	usecase := auth.NewUsecase(mockUserRepo, mockRolePermissionRepo, mockRefreshTokenRepo, mockLoginThrottleRepo, mockAuditLogRepo, mockRoleRepo, mockUserTwoFactorRepo, mockRecoveryCodeRepo, mockLoginChallengeRepo, mockUserIdentityRepo, mockOIDCLoginStateRepo, jwtAuth, nil, testThrottleConfig, testTwoFactorConfig, internal.OIDCConfig{})
+
+	user := &entity.User{Base: entity.Base{ID: 1}, Username: "employee001", Role: entity.UserRoleDefault}
+
+	refreshToken, expiresAt, err := jwtAuth.GenerateRefreshToken(context.Background(), user, "jti-1")
+	if err != nil {
+		t.Fatalf("Failed to generate refresh token: %v", err)
+	}
+
+	storedToken := func() *entity.RefreshToken {
+		return &entity.RefreshToken{
+			Base:      entity.Base{ID: 10},
+			UserID:    1,
+			JTI:       "jti-1",
+			FamilyID:  "family-1",
+			TokenHash: sha256Hex(refreshToken),
+			ExpiresAt: expiresAt,
+		}
+	}
+	rotatedAt := time.Now().Add(-time.Minute)
+	expectLookup := func(stored *entity.RefreshToken) {
+		mockRefreshTokenRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.RefreshToken{JTI: "jti-1"}, nil).
+			Return(stored, nil)
+	}
+
+	tests := []struct {
+		name           string
+		refreshToken   string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name:         "successful rotation",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				stored := storedToken()
+				expectLookup(stored)
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(user, nil)
+				expectNoTwoFactor(mockUserTwoFactorRepo, mockRoleRepo, 1, entity.UserRoleDefault)
+				mockRefreshTokenRepo.EXPECT().
+					MarkRotated(gomock.Any(), int64(10), gomock.Any(), nil).
+					Return(true, nil)
+				mockRolePermissionRepo.EXPECT().
+					FindPermissionsByRoleName(gomock.Any(), entity.UserRoleDefault, nil).
+					Return([]string{entity.PermissionAttendanceSubmit}, nil)
+				mockRefreshTokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, token *entity.RefreshToken, _ *gorm.DB) (*entity.RefreshToken, error) {
+						if token.FamilyID != "family-1" {
+							t.Errorf("Expected rotated token to stay in family-1 but got %s", token.FamilyID)
+						}
+						if token.JTI == "jti-1" {
+							t.Error("Expected rotated token to get a new jti")
+						}
+						token.ID = 11
+						return token, nil
+					})
+				replacedByID := int64(11)
+				mockRefreshTokenRepo.EXPECT().
+					Updates(gomock.Any(), stored, entity.RefreshToken{ReplacedByID: &replacedByID}, nil).
+					Return(stored, nil)
+			},
+		},
+		{
+			name:           "malformed token",
+			refreshToken:   "not-a-jwt",
+			setupMock:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "unknown token",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				expectLookup(nil)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "revoked token",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				stored := storedToken()
+				stored.RevokedAt = &rotatedAt
+				expectLookup(stored)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "reused token revokes family",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				stored := storedToken()
+				stored.RotatedAt = &rotatedAt
+				expectLookup(stored)
+				mockRefreshTokenRepo.EXPECT().
+					RevokeFamily(gomock.Any(), "family-1", gomock.Any(), nil).
+					Return(nil)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "concurrent rotation revokes family",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				expectLookup(storedToken())
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(user, nil)
+				expectNoTwoFactor(mockUserTwoFactorRepo, mockRoleRepo, 1, entity.UserRoleDefault)
+				mockRefreshTokenRepo.EXPECT().
+					MarkRotated(gomock.Any(), int64(10), gomock.Any(), nil).
+					Return(false, nil)
+				mockRefreshTokenRepo.EXPECT().
+					RevokeFamily(gomock.Any(), "family-1", gomock.Any(), nil).
+					Return(nil)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "expired token",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				stored := storedToken()
+				stored.ExpiresAt = time.Now().Add(-time.Second)
+				expectLookup(stored)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:         "repository error",
+			refreshToken: refreshToken,
+			setupMock: func() {
+				mockRefreshTokenRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.RefreshToken{JTI: "jti-1"}, nil).
+					Return(nil, gorm.ErrInvalidDB)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.Refresh(context.Background(), tt.refreshToken)
+
+			if tt.expectedStatus != 0 {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr interface{ HTTPStatus() int }
+				if !errors.As(err, &httpErr) || httpErr.HTTPStatus() != tt.expectedStatus {
+					t.Errorf("Expected status %d but got error: %v", tt.expectedStatus, err)
+				}
+				if result != nil {
+					t.Error("Expected nil result but got value")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if result.AccessToken == "" || result.RefreshToken == "" {
+				t.Error("Expected a new token pair")
+			}
+			if result.RefreshToken == tt.refreshToken {
+				t.Error("Expected refresh token to be rotated")
+			}
+		})
+	}
+}
+
+func TestAuthUsecase_Logout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := authmock.NewMockUserRepository(ctrl)
+	mockRolePermissionRepo := authmock.NewMockRolePermissionRepository(ctrl)
+	mockRefreshTokenRepo := authmock.NewMockRefreshTokenRepository(ctrl)
+	mockLoginThrottleRepo := authmock.NewMockLoginThrottleRepository(ctrl)
+	mockAuditLogRepo := authmock.NewMockAuditLogRepository(ctrl)
+	mockRoleRepo := authmock.NewMockRoleRepository(ctrl)
+	mockUserTwoFactorRepo := authmock.NewMockUserTwoFactorRepository(ctrl)
+	mockRecoveryCodeRepo := authmock.NewMockTwoFactorRecoveryCodeRepository(ctrl)
+	mockLoginChallengeRepo := authmock.NewMockLoginChallengeRepository(ctrl)
+	mockUserIdentityRepo := authmock.NewMockUserIdentityRepository(ctrl)
+	mockOIDCLoginStateRepo := authmock.NewMockOIDCLoginStateRepository(ctrl)
+
+	jwtAuth := newTestJWTAuth(t)
+	usecase := auth.NewUsecase(mockUserRepo, mockRolePermissionRepo, mockRefreshTokenRepo, mockLoginThrottleRepo, mockAuditLogRepo, mockRoleRepo, mockUserTwoFactorRepo, mockRecoveryCodeRepo, mockLoginChallengeRepo, mockUserIdentityRepo, mockOIDCLoginStateRepo, jwtAuth, nil, testThrottleConfig, testTwoFactorConfig, internal.OIDCConfig{})
+
+	user := &entity.User{Base: entity.Base{ID: 1}, Username: "employee001", Role: entity.UserRoleDefault}
+	refreshToken, expiresAt, err := jwtAuth.GenerateRefreshToken(context.Background(), user, "jti-1")
+	if err != nil {
+		t.Fatalf("Failed to generate refresh token: %v", err)
+	}
+
+	mockRefreshTokenRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.RefreshToken{JTI: "jti-1"}, nil).
+		Return(&entity.RefreshToken{
+			Base:      entity.Base{ID: 10},
+			UserID:    1,
+			JTI:       "jti-1",
+			FamilyID:  "family-1",
+			TokenHash: sha256Hex(refreshToken),
+			ExpiresAt: expiresAt,
+		}, nil)
+	mockRefreshTokenRepo.EXPECT().
+		RevokeFamily(gomock.Any(), "family-1", gomock.Any(), nil).
+		Return(nil)
+
+	if err := usecase.Logout(context.Background(), refreshToken); err != nil {
+		t.Errorf("Expected no error but got: %v", err)
+	}
+}
+
+func TestAuthUsecase_RevokeUserSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := authmock.NewMockUserRepository(ctrl)
+	mockRolePermissionRepo := authmock.NewMockRolePermissionRepository(ctrl)
+	mockRefreshTokenRepo := authmock.NewMockRefreshTokenRepository(ctrl)
+	mockLoginThrottleRepo := authmock.NewMockLoginThrottleRepository(ctrl)
+	mockAuditLogRepo := authmock.NewMockAuditLogRepository(ctrl)
+	mockRoleRepo := authmock.NewMockRoleRepository(ctrl)
+	mockUserTwoFactorRepo := authmock.NewMockUserTwoFactorRepository(ctrl)
+	mockRecoveryCodeRepo := authmock.NewMockTwoFactorRecoveryCodeRepository(ctrl)
+	mockLoginChallengeRepo := authmock.NewMockLoginChallengeRepository(ctrl)
+	mockUserIdentityRepo := authmock.NewMockUserIdentityRepository(ctrl)
+	mockOIDCLoginStateRepo := authmock.NewMockOIDCLoginStateRepository(ctrl)
+
+	usecase := auth.NewUsecase(mockUserRepo, mockRolePermissionRepo, mockRefreshTokenRepo, mockLoginThrottleRepo, mockAuditLogRepo, mockRoleRepo, mockUserTwoFactorRepo, mockRecoveryCodeRepo, mockLoginChallengeRepo, mockUserIdentityRepo, mockOIDCLoginStateRepo, newTestJWTAuth(t), nil, testThrottleConfig, testTwoFactorConfig, internal.OIDCConfig{})
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "revokes all sessions",
+			setupMock: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(5), nil).
+					Return(&entity.User{Base: entity.Base{ID: 5}}, nil)
+				mockRefreshTokenRepo.EXPECT().
+					RevokeByUserID(gomock.Any(), int64(5), gomock.Any(), nil).
+					Return(int64(3), nil)
+			},
+		},
+		{
+			name: "user not found",
+			setupMock: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(5), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.RevokeUserSessions(context.Background(), 5)
+
+			if tt.expectedStatus == 0 {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				return
+			}
+
+			var httpErr interface{ HTTPStatus() int }
+			if !errors.As(err, &httpErr) || httpErr.HTTPStatus() != tt.expectedStatus {
+				t.Errorf("Expected status %d but got error: %v", tt.expectedStatus, err)
+			}
+		})
+	}
+}
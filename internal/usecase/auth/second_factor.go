@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/totp"
+)
+
+const (
+	recoveryCodeCount = 10
+	recoveryCodeBytes = 5
+
+	// totpSkew accepts codes of the previous and the next time step to allow
+	// for clock drift on the authenticator device.
+	totpSkew = 1
+)
+
+// findTwoFactor returns the user's two-factor record, or nil when the user
+// never enrolled.
+func (u *UsecaseImpl) findTwoFactor(ctx context.Context, userID int64) (*entity.UserTwoFactor, error) {
+	twoFactor, err := u.userTwoFactorRepo.FindOneByTemplate(ctx, &entity.UserTwoFactor{UserID: userID}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find two-factor settings", "user_id", userID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find two-factor settings")
+	}
+
+	return twoFactor, nil
+}
+
+// roleRequiresTwoFactor reports whether the user's role enforces two-factor
+// authentication.
+func (u *UsecaseImpl) roleRequiresTwoFactor(ctx context.Context, user *entity.User) (bool, error) {
+	role, err := u.roleRepo.FindOneByTemplate(ctx, &entity.Role{Name: user.Role}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find role", "user_id", user.ID, "role", user.Role, "error", err)
+		return false, httppkg.NewInternalServerError("failed to find role")
+	}
+
+	return role != nil && role.RequireTwoFactor, nil
+}
+
+// enrollmentRequired reports whether the user still has to set up two-factor
+// authentication before getting a token with permissions.
+func (u *UsecaseImpl) enrollmentRequired(ctx context.Context, user *entity.User, twoFactor *entity.UserTwoFactor) (bool, error) {
+	if twoFactor.Enabled() {
+		return false, nil
+	}
+
+	return u.roleRequiresTwoFactor(ctx, user)
+}
+
+// verifySecondFactor accepts either a TOTP code or an unused recovery code.
+// Both are consumed on success so they cannot be replayed.
+func (u *UsecaseImpl) verifySecondFactor(ctx context.Context, twoFactor *entity.UserTwoFactor, code string, now time.Time) (bool, error) {
+	code = normalizeCode(code)
+	if isTOTPCode(code) {
+		step, ok, err := u.validateTOTP(ctx, twoFactor, code, now)
+		if err != nil || !ok {
+			return false, err
+		}
+
+		used, err := u.userTwoFactorRepo.MarkStepUsed(ctx, twoFactor.ID, step, now, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to record two-factor code use", "user_id", twoFactor.UserID, "error", err)
+			return false, httppkg.NewInternalServerError("failed to verify two-factor code")
+		}
+
+		return used, nil
+	}
+
+	recoveryCode, err := u.recoveryCodeRepo.FindOneByTemplate(ctx, &entity.TwoFactorRecoveryCode{UserID: twoFactor.UserID, CodeHash: hashToken(code)}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find recovery code", "user_id", twoFactor.UserID, "error", err)
+		return false, httppkg.NewInternalServerError("failed to verify two-factor code")
+	}
+	if recoveryCode == nil || recoveryCode.UsedAt != nil {
+		return false, nil
+	}
+
+	used, err := u.recoveryCodeRepo.MarkUsed(ctx, recoveryCode.ID, now, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to consume recovery code", "user_id", twoFactor.UserID, "error", err)
+		return false, httppkg.NewInternalServerError("failed to verify two-factor code")
+	}
+	if used {
+		logger.Warn(ctx, "recovery code used", "user_id", twoFactor.UserID)
+	}
+
+	return used, nil
+}
+
+// validateTOTP checks a code against the user's secret without consuming it
+// and returns the time step it belongs to.
+func (u *UsecaseImpl) validateTOTP(ctx context.Context, twoFactor *entity.UserTwoFactor, code string, now time.Time) (int64, bool, error) {
+	secret, err := u.decryptSecret(twoFactor.SecretEncrypted)
+	if err != nil {
+		logger.Error(ctx, "failed to decrypt two-factor secret", "user_id", twoFactor.UserID, "error", err)
+		return 0, false, httppkg.NewInternalServerError("failed to verify two-factor code")
+	}
+
+	step, ok, err := totp.Validate(secret, code, now, totpSkew)
+	if err != nil {
+		logger.Error(ctx, "failed to validate two-factor code", "user_id", twoFactor.UserID, "error", err)
+		return 0, false, httppkg.NewInternalServerError("failed to verify two-factor code")
+	}
+
+	// Codes of a step at or before the last accepted one were already used
+	if !ok || step <= twoFactor.LastUsedStep {
+		return 0, false, nil
+	}
+
+	return step, true, nil
+}
+
+// generateRecoveryCodes returns the plain codes shown to the user once and
+// the records holding their hashes.
+func generateRecoveryCodes(userID int64) ([]string, []entity.TwoFactorRecoveryCode, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	records := make([]entity.TwoFactorRecoveryCode, 0, recoveryCodeCount)
+
+	for range recoveryCodeCount {
+		b := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+
+		code := hex.EncodeToString(b)
+		codes = append(codes, code[:len(code)/2]+"-"+code[len(code)/2:])
+		records = append(records, entity.TwoFactorRecoveryCode{
+			UserID:   userID,
+			CodeHash: hashToken(code),
+		})
+	}
+
+	return codes, records, nil
+}
+
+// normalizeCode strips the separators users tend to type along with a code.
+func normalizeCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *UsecaseImpl) secretCipher() (cipher.AEAD, error) {
+	key, err := u.twoFactorConfig.GetSecretKey()
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// encryptSecret seals a TOTP secret with AES-GCM. The nonce is stored in front
+// of the ciphertext.
+func (u *UsecaseImpl) encryptSecret(secret string) (string, error) {
+	aead, err := u.secretCipher()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (u *UsecaseImpl) decryptSecret(encrypted string) (string, error) {
+	aead, err := u.secretCipher()
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("encrypted secret is too short")
+	}
+
+	secret, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(secret), nil
+}
@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/spf13/cast"
+)
+
+const (
+	loginFailureInvalidCredentials = "invalid_credentials"
+	loginFailureThrottled          = "throttled"
+)
+
+// dummyPasswordHash is compared against when the username does not exist so
+// unknown and existing usernames take the same time to reject.
+const dummyPasswordHash = "$2a$10$LTJoRFJKzgcqOy60UZJYb.K63C0ZLEfvInnmdOWJDCz7rAuqKi8.C"
+
+type throttleTarget struct {
+	scope       string
+	identifier  string
+	maxAttempts int
+}
+
+func (u *UsecaseImpl) throttleTargets(username, ip string) []throttleTarget {
+	targets := []throttleTarget{{
+		scope:       entity.LoginThrottleScopeUsername,
+		identifier:  username,
+		maxAttempts: u.throttleConfig.MaxAttempts,
+	}}
+	if ip != "" {
+		targets = append(targets, throttleTarget{
+			scope:       entity.LoginThrottleScopeIP,
+			identifier:  ip,
+			maxAttempts: u.throttleConfig.IPMaxAttempts,
+		})
+	}
+	return targets
+}
+
+// loginWait returns how long the caller has to wait before the username or the
+// client IP may attempt to log in again.
+func (u *UsecaseImpl) loginWait(ctx context.Context, username, ip string, now time.Time) (time.Duration, error) {
+	var wait time.Duration
+
+	for _, target := range u.throttleTargets(username, ip) {
+		throttle, err := u.loginThrottleRepo.FindOneByTemplate(ctx, &entity.LoginThrottle{Scope: target.scope, Identifier: target.identifier}, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to find login throttle", "scope", target.scope, "error", err)
+			return 0, httppkg.NewInternalServerError("failed to check login attempts")
+		}
+		if throttle == nil {
+			continue
+		}
+
+		if w := u.retryAfter(throttle, target, now); w > wait {
+			wait = w
+		}
+	}
+
+	return wait, nil
+}
+
+// retryAfter returns how long the target has to wait before the next login
+// attempt. Progressive delays only apply to usernames so clients sharing an IP
+// address are not slowed down by each other; IP addresses are only locked out
+// at their own, higher limit.
+func (u *UsecaseImpl) retryAfter(throttle *entity.LoginThrottle, target throttleTarget, now time.Time) time.Duration {
+	cfg := u.throttleConfig
+	var until time.Time
+
+	switch {
+	case target.maxAttempts > 0 && throttle.FailedAttempts >= target.maxAttempts:
+		until = throttle.LastFailedAt.Add(cfg.LockoutDuration)
+	case cfg.AttemptWindow > 0 && now.Sub(throttle.LastFailedAt) > cfg.AttemptWindow:
+		return 0
+	case target.scope == entity.LoginThrottleScopeUsername && cfg.DelayAfter > 0 && throttle.FailedAttempts >= cfg.DelayAfter:
+		until = throttle.LastFailedAt.Add(u.progressiveDelay(throttle.FailedAttempts))
+	}
+
+	if now.Before(until) {
+		return until.Sub(now)
+	}
+	return 0
+}
+
+func (u *UsecaseImpl) progressiveDelay(failedAttempts int) time.Duration {
+	cfg := u.throttleConfig
+	delay := cfg.BaseDelay
+
+	for i := cfg.DelayAfter; i < failedAttempts; i++ {
+		if cfg.MaxDelay > 0 && delay >= cfg.MaxDelay {
+			break
+		}
+		delay *= 2
+	}
+
+	if cfg.MaxDelay > 0 && delay > cfg.MaxDelay {
+		return cfg.MaxDelay
+	}
+	return delay
+}
+
+// recordLoginFailure bumps the failure counters and writes the attempt to the
+// audit log. Errors are only logged so the caller's response stays uniform.
+func (u *UsecaseImpl) recordLoginFailure(ctx context.Context, username, ip string, user *entity.User, reason string, now time.Time) {
+	windowStart := time.Time{}
+	if u.throttleConfig.AttemptWindow > 0 {
+		windowStart = now.Add(-u.throttleConfig.AttemptWindow)
+	}
+
+	var failedAttempts int
+	locked := false
+	for _, target := range u.throttleTargets(username, ip) {
+		throttle, err := u.loginThrottleRepo.RecordFailure(ctx, target.scope, target.identifier, now, windowStart, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to record login failure", "scope", target.scope, "error", err)
+			continue
+		}
+
+		if target.scope == entity.LoginThrottleScopeUsername {
+			failedAttempts = throttle.FailedAttempts
+		}
+		if target.maxAttempts > 0 && throttle.FailedAttempts == target.maxAttempts {
+			locked = true
+			logger.Warn(ctx, "login locked after repeated failures",
+				"scope", target.scope,
+				"identifier", target.identifier,
+				"failed_attempts", throttle.FailedAttempts)
+		}
+	}
+
+	u.auditLoginFailure(ctx, username, ip, user, reason, entity.JSONMap{
+		"failed_attempts": failedAttempts,
+		"locked":          locked,
+	})
+}
+
+func (u *UsecaseImpl) auditLoginFailure(ctx context.Context, username, ip string, user *entity.User, reason string, extra entity.JSONMap) {
+	var recordID int64
+	if user != nil {
+		recordID = user.ID
+	}
+
+	data := entity.JSONMap{
+		"username": username,
+		"reason":   reason,
+	}
+	for k, v := range extra {
+		data[k] = v
+	}
+
+	err := u.auditLogRepo.Create(ctx, &entity.AuditLog{
+		TableName: "users",
+		RecordID:  recordID,
+		Action:    entity.AuditLogActionLoginFailed,
+		DataAfter: data,
+		IPAddress: ip,
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to write login audit log", "username", username, "error", err)
+	}
+}
+
+func clientIP(ctx context.Context) string {
+	return cast.ToString(ctx.Value(constant.ContextKeyIPAddress))
+}
@@ -0,0 +1,267 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal"
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	authmock "github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/auth"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+var testThrottleConfig = internal.LoginThrottleConfig{
+	MaxAttempts:     5,
+	IPMaxAttempts:   20,
+	LockoutDuration: 15 * time.Minute,
+	DelayAfter:      3,
+	BaseDelay:       time.Second,
+	MaxDelay:        30 * time.Second,
+	AttemptWindow:   15 * time.Minute,
+}
+
+func TestAuthUsecase_Auth_Throttle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := authmock.NewMockUserRepository(ctrl)
+	mockRolePermissionRepo := authmock.NewMockRolePermissionRepository(ctrl)
+	mockRefreshTokenRepo := authmock.NewMockRefreshTokenRepository(ctrl)
+	mockLoginThrottleRepo := authmock.NewMockLoginThrottleRepository(ctrl)
+	mockAuditLogRepo := authmock.NewMockAuditLogRepository(ctrl)
+	mockRoleRepo := authmock.NewMockRoleRepository(ctrl)
+	mockUserTwoFactorRepo := authmock.NewMockUserTwoFactorRepository(ctrl)
+	mockRecoveryCodeRepo := authmock.NewMockTwoFactorRecoveryCodeRepository(ctrl)
+	mockLoginChallengeRepo := authmock.NewMockLoginChallengeRepository(ctrl)
+	mockUserIdentityRepo := authmock.NewMockUserIdentityRepository(ctrl)
+	mockOIDCLoginStateRepo := authmock.NewMockOIDCLoginStateRepository(ctrl)
+
+	usecase := auth.NewUsecase(mockUserRepo, mockRolePermissionRepo, mockRefreshTokenRepo, mockLoginThrottleRepo, mockAuditLogRepo, mockRoleRepo, mockUserTwoFactorRepo, mockRecoveryCodeRepo, mockLoginChallengeRepo, mockUserIdentityRepo, mockOIDCLoginStateRepo, newTestJWTAuth(t), nil, testThrottleConfig, testTwoFactorConfig, internal.OIDCConfig{})
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyIPAddress, "10.0.0.1")
+	usernameTemplate := &entity.LoginThrottle{Scope: entity.LoginThrottleScopeUsername, Identifier: "employee001"}
+	ipTemplate := &entity.LoginThrottle{Scope: entity.LoginThrottleScopeIP, Identifier: "10.0.0.1"}
+
+	expectThrottles := func(username, ip *entity.LoginThrottle) {
+		mockLoginThrottleRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), usernameTemplate, nil).
+			Return(username, nil)
+		mockLoginThrottleRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), ipTemplate, nil).
+			Return(ip, nil)
+	}
+	expectAudit := func(reason string) {
+		mockAuditLogRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), nil).
+			DoAndReturn(func(_ context.Context, auditLog *entity.AuditLog, _ *gorm.DB) error {
+				if auditLog.Action != entity.AuditLogActionLoginFailed {
+					t.Errorf("Expected action %s but got %s", entity.AuditLogActionLoginFailed, auditLog.Action)
+				}
+				if auditLog.DataAfter["reason"] != reason {
+					t.Errorf("Expected reason %s but got %v", reason, auditLog.DataAfter["reason"])
+				}
+				if auditLog.IPAddress != "10.0.0.1" {
+					t.Errorf("Expected IP address 10.0.0.1 but got %s", auditLog.IPAddress)
+				}
+				return nil
+			})
+	}
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+		minRetryAfter  time.Duration
+		maxRetryAfter  time.Duration
+	}{
+		{
+			name: "username locked out",
+			setupMock: func() {
+				expectThrottles(&entity.LoginThrottle{FailedAttempts: 5, LastFailedAt: time.Now().Add(-time.Minute)}, nil)
+				expectAudit("throttled")
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			minRetryAfter:  13 * time.Minute,
+			maxRetryAfter:  14 * time.Minute,
+		},
+		{
+			name: "ip locked out",
+			setupMock: func() {
+				expectThrottles(nil, &entity.LoginThrottle{FailedAttempts: 20, LastFailedAt: time.Now()})
+				expectAudit("throttled")
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			minRetryAfter:  14 * time.Minute,
+			maxRetryAfter:  15 * time.Minute,
+		},
+		{
+			name: "progressive delay doubles per failure",
+			setupMock: func() {
+				expectThrottles(&entity.LoginThrottle{FailedAttempts: 4, LastFailedAt: time.Now()}, nil)
+				expectAudit("throttled")
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			minRetryAfter:  time.Second,
+			maxRetryAfter:  2 * time.Second,
+		},
+		{
+			name: "ip failures below lockout are not delayed",
+			setupMock: func() {
+				expectThrottles(nil, &entity.LoginThrottle{FailedAttempts: 10, LastFailedAt: time.Now()})
+				mockUserRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.User{Username: "employee001"}, nil).
+					Return(nil, nil)
+				mockLoginThrottleRepo.EXPECT().
+					RecordFailure(gomock.Any(), entity.LoginThrottleScopeUsername, "employee001", gomock.Any(), gomock.Any(), nil).
+					Return(&entity.LoginThrottle{FailedAttempts: 1}, nil)
+				mockLoginThrottleRepo.EXPECT().
+					RecordFailure(gomock.Any(), entity.LoginThrottleScopeIP, "10.0.0.1", gomock.Any(), gomock.Any(), nil).
+					Return(&entity.LoginThrottle{FailedAttempts: 11}, nil)
+				expectAudit("invalid_credentials")
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "failures outside the attempt window are ignored",
+			setupMock: func() {
+				expectThrottles(&entity.LoginThrottle{FailedAttempts: 4, LastFailedAt: time.Now().Add(-time.Hour)}, nil)
+				mockUserRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.User{Username: "employee001"}, nil).
+					Return(nil, nil)
+				mockLoginThrottleRepo.EXPECT().
+					RecordFailure(gomock.Any(), entity.LoginThrottleScopeUsername, "employee001", gomock.Any(), gomock.Any(), nil).
+					Return(&entity.LoginThrottle{FailedAttempts: 1}, nil)
+				mockLoginThrottleRepo.EXPECT().
+					RecordFailure(gomock.Any(), entity.LoginThrottleScopeIP, "10.0.0.1", gomock.Any(), gomock.Any(), nil).
+					Return(&entity.LoginThrottle{FailedAttempts: 1}, nil)
+				expectAudit("invalid_credentials")
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "throttle lookup error",
+			setupMock: func() {
+				mockLoginThrottleRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), usernameTemplate, nil).
+					Return(nil, gorm.ErrInvalidDB)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.Auth(ctx, "employee001", "password123")
+			if result != nil {
+				t.Error("Expected nil result but got value")
+			}
+
+			var httpErr *httppkg.ErrorWrapper
+			if !errors.As(err, &httpErr) || httpErr.StatusCode != tt.expectedStatus {
+				t.Fatalf("Expected status %d but got error: %v", tt.expectedStatus, err)
+			}
+
+			if tt.expectedStatus == http.StatusTooManyRequests &&
+				(httpErr.RetryAfter < tt.minRetryAfter || httpErr.RetryAfter > tt.maxRetryAfter) {
+				t.Errorf("Expected retry after between %s and %s but got %s", tt.minRetryAfter, tt.maxRetryAfter, httpErr.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestAuthUsecase_UnlockUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := authmock.NewMockUserRepository(ctrl)
+	mockRolePermissionRepo := authmock.NewMockRolePermissionRepository(ctrl)
+	mockRefreshTokenRepo := authmock.NewMockRefreshTokenRepository(ctrl)
+	mockLoginThrottleRepo := authmock.NewMockLoginThrottleRepository(ctrl)
+	mockAuditLogRepo := authmock.NewMockAuditLogRepository(ctrl)
+	mockRoleRepo := authmock.NewMockRoleRepository(ctrl)
+	mockUserTwoFactorRepo := authmock.NewMockUserTwoFactorRepository(ctrl)
+	mockRecoveryCodeRepo := authmock.NewMockTwoFactorRecoveryCodeRepository(ctrl)
+	mockLoginChallengeRepo := authmock.NewMockLoginChallengeRepository(ctrl)
+	mockUserIdentityRepo := authmock.NewMockUserIdentityRepository(ctrl)
+	mockOIDCLoginStateRepo := authmock.NewMockOIDCLoginStateRepository(ctrl)
+
+	usecase := auth.NewUsecase(mockUserRepo, mockRolePermissionRepo, mockRefreshTokenRepo, mockLoginThrottleRepo, mockAuditLogRepo, mockRoleRepo, mockUserTwoFactorRepo, mockRecoveryCodeRepo, mockLoginChallengeRepo, mockUserIdentityRepo, mockOIDCLoginStateRepo, newTestJWTAuth(t), nil, testThrottleConfig, testTwoFactorConfig, internal.OIDCConfig{})
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "1")
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "successful unlock",
+			setupMock: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(5), nil).
+					Return(&entity.User{Base: entity.Base{ID: 5}, Username: "employee004"}, nil)
+				mockLoginThrottleRepo.EXPECT().
+					Reset(gomock.Any(), entity.LoginThrottleScopeUsername, "employee004", nil).
+					Return(nil)
+				mockAuditLogRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, auditLog *entity.AuditLog, _ *gorm.DB) error {
+						if auditLog.Action != entity.AuditLogActionLoginUnlock || auditLog.RecordID != 5 || auditLog.UserID != "1" {
+							t.Errorf("Unexpected audit log: %+v", auditLog)
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name: "user not found",
+			setupMock: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(5), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "reset error",
+			setupMock: func() {
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(5), nil).
+					Return(&entity.User{Base: entity.Base{ID: 5}, Username: "employee004"}, nil)
+				mockLoginThrottleRepo.EXPECT().
+					Reset(gomock.Any(), entity.LoginThrottleScopeUsername, "employee004", nil).
+					Return(gorm.ErrInvalidDB)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.UnlockUser(ctx, 5)
+
+			if tt.expectedStatus == 0 {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				return
+			}
+
+			var httpErr interface{ HTTPStatus() int }
+			if !errors.As(err, &httpErr) || httpErr.HTTPStatus() != tt.expectedStatus {
+				t.Errorf("Expected status %d but got error: %v", tt.expectedStatus, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+)
+
+// issueTokens creates an access/refresh token pair and stores the refresh
+// token record in the given family. Users that still have to change their
+// password or enroll a second factor get an access token without
+// permissions, which only allows the password and two-factor endpoints.
+func (u *UsecaseImpl) issueTokens(ctx context.Context, user *entity.User, familyID string, enrollmentRequired bool) (*Result, *entity.RefreshToken, error) {
+	var permissions []string
+	if !user.MustChangePassword && !enrollmentRequired {
+		var err error
+		permissions, err = u.rolePermissionRepo.FindPermissionsByRoleName(ctx, user.Role, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to find role permissions", "user_id", user.ID, "role", user.Role, "error", err)
+			return nil, nil, err
+		}
+	}
+
+	accessToken, _, err := u.jwtAuth.GenerateAccessToken(ctx, user, permissions)
+	if err != nil {
+		logger.Error(ctx, "failed to generate access token", "user_id", user.ID, "error", err)
+		return nil, nil, err
+	}
+
+	jti, err := newTokenID()
+	if err != nil {
+		logger.Error(ctx, "failed to generate token id", "user_id", user.ID, "error", err)
+		return nil, nil, err
+	}
+
+	refreshToken, expiresAt, err := u.jwtAuth.GenerateRefreshToken(ctx, user, jti)
+	if err != nil {
+		logger.Error(ctx, "failed to generate refresh token", "user_id", user.ID, "error", err)
+		return nil, nil, err
+	}
+
+	stored, err := u.refreshTokenRepo.Create(ctx, &entity.RefreshToken{
+		UserID:    user.ID,
+		JTI:       jti,
+		FamilyID:  familyID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: expiresAt,
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to store refresh token", "user_id", user.ID, "error", err)
+		return nil, nil, err
+	}
+
+	return &Result{
+		AccessToken:                 accessToken,
+		RefreshToken:                refreshToken,
+		PasswordChangeRequired:      user.MustChangePassword,
+		TwoFactorEnrollmentRequired: enrollmentRequired,
+	}, stored, nil
+}
+
+func newTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,219 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/totp"
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+type TwoFactorEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// EnrollTwoFactor generates a new TOTP secret for the authenticated user.
+// The secret only takes effect once ActivateTwoFactor confirmed it with a
+// valid code, so enrolling again before that simply replaces it.
+func (u *UsecaseImpl) EnrollTwoFactor(ctx context.Context) (*TwoFactorEnrollment, error) {
+	user, err := u.authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		logger.Error(ctx, "failed to generate two-factor secret", "user_id", user.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to enroll two-factor authentication")
+	}
+
+	encrypted, err := u.encryptSecret(secret)
+	if err != nil {
+		logger.Error(ctx, "failed to encrypt two-factor secret", "user_id", user.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to enroll two-factor authentication")
+	}
+
+	twoFactor, err := u.userTwoFactorRepo.Enroll(ctx, user.ID, encrypted, time.Now(), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to store two-factor secret", "user_id", user.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to enroll two-factor authentication")
+	}
+	if twoFactor == nil {
+		return nil, httppkg.NewConflictError("two-factor authentication is already enabled")
+	}
+
+	logger.Info(ctx, "two-factor enrollment started", "user_id", user.ID)
+
+	return &TwoFactorEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(u.twoFactorConfig.Issuer, user.Username, secret),
+	}, nil
+}
+
+// ActivateTwoFactor confirms a pending enrollment with a code from the
+// authenticator app and returns freshly generated recovery codes. Every
+// session of the user ends, so the next login goes through the challenge.
+func (u *UsecaseImpl) ActivateTwoFactor(ctx context.Context, code string) ([]string, error) {
+	user, err := u.authenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := u.findTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor == nil {
+		return nil, httppkg.NewUnprocessableEntityError("two-factor enrollment has not been started")
+	}
+	if twoFactor.Enabled() {
+		return nil, httppkg.NewConflictError("two-factor authentication is already enabled")
+	}
+
+	now := time.Now()
+	step, ok, err := u.validateTOTP(ctx, twoFactor, normalizeCode(code), now)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, httppkg.NewUnprocessableEntityError("invalid two-factor code")
+	}
+
+	codes, records, err := generateRecoveryCodes(user.ID)
+	if err != nil {
+		logger.Error(ctx, "failed to generate recovery codes", "user_id", user.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to activate two-factor authentication")
+	}
+
+	activated, err := u.userTwoFactorRepo.Activate(ctx, twoFactor, step, records, now, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to activate two-factor authentication", "user_id", user.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to activate two-factor authentication")
+	}
+	if !activated {
+		return nil, httppkg.NewConflictError("two-factor authentication is already enabled")
+	}
+
+	if _, err := u.refreshTokenRepo.RevokeByUserID(ctx, user.ID, now, nil); err != nil {
+		logger.Error(ctx, "failed to revoke sessions after two-factor activation", "user_id", user.ID, "error", err)
+	}
+
+	u.auditTwoFactor(ctx, user, entity.AuditLogActionTwoFactorEnable)
+
+	logger.Info(ctx, "two-factor authentication enabled", "user_id", user.ID)
+
+	return codes, nil
+}
+
+// DisableTwoFactor turns two-factor authentication off for the authenticated
+// user after checking a TOTP or recovery code. Users whose role requires two
+// factors cannot disable it.
+func (u *UsecaseImpl) DisableTwoFactor(ctx context.Context, code string) error {
+	user, err := u.authenticatedUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	twoFactor, err := u.findTwoFactor(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if !twoFactor.Enabled() {
+		return httppkg.NewUnprocessableEntityError("two-factor authentication is not enabled")
+	}
+
+	required, err := u.roleRequiresTwoFactor(ctx, user)
+	if err != nil {
+		return err
+	}
+	if required {
+		return httppkg.NewForbiddenError("two-factor authentication is required for your role")
+	}
+
+	ok, err := u.verifySecondFactor(ctx, twoFactor, code, time.Now())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return httppkg.NewUnprocessableEntityError("invalid two-factor code")
+	}
+
+	if err := u.userTwoFactorRepo.DeleteByUserID(ctx, user.ID, nil); err != nil {
+		logger.Error(ctx, "failed to disable two-factor authentication", "user_id", user.ID, "error", err)
+		return httppkg.NewInternalServerError("failed to disable two-factor authentication")
+	}
+
+	u.auditTwoFactor(ctx, user, entity.AuditLogActionTwoFactorDisable)
+
+	logger.Info(ctx, "two-factor authentication disabled", "user_id", user.ID)
+
+	return nil
+}
+
+// ResetTwoFactor removes the second factor of a user who lost their device
+// and ends their sessions. Users whose role requires two factors have to
+// enroll again on their next login.
+func (u *UsecaseImpl) ResetTwoFactor(ctx context.Context, userID int64) error {
+	user, err := u.userRepo.FindByID(ctx, uint(userID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find user", "user_id", userID, "error", err)
+		return httppkg.NewInternalServerError("failed to find user")
+	}
+	if user == nil {
+		return httppkg.NewNotFoundError("user not found")
+	}
+
+	if err := u.userTwoFactorRepo.DeleteByUserID(ctx, user.ID, nil); err != nil {
+		logger.Error(ctx, "failed to reset two-factor authentication", "user_id", user.ID, "error", err)
+		return httppkg.NewInternalServerError("failed to reset two-factor authentication")
+	}
+
+	if _, err := u.refreshTokenRepo.RevokeByUserID(ctx, user.ID, time.Now(), nil); err != nil {
+		logger.Error(ctx, "failed to revoke sessions after two-factor reset", "user_id", user.ID, "error", err)
+	}
+
+	u.auditTwoFactor(ctx, user, entity.AuditLogActionTwoFactorReset)
+
+	logger.Info(ctx, "two-factor authentication reset", "user_id", user.ID)
+
+	return nil
+}
+
+func (u *UsecaseImpl) authenticatedUser(ctx context.Context) (*entity.User, error) {
+	userID := cast.ToInt64(ctx.Value(constant.ContextKeyUserID))
+	if userID == 0 {
+		return nil, httppkg.NewUnauthorizedError("user not authenticated")
+	}
+
+	user, err := u.userRepo.FindByID(ctx, uint(userID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find user", "user_id", userID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find user")
+	}
+	if user == nil {
+		return nil, httppkg.NewUnauthorizedError("user not found")
+	}
+
+	return user, nil
+}
+
+func (u *UsecaseImpl) auditTwoFactor(ctx context.Context, user *entity.User, action string) {
+	err := u.auditLogRepo.Create(ctx, &entity.AuditLog{
+		TableName: "users",
+		RecordID:  user.ID,
+		Action:    action,
+		DataAfter: entity.JSONMap{"username": user.Username},
+		UserID:    cast.ToString(ctx.Value(constant.ContextKeyUserID)),
+		IPAddress: clientIP(ctx),
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to write two-factor audit log", "user_id", user.ID, "action", action, "error", err)
+	}
+}
@@ -0,0 +1,661 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal"
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	authmock "github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/auth"
+	"github.com/asyauqi15/payslip-system/pkg/totp"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+var testTwoFactorConfig = internal.TwoFactorConfig{
+	Issuer:               "Payslip System",
+	SecretKey:            []byte("test-two-factor-secret-key-32byt"),
+	ChallengeDuration:    5 * time.Minute,
+	MaxChallengeAttempts: 5,
+}
+
+const testTOTPSecret = "JBSWY3DPEHPK3PXP"
+
+// expectNoTwoFactor sets up a user without a second factor whose role does
+// not require one.
+func expectNoTwoFactor(userTwoFactorRepo *authmock.MockUserTwoFactorRepository, roleRepo *authmock.MockRoleRepository, userID int64, role string) {
+	userTwoFactorRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.UserTwoFactor{UserID: userID}, nil).
+		Return(nil, nil)
+	roleRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.Role{Name: role}, nil).
+		Return(&entity.Role{Name: role}, nil)
+}
+
+// encryptTestSecret seals a secret the same way the usecase stores it.
+func encryptTestSecret(t *testing.T, secret string) string {
+	t.Helper()
+
+	key, err := testTwoFactorConfig.GetSecretKey()
+	if err != nil {
+		t.Fatalf("Failed to decode secret key: %v", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatalf("Failed to create cipher: %v", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatalf("Failed to create GCM: %v", err)
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		t.Fatalf("Failed to generate nonce: %v", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(secret), nil))
+}
+
+func assertHTTPStatus(t *testing.T, err error, expectedStatus int) {
+	t.Helper()
+
+	if err == nil {
+		t.Fatalf("Expected status %d but got no error", expectedStatus)
+	}
+	var httpErr interface{ HTTPStatus() int }
+	if !errors.As(err, &httpErr) || httpErr.HTTPStatus() != expectedStatus {
+		t.Errorf("Expected status %d but got error: %v", expectedStatus, err)
+	}
+}
+
+type twoFactorMocks struct {
+	userRepo           *authmock.MockUserRepository
+	rolePermissionRepo *authmock.MockRolePermissionRepository
+	refreshTokenRepo   *authmock.MockRefreshTokenRepository
+	loginThrottleRepo  *authmock.MockLoginThrottleRepository
+	auditLogRepo       *authmock.MockAuditLogRepository
+	roleRepo           *authmock.MockRoleRepository
+	userTwoFactorRepo  *authmock.MockUserTwoFactorRepository
+	recoveryCodeRepo   *authmock.MockTwoFactorRecoveryCodeRepository
+	loginChallengeRepo *authmock.MockLoginChallengeRepository
+	userIdentityRepo   *authmock.MockUserIdentityRepository
+	oidcLoginStateRepo *authmock.MockOIDCLoginStateRepository
+}
+
+func setupTwoFactorTest(t *testing.T) (auth.Usecase, *twoFactorMocks) {
+	ctrl := gomock.NewController(t)
+
+	m := &twoFactorMocks{
+		userRepo:           authmock.NewMockUserRepository(ctrl),
+		rolePermissionRepo: authmock.NewMockRolePermissionRepository(ctrl),
+		refreshTokenRepo:   authmock.NewMockRefreshTokenRepository(ctrl),
+		loginThrottleRepo:  authmock.NewMockLoginThrottleRepository(ctrl),
+		auditLogRepo:       authmock.NewMockAuditLogRepository(ctrl),
+		roleRepo:           authmock.NewMockRoleRepository(ctrl),
+		userTwoFactorRepo:  authmock.NewMockUserTwoFactorRepository(ctrl),
+		recoveryCodeRepo:   authmock.NewMockTwoFactorRecoveryCodeRepository(ctrl),
+		loginChallengeRepo: authmock.NewMockLoginChallengeRepository(ctrl),
+		userIdentityRepo:   authmock.NewMockUserIdentityRepository(ctrl),
+		oidcLoginStateRepo: authmock.NewMockOIDCLoginStateRepository(ctrl),
+	}
+
+	usecase := auth.NewUsecase(m.userRepo, m.rolePermissionRepo, m.refreshTokenRepo, m.loginThrottleRepo, m.auditLogRepo,
+		m.roleRepo, m.userTwoFactorRepo, m.recoveryCodeRepo, m.loginChallengeRepo, m.userIdentityRepo, m.oidcLoginStateRepo, newTestJWTAuth(t), nil, testThrottleConfig, testTwoFactorConfig, internal.OIDCConfig{})
+
+	return usecase, m
+}
+
+func TestAuthUsecase_Auth_TwoFactorChallenge(t *testing.T) {
+	usecase, m := setupTwoFactorTest(t)
+
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	user := &entity.User{Base: entity.Base{ID: 1}, Username: "admin", PasswordHash: string(hashedPassword), Role: entity.UserRoleAdmin}
+	enabledAt := time.Now().Add(-time.Hour)
+
+	m.loginThrottleRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.LoginThrottle{Scope: entity.LoginThrottleScopeUsername, Identifier: "admin"}, nil).
+		Return(nil, nil)
+	m.userRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.User{Username: "admin"}, nil).
+		Return(user, nil)
+	m.userTwoFactorRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.UserTwoFactor{UserID: 1}, nil).
+		Return(&entity.UserTwoFactor{Base: entity.Base{ID: 7}, UserID: 1, EnabledAt: &enabledAt}, nil)
+
+	// No throttle reset and no tokens until the second factor is verified
+	var storedHash string
+	m.loginChallengeRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any(), nil).
+		DoAndReturn(func(_ context.Context, challenge *entity.LoginChallenge, _ *gorm.DB) (*entity.LoginChallenge, error) {
+			if challenge.UserID != 1 {
+				t.Errorf("Expected challenge for user 1 but got %d", challenge.UserID)
+			}
+			storedHash = challenge.TokenHash
+			return challenge, nil
+		})
+
+	result, err := usecase.Auth(context.Background(), "admin", "password")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if result.AccessToken != "" || result.RefreshToken != "" {
+		t.Error("Expected no tokens before the second factor")
+	}
+	if result.ChallengeToken == "" || sha256Hex(result.ChallengeToken) != storedHash {
+		t.Error("Expected the challenge token to be stored as a hash")
+	}
+	if result.ChallengeExpiresAt.Before(time.Now().Add(4 * time.Minute)) {
+		t.Errorf("Unexpected challenge expiry %v", result.ChallengeExpiresAt)
+	}
+}
+
+func TestAuthUsecase_Auth_TwoFactorEnrollmentRequired(t *testing.T) {
+	usecase, m := setupTwoFactorTest(t)
+	jwtAuth := newTestJWTAuth(t)
+
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	user := &entity.User{Base: entity.Base{ID: 1}, Username: "admin", PasswordHash: string(hashedPassword), Role: entity.UserRoleAdmin}
+
+	m.loginThrottleRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.LoginThrottle{Scope: entity.LoginThrottleScopeUsername, Identifier: "admin"}, nil).
+		Return(nil, nil)
+	m.userRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.User{Username: "admin"}, nil).
+		Return(user, nil)
+	m.userTwoFactorRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.UserTwoFactor{UserID: 1}, nil).
+		Return(nil, nil)
+	m.loginThrottleRepo.EXPECT().
+		Reset(gomock.Any(), entity.LoginThrottleScopeUsername, "admin", nil).
+		Return(nil)
+	m.roleRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.Role{Name: entity.UserRoleAdmin}, nil).
+		Return(&entity.Role{Name: entity.UserRoleAdmin, RequireTwoFactor: true}, nil)
+	// No permission lookup: the token only allows enrolling
+	m.refreshTokenRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any(), nil).
+		DoAndReturn(func(_ context.Context, token *entity.RefreshToken, _ *gorm.DB) (*entity.RefreshToken, error) {
+			return token, nil
+		})
+
+	result, err := usecase.Auth(context.Background(), "admin", "password")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if !result.TwoFactorEnrollmentRequired {
+		t.Error("Expected two-factor enrollment to be required")
+	}
+
+	claims, err := jwtAuth.ParseAccessToken(context.Background(), result.AccessToken)
+	if err != nil {
+		t.Fatalf("Failed to parse access token: %v", err)
+	}
+	if len(claims.Permissions) != 0 {
+		t.Errorf("Expected no permissions but got %v", claims.Permissions)
+	}
+}
+
+func TestAuthUsecase_VerifyLoginChallenge(t *testing.T) {
+	usecase, m := setupTwoFactorTest(t)
+
+	now := time.Now()
+	code, err := totp.GenerateCode(testTOTPSecret, now)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	step := totp.Step(now)
+	encrypted := encryptTestSecret(t, testTOTPSecret)
+	enabledAt := now.Add(-time.Hour)
+
+	user := &entity.User{Base: entity.Base{ID: 1}, Username: "admin", Role: entity.UserRoleAdmin}
+	challengeToken := "challenge-token"
+
+	challenge := func(modify func(*entity.LoginChallenge)) *entity.LoginChallenge {
+		c := &entity.LoginChallenge{
+			Base:      entity.Base{ID: 5},
+			UserID:    1,
+			TokenHash: sha256Hex(challengeToken),
+			ExpiresAt: now.Add(5 * time.Minute),
+		}
+		if modify != nil {
+			modify(c)
+		}
+		return c
+	}
+	twoFactor := func(lastUsedStep int64) *entity.UserTwoFactor {
+		return &entity.UserTwoFactor{
+			Base:            entity.Base{ID: 7},
+			UserID:          1,
+			SecretEncrypted: encrypted,
+			EnabledAt:       &enabledAt,
+			LastUsedStep:    lastUsedStep,
+		}
+	}
+
+	expectChallenge := func(c *entity.LoginChallenge) {
+		m.loginChallengeRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.LoginChallenge{TokenHash: sha256Hex(challengeToken)}, nil).
+			Return(c, nil)
+	}
+	expectUser := func(throttle *entity.LoginThrottle, tf *entity.UserTwoFactor) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(1), nil).
+			Return(user, nil)
+		m.loginThrottleRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.LoginThrottle{Scope: entity.LoginThrottleScopeUsername, Identifier: "admin"}, nil).
+			Return(throttle, nil)
+		if tf != nil {
+			m.userTwoFactorRepo.EXPECT().
+				FindOneByTemplate(gomock.Any(), &entity.UserTwoFactor{UserID: 1}, nil).
+				Return(tf, nil)
+		}
+	}
+	expectSession := func() {
+		m.loginChallengeRepo.EXPECT().
+			Complete(gomock.Any(), int64(5), gomock.Any(), nil).
+			Return(true, nil)
+		m.loginThrottleRepo.EXPECT().
+			Reset(gomock.Any(), entity.LoginThrottleScopeUsername, "admin", nil).
+			Return(nil)
+		m.rolePermissionRepo.EXPECT().
+			FindPermissionsByRoleName(gomock.Any(), entity.UserRoleAdmin, nil).
+			Return([]string{entity.PermissionPayrollRun}, nil)
+		m.refreshTokenRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), nil).
+			DoAndReturn(func(_ context.Context, token *entity.RefreshToken, _ *gorm.DB) (*entity.RefreshToken, error) {
+				return token, nil
+			})
+	}
+	expectFailure := func() {
+		m.loginChallengeRepo.EXPECT().
+			RecordFailure(gomock.Any(), int64(5), gomock.Any(), nil).
+			Return(nil)
+		m.loginThrottleRepo.EXPECT().
+			RecordFailure(gomock.Any(), entity.LoginThrottleScopeUsername, "admin", gomock.Any(), gomock.Any(), nil).
+			Return(&entity.LoginThrottle{FailedAttempts: 1}, nil)
+		m.auditLogRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), nil).
+			DoAndReturn(func(_ context.Context, auditLog *entity.AuditLog, _ *gorm.DB) error {
+				if auditLog.DataAfter["reason"] != "invalid_second_factor" {
+					t.Errorf("Expected reason invalid_second_factor but got %v", auditLog.DataAfter["reason"])
+				}
+				return nil
+			})
+	}
+
+	tests := []struct {
+		name           string
+		code           string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "valid totp code",
+			code: code,
+			setupMock: func() {
+				expectChallenge(challenge(nil))
+				expectUser(nil, twoFactor(0))
+				m.userTwoFactorRepo.EXPECT().
+					MarkStepUsed(gomock.Any(), int64(7), step, gomock.Any(), nil).
+					Return(true, nil)
+				expectSession()
+			},
+		},
+		{
+			name: "replayed totp code",
+			code: code,
+			setupMock: func() {
+				expectChallenge(challenge(nil))
+				expectUser(nil, twoFactor(step))
+				expectFailure()
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "totp code used concurrently",
+			code: code,
+			setupMock: func() {
+				expectChallenge(challenge(nil))
+				expectUser(nil, twoFactor(0))
+				m.userTwoFactorRepo.EXPECT().
+					MarkStepUsed(gomock.Any(), int64(7), step, gomock.Any(), nil).
+					Return(false, nil)
+				expectFailure()
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "valid recovery code",
+			code: "ABCDE-12345",
+			setupMock: func() {
+				expectChallenge(challenge(nil))
+				expectUser(nil, twoFactor(0))
+				m.recoveryCodeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.TwoFactorRecoveryCode{UserID: 1, CodeHash: sha256Hex("abcde12345")}, nil).
+					Return(&entity.TwoFactorRecoveryCode{Base: entity.Base{ID: 3}, UserID: 1}, nil)
+				m.recoveryCodeRepo.EXPECT().
+					MarkUsed(gomock.Any(), int64(3), gomock.Any(), nil).
+					Return(true, nil)
+				expectSession()
+			},
+		},
+		{
+			name: "used recovery code",
+			code: "abcde-12345",
+			setupMock: func() {
+				usedAt := now.Add(-time.Minute)
+				expectChallenge(challenge(nil))
+				expectUser(nil, twoFactor(0))
+				m.recoveryCodeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.TwoFactorRecoveryCode{UserID: 1, CodeHash: sha256Hex("abcde12345")}, nil).
+					Return(&entity.TwoFactorRecoveryCode{Base: entity.Base{ID: 3}, UserID: 1, UsedAt: &usedAt}, nil)
+				expectFailure()
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "unknown challenge",
+			code: code,
+			setupMock: func() {
+				expectChallenge(nil)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "expired challenge",
+			code: code,
+			setupMock: func() {
+				expectChallenge(challenge(func(c *entity.LoginChallenge) { c.ExpiresAt = now.Add(-time.Second) }))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "completed challenge",
+			code: code,
+			setupMock: func() {
+				expectChallenge(challenge(func(c *entity.LoginChallenge) { c.CompletedAt = &now }))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "challenge attempts exhausted",
+			code: code,
+			setupMock: func() {
+				expectChallenge(challenge(func(c *entity.LoginChallenge) { c.FailedAttempts = 5 }))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "username locked out",
+			code: code,
+			setupMock: func() {
+				expectChallenge(challenge(nil))
+				expectUser(&entity.LoginThrottle{FailedAttempts: 5, LastFailedAt: now}, nil)
+				m.auditLogRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					Return(nil)
+			},
+			expectedStatus: http.StatusTooManyRequests,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.VerifyLoginChallenge(context.Background(), challengeToken, tt.code)
+
+			if tt.expectedStatus != 0 {
+				assertHTTPStatus(t, err, tt.expectedStatus)
+				if result != nil {
+					t.Error("Expected nil result but got value")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if result.AccessToken == "" || result.RefreshToken == "" {
+				t.Error("Expected a token pair")
+			}
+		})
+	}
+}
+
+func TestAuthUsecase_EnrollTwoFactor(t *testing.T) {
+	usecase, m := setupTwoFactorTest(t)
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "1")
+	user := &entity.User{Base: entity.Base{ID: 1}, Username: "admin", Role: entity.UserRoleAdmin}
+
+	t.Run("successful enrollment", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(1), nil).
+			Return(user, nil)
+		m.userTwoFactorRepo.EXPECT().
+			Enroll(gomock.Any(), int64(1), gomock.Any(), gomock.Any(), nil).
+			DoAndReturn(func(_ context.Context, userID int64, secretEncrypted string, _ time.Time, _ *gorm.DB) (*entity.UserTwoFactor, error) {
+				if secretEncrypted == "" {
+					t.Error("Expected an encrypted secret")
+				}
+				return &entity.UserTwoFactor{Base: entity.Base{ID: 7}, UserID: userID, SecretEncrypted: secretEncrypted}, nil
+			})
+
+		enrollment, err := usecase.EnrollTwoFactor(ctx)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %v", err)
+		}
+		if enrollment.Secret == "" {
+			t.Error("Expected a secret")
+		}
+		if !strings.HasPrefix(enrollment.ProvisioningURI, "otpauth://totp/Payslip%20System:admin?") ||
+			!strings.Contains(enrollment.ProvisioningURI, "secret="+enrollment.Secret) {
+			t.Errorf("Unexpected provisioning URI %s", enrollment.ProvisioningURI)
+		}
+	})
+
+	t.Run("already enabled", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(1), nil).
+			Return(user, nil)
+		m.userTwoFactorRepo.EXPECT().
+			Enroll(gomock.Any(), int64(1), gomock.Any(), gomock.Any(), nil).
+			Return(nil, nil)
+
+		_, err := usecase.EnrollTwoFactor(ctx)
+		assertHTTPStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("not authenticated", func(t *testing.T) {
+		_, err := usecase.EnrollTwoFactor(context.Background())
+		assertHTTPStatus(t, err, http.StatusUnauthorized)
+	})
+}
+
+func TestAuthUsecase_ActivateTwoFactor(t *testing.T) {
+	usecase, m := setupTwoFactorTest(t)
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "1")
+	user := &entity.User{Base: entity.Base{ID: 1}, Username: "admin", Role: entity.UserRoleAdmin}
+	now := time.Now()
+	code, err := totp.GenerateCode(testTOTPSecret, now)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	pending := &entity.UserTwoFactor{Base: entity.Base{ID: 7}, UserID: 1, SecretEncrypted: encryptTestSecret(t, testTOTPSecret)}
+
+	expectLookup := func(twoFactor *entity.UserTwoFactor) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(1), nil).
+			Return(user, nil)
+		m.userTwoFactorRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.UserTwoFactor{UserID: 1}, nil).
+			Return(twoFactor, nil)
+	}
+
+	t.Run("successful activation", func(t *testing.T) {
+		var stored []entity.TwoFactorRecoveryCode
+		expectLookup(pending)
+		m.userTwoFactorRepo.EXPECT().
+			Activate(gomock.Any(), pending, totp.Step(now), gomock.Any(), gomock.Any(), nil).
+			DoAndReturn(func(_ context.Context, _ *entity.UserTwoFactor, _ int64, recoveryCodes []entity.TwoFactorRecoveryCode, _ time.Time, _ *gorm.DB) (bool, error) {
+				stored = recoveryCodes
+				return true, nil
+			})
+		m.refreshTokenRepo.EXPECT().
+			RevokeByUserID(gomock.Any(), int64(1), gomock.Any(), nil).
+			Return(int64(1), nil)
+		m.auditLogRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), nil).
+			DoAndReturn(func(_ context.Context, auditLog *entity.AuditLog, _ *gorm.DB) error {
+				if auditLog.Action != entity.AuditLogActionTwoFactorEnable {
+					t.Errorf("Expected action %s but got %s", entity.AuditLogActionTwoFactorEnable, auditLog.Action)
+				}
+				return nil
+			})
+
+		codes, err := usecase.ActivateTwoFactor(ctx, code)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %v", err)
+		}
+		if len(codes) != 10 || len(stored) != len(codes) {
+			t.Fatalf("Expected 10 recovery codes but got %d (%d stored)", len(codes), len(stored))
+		}
+		for i, recoveryCode := range codes {
+			if stored[i].UserID != 1 || stored[i].CodeHash != sha256Hex(strings.ReplaceAll(recoveryCode, "-", "")) {
+				t.Errorf("Recovery code %s is not stored as its hash", recoveryCode)
+			}
+		}
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		expectLookup(pending)
+
+		_, err := usecase.ActivateTwoFactor(ctx, "abcdef")
+		assertHTTPStatus(t, err, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("enrollment not started", func(t *testing.T) {
+		expectLookup(nil)
+
+		_, err := usecase.ActivateTwoFactor(ctx, code)
+		assertHTTPStatus(t, err, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("already enabled", func(t *testing.T) {
+		enabledAt := now.Add(-time.Hour)
+		expectLookup(&entity.UserTwoFactor{Base: entity.Base{ID: 7}, UserID: 1, EnabledAt: &enabledAt})
+
+		_, err := usecase.ActivateTwoFactor(ctx, code)
+		assertHTTPStatus(t, err, http.StatusConflict)
+	})
+}
+
+func TestAuthUsecase_DisableTwoFactor(t *testing.T) {
+	usecase, m := setupTwoFactorTest(t)
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "2")
+	user := &entity.User{Base: entity.Base{ID: 2}, Username: "employee001", Role: entity.UserRoleDefault}
+	now := time.Now()
+	code, err := totp.GenerateCode(testTOTPSecret, now)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	enabledAt := now.Add(-time.Hour)
+	enabled := &entity.UserTwoFactor{Base: entity.Base{ID: 8}, UserID: 2, SecretEncrypted: encryptTestSecret(t, testTOTPSecret), EnabledAt: &enabledAt}
+
+	expectLookup := func(requireTwoFactor bool) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(2), nil).
+			Return(user, nil)
+		m.userTwoFactorRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.UserTwoFactor{UserID: 2}, nil).
+			Return(enabled, nil)
+		m.roleRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.Role{Name: entity.UserRoleDefault}, nil).
+			Return(&entity.Role{Name: entity.UserRoleDefault, RequireTwoFactor: requireTwoFactor}, nil)
+	}
+
+	t.Run("successful disable", func(t *testing.T) {
+		expectLookup(false)
+		m.userTwoFactorRepo.EXPECT().
+			MarkStepUsed(gomock.Any(), int64(8), totp.Step(now), gomock.Any(), nil).
+			Return(true, nil)
+		m.userTwoFactorRepo.EXPECT().
+			DeleteByUserID(gomock.Any(), int64(2), nil).
+			Return(nil)
+		m.auditLogRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), nil).
+			Return(nil)
+
+		if err := usecase.DisableTwoFactor(ctx, code); err != nil {
+			t.Fatalf("Expected no error but got: %v", err)
+		}
+	})
+
+	t.Run("required by role", func(t *testing.T) {
+		expectLookup(true)
+
+		assertHTTPStatus(t, usecase.DisableTwoFactor(ctx, code), http.StatusForbidden)
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		expectLookup(false)
+		m.recoveryCodeRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.TwoFactorRecoveryCode{UserID: 2, CodeHash: sha256Hex("abcdef")}, nil).
+			Return(nil, nil)
+
+		assertHTTPStatus(t, usecase.DisableTwoFactor(ctx, "abcdef"), http.StatusUnprocessableEntity)
+	})
+}
+
+func TestAuthUsecase_ResetTwoFactor(t *testing.T) {
+	usecase, m := setupTwoFactorTest(t)
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "1")
+
+	t.Run("successful reset", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(2), nil).
+			Return(&entity.User{Base: entity.Base{ID: 2}, Username: "employee001"}, nil)
+		m.userTwoFactorRepo.EXPECT().
+			DeleteByUserID(gomock.Any(), int64(2), nil).
+			Return(nil)
+		m.refreshTokenRepo.EXPECT().
+			RevokeByUserID(gomock.Any(), int64(2), gomock.Any(), nil).
+			Return(int64(3), nil)
+		m.auditLogRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), nil).
+			DoAndReturn(func(_ context.Context, auditLog *entity.AuditLog, _ *gorm.DB) error {
+				if auditLog.Action != entity.AuditLogActionTwoFactorReset || auditLog.RecordID != 2 || auditLog.UserID != "1" {
+					t.Errorf("Unexpected audit log %+v", auditLog)
+				}
+				return nil
+			})
+
+		if err := usecase.ResetTwoFactor(ctx, 2); err != nil {
+			t.Fatalf("Expected no error but got: %v", err)
+		}
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(99), nil).
+			Return(nil, gorm.ErrRecordNotFound)
+
+		assertHTTPStatus(t, usecase.ResetTwoFactor(ctx, 99), http.StatusNotFound)
+	})
+}
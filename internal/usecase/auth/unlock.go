@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+// UnlockUser clears the failed login counter of a user's username. IP address
+// lockouts are left to expire on their own.
+func (u *UsecaseImpl) UnlockUser(ctx context.Context, userID int64) error {
+	user, err := u.userRepo.FindByID(ctx, uint(userID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find user", "user_id", userID, "error", err)
+		return httppkg.NewInternalServerError("failed to find user")
+	}
+	if user == nil {
+		return httppkg.NewNotFoundError("user not found")
+	}
+
+	if err := u.loginThrottleRepo.Reset(ctx, entity.LoginThrottleScopeUsername, user.Username, nil); err != nil {
+		logger.Error(ctx, "failed to reset login throttle", "user_id", userID, "error", err)
+		return httppkg.NewInternalServerError("failed to unlock user")
+	}
+
+	err = u.auditLogRepo.Create(ctx, &entity.AuditLog{
+		TableName: "users",
+		RecordID:  user.ID,
+		Action:    entity.AuditLogActionLoginUnlock,
+		DataAfter: entity.JSONMap{"username": user.Username},
+		UserID:    cast.ToString(ctx.Value(constant.ContextKeyUserID)),
+		IPAddress: clientIP(ctx),
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to write unlock audit log", "user_id", userID, "error", err)
+	}
+
+	logger.Info(ctx, "user login unlocked", "user_id", userID)
+
+	return nil
+}
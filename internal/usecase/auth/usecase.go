@@ -2,23 +2,82 @@ package auth
 
 import (
 	"context"
+
+	"github.com/asyauqi15/payslip-system/internal"
 	"github.com/asyauqi15/payslip-system/internal/repository"
 	jwt_auth "github.com/asyauqi15/payslip-system/pkg/jwt-auth"
+	"github.com/asyauqi15/payslip-system/pkg/oidc"
 )
 
 //go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/auth Usecase
 type Usecase interface {
 	Auth(ctx context.Context, email string, password string) (*Result, error)
+	Refresh(ctx context.Context, refreshToken string) (*Result, error)
+	Logout(ctx context.Context, refreshToken string) error
+	RevokeUserSessions(ctx context.Context, userID int64) error
+	UnlockUser(ctx context.Context, userID int64) error
+	VerifyLoginChallenge(ctx context.Context, challengeToken string, code string) (*Result, error)
+	EnrollTwoFactor(ctx context.Context) (*TwoFactorEnrollment, error)
+	ActivateTwoFactor(ctx context.Context, code string) ([]string, error)
+	DisableTwoFactor(ctx context.Context, code string) error
+	ResetTwoFactor(ctx context.Context, userID int64) error
+	StartOIDCLogin(ctx context.Context) (*OIDCAuthorization, error)
+	CompleteOIDCLogin(ctx context.Context, code string, state string) (*Result, error)
 }
 
 type UsecaseImpl struct {
-	userRepo repository.UserRepository
-	jwtAuth  *jwt_auth.JWTAuthentication
+	userRepo           repository.UserRepository
+	rolePermissionRepo repository.RolePermissionRepository
+	refreshTokenRepo   repository.RefreshTokenRepository
+	loginThrottleRepo  repository.LoginThrottleRepository
+	auditLogRepo       repository.AuditLogRepository
+	roleRepo           repository.RoleRepository
+	userTwoFactorRepo  repository.UserTwoFactorRepository
+	recoveryCodeRepo   repository.TwoFactorRecoveryCodeRepository
+	loginChallengeRepo repository.LoginChallengeRepository
+	userIdentityRepo   repository.UserIdentityRepository
+	oidcLoginStateRepo repository.OIDCLoginStateRepository
+	jwtAuth            *jwt_auth.JWTAuthentication
+	oidcClient         *oidc.Client
+	throttleConfig     internal.LoginThrottleConfig
+	twoFactorConfig    internal.TwoFactorConfig
+	oidcConfig         internal.OIDCConfig
 }
 
-func NewUsecase(userRepo repository.UserRepository, jwtAuth *jwt_auth.JWTAuthentication) Usecase {
+func NewUsecase(
+	userRepo repository.UserRepository,
+	rolePermissionRepo repository.RolePermissionRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	loginThrottleRepo repository.LoginThrottleRepository,
+	auditLogRepo repository.AuditLogRepository,
+	roleRepo repository.RoleRepository,
+	userTwoFactorRepo repository.UserTwoFactorRepository,
+	recoveryCodeRepo repository.TwoFactorRecoveryCodeRepository,
+	loginChallengeRepo repository.LoginChallengeRepository,
+	userIdentityRepo repository.UserIdentityRepository,
+	oidcLoginStateRepo repository.OIDCLoginStateRepository,
+	jwtAuth *jwt_auth.JWTAuthentication,
+	oidcClient *oidc.Client,
+	throttleConfig internal.LoginThrottleConfig,
+	twoFactorConfig internal.TwoFactorConfig,
+	oidcConfig internal.OIDCConfig,
+) Usecase {
 	return &UsecaseImpl{
-		userRepo: userRepo,
-		jwtAuth:  jwtAuth,
+		userRepo:           userRepo,
+		rolePermissionRepo: rolePermissionRepo,
+		refreshTokenRepo:   refreshTokenRepo,
+		loginThrottleRepo:  loginThrottleRepo,
+		auditLogRepo:       auditLogRepo,
+		roleRepo:           roleRepo,
+		userTwoFactorRepo:  userTwoFactorRepo,
+		recoveryCodeRepo:   recoveryCodeRepo,
+		loginChallengeRepo: loginChallengeRepo,
+		userIdentityRepo:   userIdentityRepo,
+		oidcLoginStateRepo: oidcLoginStateRepo,
+		jwtAuth:            jwtAuth,
+		oidcClient:         oidcClient,
+		throttleConfig:     throttleConfig,
+		twoFactorConfig:    twoFactorConfig,
+		oidcConfig:         oidcConfig,
 	}
 }
@@ -0,0 +1,288 @@
+package bulk_import_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/bulk_import"
+	organizationmock "github.com/asyauqi15/payslip-system/internal/usecase/organization/mock"
+	overtimemock "github.com/asyauqi15/payslip-system/internal/usecase/overtime/mock"
+	passwordmock "github.com/asyauqi15/payslip-system/internal/usecase/password/mock"
+	reimbursementmock "github.com/asyauqi15/payslip-system/internal/usecase/reimbursement/mock"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestBulkImportUsecase_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+	mockOrganizationUsecase := organizationmock.NewMockUsecase(ctrl)
+	mockOvertimeUsecase := overtimemock.NewMockUsecase(ctrl)
+	mockReimbursementUsecase := reimbursementmock.NewMockUsecase(ctrl)
+	mockPasswordUsecase := passwordmock.NewMockUsecase(ctrl)
+
+	usecase := bulk_import.NewUsecase(mockUserRepo, mockEmployeeRepo, mockRoleRepo, mockOvertimeRepo, mockReimbursementRepo,
+		mockOrganizationUsecase, mockOvertimeUsecase, mockReimbursementUsecase, mockPasswordUsecase)
+
+	code := "0042"
+	employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 3, Code: &code}
+	manager := &entity.Employee{Base: entity.Base{ID: 5}, UserID: 9}
+
+	firstOvertime := v1.OvertimeRequest{
+		StartTime:   time.Date(2025, 1, 6, 18, 0, 0, 0, time.UTC),
+		EndTime:     time.Date(2025, 1, 6, 20, 0, 0, 0, time.UTC),
+		Description: "Month end closing",
+	}
+	secondOvertime := v1.OvertimeRequest{
+		StartTime:   time.Date(2025, 1, 6, 19, 0, 0, 0, time.UTC),
+		EndTime:     time.Date(2025, 1, 6, 21, 0, 0, 0, time.UTC),
+		Description: "Month end closing",
+	}
+	prepared := entity.Overtime{EmployeeID: 1, StartAt: firstOvertime.StartTime, EndAt: firstOvertime.EndTime, Description: "Month end closing", Status: entity.ApprovalStatusPending}
+
+	type expectedError struct {
+		row     int
+		column  string
+		message string
+	}
+
+	tests := []struct {
+		name           string
+		kind           v1.ImportKind
+		file           string
+		dryRun         bool
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+		expected       *v1.ImportResult
+		expectedErrors []expectedError
+	}{
+		{
+			name: "creates employees with their users",
+			kind: v1.Employees,
+			file: "username,base_salary,role,code,manager_username\n" +
+				"alice,5000000,,0042,\n" +
+				"bob,4000000,manager,,carol\n",
+			setupMock: func() {
+				mockUserRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.User{Username: "alice"}, nil).Return(nil, nil)
+				mockOrganizationUsecase.EXPECT().PrepareEmployee(gomock.Any(), &entity.Employee{BaseSalary: 5000000, Code: &code}).Return(nil)
+				mockUserRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.User{Username: "bob"}, nil).Return(nil, nil)
+				mockRoleRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.Role{Name: "manager"}, nil).Return(&entity.Role{Name: "manager"}, nil)
+				mockUserRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.User{Username: "carol"}, nil).Return(&entity.User{Base: entity.Base{ID: 9}}, nil)
+				mockEmployeeRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: 9}, nil).Return(manager, nil)
+				mockOrganizationUsecase.EXPECT().PrepareEmployee(gomock.Any(), &entity.Employee{BaseSalary: 4000000, ManagerID: &manager.ID}).Return(nil)
+				mockEmployeeRepo.EXPECT().
+					CreateWithUsers(gomock.Any(), []entity.User{
+						{Username: "alice", Role: entity.UserRoleDefault, MustChangePassword: true},
+						{Username: "bob", Role: "manager", MustChangePassword: true},
+					}, []entity.Employee{
+						{BaseSalary: 5000000, Code: &code},
+						{BaseSalary: 4000000, ManagerID: &manager.ID},
+					}, nil).
+					DoAndReturn(func(_ context.Context, users []entity.User, _ []entity.Employee, _ *gorm.DB) error {
+						users[0].ID, users[1].ID = 11, 12
+						return nil
+					})
+				mockPasswordUsecase.EXPECT().IssuePasswordReset(gomock.Any(), int64(11)).Return(nil)
+				mockPasswordUsecase.EXPECT().IssuePasswordReset(gomock.Any(), int64(12)).Return(httppkg.NewInternalServerError("failed to issue password reset"))
+			},
+			expected: &v1.ImportResult{Kind: v1.Employees, Applied: true, Rows: 2, Imported: 2},
+		},
+		{
+			name: "employee rows breaking the rules",
+			kind: v1.Employees,
+			file: "username,base_salary,role,department_id\n" +
+				"alice,5000000,,\n" +
+				"alice,5000000,,\n" +
+				"dave,5000000,,\n" +
+				"erin,lots,,\n" +
+				"frank,5000000,auditor,\n" +
+				"grace,5000000,,7\n",
+			setupMock: func() {
+				mockUserRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.User{Username: "alice"}, nil).Return(nil, nil)
+				mockOrganizationUsecase.EXPECT().PrepareEmployee(gomock.Any(), gomock.Any()).Return(nil)
+				mockUserRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.User{Username: "dave"}, nil).Return(&entity.User{Username: "dave"}, nil)
+				mockUserRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.User{Username: "erin"}, nil).Return(nil, nil)
+				mockUserRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.User{Username: "frank"}, nil).Return(nil, nil)
+				mockRoleRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.Role{Name: "auditor"}, nil).Return(nil, nil)
+				mockUserRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.User{Username: "grace"}, nil).Return(nil, nil)
+				mockOrganizationUsecase.EXPECT().PrepareEmployee(gomock.Any(), gomock.Any()).Return(httppkg.NewNotFoundError("department not found"))
+			},
+			expected: &v1.ImportResult{Kind: v1.Employees, Rows: 6, Imported: 1},
+			expectedErrors: []expectedError{
+				{row: 3, column: "username", message: "username appears earlier in the file"},
+				{row: 4, column: "username", message: "username already exists"},
+				{row: 5, column: "base_salary", message: `invalid base salary "lots"`},
+				{row: 6, column: "role", message: `role "auditor" not found`},
+				{row: 7, message: "department not found"},
+			},
+		},
+		{
+			name:   "dry run stores nothing",
+			kind:   v1.Employees,
+			dryRun: true,
+			file:   "username,base_salary\nalice,5000000\n",
+			setupMock: func() {
+				mockUserRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.User{Username: "alice"}, nil).Return(nil, nil)
+				mockOrganizationUsecase.EXPECT().PrepareEmployee(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expected: &v1.ImportResult{Kind: v1.Employees, DryRun: true, Rows: 1, Imported: 1},
+		},
+		{
+			name: "imports overtime",
+			kind: v1.Overtimes,
+			file: "employee_code,start_time,end_time,description\n" +
+				"0042,2025-01-06T18:00:00Z,2025-01-06T20:00:00Z,Month end closing\n",
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.Employee{Code: &code}, nil).Return(employee, nil)
+				mockOvertimeUsecase.EXPECT().PrepareOvertime(gomock.Any(), employee, firstOvertime, nil).Return(&prepared, nil)
+				mockOvertimeRepo.EXPECT().CreateAll(gomock.Any(), []entity.Overtime{prepared}, nil).Return(nil)
+			},
+			expected: &v1.ImportResult{Kind: v1.Overtimes, Applied: true, Rows: 1, Imported: 1},
+		},
+		{
+			name: "overtime checked against the rows before it",
+			kind: v1.Overtimes,
+			file: "username,start_time,end_time,description\n" +
+				"alice,2025-01-06T18:00:00Z,2025-01-06T20:00:00Z,Month end closing\n" +
+				"alice,2025-01-06T19:00:00Z,2025-01-06T21:00:00Z,Month end closing\n" +
+				"alice,6 PM,2025-01-06T21:00:00Z,Month end closing\n" +
+				",2025-01-06T19:00:00Z,2025-01-06T21:00:00Z,Month end closing\n",
+			setupMock: func() {
+				mockUserRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.User{Username: "alice"}, nil).Return(&entity.User{Base: entity.Base{ID: 3}}, nil)
+				mockEmployeeRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: 3}, nil).Return(employee, nil)
+				mockOvertimeUsecase.EXPECT().PrepareOvertime(gomock.Any(), employee, firstOvertime, nil).Return(&prepared, nil)
+				mockOvertimeUsecase.EXPECT().PrepareOvertime(gomock.Any(), employee, secondOvertime, []entity.Overtime{prepared}).
+					Return(nil, httppkg.NewBadRequestError("overtime period overlaps with existing overtime"))
+			},
+			expected: &v1.ImportResult{Kind: v1.Overtimes, Rows: 4, Imported: 1},
+			expectedErrors: []expectedError{
+				{row: 3, message: "overtime period overlaps with existing overtime"},
+				{row: 4, column: "start_time", message: `invalid time "6 PM", expected a time like 2025-01-06T18:00:00+07:00`},
+				{row: 5, column: "employee_code", message: "employee_code or username is required"},
+			},
+		},
+		{
+			name: "imports reimbursements",
+			kind: v1.Reimbursements,
+			file: "employee_code,date,amount,description,currency\n" +
+				"0042,2025-01-06,150000,Taxi,\n" +
+				"0099,2025-01-06,150000,Taxi,\n" +
+				"0042,06/01/2025,150000,Taxi,\n" +
+				"0042,2025-01-07,12,Taxi,usd\n" +
+				"0042,2025-01-07,12,Taxi,dollars\n",
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.Employee{Code: &code}, nil).Return(employee, nil)
+				mockReimbursementUsecase.EXPECT().
+					PrepareReimbursement(gomock.Any(), employee, v1.ReimbursementRequest{
+						Date:        openapi_types.Date{Time: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)},
+						Amount:      150000,
+						Description: "Taxi",
+					}).
+					Return(&entity.Reimbursement{EmployeeID: 1, Amount: 150000}, nil)
+				usd := "USD"
+				mockReimbursementUsecase.EXPECT().
+					PrepareReimbursement(gomock.Any(), employee, v1.ReimbursementRequest{
+						Date:        openapi_types.Date{Time: time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC)},
+						Amount:      12,
+						Description: "Taxi",
+						Currency:    &usd,
+					}).
+					Return(&entity.Reimbursement{EmployeeID: 1, Amount: 12, Currency: "USD"}, nil)
+				unknown := "0099"
+				mockEmployeeRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.Employee{Code: &unknown}, nil).Return(nil, nil)
+			},
+			expected: &v1.ImportResult{Kind: v1.Reimbursements, Rows: 5, Imported: 2},
+			expectedErrors: []expectedError{
+				{row: 3, column: "employee_code", message: `no employee has code "0099"`},
+				{row: 4, column: "date", message: `invalid date "06/01/2025", expected YYYY-MM-DD`},
+				{row: 6, column: "currency", message: `invalid currency "dollars", expected a three-letter code`},
+			},
+		},
+		{
+			name: "failure checking a row aborts the import",
+			kind: v1.Overtimes,
+			file: "employee_code,start_time,end_time,description\n" +
+				"0042,2025-01-06T18:00:00Z,2025-01-06T20:00:00Z,Month end closing\n",
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().FindOneByTemplate(gomock.Any(), &entity.Employee{Code: &code}, nil).Return(employee, nil)
+				mockOvertimeUsecase.EXPECT().PrepareOvertime(gomock.Any(), employee, firstOvertime, nil).
+					Return(nil, httppkg.NewInternalServerError("failed to find work schedule"))
+			},
+			expectError:    true,
+			expectedStatus: 500,
+		},
+		{
+			name:           "missing column",
+			kind:           v1.Reimbursements,
+			file:           "employee_code,date,amount\n0042,2025-01-06,150000\n",
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:           "unknown kind",
+			kind:           v1.ImportKind("payslips"),
+			file:           "employee_code\n0042\n",
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.Import(context.Background(), tt.kind, strings.NewReader(tt.file), tt.dryRun)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+
+			if result.Kind != tt.expected.Kind || result.Applied != tt.expected.Applied || result.DryRun != tt.expected.DryRun ||
+				result.Rows != tt.expected.Rows || result.Imported != tt.expected.Imported {
+				t.Errorf("Expected %s applied %v, dry run %v, rows %d, imported %d but got %s %v, %v, %d, %d",
+					tt.expected.Kind, tt.expected.Applied, tt.expected.DryRun, tt.expected.Rows, tt.expected.Imported,
+					result.Kind, result.Applied, result.DryRun, result.Rows, result.Imported)
+			}
+			if len(result.Errors) != len(tt.expectedErrors) {
+				t.Fatalf("Expected %d errors but got %+v", len(tt.expectedErrors), result.Errors)
+			}
+			for i, expected := range tt.expectedErrors {
+				got := result.Errors[i]
+				column := ""
+				if got.Column != nil {
+					column = *got.Column
+				}
+				if got.Row != expected.row || column != expected.column || got.Message != expected.message {
+					t.Errorf("Expected error %+v but got row %d, column %q, message %q", expected, got.Row, column, got.Message)
+				}
+			}
+		})
+	}
+}
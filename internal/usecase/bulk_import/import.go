@@ -0,0 +1,164 @@
+package bulk_import
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"github.com/asyauqi15/payslip-system/pkg/csvimport"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+// maxImportRows keeps an upload within what one request can check.
+const maxImportRows = 1000
+
+// Import checks every row of a file against the rules for its kind of record
+// and stores all of them, unless a row breaks a rule or it is a dry run.
+func (u *UsecaseImpl) Import(ctx context.Context, kind v1.ImportKind, file io.Reader, dryRun bool) (*v1.ImportResult, error) {
+	opts := csvimport.Options{MaxRows: maxImportRows, DryRun: dryRun}
+	employees := &employeeFinder{
+		userRepo:     u.userRepo,
+		employeeRepo: u.employeeRepo,
+		found:        make(map[string]*entity.Employee),
+	}
+
+	var report *csvimport.Report
+	var err error
+	switch kind {
+	case v1.Employees:
+		mapping := &employeeMapping{
+			u:         u,
+			employees: employees,
+			roles:     make(map[string]bool),
+			usernames: make(map[string]bool),
+			codes:     make(map[string]bool),
+		}
+		report, err = csvimport.Run[newEmployee](ctx, file, mapping, opts)
+		if err == nil && report.Applied {
+			u.issuePasswordResets(ctx, mapping.created)
+		}
+	case v1.Overtimes:
+		mapping := &overtimeMapping{u: u, employees: employees}
+		report, err = csvimport.Run[entity.Overtime](ctx, file, mapping, opts)
+	case v1.Reimbursements:
+		mapping := &reimbursementMapping{u: u, employees: employees}
+		report, err = csvimport.Run[entity.Reimbursement](ctx, file, mapping, opts)
+	default:
+		return nil, httppkg.NewBadRequestError(fmt.Sprintf("unknown import kind %q", kind))
+	}
+	if err != nil {
+		var httpErr *httppkg.ErrorWrapper
+		if errors.As(err, &httpErr) {
+			return nil, err
+		}
+		// Anything else is wrong with the file itself
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+
+	logger.Info(ctx, "import processed",
+		"kind", kind,
+		"rows", report.Rows,
+		"imported", report.Imported,
+		"errors", len(report.Errors),
+		"dry_run", dryRun,
+		"applied", report.Applied)
+
+	return toImportResult(kind, report), nil
+}
+
+// rowError reports a rule a row breaks against the row. Failures of the
+// system itself abort the import instead.
+func rowError(column string, err error) error {
+	var httpErr *httppkg.ErrorWrapper
+	if errors.As(err, &httpErr) && httpErr.StatusCode < http.StatusInternalServerError {
+		return csvimport.Invalid(column, httpErr.Message)
+	}
+	return err
+}
+
+// employeeFinder looks up the employee a row refers to, remembering the
+// answers for the rest of the file.
+type employeeFinder struct {
+	userRepo     repository.UserRepository
+	employeeRepo repository.EmployeeRepository
+	found        map[string]*entity.Employee
+}
+
+// byRow finds the employee by the employee_code column, or by the username
+// column when the row has no code.
+func (f *employeeFinder) byRow(ctx context.Context, row csvimport.Row) (*entity.Employee, error) {
+	if code := row.Get("employee_code"); code != "" {
+		return f.find(ctx, "employee_code", code)
+	}
+	if username := row.Get("username"); username != "" {
+		return f.find(ctx, "username", username)
+	}
+	return nil, csvimport.Invalid("employee_code", "employee_code or username is required")
+}
+
+// find looks the value up as a time-clock code for the employee_code column
+// and as a username for any other column.
+func (f *employeeFinder) find(ctx context.Context, column, value string) (*entity.Employee, error) {
+	byCode := column == "employee_code"
+	key := "username:" + value
+	if byCode {
+		key = "code:" + value
+	}
+
+	employee, ok := f.found[key]
+	if !ok {
+		var err error
+		if byCode {
+			employee, err = f.employeeRepo.FindOneByTemplate(ctx, &entity.Employee{Code: &value}, nil)
+		} else {
+			employee, err = f.findByUsername(ctx, value)
+		}
+		if err != nil {
+			logger.Error(ctx, "failed to find employee", column, value, "error", err)
+			return nil, httppkg.NewInternalServerError("failed to find employee")
+		}
+		f.found[key] = employee
+	}
+
+	if employee == nil {
+		if byCode {
+			return nil, csvimport.Invalid(column, fmt.Sprintf("no employee has code %q", value))
+		}
+		return nil, csvimport.Invalid(column, fmt.Sprintf("no employee has username %q", value))
+	}
+	return employee, nil
+}
+
+func (f *employeeFinder) findByUsername(ctx context.Context, username string) (*entity.Employee, error) {
+	user, err := f.userRepo.FindOneByTemplate(ctx, &entity.User{Username: username}, nil)
+	if err != nil || user == nil {
+		return nil, err
+	}
+	return f.employeeRepo.FindOneByTemplate(ctx, &entity.Employee{UserID: user.ID}, nil)
+}
+
+func toImportResult(kind v1.ImportKind, report *csvimport.Report) *v1.ImportResult {
+	result := &v1.ImportResult{
+		Kind:     kind,
+		DryRun:   report.DryRun,
+		Applied:  report.Applied,
+		Rows:     report.Rows,
+		Imported: report.Imported,
+		Errors:   make([]v1.ImportError, 0, len(report.Errors)),
+	}
+	for _, e := range report.Errors {
+		importErr := v1.ImportError{Row: e.Line, Message: e.Message}
+		if e.Column != "" {
+			column := e.Column
+			importErr.Column = &column
+		}
+		result.Errors = append(result.Errors, importErr)
+	}
+	return result
+}
@@ -0,0 +1,170 @@
+package bulk_import
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/pkg/csvimport"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+)
+
+// newEmployee is an employee to create together with their user.
+type newEmployee struct {
+	user     entity.User
+	employee entity.Employee
+}
+
+// employeeMapping imports employees with the columns username and
+// base_salary, and optionally role, code, department_id, manager_username,
+// location_id and currency. Managers must already be employees.
+type employeeMapping struct {
+	u         *UsecaseImpl
+	employees *employeeFinder
+	roles     map[string]bool // whether each role named so far exists
+	usernames map[string]bool
+	codes     map[string]bool
+	created   []int64 // users created by the import
+}
+
+func (m *employeeMapping) Columns() []string {
+	return []string{"username", "base_salary"}
+}
+
+func (m *employeeMapping) Prepare(ctx context.Context, row csvimport.Row) (newEmployee, error) {
+	username := row.Get("username")
+	if username == "" {
+		return newEmployee{}, csvimport.Invalid("username", "username is required")
+	}
+	if m.usernames[username] {
+		return newEmployee{}, csvimport.Invalid("username", "username appears earlier in the file")
+	}
+	m.usernames[username] = true
+
+	existing, err := m.u.userRepo.FindOneByTemplate(ctx, &entity.User{Username: username}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to check existing user", "username", username, "error", err)
+		return newEmployee{}, httppkg.NewInternalServerError("failed to check existing user")
+	}
+	if existing != nil {
+		return newEmployee{}, csvimport.Invalid("username", "username already exists")
+	}
+
+	role, err := m.role(ctx, row.Get("role"))
+	if err != nil {
+		return newEmployee{}, err
+	}
+
+	baseSalary, err := strconv.ParseInt(row.Get("base_salary"), 10, 64)
+	if err != nil {
+		return newEmployee{}, csvimport.Invalid("base_salary", fmt.Sprintf("invalid base salary %q", row.Get("base_salary")))
+	}
+
+	employee := entity.Employee{BaseSalary: baseSalary}
+	if employee.DepartmentID, err = optionalID(row, "department_id"); err != nil {
+		return newEmployee{}, err
+	}
+	if employee.LocationID, err = optionalID(row, "location_id"); err != nil {
+		return newEmployee{}, err
+	}
+	if employee.Currency, err = optionalCurrency(row); err != nil {
+		return newEmployee{}, err
+	}
+	if managerUsername := row.Get("manager_username"); managerUsername != "" {
+		manager, err := m.employees.find(ctx, "manager_username", managerUsername)
+		if err != nil {
+			return newEmployee{}, err
+		}
+		employee.ManagerID = &manager.ID
+	}
+	if code := row.Get("code"); code != "" {
+		if m.codes[code] {
+			return newEmployee{}, csvimport.Invalid("code", "code appears earlier in the file")
+		}
+		m.codes[code] = true
+		employee.Code = &code
+	}
+
+	if err := m.u.organizationUsecase.PrepareEmployee(ctx, &employee); err != nil {
+		return newEmployee{}, rowError("", err)
+	}
+
+	return newEmployee{
+		user: entity.User{
+			Username:           username,
+			Role:               role,
+			MustChangePassword: true,
+		},
+		employee: employee,
+	}, nil
+}
+
+func (m *employeeMapping) Store(ctx context.Context, records []newEmployee) error {
+	users := make([]entity.User, len(records))
+	employees := make([]entity.Employee, len(records))
+	for i, record := range records {
+		users[i] = record.user
+		employees[i] = record.employee
+	}
+
+	if err := m.u.employeeRepo.CreateWithUsers(ctx, users, employees, nil); err != nil {
+		logger.Error(ctx, "failed to import employees", "count", len(records), "error", err)
+		return httppkg.NewInternalServerError("failed to import employees")
+	}
+
+	for _, user := range users {
+		m.created = append(m.created, user.ID)
+	}
+	return nil
+}
+
+// role defaults to the default role and must name an existing one. Service
+// accounts are created through their own endpoint.
+func (m *employeeMapping) role(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		return entity.UserRoleDefault, nil
+	}
+	if name == entity.UserRoleServiceAccount {
+		return "", csvimport.Invalid("role", "employees cannot have the service account role")
+	}
+
+	exists, ok := m.roles[name]
+	if !ok {
+		role, err := m.u.roleRepo.FindOneByTemplate(ctx, &entity.Role{Name: name}, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to find role", "role", name, "error", err)
+			return "", httppkg.NewInternalServerError("failed to find role")
+		}
+		exists = role != nil
+		m.roles[name] = exists
+	}
+	if !exists {
+		return "", csvimport.Invalid("role", fmt.Sprintf("role %q not found", name))
+	}
+	return name, nil
+}
+
+func optionalID(row csvimport.Row, column string) (*int64, error) {
+	value := row.Get(column)
+	if value == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, csvimport.Invalid(column, fmt.Sprintf("invalid ID %q", value))
+	}
+	return &id, nil
+}
+
+// issuePasswordResets sends imported users a link to set their password. A
+// failure is only logged: the employees are in and the reset can be issued
+// again.
+func (u *UsecaseImpl) issuePasswordResets(ctx context.Context, userIDs []int64) {
+	for _, userID := range userIDs {
+		if err := u.passwordUsecase.IssuePasswordReset(ctx, userID); err != nil {
+			logger.Warn(ctx, "failed to issue password reset for imported user", "user_id", userID, "error", err)
+		}
+	}
+}
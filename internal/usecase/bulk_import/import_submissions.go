@@ -0,0 +1,159 @@
+package bulk_import
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/pkg/csvimport"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/money"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// overtimeMapping imports overtime with the columns start_time, end_time and
+// description, and employee_code or username. Overtime earlier in the file
+// counts towards overlaps and the daily limit.
+type overtimeMapping struct {
+	u         *UsecaseImpl
+	employees *employeeFinder
+	prepared  []entity.Overtime
+}
+
+func (m *overtimeMapping) Columns() []string {
+	return []string{"start_time", "end_time", "description"}
+}
+
+func (m *overtimeMapping) Prepare(ctx context.Context, row csvimport.Row) (entity.Overtime, error) {
+	employee, err := m.employees.byRow(ctx, row)
+	if err != nil {
+		return entity.Overtime{}, err
+	}
+
+	startTime, err := parseTime(row, "start_time")
+	if err != nil {
+		return entity.Overtime{}, err
+	}
+	endTime, err := parseTime(row, "end_time")
+	if err != nil {
+		return entity.Overtime{}, err
+	}
+	description, err := requiredValue(row, "description")
+	if err != nil {
+		return entity.Overtime{}, err
+	}
+
+	overtime, err := m.u.overtimeUsecase.PrepareOvertime(ctx, employee, v1.OvertimeRequest{
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Description: description,
+	}, m.prepared)
+	if err != nil {
+		return entity.Overtime{}, rowError("", err)
+	}
+
+	m.prepared = append(m.prepared, *overtime)
+	return *overtime, nil
+}
+
+func (m *overtimeMapping) Store(ctx context.Context, records []entity.Overtime) error {
+	if err := m.u.overtimeRepo.CreateAll(ctx, records, nil); err != nil {
+		logger.Error(ctx, "failed to import overtime", "count", len(records), "error", err)
+		return httppkg.NewInternalServerError("failed to import overtime")
+	}
+	return nil
+}
+
+// reimbursementMapping imports reimbursements with the columns date, amount
+// and description, and employee_code or username, and optionally currency,
+// which defaults to the employee's.
+type reimbursementMapping struct {
+	u         *UsecaseImpl
+	employees *employeeFinder
+}
+
+func (m *reimbursementMapping) Columns() []string {
+	return []string{"date", "amount", "description"}
+}
+
+func (m *reimbursementMapping) Prepare(ctx context.Context, row csvimport.Row) (entity.Reimbursement, error) {
+	employee, err := m.employees.byRow(ctx, row)
+	if err != nil {
+		return entity.Reimbursement{}, err
+	}
+
+	date, err := time.Parse("2006-01-02", row.Get("date"))
+	if err != nil {
+		return entity.Reimbursement{}, csvimport.Invalid("date", fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", row.Get("date")))
+	}
+	amount, err := strconv.Atoi(row.Get("amount"))
+	if err != nil {
+		return entity.Reimbursement{}, csvimport.Invalid("amount", fmt.Sprintf("invalid amount %q", row.Get("amount")))
+	}
+	description, err := requiredValue(row, "description")
+	if err != nil {
+		return entity.Reimbursement{}, err
+	}
+
+	currency, err := optionalCurrency(row)
+	if err != nil {
+		return entity.Reimbursement{}, err
+	}
+
+	request := v1.ReimbursementRequest{
+		Date:        openapi_types.Date{Time: date},
+		Amount:      amount,
+		Description: description,
+	}
+	if currency != "" {
+		request.Currency = &currency
+	}
+	reimbursement, err := m.u.reimbursementUsecase.PrepareReimbursement(ctx, employee, request)
+	if err != nil {
+		return entity.Reimbursement{}, rowError("", err)
+	}
+
+	return *reimbursement, nil
+}
+
+func (m *reimbursementMapping) Store(ctx context.Context, records []entity.Reimbursement) error {
+	if err := m.u.reimbursementRepo.CreateAll(ctx, records, nil); err != nil {
+		logger.Error(ctx, "failed to import reimbursements", "count", len(records), "error", err)
+		return httppkg.NewInternalServerError("failed to import reimbursements")
+	}
+	return nil
+}
+
+func parseTime(row csvimport.Row, column string) (time.Time, error) {
+	value := row.Get(column)
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, csvimport.Invalid(column, fmt.Sprintf("invalid time %q, expected a time like 2025-01-06T18:00:00+07:00", value))
+	}
+	return t, nil
+}
+
+// optionalCurrency reads the currency column, which may be left empty.
+func optionalCurrency(row csvimport.Row) (string, error) {
+	value := row.Get("currency")
+	if value == "" {
+		return "", nil
+	}
+	currency, err := money.ParseCurrency(value)
+	if err != nil {
+		return "", csvimport.Invalid("currency", err.Error())
+	}
+	return string(currency), nil
+}
+
+func requiredValue(row csvimport.Row, column string) (string, error) {
+	value := row.Get(column)
+	if value == "" {
+		return "", csvimport.Invalid(column, column+" is required")
+	}
+	return value, nil
+}
@@ -0,0 +1,56 @@
+package bulk_import
+
+import (
+	"context"
+	"io"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"github.com/asyauqi15/payslip-system/internal/usecase/organization"
+	"github.com/asyauqi15/payslip-system/internal/usecase/overtime"
+	"github.com/asyauqi15/payslip-system/internal/usecase/password"
+	"github.com/asyauqi15/payslip-system/internal/usecase/reimbursement"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+//go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/bulk_import Usecase
+type Usecase interface {
+	Import(ctx context.Context, kind v1.ImportKind, file io.Reader, dryRun bool) (*v1.ImportResult, error)
+}
+
+type UsecaseImpl struct {
+	userRepo             repository.UserRepository
+	employeeRepo         repository.EmployeeRepository
+	roleRepo             repository.RoleRepository
+	overtimeRepo         repository.OvertimeRepository
+	reimbursementRepo    repository.ReimbursementRepository
+	organizationUsecase  organization.Usecase
+	overtimeUsecase      overtime.Usecase
+	reimbursementUsecase reimbursement.Usecase
+	passwordUsecase      password.Usecase
+}
+
+// NewUsecase takes the usecases whose rules imported rows are checked
+// against, so a row is accepted exactly when the API would accept it.
+func NewUsecase(
+	userRepo repository.UserRepository,
+	employeeRepo repository.EmployeeRepository,
+	roleRepo repository.RoleRepository,
+	overtimeRepo repository.OvertimeRepository,
+	reimbursementRepo repository.ReimbursementRepository,
+	organizationUsecase organization.Usecase,
+	overtimeUsecase overtime.Usecase,
+	reimbursementUsecase reimbursement.Usecase,
+	passwordUsecase password.Usecase,
+) Usecase {
+	return &UsecaseImpl{
+		userRepo:             userRepo,
+		employeeRepo:         employeeRepo,
+		roleRepo:             roleRepo,
+		overtimeRepo:         overtimeRepo,
+		reimbursementRepo:    reimbursementRepo,
+		organizationUsecase:  organizationUsecase,
+		overtimeUsecase:      overtimeUsecase,
+		reimbursementUsecase: reimbursementUsecase,
+		passwordUsecase:      passwordUsecase,
+	}
+}
@@ -0,0 +1,99 @@
+package exchange_rate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/money"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/spf13/cast"
+)
+
+// maxRateIntegerDigits and money.RateDecimals match the numeric(20,10)
+// columns rates are stored in.
+const maxRateIntegerDigits = 10
+
+// CreateExchangeRate adds the rate of a currency pair from a date on. Rates
+// are not edited once added: a correction is a new rate, so payrolls already
+// run can still be explained by the rate they used.
+func (u *UsecaseImpl) CreateExchangeRate(ctx context.Context, req v1.ExchangeRateRequest) (*v1.ExchangeRate, error) {
+	userID := ctx.Value(constant.ContextKeyUserID)
+	if userID == nil {
+		return nil, httppkg.NewUnauthorizedError("user not authenticated")
+	}
+
+	from, err := money.ParseCurrency(req.FromCurrency)
+	if err != nil {
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+	to, err := money.ParseCurrency(req.ToCurrency)
+	if err != nil {
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+	if from == to {
+		return nil, httppkg.NewBadRequestError("from and to currencies must differ")
+	}
+
+	value := strings.TrimSpace(req.Rate)
+	if err := validateRateDigits(value); err != nil {
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+	rate, err := money.ParseRate(from, to, value)
+	if err != nil {
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+
+	existing, err := u.exchangeRateRepo.FindOneByTemplate(ctx, &entity.ExchangeRate{
+		FromCurrency:  string(from),
+		ToCurrency:    string(to),
+		EffectiveDate: req.EffectiveDate.Time,
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to check existing exchange rate", "from", from, "to", to, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to check existing exchange rate")
+	}
+	if existing != nil {
+		return nil, httppkg.NewConflictError(fmt.Sprintf("%s to %s already has a rate effective on %s", from, to, req.EffectiveDate.Format("2006-01-02")))
+	}
+
+	createdBy := cast.ToInt64(userID)
+	created, err := u.exchangeRateRepo.Create(ctx, &entity.ExchangeRate{
+		FromCurrency:  string(from),
+		ToCurrency:    string(to),
+		EffectiveDate: req.EffectiveDate.Time,
+		Rate:          rate.String(),
+		CreatedBy:     &createdBy,
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to create exchange rate", "from", from, "to", to, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to create exchange rate")
+	}
+
+	logger.Info(ctx, "exchange rate created",
+		"exchange_rate_id", created.ID,
+		"from", from,
+		"to", to,
+		"effective_date", req.EffectiveDate.Format("2006-01-02"),
+		"rate", created.Rate,
+		"created_by", createdBy)
+
+	return toExchangeRateResponse(created), nil
+}
+
+// validateRateDigits makes sure a decimal rate fits the columns it is stored
+// in, so the rate recorded is exactly the rate given.
+func validateRateDigits(value string) error {
+	integer, fraction, _ := strings.Cut(value, ".")
+	if len(strings.TrimLeft(integer, "0")) > maxRateIntegerDigits {
+		return fmt.Errorf("rate cannot have more than %d digits before the decimal point", maxRateIntegerDigits)
+	}
+	if len(strings.TrimRight(fraction, "0")) > money.RateDecimals {
+		return fmt.Errorf("rate cannot have more than %d decimal places", money.RateDecimals)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package exchange_rate_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExchangeRateUsecase_CreateExchangeRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExchangeRateRepo := mock.NewMockExchangeRateRepository(ctrl)
+
+	usecase := exchange_rate.NewUsecase(mockExchangeRateRepo)
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "5")
+	effectiveDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	pair := &entity.ExchangeRate{FromCurrency: "USD", ToCurrency: "IDR", EffectiveDate: effectiveDate}
+
+	tests := []struct {
+		name           string
+		request        v1.ExchangeRateRequest
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+		expectedRate   string
+	}{
+		{
+			name:    "successful creation",
+			request: v1.ExchangeRateRequest{FromCurrency: "usd", ToCurrency: "IDR", EffectiveDate: openapi_types.Date{Time: effectiveDate}, Rate: "16250.50"},
+			setupMock: func() {
+				mockExchangeRateRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), pair, nil).
+					Return(nil, nil)
+				mockExchangeRateRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, r *entity.ExchangeRate, _ interface{}) (*entity.ExchangeRate, error) {
+						if r.CreatedBy == nil || *r.CreatedBy != 5 {
+							t.Errorf("Expected the rate to be created by user 5 but got %v", r.CreatedBy)
+						}
+						r.ID = 1
+						return r, nil
+					})
+			},
+			expectError:  false,
+			expectedRate: "16250.5",
+		},
+		{
+			name:           "same currency",
+			request:        v1.ExchangeRateRequest{FromCurrency: "IDR", ToCurrency: "idr", EffectiveDate: openapi_types.Date{Time: effectiveDate}, Rate: "1"},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:           "rate not positive",
+			request:        v1.ExchangeRateRequest{FromCurrency: "USD", ToCurrency: "IDR", EffectiveDate: openapi_types.Date{Time: effectiveDate}, Rate: "0"},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:           "rate with an exponent",
+			request:        v1.ExchangeRateRequest{FromCurrency: "USD", ToCurrency: "IDR", EffectiveDate: openapi_types.Date{Time: effectiveDate}, Rate: "1.6e4"},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:           "too many decimal places",
+			request:        v1.ExchangeRateRequest{FromCurrency: "IDR", ToCurrency: "USD", EffectiveDate: openapi_types.Date{Time: effectiveDate}, Rate: "0.00006153846153"},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:    "pair already has a rate on the date",
+			request: v1.ExchangeRateRequest{FromCurrency: "USD", ToCurrency: "IDR", EffectiveDate: openapi_types.Date{Time: effectiveDate}, Rate: "16000"},
+			setupMock: func() {
+				mockExchangeRateRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), pair, nil).
+					Return(&entity.ExchangeRate{Base: entity.Base{ID: 1}}, nil)
+			},
+			expectError:    true,
+			expectedStatus: 409,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.CreateExchangeRate(ctx, tt.request)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if result.FromCurrency != "USD" || result.Rate != tt.expectedRate {
+				t.Errorf("Unexpected exchange rate response: %+v", result)
+			}
+		})
+	}
+}
+
+func TestExchangeRateUsecase_ListExchangeRates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExchangeRateRepo := mock.NewMockExchangeRateRepository(ctrl)
+
+	usecase := exchange_rate.NewUsecase(mockExchangeRateRepo)
+
+	usd := "usd"
+	invalid := "dollar"
+
+	tests := []struct {
+		name           string
+		params         v1.GetAdminExchangeRatesParams
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name:   "rates of one currency",
+			params: v1.GetAdminExchangeRatesParams{FromCurrency: &usd},
+			setupMock: func() {
+				mockExchangeRateRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.ExchangeRate{{Base: entity.Base{ID: 1}, FromCurrency: "USD", ToCurrency: "IDR", Rate: "16250.5000000000"}}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name:           "invalid currency",
+			params:         v1.GetAdminExchangeRatesParams{ToCurrency: &invalid},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.ListExchangeRates(context.Background(), tt.params)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if len(result) != 1 || result[0].Rate != "16250.5" {
+				t.Errorf("Unexpected exchange rates: %+v", result)
+			}
+		})
+	}
+}
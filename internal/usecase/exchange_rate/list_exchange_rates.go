@@ -0,0 +1,66 @@
+package exchange_rate
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/money"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ListExchangeRates returns the rates of every pair, or of the pairs matching
+// the currencies given, latest effective date first.
+func (u *UsecaseImpl) ListExchangeRates(ctx context.Context, params v1.GetAdminExchangeRatesParams) ([]v1.ExchangeRate, error) {
+	query := repository.NewQuery().OrderBy("effective_date", true)
+
+	for _, filter := range []struct {
+		column string
+		code   *string
+	}{
+		{"from_currency", params.FromCurrency},
+		{"to_currency", params.ToCurrency},
+	} {
+		if filter.code == nil {
+			continue
+		}
+		currency, err := money.ParseCurrency(*filter.code)
+		if err != nil {
+			return nil, httppkg.NewBadRequestError(err.Error())
+		}
+		query.Where(filter.column, repository.OpEqual, string(currency))
+	}
+
+	rates, err := u.exchangeRateRepo.Find(ctx, query, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to list exchange rates", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to list exchange rates")
+	}
+
+	response := make([]v1.ExchangeRate, 0, len(rates))
+	for i := range rates {
+		response = append(response, *toExchangeRateResponse(&rates[i]))
+	}
+	return response, nil
+}
+
+func toExchangeRateResponse(rate *entity.ExchangeRate) *v1.ExchangeRate {
+	response := &v1.ExchangeRate{
+		Id:            rate.ID,
+		FromCurrency:  rate.FromCurrency,
+		ToCurrency:    rate.ToCurrency,
+		EffectiveDate: openapi_types.Date{Time: rate.EffectiveDate},
+		Rate:          rate.Rate,
+		CreatedBy:     rate.CreatedBy,
+		CreatedAt:     rate.CreatedAt,
+	}
+
+	// Rates read back from the database carry every decimal place
+	if parsed, err := money.ParseRate(money.Currency(rate.FromCurrency), money.Currency(rate.ToCurrency), rate.Rate); err == nil {
+		response.Rate = parsed.String()
+	}
+	return response
+}
@@ -0,0 +1,24 @@
+package exchange_rate
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+//go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate Usecase
+type Usecase interface {
+	CreateExchangeRate(ctx context.Context, req v1.ExchangeRateRequest) (*v1.ExchangeRate, error)
+	ListExchangeRates(ctx context.Context, params v1.GetAdminExchangeRatesParams) ([]v1.ExchangeRate, error)
+}
+
+type UsecaseImpl struct {
+	exchangeRateRepo repository.ExchangeRateRepository
+}
+
+func NewUsecase(exchangeRateRepo repository.ExchangeRateRepository) Usecase {
+	return &UsecaseImpl{
+		exchangeRateRepo: exchangeRateRepo,
+	}
+}
@@ -0,0 +1,52 @@
+package history
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+func (u *UsecaseImpl) ListAttendances(ctx context.Context, params v1.GetEmployeeAttendanceParams) (*v1.AttendanceHistoryResponse, error) {
+	f, err := u.newFilter(ctx, params.Page, params.PageSize, params.From, params.To, params.AttendancePeriodId)
+	if err != nil {
+		return nil, err
+	}
+
+	employee, err := u.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attendances, total, err := u.attendanceRepo.FindByEmployee(ctx, employee.ID, f.dates, f.page, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find attendances", "employee_id", employee.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find attendances")
+	}
+
+	data := make([]v1.AttendanceRecord, 0, len(attendances))
+	for _, attendance := range attendances {
+		data = append(data, toAttendanceRecord(&attendance))
+	}
+
+	return &v1.AttendanceHistoryResponse{
+		Data:       data,
+		Pagination: toPaginationResponse(f.page, total),
+	}, nil
+}
+
+func toAttendanceRecord(attendance *entity.Attendance) v1.AttendanceRecord {
+	record := v1.AttendanceRecord{
+		Id:           attendance.ID,
+		EmployeeId:   attendance.EmployeeID,
+		ClockInTime:  attendance.ClockInTime,
+		Corrected:    attendance.Corrected,
+		CorrectionId: attendance.CorrectionID,
+	}
+	if attendance.ClockOutTime != "" {
+		record.ClockOutTime = &attendance.ClockOutTime
+	}
+	return record
+}
@@ -0,0 +1,108 @@
+package history
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// filter is the pagination and date range shared by the history endpoints.
+type filter struct {
+	page  repository.Pagination
+	dates repository.DateRange
+}
+
+// newFilter validates the pagination and resolves the date range. An
+// attendance period is turned into the range of its dates.
+func (u *UsecaseImpl) newFilter(ctx context.Context, page, pageSize *int, from, to *openapi_types.Date, attendancePeriodID *int64) (filter, error) {
+	f := filter{page: repository.Pagination{Page: 1, PageSize: defaultPageSize}}
+
+	if page != nil {
+		if *page < 1 {
+			return filter{}, httppkg.NewBadRequestError("page must be at least 1")
+		}
+		f.page.Page = *page
+	}
+	if pageSize != nil {
+		if *pageSize < 1 || *pageSize > maxPageSize {
+			return filter{}, httppkg.NewBadRequestError("page_size must be between 1 and 100")
+		}
+		f.page.PageSize = *pageSize
+	}
+
+	if attendancePeriodID != nil {
+		if from != nil || to != nil {
+			return filter{}, httppkg.NewBadRequestError("attendance_period_id cannot be combined with from and to")
+		}
+
+		if *attendancePeriodID <= 0 {
+			return filter{}, httppkg.NewBadRequestError("invalid attendance_period_id")
+		}
+
+		period, err := u.attendancePeriodRepo.FindByID(ctx, uint(*attendancePeriodID), nil)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Error(ctx, "failed to find attendance period", "attendance_period_id", *attendancePeriodID, "error", err)
+			return filter{}, httppkg.NewInternalServerError("failed to find attendance period")
+		}
+		if period == nil {
+			return filter{}, httppkg.NewNotFoundError("attendance period not found")
+		}
+
+		f.dates = repository.DaysBetween(period.StartDate, period.EndDate)
+		return f, nil
+	}
+
+	if from != nil && to != nil && to.Time.Before(from.Time) {
+		return filter{}, httppkg.NewBadRequestError("from must not be after to")
+	}
+	if from != nil {
+		f.dates.From = &from.Time
+	}
+	if to != nil {
+		// The last day is included, so the exclusive bound is the next day
+		end := to.Time.AddDate(0, 0, 1)
+		f.dates.To = &end
+	}
+
+	return f, nil
+}
+
+func (u *UsecaseImpl) currentEmployee(ctx context.Context) (*entity.Employee, error) {
+	userID := ctx.Value(constant.ContextKeyUserID)
+	if userID == nil {
+		return nil, httppkg.NewUnauthorizedError("user not authenticated")
+	}
+
+	employee, err := u.employeeRepo.FindOneByTemplate(ctx, &entity.Employee{UserID: cast.ToInt64(userID)}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find employee", "user_id", userID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find employee")
+	}
+	if employee == nil {
+		return nil, httppkg.NewNotFoundError("employee not found")
+	}
+
+	return employee, nil
+}
+
+func toPaginationResponse(page repository.Pagination, total int64) v1.Pagination {
+	return v1.Pagination{
+		Page:     page.Page,
+		PageSize: page.PageSize,
+		Total:    total,
+	}
+}
@@ -0,0 +1,237 @@
+package history_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/history"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestHistoryUsecase_ListOvertimes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+	mockPayslipRepo := mock.NewMockPayslipRepository(ctrl)
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+
+	usecase := history.NewUsecase(mockEmployeeRepo, mockAttendanceRepo, mockOvertimeRepo, mockReimbursementRepo, mockPayslipRepo, mockAttendancePeriodRepo)
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "5")
+	periodStart := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	startTime := time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC)
+
+	intPtr := func(v int) *int { return &v }
+	periodID := int64(3)
+	zeroPeriodID := int64(0)
+	approved := v1.GetEmployeeOvertimeParamsStatusApproved
+	invalidStatus := v1.GetEmployeeOvertimeParamsStatus("archived")
+	from := openapi_types.Date{Time: periodStart}
+
+	expectEmployee := func() {
+		mockEmployeeRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(5)}, nil).
+			Return(&entity.Employee{Base: entity.Base{ID: 2}, UserID: 5}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		params         v1.GetEmployeeOvertimeParams
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+		expectedTotal  int64
+	}{
+		{
+			name:   "filtered by attendance period and status",
+			ctx:    ctx,
+			params: v1.GetEmployeeOvertimeParams{Page: intPtr(2), PageSize: intPtr(1), AttendancePeriodId: &periodID, Status: &approved},
+			setupMock: func() {
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(3), nil).
+					Return(&entity.AttendancePeriod{Base: entity.Base{ID: 3}, StartDate: periodStart, EndDate: periodEnd}, nil)
+				expectEmployee()
+				expectedEnd := periodEnd.AddDate(0, 0, 1)
+				mockOvertimeRepo.EXPECT().
+					FindByEmployee(gomock.Any(), int64(2), entity.ApprovalStatusApproved,
+						repository.DateRange{From: &periodStart, To: &expectedEnd},
+						repository.Pagination{Page: 2, PageSize: 1}, nil).
+					Return([]entity.Overtime{{
+						Base:       entity.Base{ID: 7},
+						EmployeeID: 2,
+						StartAt:    startTime,
+						EndAt:      startTime.Add(2 * time.Hour),
+						Status:     entity.ApprovalStatusApproved,
+					}}, int64(4), nil)
+			},
+			expectedTotal: 4,
+		},
+		{
+			name:   "default pagination without filters",
+			ctx:    ctx,
+			params: v1.GetEmployeeOvertimeParams{},
+			setupMock: func() {
+				expectEmployee()
+				mockOvertimeRepo.EXPECT().
+					FindByEmployee(gomock.Any(), int64(2), "", repository.DateRange{}, repository.Pagination{Page: 1, PageSize: 20}, nil).
+					Return([]entity.Overtime{}, int64(0), nil)
+			},
+			expectedTotal: 0,
+		},
+		{
+			name:           "page size above maximum",
+			ctx:            ctx,
+			params:         v1.GetEmployeeOvertimeParams{PageSize: intPtr(101)},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:           "attendance period combined with date range",
+			ctx:            ctx,
+			params:         v1.GetEmployeeOvertimeParams{AttendancePeriodId: &periodID, From: &from},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:           "invalid status",
+			ctx:            ctx,
+			params:         v1.GetEmployeeOvertimeParams{Status: &invalidStatus},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:   "attendance period not found",
+			ctx:    ctx,
+			params: v1.GetEmployeeOvertimeParams{AttendancePeriodId: &periodID},
+			setupMock: func() {
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(3), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError:    true,
+			expectedStatus: 404,
+		},
+		{
+			name:           "attendance period ID zero",
+			ctx:            ctx,
+			params:         v1.GetEmployeeOvertimeParams{AttendancePeriodId: &zeroPeriodID},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:   "employee not found",
+			ctx:    ctx,
+			params: v1.GetEmployeeOvertimeParams{},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(5)}, nil).
+					Return(nil, nil)
+			},
+			expectError:    true,
+			expectedStatus: 404,
+		},
+		{
+			name:           "unauthenticated",
+			ctx:            context.Background(),
+			params:         v1.GetEmployeeOvertimeParams{},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.ListOvertimes(tt.ctx, tt.params)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if result.Pagination.Total != tt.expectedTotal {
+					t.Errorf("Expected total %d but got %d", tt.expectedTotal, result.Pagination.Total)
+				}
+				if result.Data == nil {
+					t.Error("Expected a non-nil data slice")
+				}
+			}
+		})
+	}
+}
+
+func TestHistoryUsecase_ListPayslips(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+	mockPayslipRepo := mock.NewMockPayslipRepository(ctrl)
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+
+	usecase := history.NewUsecase(mockEmployeeRepo, mockAttendanceRepo, mockOvertimeRepo, mockReimbursementRepo, mockPayslipRepo, mockAttendancePeriodRepo)
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "5")
+	from := openapi_types.Date{Time: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)}
+	to := openapi_types.Date{Time: time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)}
+
+	mockEmployeeRepo.EXPECT().
+		FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(5)}, nil).
+		Return(&entity.Employee{Base: entity.Base{ID: 2}, UserID: 5}, nil)
+
+	exclusiveTo := to.Time.AddDate(0, 0, 1)
+	mockPayslipRepo.EXPECT().
+		FindByEmployee(gomock.Any(), int64(2), repository.DateRange{From: &from.Time, To: &exclusiveTo}, repository.Pagination{Page: 1, PageSize: 20}, nil).
+		Return([]repository.PayslipWithPeriod{{
+			Payslip:            entity.Payslip{Base: entity.Base{ID: 9}, PayrollID: 4, EmployeeID: 2, TotalTakeHome: 5000000},
+			AttendancePeriodID: 3,
+			PeriodStartDate:    from.Time,
+			PeriodEndDate:      to.Time,
+		}}, int64(1), nil)
+
+	result, err := usecase.ListPayslips(ctx, v1.GetEmployeePayslipsParams{From: &from, To: &to})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if len(result.Data) != 1 {
+		t.Fatalf("Expected 1 payslip but got %d", len(result.Data))
+	}
+	payslip := result.Data[0]
+	if payslip.PayrollId != 4 || payslip.AttendancePeriodId != 3 || payslip.TotalTakeHome != 5000000 {
+		t.Errorf("Unexpected payslip summary: %+v", payslip)
+	}
+	if !payslip.AttendancePeriod.EndDate.Time.Equal(to.Time) {
+		t.Errorf("Expected period end %s but got %s", to.Time, payslip.AttendancePeriod.EndDate.Time)
+	}
+}
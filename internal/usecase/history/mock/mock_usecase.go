@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/asyauqi15/payslip-system/internal/usecase/history (interfaces: Usecase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/history Usecase
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ListAttendances mocks base method.
+func (m *MockUsecase) ListAttendances(ctx context.Context, params v1.GetEmployeeAttendanceParams) (*v1.AttendanceHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttendances", ctx, params)
+	ret0, _ := ret[0].(*v1.AttendanceHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttendances indicates an expected call of ListAttendances.
+func (mr *MockUsecaseMockRecorder) ListAttendances(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttendances", reflect.TypeOf((*MockUsecase)(nil).ListAttendances), ctx, params)
+}
+
+// ListOvertimes mocks base method.
+func (m *MockUsecase) ListOvertimes(ctx context.Context, params v1.GetEmployeeOvertimeParams) (*v1.OvertimeHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOvertimes", ctx, params)
+	ret0, _ := ret[0].(*v1.OvertimeHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOvertimes indicates an expected call of ListOvertimes.
+func (mr *MockUsecaseMockRecorder) ListOvertimes(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOvertimes", reflect.TypeOf((*MockUsecase)(nil).ListOvertimes), ctx, params)
+}
+
+// ListPayslips mocks base method.
+func (m *MockUsecase) ListPayslips(ctx context.Context, params v1.GetEmployeePayslipsParams) (*v1.PayslipHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPayslips", ctx, params)
+	ret0, _ := ret[0].(*v1.PayslipHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPayslips indicates an expected call of ListPayslips.
+func (mr *MockUsecaseMockRecorder) ListPayslips(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayslips", reflect.TypeOf((*MockUsecase)(nil).ListPayslips), ctx, params)
+}
+
+// ListReimbursements mocks base method.
+func (m *MockUsecase) ListReimbursements(ctx context.Context, params v1.GetEmployeeReimbursementParams) (*v1.ReimbursementHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReimbursements", ctx, params)
+	ret0, _ := ret[0].(*v1.ReimbursementHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReimbursements indicates an expected call of ListReimbursements.
+func (mr *MockUsecaseMockRecorder) ListReimbursements(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReimbursements", reflect.TypeOf((*MockUsecase)(nil).ListReimbursements), ctx, params)
+}
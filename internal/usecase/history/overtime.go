@@ -0,0 +1,64 @@
+package history
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+func (u *UsecaseImpl) ListOvertimes(ctx context.Context, params v1.GetEmployeeOvertimeParams) (*v1.OvertimeHistoryResponse, error) {
+	var status string
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	// An empty status lists every status
+	if err := entity.ValidateApprovalStatusFilter(status); err != nil {
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+
+	f, err := u.newFilter(ctx, params.Page, params.PageSize, params.From, params.To, params.AttendancePeriodId)
+	if err != nil {
+		return nil, err
+	}
+
+	employee, err := u.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	overtimes, total, err := u.overtimeRepo.FindByEmployee(ctx, employee.ID, status, f.dates, f.page, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find overtimes", "employee_id", employee.ID, "status", status, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find overtimes")
+	}
+
+	data := make([]v1.OvertimeSubmission, 0, len(overtimes))
+	for _, overtime := range overtimes {
+		data = append(data, toOvertimeSubmission(&overtime))
+	}
+
+	return &v1.OvertimeHistoryResponse{
+		Data:       data,
+		Pagination: toPaginationResponse(f.page, total),
+	}, nil
+}
+
+func toOvertimeSubmission(overtime *entity.Overtime) v1.OvertimeSubmission {
+	submission := v1.OvertimeSubmission{
+		Id:          overtime.ID,
+		EmployeeId:  overtime.EmployeeID,
+		StartTime:   overtime.StartAt,
+		EndTime:     overtime.EndAt,
+		Description: overtime.Description,
+		Status:      v1.OvertimeSubmissionStatus(overtime.Status),
+		ReviewedBy:  overtime.ReviewedBy,
+		ReviewedAt:  overtime.ReviewedAt,
+	}
+	if overtime.ReviewNote != "" {
+		submission.ReviewNote = &overtime.ReviewNote
+	}
+	return submission
+}
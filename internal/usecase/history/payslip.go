@@ -0,0 +1,55 @@
+package history
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ListPayslips lists the payslips of the employee. A date range matches the
+// payslips of every attendance period that overlaps it.
+func (u *UsecaseImpl) ListPayslips(ctx context.Context, params v1.GetEmployeePayslipsParams) (*v1.PayslipHistoryResponse, error) {
+	f, err := u.newFilter(ctx, params.Page, params.PageSize, params.From, params.To, params.AttendancePeriodId)
+	if err != nil {
+		return nil, err
+	}
+
+	employee, err := u.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payslips, total, err := u.payslipRepo.FindByEmployee(ctx, employee.ID, f.dates, f.page, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find payslips", "employee_id", employee.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find payslips")
+	}
+
+	data := make([]v1.PayslipSummary, 0, len(payslips))
+	for _, payslip := range payslips {
+		data = append(data, toPayslipSummary(&payslip))
+	}
+
+	return &v1.PayslipHistoryResponse{
+		Data:       data,
+		Pagination: toPaginationResponse(f.page, total),
+	}, nil
+}
+
+func toPayslipSummary(payslip *repository.PayslipWithPeriod) v1.PayslipSummary {
+	return v1.PayslipSummary{
+		PayrollId:          payslip.PayrollID,
+		AttendancePeriodId: payslip.AttendancePeriodID,
+		AttendancePeriod: v1.AttendancePeriod{
+			StartDate: openapi_types.Date{Time: payslip.PeriodStartDate},
+			EndDate:   openapi_types.Date{Time: payslip.PeriodEndDate},
+		},
+		TotalTakeHome: payslip.TotalTakeHome,
+		Currency:      payslip.Currency,
+		CreatedAt:     payslip.CreatedAt,
+	}
+}
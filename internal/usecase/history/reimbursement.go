@@ -0,0 +1,66 @@
+package history
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (u *UsecaseImpl) ListReimbursements(ctx context.Context, params v1.GetEmployeeReimbursementParams) (*v1.ReimbursementHistoryResponse, error) {
+	var status string
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	// An empty status lists every status
+	if err := entity.ValidateApprovalStatusFilter(status); err != nil {
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+
+	f, err := u.newFilter(ctx, params.Page, params.PageSize, params.From, params.To, params.AttendancePeriodId)
+	if err != nil {
+		return nil, err
+	}
+
+	employee, err := u.currentEmployee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reimbursements, total, err := u.reimbursementRepo.FindByEmployee(ctx, employee.ID, status, f.dates, f.page, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find reimbursements", "employee_id", employee.ID, "status", status, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find reimbursements")
+	}
+
+	data := make([]v1.ReimbursementSubmission, 0, len(reimbursements))
+	for _, reimbursement := range reimbursements {
+		data = append(data, toReimbursementSubmission(&reimbursement))
+	}
+
+	return &v1.ReimbursementHistoryResponse{
+		Data:       data,
+		Pagination: toPaginationResponse(f.page, total),
+	}, nil
+}
+
+func toReimbursementSubmission(reimbursement *entity.Reimbursement) v1.ReimbursementSubmission {
+	submission := v1.ReimbursementSubmission{
+		Id:          reimbursement.ID,
+		EmployeeId:  reimbursement.EmployeeID,
+		Date:        openapi_types.Date{Time: reimbursement.Date},
+		Amount:      reimbursement.Amount,
+		Currency:    reimbursement.Currency,
+		Description: reimbursement.Description,
+		Status:      v1.ReimbursementSubmissionStatus(reimbursement.Status),
+		ReviewedBy:  reimbursement.ReviewedBy,
+		ReviewedAt:  reimbursement.ReviewedAt,
+	}
+	if reimbursement.ReviewNote != "" {
+		submission.ReviewNote = &reimbursement.ReviewNote
+	}
+	return submission
+}
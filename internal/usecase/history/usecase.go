@@ -0,0 +1,43 @@
+package history
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+//go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/history Usecase
+type Usecase interface {
+	ListAttendances(ctx context.Context, params v1.GetEmployeeAttendanceParams) (*v1.AttendanceHistoryResponse, error)
+	ListOvertimes(ctx context.Context, params v1.GetEmployeeOvertimeParams) (*v1.OvertimeHistoryResponse, error)
+	ListReimbursements(ctx context.Context, params v1.GetEmployeeReimbursementParams) (*v1.ReimbursementHistoryResponse, error)
+	ListPayslips(ctx context.Context, params v1.GetEmployeePayslipsParams) (*v1.PayslipHistoryResponse, error)
+}
+
+type UsecaseImpl struct {
+	employeeRepo         repository.EmployeeRepository
+	attendanceRepo       repository.AttendanceRepository
+	overtimeRepo         repository.OvertimeRepository
+	reimbursementRepo    repository.ReimbursementRepository
+	payslipRepo          repository.PayslipRepository
+	attendancePeriodRepo repository.AttendancePeriodRepository
+}
+
+func NewUsecase(
+	employeeRepo repository.EmployeeRepository,
+	attendanceRepo repository.AttendanceRepository,
+	overtimeRepo repository.OvertimeRepository,
+	reimbursementRepo repository.ReimbursementRepository,
+	payslipRepo repository.PayslipRepository,
+	attendancePeriodRepo repository.AttendancePeriodRepository,
+) Usecase {
+	return &UsecaseImpl{
+		employeeRepo:         employeeRepo,
+		attendanceRepo:       attendanceRepo,
+		overtimeRepo:         overtimeRepo,
+		reimbursementRepo:    reimbursementRepo,
+		payslipRepo:          payslipRepo,
+		attendancePeriodRepo: attendancePeriodRepo,
+	}
+}
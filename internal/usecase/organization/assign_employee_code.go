@@ -0,0 +1,74 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+// maxEmployeeCodeLength matches the column and the API schema.
+const maxEmployeeCodeLength = 50
+
+// AssignEmployeeCode sets the number the employee is enrolled under on time
+// clocks, which attendance imports identify the employee by. No two employees
+// can share a code.
+func (u *UsecaseImpl) AssignEmployeeCode(ctx context.Context, employeeID int64, req v1.EmployeeCodeRequest) error {
+	employee, err := u.findEmployee(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+	if employee == nil {
+		return httppkg.NewNotFoundError("employee not found")
+	}
+
+	var code *string
+	if req.Code != nil {
+		trimmed, err := u.validateEmployeeCode(ctx, employee.ID, *req.Code)
+		if err != nil {
+			return err
+		}
+		code = &trimmed
+	}
+
+	employee.Code = code
+
+	// Save is used instead of Updates so the code can be cleared
+	if err := u.employeeRepo.Save(ctx, employee, nil); err != nil {
+		logger.Error(ctx, "failed to update employee code", "employee_id", employeeID, "error", err)
+		return httppkg.NewInternalServerError("failed to update employee code")
+	}
+
+	logger.Info(ctx, "employee code updated",
+		"employee_id", employeeID,
+		"code", code)
+
+	return nil
+}
+
+// validateEmployeeCode trims a code and makes sure it fits and that no other
+// employee holds it.
+func (u *UsecaseImpl) validateEmployeeCode(ctx context.Context, employeeID int64, code string) (string, error) {
+	trimmed := strings.TrimSpace(code)
+	if trimmed == "" {
+		return "", httppkg.NewBadRequestError("code cannot be blank")
+	}
+	if len(trimmed) > maxEmployeeCodeLength {
+		return "", httppkg.NewBadRequestError(fmt.Sprintf("code cannot be longer than %d characters", maxEmployeeCodeLength))
+	}
+
+	holder, err := u.employeeRepo.FindOneByTemplate(ctx, &entity.Employee{Code: &trimmed}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to check employee code", "code", trimmed, "error", err)
+		return "", httppkg.NewInternalServerError("failed to check employee code")
+	}
+	if holder != nil && holder.ID != employeeID {
+		return "", httppkg.NewConflictError("another employee has this code")
+	}
+
+	return trimmed, nil
+}
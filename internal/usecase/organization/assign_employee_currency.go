@@ -0,0 +1,41 @@
+package organization
+
+import (
+	"context"
+
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/money"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+// AssignEmployeeCurrency sets the currency the employee's base salary is
+// paid in. Payslips are calculated in it from the next payroll run on;
+// payslips already run keep the currency they were calculated in.
+func (u *UsecaseImpl) AssignEmployeeCurrency(ctx context.Context, employeeID int64, req v1.EmployeeCurrencyRequest) error {
+	currency, err := money.ParseCurrency(req.Currency)
+	if err != nil {
+		return httppkg.NewBadRequestError(err.Error())
+	}
+
+	employee, err := u.findEmployee(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+	if employee == nil {
+		return httppkg.NewNotFoundError("employee not found")
+	}
+
+	employee.Currency = string(currency)
+
+	if err := u.employeeRepo.Save(ctx, employee, nil); err != nil {
+		logger.Error(ctx, "failed to update employee currency", "employee_id", employeeID, "error", err)
+		return httppkg.NewInternalServerError("failed to update employee currency")
+	}
+
+	logger.Info(ctx, "employee currency updated",
+		"employee_id", employeeID,
+		"currency", employee.Currency)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package organization
+
+import (
+	"context"
+
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+// AssignEmployeeLocation sets the location whose timezone and schedule apply
+// to the employee. Without a location the default schedule applies.
+func (u *UsecaseImpl) AssignEmployeeLocation(ctx context.Context, employeeID int64, req v1.EmployeeLocationRequest) error {
+	employee, err := u.findEmployee(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+	if employee == nil {
+		return httppkg.NewNotFoundError("employee not found")
+	}
+
+	if req.LocationId != nil {
+		if _, err := u.findLocation(ctx, *req.LocationId); err != nil {
+			return err
+		}
+	}
+
+	employee.LocationID = req.LocationId
+
+	// Save is used instead of Updates so the location can be cleared
+	if err := u.employeeRepo.Save(ctx, employee, nil); err != nil {
+		logger.Error(ctx, "failed to update employee location", "employee_id", employeeID, "error", err)
+		return httppkg.NewInternalServerError("failed to update employee location")
+	}
+
+	logger.Info(ctx, "employee location updated",
+		"employee_id", employeeID,
+		"location_id", req.LocationId)
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package organization
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) AssignEmployeeOrganization(ctx context.Context, employeeID int64, req v1.EmployeeOrganizationRequest) error {
+	employee, err := u.findEmployee(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+	if employee == nil {
+		return httppkg.NewNotFoundError("employee not found")
+	}
+
+	if req.DepartmentId != nil {
+		if _, err := u.findDepartment(ctx, *req.DepartmentId); err != nil {
+			return err
+		}
+	}
+
+	if req.ManagerId != nil {
+		if err := u.validateManager(ctx, employeeID, *req.ManagerId); err != nil {
+			return err
+		}
+	}
+
+	employee.DepartmentID = req.DepartmentId
+	employee.ManagerID = req.ManagerId
+
+	// Save is used instead of Updates so department and manager can be cleared
+	if err := u.employeeRepo.Save(ctx, employee, nil); err != nil {
+		logger.Error(ctx, "failed to update employee organization", "employee_id", employeeID, "error", err)
+		return httppkg.NewInternalServerError("failed to update employee organization")
+	}
+
+	logger.Info(ctx, "employee organization updated",
+		"employee_id", employeeID,
+		"department_id", req.DepartmentId,
+		"manager_id", req.ManagerId)
+
+	return nil
+}
+
+func (u *UsecaseImpl) findEmployee(ctx context.Context, employeeID int64) (*entity.Employee, error) {
+	employee, err := u.employeeRepo.FindByID(ctx, uint(employeeID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find employee", "employee_id", employeeID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find employee")
+	}
+	return employee, nil
+}
+
+// validateManager makes sure the manager exists and that the employee is not
+// already somewhere above the manager in the reporting line.
+func (u *UsecaseImpl) validateManager(ctx context.Context, employeeID, managerID int64) error {
+	if managerID == employeeID {
+		return httppkg.NewUnprocessableEntityError("employee cannot be their own manager")
+	}
+
+	visited := map[int64]bool{employeeID: true}
+	currentID := managerID
+
+	for {
+		if visited[currentID] {
+			return httppkg.NewUnprocessableEntityError("reporting line cannot contain cycles")
+		}
+		visited[currentID] = true
+
+		manager, err := u.findEmployee(ctx, currentID)
+		if err != nil {
+			return err
+		}
+		if manager == nil {
+			if currentID == managerID {
+				return httppkg.NewNotFoundError("manager not found")
+			}
+			return nil
+		}
+		if manager.ManagerID == nil {
+			return nil
+		}
+		currentID = *manager.ManagerID
+	}
+}
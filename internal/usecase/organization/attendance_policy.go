@@ -0,0 +1,134 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+// maxGeofenceRadius keeps a geofence to the size of a site, so that a typo
+// in the radius does not accept a whole city.
+const maxGeofenceRadius = 5000.0
+
+func (u *UsecaseImpl) GetAttendancePolicy(ctx context.Context, locationID int64) (*v1.AttendancePolicy, error) {
+	location, err := u.findLocation(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	geofences, err := u.locationRepo.Geofences(ctx, location.ID, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find geofences", "location_id", locationID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find geofences")
+	}
+
+	return toAttendancePolicyResponse(location, geofences), nil
+}
+
+// UpdateAttendancePolicy replaces the geofences and IP allowlist of a
+// location. Attendance already recorded keeps the violations it was flagged
+// with.
+func (u *UsecaseImpl) UpdateAttendancePolicy(ctx context.Context, locationID int64, req v1.AttendancePolicy) (*v1.AttendancePolicy, error) {
+	geofences, err := toGeofenceEntities(req.Geofences)
+	if err != nil {
+		return nil, err
+	}
+	networks, err := entity.ParseNetworks(strings.Join(req.AllowedNetworks, ","))
+	if err != nil {
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+
+	location, err := u.findLocation(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	location.AllowedNetworks = entity.FormatNetworks(networks)
+	if err := u.locationRepo.SaveAttendancePolicy(ctx, location, geofences, nil); err != nil {
+		logger.Error(ctx, "failed to update attendance policy", "location_id", locationID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to update attendance policy")
+	}
+
+	logger.Info(ctx, "attendance policy updated",
+		"location_id", locationID,
+		"geofences", len(geofences),
+		"allowed_networks", location.AllowedNetworks)
+
+	return toAttendancePolicyResponse(location, geofences), nil
+}
+
+// UnbindEmployeeDevice clears the device an employee is bound to, for
+// example after a phone is replaced. The next device the employee submits
+// attendance with is bound instead.
+func (u *UsecaseImpl) UnbindEmployeeDevice(ctx context.Context, employeeID int64) error {
+	employee, err := u.findEmployee(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+	if employee == nil {
+		return httppkg.NewNotFoundError("employee not found")
+	}
+
+	employee.DeviceID = ""
+
+	// Save is used instead of Updates so the device can be cleared
+	if err := u.employeeRepo.Save(ctx, employee, nil); err != nil {
+		logger.Error(ctx, "failed to unbind employee device", "employee_id", employeeID, "error", err)
+		return httppkg.NewInternalServerError("failed to unbind employee device")
+	}
+
+	logger.Info(ctx, "employee device unbound", "employee_id", employeeID)
+
+	return nil
+}
+
+func toGeofenceEntities(requests []v1.Geofence) ([]entity.Geofence, error) {
+	geofences := make([]entity.Geofence, 0, len(requests))
+	for _, req := range requests {
+		name := strings.TrimSpace(req.Name)
+		if name == "" {
+			return nil, httppkg.NewBadRequestError("geofence name is required")
+		}
+		if req.Latitude < -90 || req.Latitude > 90 {
+			return nil, httppkg.NewBadRequestError("geofence latitude must be between -90 and 90")
+		}
+		if req.Longitude < -180 || req.Longitude > 180 {
+			return nil, httppkg.NewBadRequestError("geofence longitude must be between -180 and 180")
+		}
+		if req.RadiusMeters <= 0 || req.RadiusMeters > maxGeofenceRadius {
+			return nil, httppkg.NewBadRequestError(fmt.Sprintf("geofence radius must be more than 0 and at most %.0f meters", maxGeofenceRadius))
+		}
+
+		geofences = append(geofences, entity.Geofence{
+			Name:         name,
+			Latitude:     req.Latitude,
+			Longitude:    req.Longitude,
+			RadiusMeters: req.RadiusMeters,
+		})
+	}
+	return geofences, nil
+}
+
+func toAttendancePolicyResponse(location *entity.Location, geofences []entity.Geofence) *v1.AttendancePolicy {
+	response := &v1.AttendancePolicy{
+		Geofences:       make([]v1.Geofence, 0, len(geofences)),
+		AllowedNetworks: []string{},
+	}
+	for _, geofence := range geofences {
+		response.Geofences = append(response.Geofences, v1.Geofence{
+			Name:         geofence.Name,
+			Latitude:     geofence.Latitude,
+			Longitude:    geofence.Longitude,
+			RadiusMeters: geofence.RadiusMeters,
+		})
+	}
+	if location.AllowedNetworks != "" {
+		response.AllowedNetworks = strings.Split(location.AllowedNetworks, ",")
+	}
+	return response
+}
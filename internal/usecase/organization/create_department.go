@@ -0,0 +1,78 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) CreateDepartment(ctx context.Context, req v1.DepartmentRequest) (*v1.Department, error) {
+	if err := validateDepartmentRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Department codes are used in reports, so they must be unique
+	existing, err := u.departmentRepo.FindOneByTemplate(ctx, &entity.Department{Code: strings.TrimSpace(req.Code)}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to check existing department", "code", req.Code, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to check existing department")
+	}
+	if existing != nil {
+		return nil, httppkg.NewConflictError("department code already exists")
+	}
+
+	if req.ParentId != nil {
+		parent, err := u.departmentRepo.FindByID(ctx, uint(*req.ParentId), nil)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Error(ctx, "failed to find parent department", "parent_id", *req.ParentId, "error", err)
+			return nil, httppkg.NewInternalServerError("failed to find parent department")
+		}
+		if parent == nil {
+			return nil, httppkg.NewNotFoundError("parent department not found")
+		}
+	}
+
+	department := &entity.Department{
+		Code:       strings.TrimSpace(req.Code),
+		Name:       strings.TrimSpace(req.Name),
+		CostCenter: strings.TrimSpace(req.CostCenter),
+		ParentID:   req.ParentId,
+	}
+
+	created, err := u.departmentRepo.Create(ctx, department, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to create department", "code", req.Code, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to create department")
+	}
+
+	return toDepartmentResponse(created), nil
+}
+
+func validateDepartmentRequest(req v1.DepartmentRequest) error {
+	if strings.TrimSpace(req.Code) == "" {
+		return httppkg.NewBadRequestError("department code is required")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return httppkg.NewBadRequestError("department name is required")
+	}
+	if strings.TrimSpace(req.CostCenter) == "" {
+		return httppkg.NewBadRequestError("cost center is required")
+	}
+	return nil
+}
+
+func toDepartmentResponse(department *entity.Department) *v1.Department {
+	return &v1.Department{
+		Id:         department.ID,
+		Code:       department.Code,
+		Name:       department.Name,
+		CostCenter: department.CostCenter,
+		ParentId:   department.ParentID,
+	}
+}
@@ -0,0 +1,95 @@
+package organization
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+func (u *UsecaseImpl) CreateLocation(ctx context.Context, req v1.LocationRequest) (*v1.Location, error) {
+	location, err := toLocationEntity(req)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := u.locationRepo.FindOneByTemplate(ctx, &entity.Location{Name: location.Name}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to check existing location", "name", location.Name, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to check existing location")
+	}
+	if existing != nil {
+		return nil, httppkg.NewConflictError("location name already exists")
+	}
+
+	created, err := u.locationRepo.Create(ctx, location, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to create location", "name", location.Name, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to create location")
+	}
+
+	return toLocationResponse(created), nil
+}
+
+// toLocationEntity validates a location request by parsing the schedule it
+// describes, and normalizes the workdays to the stored form.
+func toLocationEntity(req v1.LocationRequest) (*entity.Location, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, httppkg.NewBadRequestError("location name is required")
+	}
+	if len(req.Workdays) == 0 {
+		return nil, httppkg.NewBadRequestError("at least one workday is required")
+	}
+
+	days := make([]string, 0, len(req.Workdays))
+	for _, day := range req.Workdays {
+		days = append(days, string(day))
+	}
+	workdays, err := entity.ParseWorkdays(strings.Join(days, ","))
+	if err != nil {
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+
+	location := &entity.Location{
+		Name:       name,
+		Timezone:   strings.TrimSpace(req.Timezone),
+		ShiftStart: strings.TrimSpace(req.ShiftStart),
+		ShiftEnd:   strings.TrimSpace(req.ShiftEnd),
+		Workdays:   entity.FormatWorkdays(workdays),
+		Rostered:   req.Rostered != nil && *req.Rostered,
+	}
+
+	schedule, err := location.WorkSchedule()
+	if err != nil {
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+	// A shift ending before it starts crosses midnight, but it cannot last
+	// the whole day
+	if schedule.ShiftEnd-schedule.ShiftStart == 24*time.Hour {
+		return nil, httppkg.NewBadRequestError("shift start and end must differ")
+	}
+
+	return location, nil
+}
+
+func toLocationResponse(location *entity.Location) *v1.Location {
+	workdays := make([]v1.Weekday, 0, 7)
+	for _, day := range strings.Split(location.Workdays, ",") {
+		workdays = append(workdays, v1.Weekday(day))
+	}
+
+	return &v1.Location{
+		Id:         location.ID,
+		Name:       location.Name,
+		Timezone:   location.Timezone,
+		ShiftStart: location.ShiftStart,
+		ShiftEnd:   location.ShiftEnd,
+		Workdays:   workdays,
+		Rostered:   location.Rostered,
+	}
+}
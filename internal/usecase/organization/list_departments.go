@@ -0,0 +1,25 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+func (u *UsecaseImpl) ListDepartments(ctx context.Context) ([]v1.Department, error) {
+	departments, err := u.departmentRepo.FindByTemplate(ctx, &entity.Department{}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to list departments", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to list departments")
+	}
+
+	response := make([]v1.Department, 0, len(departments))
+	for i := range departments {
+		response = append(response, *toDepartmentResponse(&departments[i]))
+	}
+
+	return response, nil
+}
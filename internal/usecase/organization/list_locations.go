@@ -0,0 +1,24 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+func (u *UsecaseImpl) ListLocations(ctx context.Context) ([]v1.Location, error) {
+	locations, err := u.locationRepo.FindByTemplate(ctx, &entity.Location{}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to list locations", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to list locations")
+	}
+
+	response := make([]v1.Location, 0, len(locations))
+	for i := range locations {
+		response = append(response, *toLocationResponse(&locations[i]))
+	}
+	return response, nil
+}
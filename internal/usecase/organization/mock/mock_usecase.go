@@ -0,0 +1,247 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/asyauqi15/payslip-system/internal/usecase/organization (interfaces: Usecase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/organization Usecase
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/asyauqi15/payslip-system/internal/entity"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AssignEmployeeCode mocks base method.
+func (m *MockUsecase) AssignEmployeeCode(ctx context.Context, employeeID int64, req v1.EmployeeCodeRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignEmployeeCode", ctx, employeeID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignEmployeeCode indicates an expected call of AssignEmployeeCode.
+func (mr *MockUsecaseMockRecorder) AssignEmployeeCode(ctx, employeeID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignEmployeeCode", reflect.TypeOf((*MockUsecase)(nil).AssignEmployeeCode), ctx, employeeID, req)
+}
+
+// AssignEmployeeCurrency mocks base method.
+func (m *MockUsecase) AssignEmployeeCurrency(ctx context.Context, employeeID int64, req v1.EmployeeCurrencyRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignEmployeeCurrency", ctx, employeeID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignEmployeeCurrency indicates an expected call of AssignEmployeeCurrency.
+func (mr *MockUsecaseMockRecorder) AssignEmployeeCurrency(ctx, employeeID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignEmployeeCurrency", reflect.TypeOf((*MockUsecase)(nil).AssignEmployeeCurrency), ctx, employeeID, req)
+}
+
+// AssignEmployeeLocation mocks base method.
+func (m *MockUsecase) AssignEmployeeLocation(ctx context.Context, employeeID int64, req v1.EmployeeLocationRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignEmployeeLocation", ctx, employeeID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignEmployeeLocation indicates an expected call of AssignEmployeeLocation.
+func (mr *MockUsecaseMockRecorder) AssignEmployeeLocation(ctx, employeeID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignEmployeeLocation", reflect.TypeOf((*MockUsecase)(nil).AssignEmployeeLocation), ctx, employeeID, req)
+}
+
+// AssignEmployeeOrganization mocks base method.
+func (m *MockUsecase) AssignEmployeeOrganization(ctx context.Context, employeeID int64, req v1.EmployeeOrganizationRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignEmployeeOrganization", ctx, employeeID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignEmployeeOrganization indicates an expected call of AssignEmployeeOrganization.
+func (mr *MockUsecaseMockRecorder) AssignEmployeeOrganization(ctx, employeeID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignEmployeeOrganization", reflect.TypeOf((*MockUsecase)(nil).AssignEmployeeOrganization), ctx, employeeID, req)
+}
+
+// CreateDepartment mocks base method.
+func (m *MockUsecase) CreateDepartment(ctx context.Context, req v1.DepartmentRequest) (*v1.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDepartment", ctx, req)
+	ret0, _ := ret[0].(*v1.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDepartment indicates an expected call of CreateDepartment.
+func (mr *MockUsecaseMockRecorder) CreateDepartment(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDepartment", reflect.TypeOf((*MockUsecase)(nil).CreateDepartment), ctx, req)
+}
+
+// CreateLocation mocks base method.
+func (m *MockUsecase) CreateLocation(ctx context.Context, req v1.LocationRequest) (*v1.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLocation", ctx, req)
+	ret0, _ := ret[0].(*v1.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLocation indicates an expected call of CreateLocation.
+func (mr *MockUsecaseMockRecorder) CreateLocation(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLocation", reflect.TypeOf((*MockUsecase)(nil).CreateLocation), ctx, req)
+}
+
+// GetAttendancePolicy mocks base method.
+func (m *MockUsecase) GetAttendancePolicy(ctx context.Context, locationID int64) (*v1.AttendancePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendancePolicy", ctx, locationID)
+	ret0, _ := ret[0].(*v1.AttendancePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendancePolicy indicates an expected call of GetAttendancePolicy.
+func (mr *MockUsecaseMockRecorder) GetAttendancePolicy(ctx, locationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendancePolicy", reflect.TypeOf((*MockUsecase)(nil).GetAttendancePolicy), ctx, locationID)
+}
+
+// ListDepartments mocks base method.
+func (m *MockUsecase) ListDepartments(ctx context.Context) ([]v1.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDepartments", ctx)
+	ret0, _ := ret[0].([]v1.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDepartments indicates an expected call of ListDepartments.
+func (mr *MockUsecaseMockRecorder) ListDepartments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDepartments", reflect.TypeOf((*MockUsecase)(nil).ListDepartments), ctx)
+}
+
+// ListLocations mocks base method.
+func (m *MockUsecase) ListLocations(ctx context.Context) ([]v1.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocations", ctx)
+	ret0, _ := ret[0].([]v1.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocations indicates an expected call of ListLocations.
+func (mr *MockUsecaseMockRecorder) ListLocations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocations", reflect.TypeOf((*MockUsecase)(nil).ListLocations), ctx)
+}
+
+// PrepareEmployee mocks base method.
+func (m *MockUsecase) PrepareEmployee(ctx context.Context, employee *entity.Employee) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareEmployee", ctx, employee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrepareEmployee indicates an expected call of PrepareEmployee.
+func (mr *MockUsecaseMockRecorder) PrepareEmployee(ctx, employee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareEmployee", reflect.TypeOf((*MockUsecase)(nil).PrepareEmployee), ctx, employee)
+}
+
+// UnbindEmployeeDevice mocks base method.
+func (m *MockUsecase) UnbindEmployeeDevice(ctx context.Context, employeeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbindEmployeeDevice", ctx, employeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnbindEmployeeDevice indicates an expected call of UnbindEmployeeDevice.
+func (mr *MockUsecaseMockRecorder) UnbindEmployeeDevice(ctx, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbindEmployeeDevice", reflect.TypeOf((*MockUsecase)(nil).UnbindEmployeeDevice), ctx, employeeID)
+}
+
+// UpdateAttendancePolicy mocks base method.
+func (m *MockUsecase) UpdateAttendancePolicy(ctx context.Context, locationID int64, req v1.AttendancePolicy) (*v1.AttendancePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendancePolicy", ctx, locationID, req)
+	ret0, _ := ret[0].(*v1.AttendancePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAttendancePolicy indicates an expected call of UpdateAttendancePolicy.
+func (mr *MockUsecaseMockRecorder) UpdateAttendancePolicy(ctx, locationID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendancePolicy", reflect.TypeOf((*MockUsecase)(nil).UpdateAttendancePolicy), ctx, locationID, req)
+}
+
+// UpdateDepartment mocks base method.
+func (m *MockUsecase) UpdateDepartment(ctx context.Context, departmentID int64, req v1.DepartmentRequest) (*v1.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDepartment", ctx, departmentID, req)
+	ret0, _ := ret[0].(*v1.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDepartment indicates an expected call of UpdateDepartment.
+func (mr *MockUsecaseMockRecorder) UpdateDepartment(ctx, departmentID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDepartment", reflect.TypeOf((*MockUsecase)(nil).UpdateDepartment), ctx, departmentID, req)
+}
+
+// UpdateLocation mocks base method.
+func (m *MockUsecase) UpdateLocation(ctx context.Context, locationID int64, req v1.LocationRequest) (*v1.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLocation", ctx, locationID, req)
+	ret0, _ := ret[0].(*v1.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLocation indicates an expected call of UpdateLocation.
+func (mr *MockUsecaseMockRecorder) UpdateLocation(ctx, locationID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLocation", reflect.TypeOf((*MockUsecase)(nil).UpdateLocation), ctx, locationID, req)
+}
@@ -0,0 +1,922 @@
+package organization_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/organization"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestOrganizationUsecase_CreateDepartment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDepartmentRepo := mock.NewMockDepartmentRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := organization.NewUsecase(mockDepartmentRepo, mockEmployeeRepo, mockLocationRepo)
+
+	parentID := int64(1)
+
+	tests := []struct {
+		name           string
+		request        v1.DepartmentRequest
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name: "successful creation",
+			request: v1.DepartmentRequest{
+				Code:       "ENG",
+				Name:       "Engineering",
+				CostCenter: "CC-100",
+				ParentId:   &parentID,
+			},
+			setupMock: func() {
+				mockDepartmentRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Department{Code: "ENG"}, nil).
+					Return(nil, nil)
+				mockDepartmentRepo.EXPECT().
+					FindByID(gomock.Any(), uint(parentID), nil).
+					Return(&entity.Department{Base: entity.Base{ID: parentID}, Code: "HQ"}, nil)
+				mockDepartmentRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, d *entity.Department, _ interface{}) (*entity.Department, error) {
+						d.ID = 2
+						return d, nil
+					})
+			},
+			expectError: false,
+		},
+		{
+			name: "missing cost center",
+			request: v1.DepartmentRequest{
+				Code: "ENG",
+				Name: "Engineering",
+			},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name: "duplicate code",
+			request: v1.DepartmentRequest{
+				Code:       "ENG",
+				Name:       "Engineering",
+				CostCenter: "CC-100",
+			},
+			setupMock: func() {
+				mockDepartmentRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Department{Code: "ENG"}, nil).
+					Return(&entity.Department{Base: entity.Base{ID: 5}, Code: "ENG"}, nil)
+			},
+			expectError:    true,
+			expectedStatus: 409,
+		},
+		{
+			name: "parent not found",
+			request: v1.DepartmentRequest{
+				Code:       "ENG",
+				Name:       "Engineering",
+				CostCenter: "CC-100",
+				ParentId:   &parentID,
+			},
+			setupMock: func() {
+				mockDepartmentRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Department{Code: "ENG"}, nil).
+					Return(nil, nil)
+				mockDepartmentRepo.EXPECT().
+					FindByID(gomock.Any(), uint(parentID), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError:    true,
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.CreateDepartment(context.Background(), tt.request)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if result.Id != 2 || result.CostCenter != "CC-100" {
+					t.Errorf("Unexpected department response: %+v", result)
+				}
+			}
+		})
+	}
+}
+
+func TestOrganizationUsecase_UpdateDepartment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDepartmentRepo := mock.NewMockDepartmentRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := organization.NewUsecase(mockDepartmentRepo, mockEmployeeRepo, mockLocationRepo)
+
+	childID := int64(2)
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		expectError bool
+	}{
+		{
+			name: "successful update",
+			setupMock: func() {
+				mockDepartmentRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.Department{Base: entity.Base{ID: 1}, Code: "ENG"}, nil)
+				mockDepartmentRepo.EXPECT().
+					FindByID(gomock.Any(), uint(childID), nil).
+					Return(&entity.Department{Base: entity.Base{ID: childID}, Code: "PLAT"}, nil)
+				mockDepartmentRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "parent cycle",
+			setupMock: func() {
+				oneID := int64(1)
+				mockDepartmentRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.Department{Base: entity.Base{ID: 1}, Code: "ENG"}, nil)
+				mockDepartmentRepo.EXPECT().
+					FindByID(gomock.Any(), uint(childID), nil).
+					Return(&entity.Department{Base: entity.Base{ID: childID}, Code: "PLAT", ParentID: &oneID}, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			_, err := usecase.UpdateDepartment(context.Background(), 1, v1.DepartmentRequest{
+				Code:       "ENG",
+				Name:       "Engineering",
+				CostCenter: "CC-100",
+				ParentId:   &childID,
+			})
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestOrganizationUsecase_AssignEmployeeOrganization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDepartmentRepo := mock.NewMockDepartmentRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := organization.NewUsecase(mockDepartmentRepo, mockEmployeeRepo, mockLocationRepo)
+
+	departmentID := int64(3)
+	managerID := int64(2)
+	employeeID := int64(1)
+
+	tests := []struct {
+		name        string
+		request     v1.EmployeeOrganizationRequest
+		setupMock   func()
+		expectError bool
+	}{
+		{
+			name: "successful assignment",
+			request: v1.EmployeeOrganizationRequest{
+				DepartmentId: &departmentID,
+				ManagerId:    &managerID,
+			},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: employeeID}}, nil)
+				mockDepartmentRepo.EXPECT().
+					FindByID(gomock.Any(), uint(departmentID), nil).
+					Return(&entity.Department{Base: entity.Base{ID: departmentID}}, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(managerID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: managerID}}, nil)
+				mockEmployeeRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, e *entity.Employee, _ interface{}) error {
+						if e.DepartmentID == nil || *e.DepartmentID != departmentID {
+							t.Errorf("Expected department %d to be saved", departmentID)
+						}
+						if e.ManagerID == nil || *e.ManagerID != managerID {
+							t.Errorf("Expected manager %d to be saved", managerID)
+						}
+						return nil
+					})
+			},
+			expectError: false,
+		},
+		{
+			name:    "clear assignment",
+			request: v1.EmployeeOrganizationRequest{},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: employeeID}, DepartmentID: &departmentID, ManagerID: &managerID}, nil)
+				mockEmployeeRepo.EXPECT().
+					Save(gomock.Any(), &entity.Employee{Base: entity.Base{ID: employeeID}}, nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "employee not found",
+			request: v1.EmployeeOrganizationRequest{
+				ManagerId: &managerID,
+			},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError: true,
+		},
+		{
+			name: "self manager",
+			request: v1.EmployeeOrganizationRequest{
+				ManagerId: &employeeID,
+			},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: employeeID}}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "reporting line cycle",
+			request: v1.EmployeeOrganizationRequest{
+				ManagerId: &managerID,
+			},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: employeeID}}, nil)
+				// The proposed manager already reports to this employee
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(managerID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: managerID}, ManagerID: &employeeID}, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.AssignEmployeeOrganization(context.Background(), employeeID, tt.request)
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestOrganizationUsecase_CreateLocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDepartmentRepo := mock.NewMockDepartmentRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := organization.NewUsecase(mockDepartmentRepo, mockEmployeeRepo, mockLocationRepo)
+
+	validRequest := v1.LocationRequest{
+		Name:       "Jakarta",
+		Timezone:   "Asia/Jakarta",
+		ShiftStart: "08:00",
+		ShiftEnd:   "17:00",
+		Workdays:   []v1.Weekday{v1.Fri, v1.Mon, v1.Tue, v1.Wed, v1.Thu},
+	}
+
+	tests := []struct {
+		name           string
+		request        v1.LocationRequest
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name:    "successful creation",
+			request: validRequest,
+			setupMock: func() {
+				mockLocationRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Location{Name: "Jakarta"}, nil).
+					Return(nil, nil)
+				mockLocationRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, l *entity.Location, _ interface{}) (*entity.Location, error) {
+						if l.Workdays != "mon,tue,wed,thu,fri" {
+							t.Errorf("Expected normalized workdays but got %q", l.Workdays)
+						}
+						l.ID = 4
+						return l, nil
+					})
+			},
+			expectError: false,
+		},
+		{
+			name: "unknown timezone",
+			request: v1.LocationRequest{
+				Name:       "Nowhere",
+				Timezone:   "Mars/Olympus",
+				ShiftStart: "08:00",
+				ShiftEnd:   "17:00",
+				Workdays:   []v1.Weekday{v1.Mon},
+			},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name: "shift starts and ends at the same time",
+			request: v1.LocationRequest{
+				Name:       "Jakarta",
+				Timezone:   "Asia/Jakarta",
+				ShiftStart: "08:00",
+				ShiftEnd:   "08:00",
+				Workdays:   []v1.Weekday{v1.Mon},
+			},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:    "duplicate name",
+			request: validRequest,
+			setupMock: func() {
+				mockLocationRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Location{Name: "Jakarta"}, nil).
+					Return(&entity.Location{Base: entity.Base{ID: 1}, Name: "Jakarta"}, nil)
+			},
+			expectError:    true,
+			expectedStatus: 409,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.CreateLocation(context.Background(), tt.request)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if result.Id != 4 || len(result.Workdays) != 5 || result.Workdays[0] != v1.Mon {
+					t.Errorf("Unexpected location response: %+v", result)
+				}
+			}
+		})
+	}
+}
+
+func TestOrganizationUsecase_AssignEmployeeLocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDepartmentRepo := mock.NewMockDepartmentRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := organization.NewUsecase(mockDepartmentRepo, mockEmployeeRepo, mockLocationRepo)
+
+	employeeID := int64(1)
+	locationID := int64(4)
+
+	tests := []struct {
+		name           string
+		request        v1.EmployeeLocationRequest
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name:    "successful assignment",
+			request: v1.EmployeeLocationRequest{LocationId: &locationID},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: employeeID}}, nil)
+				mockLocationRepo.EXPECT().
+					FindByID(gomock.Any(), uint(locationID), nil).
+					Return(&entity.Location{Base: entity.Base{ID: locationID}}, nil)
+				mockEmployeeRepo.EXPECT().
+					Save(gomock.Any(), &entity.Employee{Base: entity.Base{ID: employeeID}, LocationID: &locationID}, nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:    "clear location",
+			request: v1.EmployeeLocationRequest{},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: employeeID}, LocationID: &locationID}, nil)
+				mockEmployeeRepo.EXPECT().
+					Save(gomock.Any(), &entity.Employee{Base: entity.Base{ID: employeeID}}, nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:    "location not found",
+			request: v1.EmployeeLocationRequest{LocationId: &locationID},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: employeeID}}, nil)
+				mockLocationRepo.EXPECT().
+					FindByID(gomock.Any(), uint(locationID), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError:    true,
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.AssignEmployeeLocation(context.Background(), employeeID, tt.request)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestOrganizationUsecase_AssignEmployeeCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDepartmentRepo := mock.NewMockDepartmentRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := organization.NewUsecase(mockDepartmentRepo, mockEmployeeRepo, mockLocationRepo)
+
+	employeeID := int64(1)
+
+	tests := []struct {
+		name           string
+		request        v1.EmployeeCurrencyRequest
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name:    "successful assignment",
+			request: v1.EmployeeCurrencyRequest{Currency: "usd"},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: employeeID}, Currency: "IDR"}, nil)
+				mockEmployeeRepo.EXPECT().
+					Save(gomock.Any(), &entity.Employee{Base: entity.Base{ID: employeeID}, Currency: "USD"}, nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:           "invalid currency",
+			request:        v1.EmployeeCurrencyRequest{Currency: "US"},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:    "employee not found",
+			request: v1.EmployeeCurrencyRequest{Currency: "USD"},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError:    true,
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.AssignEmployeeCurrency(context.Background(), employeeID, tt.request)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestOrganizationUsecase_AssignEmployeeCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDepartmentRepo := mock.NewMockDepartmentRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := organization.NewUsecase(mockDepartmentRepo, mockEmployeeRepo, mockLocationRepo)
+
+	employeeID := int64(1)
+	code := "0042"
+	paddedCode := " 0042 "
+	blankCode := "  "
+
+	tests := []struct {
+		name           string
+		request        v1.EmployeeCodeRequest
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name:    "successful assignment",
+			request: v1.EmployeeCodeRequest{Code: &paddedCode},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: employeeID}}, nil)
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{Code: &code}, nil).
+					Return(nil, nil)
+				mockEmployeeRepo.EXPECT().
+					Save(gomock.Any(), &entity.Employee{Base: entity.Base{ID: employeeID}, Code: &code}, nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:    "clear code",
+			request: v1.EmployeeCodeRequest{},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: employeeID}, Code: &code}, nil)
+				mockEmployeeRepo.EXPECT().
+					Save(gomock.Any(), &entity.Employee{Base: entity.Base{ID: employeeID}}, nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:    "code held by another employee",
+			request: v1.EmployeeCodeRequest{Code: &code},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: employeeID}}, nil)
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{Code: &code}, nil).
+					Return(&entity.Employee{Base: entity.Base{ID: 2}, Code: &code}, nil)
+			},
+			expectError:    true,
+			expectedStatus: 409,
+		},
+		{
+			name:    "blank code",
+			request: v1.EmployeeCodeRequest{Code: &blankCode},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: employeeID}}, nil)
+			},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:    "employee not found",
+			request: v1.EmployeeCodeRequest{Code: &code},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(employeeID), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError:    true,
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.AssignEmployeeCode(context.Background(), employeeID, tt.request)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestOrganizationUsecase_PrepareEmployee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDepartmentRepo := mock.NewMockDepartmentRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := organization.NewUsecase(mockDepartmentRepo, mockEmployeeRepo, mockLocationRepo)
+
+	departmentID := int64(2)
+	managerID := int64(5)
+	locationID := int64(4)
+	code := "0042"
+	paddedCode := " 0042 "
+
+	tests := []struct {
+		name           string
+		employee       entity.Employee
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name: "valid employee",
+			employee: entity.Employee{
+				BaseSalary:   5000000,
+				DepartmentID: &departmentID,
+				ManagerID:    &managerID,
+				LocationID:   &locationID,
+				Code:         &paddedCode,
+				Currency:     "usd",
+			},
+			setupMock: func() {
+				mockDepartmentRepo.EXPECT().
+					FindByID(gomock.Any(), uint(departmentID), nil).
+					Return(&entity.Department{Base: entity.Base{ID: departmentID}}, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(managerID), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: managerID}}, nil)
+				mockLocationRepo.EXPECT().
+					FindByID(gomock.Any(), uint(locationID), nil).
+					Return(&entity.Location{Base: entity.Base{ID: locationID}}, nil)
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{Code: &code}, nil).
+					Return(nil, nil)
+			},
+			expectError: false,
+		},
+		{
+			name:           "base salary missing",
+			employee:       entity.Employee{},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:           "invalid currency",
+			employee:       entity.Employee{BaseSalary: 5000000, Currency: "dollar"},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:     "manager not found",
+			employee: entity.Employee{BaseSalary: 5000000, ManagerID: &managerID},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(managerID), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError:    true,
+			expectedStatus: 404,
+		},
+		{
+			name:     "code held by another employee",
+			employee: entity.Employee{BaseSalary: 5000000, Code: &code},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{Code: &code}, nil).
+					Return(&entity.Employee{Base: entity.Base{ID: 2}, Code: &code}, nil)
+			},
+			expectError:    true,
+			expectedStatus: 409,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			employee := tt.employee
+			err := usecase.PrepareEmployee(context.Background(), &employee)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if employee.Code != nil && *employee.Code != code {
+				t.Errorf("Expected code %q but got %q", code, *employee.Code)
+			}
+			if employee.Currency != "" && employee.Currency != "USD" {
+				t.Errorf("Expected currency USD but got %q", employee.Currency)
+			}
+		})
+	}
+}
+
+func TestOrganizationUsecase_UpdateAttendancePolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDepartmentRepo := mock.NewMockDepartmentRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := organization.NewUsecase(mockDepartmentRepo, mockEmployeeRepo, mockLocationRepo)
+
+	locationID := int64(4)
+	office := v1.Geofence{Name: "HQ", Latitude: -6.2, Longitude: 106.8166, RadiusMeters: 150}
+
+	tests := []struct {
+		name           string
+		request        v1.AttendancePolicy
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name: "successful update",
+			request: v1.AttendancePolicy{
+				Geofences:       []v1.Geofence{office},
+				AllowedNetworks: []string{"10.0.0.0/8", " 203.0.113.7 "},
+			},
+			setupMock: func() {
+				mockLocationRepo.EXPECT().
+					FindByID(gomock.Any(), uint(locationID), nil).
+					Return(&entity.Location{Base: entity.Base{ID: locationID}, Name: "Jakarta"}, nil)
+				mockLocationRepo.EXPECT().
+					SaveAttendancePolicy(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, location *entity.Location, geofences []entity.Geofence, _ interface{}) error {
+						if location.AllowedNetworks != "10.0.0.0/8,203.0.113.7/32" {
+							t.Errorf("Expected normalized networks but got %q", location.AllowedNetworks)
+						}
+						if len(geofences) != 1 || geofences[0].Name != "HQ" || geofences[0].RadiusMeters != 150 {
+							t.Errorf("Expected the HQ geofence but got %+v", geofences)
+						}
+						return nil
+					})
+			},
+			expectError: false,
+		},
+		{
+			name:    "clear policy",
+			request: v1.AttendancePolicy{Geofences: []v1.Geofence{}, AllowedNetworks: []string{}},
+			setupMock: func() {
+				mockLocationRepo.EXPECT().
+					FindByID(gomock.Any(), uint(locationID), nil).
+					Return(&entity.Location{Base: entity.Base{ID: locationID}, AllowedNetworks: "10.0.0.0/8"}, nil)
+				mockLocationRepo.EXPECT().
+					SaveAttendancePolicy(gomock.Any(), &entity.Location{Base: entity.Base{ID: locationID}}, []entity.Geofence{}, nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:           "invalid IP range",
+			request:        v1.AttendancePolicy{Geofences: []v1.Geofence{}, AllowedNetworks: []string{"10.0.0.0/40"}},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name: "geofence radius too large",
+			request: v1.AttendancePolicy{
+				Geofences:       []v1.Geofence{{Name: "City", Latitude: -6.2, Longitude: 106.8, RadiusMeters: 20000}},
+				AllowedNetworks: []string{},
+			},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:    "location not found",
+			request: v1.AttendancePolicy{Geofences: []v1.Geofence{office}, AllowedNetworks: []string{}},
+			setupMock: func() {
+				mockLocationRepo.EXPECT().
+					FindByID(gomock.Any(), uint(locationID), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError:    true,
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			_, err := usecase.UpdateAttendancePolicy(context.Background(), locationID, tt.request)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+			} else if err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/money"
+)
+
+// PrepareEmployee checks an employee about to be created against the rules
+// for assigning a department, manager, location, code and currency,
+// normalizing the code and currency. Nothing is stored.
+func (u *UsecaseImpl) PrepareEmployee(ctx context.Context, employee *entity.Employee) error {
+	if employee.BaseSalary <= 0 {
+		return httppkg.NewBadRequestError("base salary must be greater than 0")
+	}
+
+	if employee.Currency != "" {
+		currency, err := money.ParseCurrency(employee.Currency)
+		if err != nil {
+			return httppkg.NewBadRequestError(err.Error())
+		}
+		employee.Currency = string(currency)
+	}
+
+	if employee.DepartmentID != nil {
+		if _, err := u.findDepartment(ctx, *employee.DepartmentID); err != nil {
+			return err
+		}
+	}
+
+	if employee.ManagerID != nil {
+		if err := u.validateManager(ctx, employee.ID, *employee.ManagerID); err != nil {
+			return err
+		}
+	}
+
+	if employee.LocationID != nil {
+		if _, err := u.findLocation(ctx, *employee.LocationID); err != nil {
+			return err
+		}
+	}
+
+	if employee.Code != nil {
+		code, err := u.validateEmployeeCode(ctx, employee.ID, *employee.Code)
+		if err != nil {
+			return err
+		}
+		employee.Code = &code
+	}
+
+	return nil
+}
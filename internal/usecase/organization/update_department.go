@@ -0,0 +1,97 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) UpdateDepartment(ctx context.Context, departmentID int64, req v1.DepartmentRequest) (*v1.Department, error) {
+	if err := validateDepartmentRequest(req); err != nil {
+		return nil, err
+	}
+
+	department, err := u.findDepartment(ctx, departmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	code := strings.TrimSpace(req.Code)
+	if code != department.Code {
+		existing, err := u.departmentRepo.FindOneByTemplate(ctx, &entity.Department{Code: code}, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to check existing department", "code", code, "error", err)
+			return nil, httppkg.NewInternalServerError("failed to check existing department")
+		}
+		if existing != nil {
+			return nil, httppkg.NewConflictError("department code already exists")
+		}
+	}
+
+	if req.ParentId != nil {
+		if err := u.validateParentDepartment(ctx, departmentID, *req.ParentId); err != nil {
+			return nil, err
+		}
+	}
+
+	department.Code = code
+	department.Name = strings.TrimSpace(req.Name)
+	department.CostCenter = strings.TrimSpace(req.CostCenter)
+	department.ParentID = req.ParentId
+
+	// Save is used instead of Updates so the parent can be cleared
+	if err := u.departmentRepo.Save(ctx, department, nil); err != nil {
+		logger.Error(ctx, "failed to update department", "department_id", departmentID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to update department")
+	}
+
+	return toDepartmentResponse(department), nil
+}
+
+func (u *UsecaseImpl) findDepartment(ctx context.Context, departmentID int64) (*entity.Department, error) {
+	department, err := u.departmentRepo.FindByID(ctx, uint(departmentID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find department", "department_id", departmentID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find department")
+	}
+	if department == nil {
+		return nil, httppkg.NewNotFoundError("department not found")
+	}
+	return department, nil
+}
+
+// validateParentDepartment walks up the parent chain to make sure the
+// department would not become its own ancestor.
+func (u *UsecaseImpl) validateParentDepartment(ctx context.Context, departmentID, parentID int64) error {
+	visited := map[int64]bool{departmentID: true}
+	currentID := parentID
+
+	for {
+		if visited[currentID] {
+			return httppkg.NewUnprocessableEntityError("department hierarchy cannot contain cycles")
+		}
+		visited[currentID] = true
+
+		parent, err := u.departmentRepo.FindByID(ctx, uint(currentID), nil)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Error(ctx, "failed to find parent department", "parent_id", currentID, "error", err)
+			return httppkg.NewInternalServerError("failed to find parent department")
+		}
+		if parent == nil {
+			if currentID == parentID {
+				return httppkg.NewNotFoundError("parent department not found")
+			}
+			return nil
+		}
+		if parent.ParentID == nil {
+			return nil
+		}
+		currentID = *parent.ParentID
+	}
+}
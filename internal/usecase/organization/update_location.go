@@ -0,0 +1,64 @@
+package organization
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"gorm.io/gorm"
+)
+
+// UpdateLocation changes the timezone or schedule of a location. Records
+// already stored keep the day they were assigned to; the new schedule applies
+// from the next submission and payroll run on.
+func (u *UsecaseImpl) UpdateLocation(ctx context.Context, locationID int64, req v1.LocationRequest) (*v1.Location, error) {
+	updated, err := toLocationEntity(req)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := u.findLocation(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if updated.Name != location.Name {
+		existing, err := u.locationRepo.FindOneByTemplate(ctx, &entity.Location{Name: updated.Name}, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to check existing location", "name", updated.Name, "error", err)
+			return nil, httppkg.NewInternalServerError("failed to check existing location")
+		}
+		if existing != nil {
+			return nil, httppkg.NewConflictError("location name already exists")
+		}
+	}
+
+	location.Name = updated.Name
+	location.Timezone = updated.Timezone
+	location.ShiftStart = updated.ShiftStart
+	location.ShiftEnd = updated.ShiftEnd
+	location.Workdays = updated.Workdays
+	location.Rostered = updated.Rostered
+
+	if err := u.locationRepo.Save(ctx, location, nil); err != nil {
+		logger.Error(ctx, "failed to update location", "location_id", locationID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to update location")
+	}
+
+	return toLocationResponse(location), nil
+}
+
+func (u *UsecaseImpl) findLocation(ctx context.Context, locationID int64) (*entity.Location, error) {
+	location, err := u.locationRepo.FindByID(ctx, uint(locationID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find location", "location_id", locationID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find location")
+	}
+	if location == nil {
+		return nil, httppkg.NewNotFoundError("location not found")
+	}
+	return location, nil
+}
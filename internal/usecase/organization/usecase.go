@@ -0,0 +1,45 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+//go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/organization Usecase
+type Usecase interface {
+	CreateDepartment(ctx context.Context, req v1.DepartmentRequest) (*v1.Department, error)
+	ListDepartments(ctx context.Context) ([]v1.Department, error)
+	UpdateDepartment(ctx context.Context, departmentID int64, req v1.DepartmentRequest) (*v1.Department, error)
+	AssignEmployeeOrganization(ctx context.Context, employeeID int64, req v1.EmployeeOrganizationRequest) error
+	CreateLocation(ctx context.Context, req v1.LocationRequest) (*v1.Location, error)
+	ListLocations(ctx context.Context) ([]v1.Location, error)
+	UpdateLocation(ctx context.Context, locationID int64, req v1.LocationRequest) (*v1.Location, error)
+	AssignEmployeeLocation(ctx context.Context, employeeID int64, req v1.EmployeeLocationRequest) error
+	GetAttendancePolicy(ctx context.Context, locationID int64) (*v1.AttendancePolicy, error)
+	UpdateAttendancePolicy(ctx context.Context, locationID int64, req v1.AttendancePolicy) (*v1.AttendancePolicy, error)
+	UnbindEmployeeDevice(ctx context.Context, employeeID int64) error
+	AssignEmployeeCode(ctx context.Context, employeeID int64, req v1.EmployeeCodeRequest) error
+	AssignEmployeeCurrency(ctx context.Context, employeeID int64, req v1.EmployeeCurrencyRequest) error
+	PrepareEmployee(ctx context.Context, employee *entity.Employee) error
+}
+
+type UsecaseImpl struct {
+	departmentRepo repository.DepartmentRepository
+	employeeRepo   repository.EmployeeRepository
+	locationRepo   repository.LocationRepository
+}
+
+func NewUsecase(
+	departmentRepo repository.DepartmentRepository,
+	employeeRepo repository.EmployeeRepository,
+	locationRepo repository.LocationRepository,
+) Usecase {
+	return &UsecaseImpl{
+		departmentRepo: departmentRepo,
+		employeeRepo:   employeeRepo,
+		locationRepo:   locationRepo,
+	}
+}
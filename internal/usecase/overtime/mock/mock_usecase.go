@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	entity "github.com/asyauqi15/payslip-system/internal/entity"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -21,6 +22,7 @@ import (
 type MockUsecase struct {
 	ctrl     *gomock.Controller
 	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
 }
 
 // MockUsecaseMockRecorder is the mock recorder for MockUsecase.
@@ -40,16 +42,31 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// PrepareOvertime mocks base method.
+func (m *MockUsecase) PrepareOvertime(ctx context.Context, employee *entity.Employee, req v1.OvertimeRequest, pending []entity.Overtime) (*entity.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareOvertime", ctx, employee, req, pending)
+	ret0, _ := ret[0].(*entity.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareOvertime indicates an expected call of PrepareOvertime.
+func (mr *MockUsecaseMockRecorder) PrepareOvertime(ctx, employee, req, pending any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareOvertime", reflect.TypeOf((*MockUsecase)(nil).PrepareOvertime), ctx, employee, req, pending)
+}
+
 // SubmitOvertime mocks base method.
-func (m *MockUsecase) SubmitOvertime(arg0 context.Context, arg1 v1.OvertimeRequest) error {
+func (m *MockUsecase) SubmitOvertime(ctx context.Context, req v1.OvertimeRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubmitOvertime", arg0, arg1)
+	ret := m.ctrl.Call(m, "SubmitOvertime", ctx, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SubmitOvertime indicates an expected call of SubmitOvertime.
-func (mr *MockUsecaseMockRecorder) SubmitOvertime(arg0, arg1 any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) SubmitOvertime(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitOvertime", reflect.TypeOf((*MockUsecase)(nil).SubmitOvertime), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitOvertime", reflect.TypeOf((*MockUsecase)(nil).SubmitOvertime), ctx, req)
 }
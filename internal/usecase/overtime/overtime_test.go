@@ -7,6 +7,7 @@ import (
 
 	"github.com/asyauqi15/payslip-system/internal/constant"
 	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
 	"github.com/asyauqi15/payslip-system/internal/repository/mock"
 	"github.com/asyauqi15/payslip-system/internal/usecase/overtime"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
@@ -21,8 +22,29 @@ func TestOvertimeUsecase_SubmitOvertime(t *testing.T) {
 	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
 	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
 	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
 
-	usecase := overtime.NewUsecase(mockOvertimeRepo, mockEmployeeRepo, mockAttendanceRepo)
+	usecase := overtime.NewUsecase(mockOvertimeRepo, mockEmployeeRepo, mockAttendanceRepo, mockLocationRepo)
+
+	utcSchedule := entity.DefaultWorkSchedule()
+	utcSchedule.Zone = time.UTC
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	jakartaSchedule := entity.DefaultWorkSchedule()
+	jakartaSchedule.Zone = jakarta
+	// A late shift on Saturday 2025-01-04 only
+	rosteredSchedule := &entity.WorkSchedule{
+		Zone:     time.UTC,
+		Rostered: true,
+		Roster: map[string]entity.ShiftHours{
+			"2025-01-04": {Start: 14 * time.Hour, End: 22 * time.Hour},
+		},
+	}
+
+	// Night shifts from 22:00 to 06:00 the next morning
+	nightSchedule := entity.DefaultWorkSchedule()
+	nightSchedule.Zone = time.UTC
+	nightShift := entity.NewShiftHours(22*time.Hour, 6*time.Hour)
+	nightSchedule.ShiftStart, nightSchedule.ShiftEnd = nightShift.Start, nightShift.End
 
 	startTime := time.Date(2025, 1, 1, 18, 0, 0, 0, time.UTC) // 6 PM
 	endTime := time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC)   // 8 PM
@@ -54,10 +76,13 @@ func TestOvertimeUsecase_SubmitOvertime(t *testing.T) {
 				mockEmployeeRepo.EXPECT().
 					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
 					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(utcSchedule, nil)
 
 				// Mock finding no conflicting overtime
 				mockOvertimeRepo.EXPECT().
-					FindByTemplate(gomock.Any(), gomock.Any(), nil).
+					Find(gomock.Any(), gomock.Any(), nil).
 					Return([]entity.Overtime{}, nil)
 
 				// Mock creating overtime
@@ -169,10 +194,13 @@ func TestOvertimeUsecase_SubmitOvertime(t *testing.T) {
 				mockEmployeeRepo.EXPECT().
 					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
 					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(utcSchedule, nil)
 
 				// Mock finding no conflicting overtime
 				mockOvertimeRepo.EXPECT().
-					FindByTemplate(gomock.Any(), gomock.Any(), nil).
+					Find(gomock.Any(), gomock.Any(), nil).
 					Return([]entity.Overtime{}, nil)
 
 				// Mock creating overtime (weekend work is allowed)
@@ -208,6 +236,9 @@ func TestOvertimeUsecase_SubmitOvertime(t *testing.T) {
 				mockEmployeeRepo.EXPECT().
 					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
 					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(utcSchedule, nil)
 
 				// Mock finding conflicting overtime
 				conflictingOvertime := entity.Overtime{
@@ -218,11 +249,170 @@ func TestOvertimeUsecase_SubmitOvertime(t *testing.T) {
 					Description: "Existing overtime",
 				}
 				mockOvertimeRepo.EXPECT().
-					FindByTemplate(gomock.Any(), gomock.Any(), nil).
+					Find(gomock.Any(), gomock.Any(), nil).
 					Return([]entity.Overtime{conflictingOvertime}, nil)
 			},
 			expectError: true,
 		},
+		{
+			name: "workday overtime before shift end",
+			request: v1.OvertimeRequest{
+				StartTime:   time.Date(2025, 1, 3, 16, 0, 0, 0, time.UTC), // Friday
+				EndTime:     time.Date(2025, 1, 3, 18, 0, 0, 0, time.UTC),
+				Description: "Release",
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}
+
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(utcSchedule, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "workday overtime after shift end in employee timezone",
+			request: v1.OvertimeRequest{
+				StartTime:   time.Date(2025, 1, 3, 11, 0, 0, 0, time.UTC), // Friday 18:00 in Jakarta
+				EndTime:     time.Date(2025, 1, 3, 13, 0, 0, 0, time.UTC),
+				Description: "Release",
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}
+
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(jakartaSchedule, nil)
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Overtime{}, nil)
+				mockOvertimeRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					Return(&entity.Overtime{Base: entity.Base{ID: 1}}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "overtime during rostered shift",
+			request: v1.OvertimeRequest{
+				StartTime:   time.Date(2025, 1, 4, 20, 0, 0, 0, time.UTC), // Saturday
+				EndTime:     time.Date(2025, 1, 4, 22, 0, 0, 0, time.UTC),
+				Description: "Stock count",
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}
+
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(rosteredSchedule, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "overtime on a weekday off the roster",
+			request: v1.OvertimeRequest{
+				StartTime:   time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC), // Monday
+				EndTime:     time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC),
+				Description: "Stock count",
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}
+
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(rosteredSchedule, nil)
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Overtime{}, nil)
+				mockOvertimeRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					Return(&entity.Overtime{Base: entity.Base{ID: 1}}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "overtime right after a night shift",
+			request: v1.OvertimeRequest{
+				StartTime:   time.Date(2025, 1, 7, 6, 0, 0, 0, time.UTC), // Tuesday, after Monday's night
+				EndTime:     time.Date(2025, 1, 7, 8, 0, 0, 0, time.UTC),
+				Description: "Handover",
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}
+
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(nightSchedule, nil)
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Overtime{}, nil)
+				mockOvertimeRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					Return(&entity.Overtime{Base: entity.Base{ID: 1}}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "night shift overtime across midnight exceeding daily limit",
+			request: v1.OvertimeRequest{
+				StartTime:   time.Date(2025, 1, 7, 7, 0, 0, 0, time.UTC),
+				EndTime:     time.Date(2025, 1, 7, 9, 0, 0, 0, time.UTC),
+				Description: "Handover",
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}
+
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(employee, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+					Return(nightSchedule, nil)
+				// Two hours before Monday's night shift already count for Monday
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Overtime{{
+						EmployeeID: 1,
+						StartAt:    time.Date(2025, 1, 6, 6, 0, 0, 0, time.UTC),
+						EndAt:      time.Date(2025, 1, 6, 8, 0, 0, 0, time.UTC),
+						Status:     entity.ApprovalStatusApproved,
+					}}, nil)
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -244,3 +434,93 @@ func TestOvertimeUsecase_SubmitOvertime(t *testing.T) {
 		})
 	}
 }
+
+func TestOvertimeUsecase_PrepareOvertime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := overtime.NewUsecase(mockOvertimeRepo, mockEmployeeRepo, mockAttendanceRepo, mockLocationRepo)
+
+	schedule := entity.DefaultWorkSchedule()
+	schedule.Zone = time.UTC
+
+	employee := &entity.Employee{Base: entity.Base{ID: 1}, UserID: 1, BaseSalary: 5000000}
+	request := v1.OvertimeRequest{
+		StartTime:   time.Date(2025, 1, 1, 18, 0, 0, 0, time.UTC),
+		EndTime:     time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC),
+		Description: "Month end closing",
+	}
+	// Only overtimes from the day before to the day after the work day
+	conflictQuery := repository.NewQuery().
+		Where("employee_id", repository.OpEqual, int64(1)).
+		Where("status", repository.OpNotEqual, entity.ApprovalStatusRejected).
+		WhereDateRange("start_at", repository.DaysBetween(
+			time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		))
+
+	tests := []struct {
+		name        string
+		pending     []entity.Overtime
+		expectError bool
+	}{
+		{
+			name: "pending overtime of another employee is ignored",
+			pending: []entity.Overtime{{
+				EmployeeID: 2,
+				StartAt:    time.Date(2025, 1, 1, 18, 0, 0, 0, time.UTC),
+				EndAt:      time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC),
+			}},
+			expectError: false,
+		},
+		{
+			name: "overlaps pending overtime",
+			pending: []entity.Overtime{{
+				EmployeeID: 1,
+				StartAt:    time.Date(2025, 1, 1, 19, 0, 0, 0, time.UTC),
+				EndAt:      time.Date(2025, 1, 1, 20, 30, 0, 0, time.UTC),
+			}},
+			expectError: true,
+		},
+		{
+			name: "pending overtime counts towards the daily limit",
+			pending: []entity.Overtime{{
+				EmployeeID: 1,
+				StartAt:    time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC),
+				EndAt:      time.Date(2025, 1, 1, 21, 30, 0, 0, time.UTC),
+			}},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockLocationRepo.EXPECT().
+				ScheduleFor(gomock.Any(), employee, gomock.Any(), nil).
+				Return(schedule, nil)
+			mockOvertimeRepo.EXPECT().
+				Find(gomock.Any(), conflictQuery, nil).
+				Return([]entity.Overtime{}, nil)
+
+			result, err := usecase.PrepareOvertime(context.Background(), employee, request, tt.pending)
+
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if result.EmployeeID != 1 || result.Status != entity.ApprovalStatusPending {
+				t.Errorf("Expected pending overtime of employee 1 but got %+v", result)
+			}
+		})
+	}
+}
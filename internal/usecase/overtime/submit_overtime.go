@@ -6,6 +6,7 @@ import (
 
 	"github.com/asyauqi15/payslip-system/internal/constant"
 	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
 	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
 	"github.com/asyauqi15/payslip-system/pkg/logger"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
@@ -31,85 +32,117 @@ func (u *UsecaseImpl) SubmitOvertime(ctx context.Context, req v1.OvertimeRequest
 		return httppkg.NewNotFoundError("employee not found")
 	}
 
+	overtime, err := u.PrepareOvertime(ctx, employee, req, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = u.overtimeRepo.Create(ctx, overtime, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to create overtime", "employee_id", employee.ID, "error", err)
+		return httppkg.NewInternalServerError("failed to submit overtime")
+	}
+
+	logger.Info(ctx, "overtime submitted successfully",
+		"employee_id", employee.ID,
+		"start_time", req.StartTime,
+		"end_time", req.EndTime)
+
+	return nil
+}
+
+// PrepareOvertime checks a request against the overtime rules for the
+// employee and returns the overtime it would record, pending review. Pending
+// holds overtimes about to be recorded along with it, which count towards
+// overlaps and the daily limit like recorded ones. Nothing is stored.
+func (u *UsecaseImpl) PrepareOvertime(ctx context.Context, employee *entity.Employee, req v1.OvertimeRequest, pending []entity.Overtime) (*entity.Overtime, error) {
 	// Validate overtime times
 	if req.EndTime.Before(req.StartTime) {
-		return httppkg.NewBadRequestError("end time must be after start time")
+		return nil, httppkg.NewBadRequestError("end time must be after start time")
 	}
 
 	// Check maximum 3 hours per day
 	duration := req.EndTime.Sub(req.StartTime)
 	if duration > 3*time.Hour {
-		return httppkg.NewBadRequestError("maximum overtime per day is 3 hours")
+		return nil, httppkg.NewBadRequestError("maximum overtime per day is 3 hours")
 	}
 
-	// Get the date of the overtime
-	overtimeDate := req.StartTime.Format("2006-01-02")
+	// Days and shift times follow the employee's location
+	schedule, err := u.locationRepo.ScheduleFor(ctx, employee, repository.DaysAround(req.StartTime), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find work schedule", "employee_id", employee.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find work schedule")
+	}
 
-	// Check if it's weekend
-	isWeekend := req.StartTime.Weekday() == time.Saturday || req.StartTime.Weekday() == time.Sunday
+	// Overtime right after a night shift belongs to the day the shift started
+	workDay := schedule.WorkDay(req.StartTime)
 
-	if !isWeekend {
-		// Weekday rules: must have checked out and overtime must start after 5PM
-		if err := u.validateWeekdayOvertime(ctx, req.StartTime); err != nil {
-			return err
+	// Workday rules: overtime must start after the shift ends
+	if schedule.IsWorkday(workDay) {
+		if err := u.validateWeekdayOvertime(ctx, schedule, workDay, req.StartTime); err != nil {
+			return nil, err
 		}
 	}
 
 	// Check for overlapping overtime records and daily limit
-	if err := u.validateOvertimeConflicts(ctx, employee.ID, req.StartTime, req.EndTime, overtimeDate); err != nil {
-		return err
+	if err := u.validateOvertimeConflicts(ctx, employee.ID, schedule, req.StartTime, req.EndTime, workDay, pending); err != nil {
+		return nil, err
 	}
 
-	// Create overtime record
-	overtime := &entity.Overtime{
+	return &entity.Overtime{
 		EmployeeID:  employee.ID,
 		StartAt:     req.StartTime,
 		EndAt:       req.EndTime,
 		Description: req.Description,
-	}
-
-	_, err = u.overtimeRepo.Create(ctx, overtime, nil)
-	if err != nil {
-		logger.Error(ctx, "failed to create overtime", "employee_id", employee.ID, "error", err)
-		return httppkg.NewInternalServerError("failed to submit overtime")
-	}
-
-	logger.Info(ctx, "overtime submitted successfully",
-		"employee_id", employee.ID,
-		"start_time", req.StartTime,
-		"end_time", req.EndTime,
-		"is_weekend", isWeekend)
-
-	return nil
+		Status:      entity.ApprovalStatusPending,
+	}, nil
 }
 
-func (u *UsecaseImpl) validateWeekdayOvertime(ctx context.Context, startTime time.Time) error {
-	// Check if overtime starts after 5PM (17:00)
-	hour := startTime.Hour()
-	if hour < 17 {
-		return httppkg.NewBadRequestError("overtime on weekdays can only start after 5PM")
+func (u *UsecaseImpl) validateWeekdayOvertime(ctx context.Context, schedule *entity.WorkSchedule, workDay, startTime time.Time) error {
+	// Check if overtime starts after the shift of its work day ends
+	shiftEnd := schedule.ShiftEndOn(workDay)
+	if startTime.Before(shiftEnd) {
+		return httppkg.NewBadRequestError("overtime on workdays can only start after the shift ends at " + shiftEnd.Format("15:04"))
 	}
 
 	return nil
 }
 
-func (u *UsecaseImpl) validateOvertimeConflicts(ctx context.Context, employeeID int64, startTime, endTime time.Time, date string) error {
-	// Get all existing overtimes for the employee
-	existingOvertimes, err := u.overtimeRepo.FindByTemplate(ctx, &entity.Overtime{EmployeeID: employeeID}, nil)
+func (u *UsecaseImpl) validateOvertimeConflicts(ctx context.Context, employeeID int64, schedule *entity.WorkSchedule, startTime, endTime, workDay time.Time, pending []entity.Overtime) error {
+	// Get the employee's overtimes that can overlap or share the work day.
+	// Overtime lasts at most 3 hours and starts on its work day or, after a
+	// night shift, on the day after, so the day before to the day after the
+	// work day covers both.
+	query := repository.NewQuery().
+		Where("employee_id", repository.OpEqual, employeeID).
+		Where("status", repository.OpNotEqual, entity.ApprovalStatusRejected).
+		WhereDateRange("start_at", repository.DaysBetween(workDay.AddDate(0, 0, -1), workDay.AddDate(0, 0, 1)))
+	existingOvertimes, err := u.overtimeRepo.Find(ctx, query, nil)
 	if err != nil {
 		logger.Error(ctx, "failed to find existing overtimes", "employee_id", employeeID, "error", err)
 		return httppkg.NewInternalServerError("failed to validate overtime period")
 	}
+	for _, overtime := range pending {
+		if overtime.EmployeeID == employeeID {
+			existingOvertimes = append(existingOvertimes, overtime)
+		}
+	}
 
+	date := schedule.Date(workDay)
 	var totalDailyOvertimeDuration time.Duration
 	for _, existing := range existingOvertimes {
+		// Rejected overtime no longer occupies its time slot
+		if existing.Status == entity.ApprovalStatusRejected {
+			continue
+		}
+
 		// Check for time overlap
 		if startTime.Before(existing.EndAt) && endTime.After(existing.StartAt) {
 			return httppkg.NewBadRequestError("overtime period overlaps with existing overtime")
 		}
 
-		// Calculate total overtime for the same date
-		if existing.StartAt.Format("2006-01-02") == date {
+		// Calculate total overtime for the same work day
+		if schedule.Date(schedule.WorkDay(existing.StartAt)) == date {
 			totalDailyOvertimeDuration += existing.EndAt.Sub(existing.StartAt)
 		}
 	}
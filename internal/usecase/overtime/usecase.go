@@ -3,6 +3,7 @@ package overtime
 import (
 	"context"
 
+	"github.com/asyauqi15/payslip-system/internal/entity"
 	"github.com/asyauqi15/payslip-system/internal/repository"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 )
@@ -10,22 +11,26 @@ import (
 //go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/overtime Usecase
 type Usecase interface {
 	SubmitOvertime(ctx context.Context, req v1.OvertimeRequest) error
+	PrepareOvertime(ctx context.Context, employee *entity.Employee, req v1.OvertimeRequest, pending []entity.Overtime) (*entity.Overtime, error)
 }
 
 type UsecaseImpl struct {
 	overtimeRepo   repository.OvertimeRepository
 	employeeRepo   repository.EmployeeRepository
 	attendanceRepo repository.AttendanceRepository
+	locationRepo   repository.LocationRepository
 }
 
 func NewUsecase(
 	overtimeRepo repository.OvertimeRepository,
 	employeeRepo repository.EmployeeRepository,
 	attendanceRepo repository.AttendanceRepository,
+	locationRepo repository.LocationRepository,
 ) Usecase {
 	return &UsecaseImpl{
 		overtimeRepo:   overtimeRepo,
 		employeeRepo:   employeeRepo,
 		attendanceRepo: attendanceRepo,
+		locationRepo:   locationRepo,
 	}
 }
@@ -0,0 +1,40 @@
+package password
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/spf13/cast"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) ChangePassword(ctx context.Context, req v1.ChangePasswordRequest) error {
+	userID := cast.ToInt64(ctx.Value(constant.ContextKeyUserID))
+	if userID == 0 {
+		return httppkg.NewUnauthorizedError("user not authenticated")
+	}
+
+	user, err := u.userRepo.FindByID(ctx, uint(userID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find user", "user_id", userID, "error", err)
+		return httppkg.NewInternalServerError("failed to find user")
+	}
+	if user == nil {
+		return httppkg.NewUnauthorizedError("user not found")
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.CurrentPassword)) != nil {
+		return httppkg.NewUnprocessableEntityError("current password is incorrect")
+	}
+
+	if err := u.validateNewPassword(ctx, user, req.NewPassword); err != nil {
+		return err
+	}
+
+	return u.setPassword(ctx, user, req.NewPassword, passwordChangeMethodSelfService)
+}
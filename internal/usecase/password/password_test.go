@@ -0,0 +1,413 @@
+package password_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal"
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/password"
+	"github.com/asyauqi15/payslip-system/pkg/notifier"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+var testPolicy = internal.PasswordPolicyConfig{
+	MinLength:        12,
+	RequireUppercase: true,
+	RequireLowercase: true,
+	RequireDigit:     true,
+	HistorySize:      3,
+}
+
+type recordingNotifier struct {
+	messages []notifier.Message
+	err      error
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, message notifier.Message) error {
+	n.messages = append(n.messages, message)
+	return n.err
+}
+
+type passwordMocks struct {
+	userRepo               *mock.MockUserRepository
+	passwordHistoryRepo    *mock.MockPasswordHistoryRepository
+	passwordResetTokenRepo *mock.MockPasswordResetTokenRepository
+	refreshTokenRepo       *mock.MockRefreshTokenRepository
+	auditLogRepo           *mock.MockAuditLogRepository
+	notifier               *recordingNotifier
+}
+
+func newPasswordUsecase(ctrl *gomock.Controller) (password.Usecase, *passwordMocks) {
+	m := &passwordMocks{
+		userRepo:               mock.NewMockUserRepository(ctrl),
+		passwordHistoryRepo:    mock.NewMockPasswordHistoryRepository(ctrl),
+		passwordResetTokenRepo: mock.NewMockPasswordResetTokenRepository(ctrl),
+		refreshTokenRepo:       mock.NewMockRefreshTokenRepository(ctrl),
+		auditLogRepo:           mock.NewMockAuditLogRepository(ctrl),
+		notifier:               &recordingNotifier{},
+	}
+
+	usecase := password.NewUsecase(m.userRepo, m.passwordHistoryRepo, m.passwordResetTokenRepo, m.refreshTokenRepo, m.auditLogRepo, m.notifier, testPolicy, time.Hour)
+
+	return usecase, m
+}
+
+func mustHash(t *testing.T, p string) string {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(p), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(hash)
+}
+
+func expectStatus(t *testing.T, err error, status int) {
+	t.Helper()
+
+	if status == 0 {
+		if err != nil {
+			t.Errorf("Expected no error but got: %v", err)
+		}
+		return
+	}
+
+	var httpErr interface{ HTTPStatus() int }
+	if !errors.As(err, &httpErr) || httpErr.HTTPStatus() != status {
+		t.Errorf("Expected status %d but got error: %v", status, err)
+	}
+}
+
+func TestPasswordUsecase_ChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, m := newPasswordUsecase(ctrl)
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "7")
+	user := &entity.User{
+		Base:               entity.Base{ID: 7},
+		Username:           "employee006",
+		PasswordHash:       mustHash(t, "Current-Pass1"),
+		MustChangePassword: true,
+	}
+	previousHash := mustHash(t, "Previous-Pass1")
+
+	expectUser := func() {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(7), nil).
+			Return(user, nil)
+	}
+	expectHistory := func() {
+		m.passwordHistoryRepo.EXPECT().
+			FindRecentHashes(gomock.Any(), int64(7), 2, nil).
+			Return([]string{previousHash}, nil)
+	}
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		req             v1.ChangePasswordRequest
+		setupMock       func()
+		expectedStatus  int
+		expectedMessage string
+	}{
+		{
+			name: "successful change",
+			ctx:  ctx,
+			req:  v1.ChangePasswordRequest{CurrentPassword: "Current-Pass1", NewPassword: "Brand-New-Pass1"},
+			setupMock: func() {
+				expectUser()
+				expectHistory()
+				m.userRepo.EXPECT().
+					UpdatePassword(gomock.Any(), user, gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, _ *entity.User, hash string, _ time.Time, _ *gorm.DB) error {
+						if bcrypt.CompareHashAndPassword([]byte(hash), []byte("Brand-New-Pass1")) != nil {
+							t.Error("Expected stored hash to match the new password")
+						}
+						return nil
+					})
+				m.refreshTokenRepo.EXPECT().
+					RevokeByUserID(gomock.Any(), int64(7), gomock.Any(), nil).
+					Return(int64(2), nil)
+				m.auditLogRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, auditLog *entity.AuditLog, _ *gorm.DB) error {
+						if auditLog.Action != entity.AuditLogActionPasswordChange || auditLog.RecordID != 7 {
+							t.Errorf("Unexpected audit log: %+v", auditLog)
+						}
+						if _, ok := auditLog.DataAfter["password_hash"]; ok {
+							t.Error("Audit log must not contain the password hash")
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name:           "unauthenticated",
+			ctx:            context.Background(),
+			req:            v1.ChangePasswordRequest{CurrentPassword: "Current-Pass1", NewPassword: "Brand-New-Pass1"},
+			setupMock:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "wrong current password",
+			ctx:  ctx,
+			req:  v1.ChangePasswordRequest{CurrentPassword: "wrong", NewPassword: "Brand-New-Pass1"},
+			setupMock: func() {
+				expectUser()
+			},
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedMessage: "current password is incorrect",
+		},
+		{
+			name: "policy violation lists every broken rule",
+			ctx:  ctx,
+			req:  v1.ChangePasswordRequest{CurrentPassword: "Current-Pass1", NewPassword: "short"},
+			setupMock: func() {
+				expectUser()
+			},
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedMessage: "password must be at least 12 characters long, contain an uppercase letter, contain a digit",
+		},
+		{
+			name: "current password reused",
+			ctx:  ctx,
+			req:  v1.ChangePasswordRequest{CurrentPassword: "Current-Pass1", NewPassword: "Current-Pass1"},
+			setupMock: func() {
+				expectUser()
+				expectHistory()
+			},
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedMessage: "password must not match any of the last 3 passwords",
+		},
+		{
+			name: "previous password reused",
+			ctx:  ctx,
+			req:  v1.ChangePasswordRequest{CurrentPassword: "Current-Pass1", NewPassword: "Previous-Pass1"},
+			setupMock: func() {
+				expectUser()
+				expectHistory()
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.ChangePassword(tt.ctx, tt.req)
+
+			expectStatus(t, err, tt.expectedStatus)
+			if tt.expectedMessage != "" && (err == nil || err.Error() != tt.expectedMessage) {
+				t.Errorf("Expected message %q but got %v", tt.expectedMessage, err)
+			}
+		})
+	}
+}
+
+func TestPasswordUsecase_IssuePasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, m := newPasswordUsecase(ctrl)
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "1")
+	user := &entity.User{Base: entity.Base{ID: 7}, Username: "employee006"}
+
+	t.Run("token is stored hashed and delivered", func(t *testing.T) {
+		var stored *entity.PasswordResetToken
+
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(7), nil).
+			Return(user, nil)
+		m.passwordResetTokenRepo.EXPECT().
+			InvalidateUnused(gomock.Any(), int64(7), gomock.Any(), nil).
+			Return(nil)
+		m.passwordResetTokenRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), nil).
+			DoAndReturn(func(_ context.Context, token *entity.PasswordResetToken, _ *gorm.DB) (*entity.PasswordResetToken, error) {
+				stored = token
+				return token, nil
+			})
+		m.auditLogRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), nil).
+			Return(nil)
+
+		expectStatus(t, usecase.IssuePasswordReset(ctx, 7), 0)
+
+		if stored == nil || stored.CreatedBy != 1 || stored.UserID != 7 {
+			t.Fatalf("Unexpected stored token: %+v", stored)
+		}
+		if len(m.notifier.messages) != 1 || m.notifier.messages[0].Recipient != "employee006" {
+			t.Fatalf("Expected one message to employee006 but got %+v", m.notifier.messages)
+		}
+		if strings.Contains(m.notifier.messages[0].Body, stored.TokenHash) {
+			t.Error("Message must contain the token, not its hash")
+		}
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(7), nil).
+			Return(nil, gorm.ErrRecordNotFound)
+
+		expectStatus(t, usecase.IssuePasswordReset(ctx, 7), http.StatusNotFound)
+	})
+
+	t.Run("service account", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(7), nil).
+			Return(&entity.User{Base: entity.Base{ID: 7}, Username: "hris-sync", IsServiceAccount: true}, nil)
+
+		expectStatus(t, usecase.IssuePasswordReset(ctx, 7), http.StatusBadRequest)
+	})
+
+	t.Run("delivery failure", func(t *testing.T) {
+		m.notifier.err = errors.New("smtp unavailable")
+		defer func() { m.notifier.err = nil }()
+
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(7), nil).
+			Return(user, nil)
+		m.passwordResetTokenRepo.EXPECT().
+			InvalidateUnused(gomock.Any(), int64(7), gomock.Any(), nil).
+			Return(nil)
+		m.passwordResetTokenRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), nil).
+			DoAndReturn(func(_ context.Context, token *entity.PasswordResetToken, _ *gorm.DB) (*entity.PasswordResetToken, error) {
+				return token, nil
+			})
+
+		expectStatus(t, usecase.IssuePasswordReset(ctx, 7), http.StatusInternalServerError)
+	})
+}
+
+func TestPasswordUsecase_ResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, m := newPasswordUsecase(ctrl)
+
+	user := &entity.User{Base: entity.Base{ID: 7}, Username: "employee006", PasswordHash: mustHash(t, "Current-Pass1")}
+	usedAt := time.Now().Add(-time.Minute)
+
+	expectToken := func(token *entity.PasswordResetToken) {
+		m.passwordResetTokenRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), gomock.Any(), nil).
+			Return(token, nil)
+	}
+	validToken := func() *entity.PasswordResetToken {
+		return &entity.PasswordResetToken{Base: entity.Base{ID: 3}, UserID: 7, ExpiresAt: time.Now().Add(time.Hour)}
+	}
+	expectUser := func() {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(7), nil).
+			Return(user, nil)
+		m.passwordHistoryRepo.EXPECT().
+			FindRecentHashes(gomock.Any(), int64(7), 2, nil).
+			Return(nil, nil).
+			AnyTimes()
+	}
+
+	tests := []struct {
+		name           string
+		req            v1.PasswordResetRequest
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "successful reset",
+			req:  v1.PasswordResetRequest{Token: "token", NewPassword: "Brand-New-Pass1"},
+			setupMock: func() {
+				expectToken(validToken())
+				expectUser()
+				m.passwordResetTokenRepo.EXPECT().
+					MarkUsed(gomock.Any(), int64(3), gomock.Any(), nil).
+					Return(true, nil)
+				m.userRepo.EXPECT().
+					UpdatePassword(gomock.Any(), user, gomock.Any(), gomock.Any(), nil).
+					Return(nil)
+				m.refreshTokenRepo.EXPECT().
+					RevokeByUserID(gomock.Any(), int64(7), gomock.Any(), nil).
+					Return(int64(0), nil)
+				m.auditLogRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					Return(nil)
+			},
+		},
+		{
+			name:           "missing token",
+			req:            v1.PasswordResetRequest{NewPassword: "Brand-New-Pass1"},
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unknown token",
+			req:  v1.PasswordResetRequest{Token: "token", NewPassword: "Brand-New-Pass1"},
+			setupMock: func() {
+				expectToken(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "used token",
+			req:  v1.PasswordResetRequest{Token: "token", NewPassword: "Brand-New-Pass1"},
+			setupMock: func() {
+				token := validToken()
+				token.UsedAt = &usedAt
+				expectToken(token)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "expired token",
+			req:  v1.PasswordResetRequest{Token: "token", NewPassword: "Brand-New-Pass1"},
+			setupMock: func() {
+				token := validToken()
+				token.ExpiresAt = time.Now().Add(-time.Second)
+				expectToken(token)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "policy violation keeps token usable",
+			req:  v1.PasswordResetRequest{Token: "token", NewPassword: "weak"},
+			setupMock: func() {
+				expectToken(validToken())
+				expectUser()
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "token consumed concurrently",
+			req:  v1.PasswordResetRequest{Token: "token", NewPassword: "Brand-New-Pass1"},
+			setupMock: func() {
+				expectToken(validToken())
+				expectUser()
+				m.passwordResetTokenRepo.EXPECT().
+					MarkUsed(gomock.Any(), int64(3), gomock.Any(), nil).
+					Return(false, nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			expectStatus(t, usecase.ResetPassword(context.Background(), tt.req), tt.expectedStatus)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package password
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// bcrypt ignores everything after the first 72 bytes
+const maxPasswordBytes = 72
+
+// validatePolicy checks the configured length and complexity rules and lists
+// every rule the password breaks in one error.
+func (u *UsecaseImpl) validatePolicy(password string) error {
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+
+	var rules []string
+	if len([]rune(password)) < u.policy.MinLength {
+		rules = append(rules, fmt.Sprintf("be at least %d characters long", u.policy.MinLength))
+	}
+	if len(password) > maxPasswordBytes {
+		rules = append(rules, fmt.Sprintf("be at most %d bytes long", maxPasswordBytes))
+	}
+	if u.policy.RequireUppercase && !hasUpper {
+		rules = append(rules, "contain an uppercase letter")
+	}
+	if u.policy.RequireLowercase && !hasLower {
+		rules = append(rules, "contain a lowercase letter")
+	}
+	if u.policy.RequireDigit && !hasDigit {
+		rules = append(rules, "contain a digit")
+	}
+	if u.policy.RequireSymbol && !hasSymbol {
+		rules = append(rules, "contain a symbol")
+	}
+
+	if len(rules) > 0 {
+		return httppkg.NewUnprocessableEntityError("password must " + strings.Join(rules, ", "))
+	}
+
+	return nil
+}
+
+// validateHistory rejects the current password and, depending on the policy,
+// the previous ones.
+func (u *UsecaseImpl) validateHistory(ctx context.Context, user *entity.User, password string) error {
+	hashes := []string{user.PasswordHash}
+
+	if u.policy.HistorySize > 1 {
+		previous, err := u.passwordHistoryRepo.FindRecentHashes(ctx, user.ID, u.policy.HistorySize-1, nil)
+		if err != nil {
+			logger.Error(ctx, "failed to find password history", "user_id", user.ID, "error", err)
+			return httppkg.NewInternalServerError("failed to validate password")
+		}
+		hashes = append(hashes, previous...)
+	}
+
+	for _, hash := range hashes {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil {
+			if u.policy.HistorySize > 1 {
+				return httppkg.NewUnprocessableEntityError(fmt.Sprintf("password must not match any of the last %d passwords", u.policy.HistorySize))
+			}
+			return httppkg.NewUnprocessableEntityError("password must differ from the current password")
+		}
+	}
+
+	return nil
+}
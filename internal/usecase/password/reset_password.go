@@ -0,0 +1,134 @@
+package password
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/notifier"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+// IssuePasswordReset creates a one-time reset token for a user and delivers it
+// through the notifier. Earlier unused tokens of the user stop working.
+func (u *UsecaseImpl) IssuePasswordReset(ctx context.Context, userID int64) error {
+	user, err := u.userRepo.FindByID(ctx, uint(userID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find user", "user_id", userID, "error", err)
+		return httppkg.NewInternalServerError("failed to find user")
+	}
+	if user == nil {
+		return httppkg.NewNotFoundError("user not found")
+	}
+	// Service accounts authenticate with API keys only
+	if user.IsServiceAccount {
+		return httppkg.NewBadRequestError("service accounts cannot have a password")
+	}
+
+	now := time.Now()
+	if err := u.passwordResetTokenRepo.InvalidateUnused(ctx, user.ID, now, nil); err != nil {
+		logger.Error(ctx, "failed to invalidate password reset tokens", "user_id", user.ID, "error", err)
+		return httppkg.NewInternalServerError("failed to issue password reset")
+	}
+
+	token, err := newResetToken()
+	if err != nil {
+		logger.Error(ctx, "failed to generate password reset token", "user_id", user.ID, "error", err)
+		return httppkg.NewInternalServerError("failed to issue password reset")
+	}
+
+	expiresAt := now.Add(u.resetTokenDuration)
+	_, err = u.passwordResetTokenRepo.Create(ctx, &entity.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: hashResetToken(token),
+		ExpiresAt: expiresAt,
+		CreatedBy: cast.ToInt64(ctx.Value(constant.ContextKeyUserID)),
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to store password reset token", "user_id", user.ID, "error", err)
+		return httppkg.NewInternalServerError("failed to issue password reset")
+	}
+
+	err = u.notifier.Notify(ctx, notifier.Message{
+		Recipient: user.Username,
+		Subject:   "Password reset",
+		Body:      fmt.Sprintf("Use this token to set a new password before %s: %s", expiresAt.Format(time.RFC3339), token),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to deliver password reset token", "user_id", user.ID, "error", err)
+		return httppkg.NewInternalServerError("failed to deliver password reset token")
+	}
+
+	u.audit(ctx, user, entity.AuditLogActionPasswordReset, entity.JSONMap{"expires_at": expiresAt})
+
+	return nil
+}
+
+// ResetPassword sets a new password with a reset token. The password is
+// validated before the token is consumed so a rejected password can be retried
+// with the same token.
+func (u *UsecaseImpl) ResetPassword(ctx context.Context, req v1.PasswordResetRequest) error {
+	invalidToken := httppkg.NewBadRequestError("invalid or expired reset token")
+
+	if req.Token == "" {
+		return invalidToken
+	}
+
+	resetToken, err := u.passwordResetTokenRepo.FindOneByTemplate(ctx, &entity.PasswordResetToken{TokenHash: hashResetToken(req.Token)}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find password reset token", "error", err)
+		return httppkg.NewInternalServerError("failed to reset password")
+	}
+
+	now := time.Now()
+	if resetToken == nil || resetToken.UsedAt != nil || now.After(resetToken.ExpiresAt) {
+		return invalidToken
+	}
+
+	user, err := u.userRepo.FindByID(ctx, uint(resetToken.UserID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find user", "user_id", resetToken.UserID, "error", err)
+		return httppkg.NewInternalServerError("failed to find user")
+	}
+	if user == nil {
+		return invalidToken
+	}
+
+	if err := u.validateNewPassword(ctx, user, req.NewPassword); err != nil {
+		return err
+	}
+
+	used, err := u.passwordResetTokenRepo.MarkUsed(ctx, resetToken.ID, now, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to consume password reset token", "token_id", resetToken.ID, "error", err)
+		return httppkg.NewInternalServerError("failed to reset password")
+	}
+	if !used {
+		return invalidToken
+	}
+
+	return u.setPassword(ctx, user, req.NewPassword, passwordChangeMethodResetToken)
+}
+
+func newResetToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,69 @@
+package password
+
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/spf13/cast"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	passwordChangeMethodSelfService = "self_service"
+	passwordChangeMethodResetToken  = "reset_token"
+)
+
+// validateNewPassword runs every policy check a new password has to pass.
+func (u *UsecaseImpl) validateNewPassword(ctx context.Context, user *entity.User, password string) error {
+	if err := u.validatePolicy(password); err != nil {
+		return err
+	}
+
+	return u.validateHistory(ctx, user, password)
+}
+
+// setPassword stores an already validated password, ends every session of
+// the user and records the change in the audit log.
+func (u *UsecaseImpl) setPassword(ctx context.Context, user *entity.User, password string, method string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Error(ctx, "failed to hash password", "user_id", user.ID, "error", err)
+		return httppkg.NewInternalServerError("failed to change password")
+	}
+
+	now := time.Now()
+	if err := u.userRepo.UpdatePassword(ctx, user, string(hash), now, nil); err != nil {
+		logger.Error(ctx, "failed to update password", "user_id", user.ID, "error", err)
+		return httppkg.NewInternalServerError("failed to change password")
+	}
+
+	if _, err := u.refreshTokenRepo.RevokeByUserID(ctx, user.ID, now, nil); err != nil {
+		logger.Error(ctx, "failed to revoke sessions after password change", "user_id", user.ID, "error", err)
+	}
+
+	u.audit(ctx, user, entity.AuditLogActionPasswordChange, entity.JSONMap{"method": method})
+
+	logger.Info(ctx, "password changed", "user_id", user.ID, "method", method)
+
+	return nil
+}
+
+func (u *UsecaseImpl) audit(ctx context.Context, user *entity.User, action string, data entity.JSONMap) {
+	data["username"] = user.Username
+
+	err := u.auditLogRepo.Create(ctx, &entity.AuditLog{
+		TableName: "users",
+		RecordID:  user.ID,
+		Action:    action,
+		DataAfter: data,
+		UserID:    cast.ToString(ctx.Value(constant.ContextKeyUserID)),
+		IPAddress: cast.ToString(ctx.Value(constant.ContextKeyIPAddress)),
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to write password audit log", "user_id", user.ID, "action", action, "error", err)
+	}
+}
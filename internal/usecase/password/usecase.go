@@ -0,0 +1,51 @@
+package password
+
+import (
+	"context"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"github.com/asyauqi15/payslip-system/pkg/notifier"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+//go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/password Usecase
+type Usecase interface {
+	ChangePassword(ctx context.Context, req v1.ChangePasswordRequest) error
+	IssuePasswordReset(ctx context.Context, userID int64) error
+	ResetPassword(ctx context.Context, req v1.PasswordResetRequest) error
+}
+
+type UsecaseImpl struct {
+	userRepo               repository.UserRepository
+	passwordHistoryRepo    repository.PasswordHistoryRepository
+	passwordResetTokenRepo repository.PasswordResetTokenRepository
+	refreshTokenRepo       repository.RefreshTokenRepository
+	auditLogRepo           repository.AuditLogRepository
+	notifier               notifier.Notifier
+	policy                 internal.PasswordPolicyConfig
+	resetTokenDuration     time.Duration
+}
+
+func NewUsecase(
+	userRepo repository.UserRepository,
+	passwordHistoryRepo repository.PasswordHistoryRepository,
+	passwordResetTokenRepo repository.PasswordResetTokenRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	auditLogRepo repository.AuditLogRepository,
+	notifier notifier.Notifier,
+	policy internal.PasswordPolicyConfig,
+	resetTokenDuration time.Duration,
+) Usecase {
+	return &UsecaseImpl{
+		userRepo:               userRepo,
+		passwordHistoryRepo:    passwordHistoryRepo,
+		passwordResetTokenRepo: passwordResetTokenRepo,
+		refreshTokenRepo:       refreshTokenRepo,
+		auditLogRepo:           auditLogRepo,
+		notifier:               notifier,
+		policy:                 policy,
+		resetTokenDuration:     resetTokenDuration,
+	}
+}
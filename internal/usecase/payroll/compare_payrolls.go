@@ -0,0 +1,266 @@
+package payroll
+
+import (
+	"context"
+	"sort"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// comparedPayroll is one side of a comparison: a payroll that was run, or a
+// preview calculated for an attendance period.
+type comparedPayroll struct {
+	payrollID         *int64
+	attendancePeriod  *entity.AttendancePeriod
+	reportingCurrency string
+	totalPayroll      int64
+	payslips          []entity.Payslip
+}
+
+// ComparePayrolls compares the payslips of a payroll, or of a preview for an
+// attendance period, with those of an earlier payroll per employee. A given
+// previous payroll must be of a period that ended before; without one, it is
+// the payroll of the latest period that ended before.
+func (u *UsecaseImpl) ComparePayrolls(ctx context.Context, params v1.GetAdminPayrollComparisonParams) (*v1.PayrollComparison, error) {
+	if (params.PayrollId == nil) == (params.AttendancePeriodId == nil) {
+		return nil, httppkg.NewBadRequestError("give either payroll_id or attendance_period_id")
+	}
+	if params.PayrollId != nil && params.PreviousPayrollId != nil && *params.PayrollId == *params.PreviousPayrollId {
+		return nil, httppkg.NewBadRequestError("a payroll cannot be compared with itself")
+	}
+
+	var current *comparedPayroll
+	var err error
+	if params.PayrollId != nil {
+		current, err = u.storedPayroll(ctx, *params.PayrollId)
+	} else {
+		current, err = u.previewPayroll(ctx, *params.AttendancePeriodId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var previous *comparedPayroll
+	if params.PreviousPayrollId != nil {
+		previous, err = u.storedPayroll(ctx, *params.PreviousPayrollId)
+	} else {
+		previous, err = u.previousPayroll(ctx, current.attendancePeriod)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !previous.attendancePeriod.EndDate.Before(current.attendancePeriod.StartDate) {
+		return nil, httppkg.NewBadRequestError("previous payroll must be of a period that ended before the compared one started")
+	}
+
+	return u.compare(current, previous), nil
+}
+
+// storedPayroll loads a payroll that was run with its payslips.
+func (u *UsecaseImpl) storedPayroll(ctx context.Context, payrollID int64) (*comparedPayroll, error) {
+	payroll, err := u.findPayroll(ctx, payrollID)
+	if err != nil {
+		return nil, err
+	}
+	return u.loadComparedPayroll(ctx, payroll)
+}
+
+// previousPayroll loads the payroll of the latest period that ended before
+// an attendance period.
+func (u *UsecaseImpl) previousPayroll(ctx context.Context, attendancePeriod *entity.AttendancePeriod) (*comparedPayroll, error) {
+	payroll, err := u.payrollRepo.FindPrevious(ctx, attendancePeriod.StartDate, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find previous payroll", "attendance_period_id", attendancePeriod.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find previous payroll")
+	}
+	if payroll == nil {
+		return nil, httppkg.NewNotFoundError("no previous payroll to compare with")
+	}
+	return u.loadComparedPayroll(ctx, payroll)
+}
+
+func (u *UsecaseImpl) loadComparedPayroll(ctx context.Context, payroll *entity.Payroll) (*comparedPayroll, error) {
+	attendancePeriod, err := u.validateAndGetAttendancePeriod(ctx, payroll.AttendancePeriodID)
+	if err != nil {
+		return nil, err
+	}
+
+	payslips, err := u.payslipRepo.FindByTemplate(ctx, &entity.Payslip{PayrollID: payroll.ID}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find payslips", "payroll_id", payroll.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find payslips")
+	}
+
+	payrollID := payroll.ID
+	return &comparedPayroll{
+		payrollID:         &payrollID,
+		attendancePeriod:  attendancePeriod,
+		reportingCurrency: string(currencyOf(payroll.ReportingCurrency)),
+		totalPayroll:      payroll.TotalPayroll,
+		payslips:          payslips,
+	}, nil
+}
+
+// previewPayroll calculates the payroll of an attendance period the way a
+// payroll run would, without storing it.
+func (u *UsecaseImpl) previewPayroll(ctx context.Context, attendancePeriodID int64) (*comparedPayroll, error) {
+	attendancePeriod, err := u.validateAndGetAttendancePeriod(ctx, attendancePeriodID)
+	if err != nil {
+		return nil, err
+	}
+
+	calculated, err := u.calculatePayroll(ctx, attendancePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	payslips := make([]entity.Payslip, 0, len(calculated.payslips))
+	for _, payslip := range calculated.payslips {
+		payslips = append(payslips, payslip.payslip)
+	}
+
+	return &comparedPayroll{
+		attendancePeriod:  attendancePeriod,
+		reportingCurrency: string(calculated.totals.TotalPayroll.Currency()),
+		totalPayroll:      calculated.totals.TotalPayroll.Amount(),
+		payslips:          payslips,
+	}, nil
+}
+
+// compare matches payslips by employee, in employee order. Amounts of an
+// employee paid in another currency than before are not compared.
+func (u *UsecaseImpl) compare(current, previous *comparedPayroll) *v1.PayrollComparison {
+	thresholds := v1.VarianceThresholds{
+		VariancePercent: u.config.Comparison.VariancePercent,
+		VarianceAmount:  u.config.Comparison.VarianceAmount,
+	}
+
+	comparison := &v1.PayrollComparison{
+		Current:          current.response(),
+		Previous:         previous.response(),
+		Thresholds:       thresholds,
+		Employees:        []v1.EmployeeComparison{},
+		AddedEmployees:   []v1.PayslipAmounts{},
+		RemovedEmployees: []v1.PayslipAmounts{},
+	}
+
+	previousPayslips := make(map[int64]entity.Payslip, len(previous.payslips))
+	for _, payslip := range previous.payslips {
+		previousPayslips[payslip.EmployeeID] = payslip
+	}
+
+	for _, payslip := range sortedByEmployee(current.payslips) {
+		before, ok := previousPayslips[payslip.EmployeeID]
+		if !ok {
+			comparison.AddedEmployees = append(comparison.AddedEmployees, payslipAmounts(payslip))
+			continue
+		}
+		delete(previousPayslips, payslip.EmployeeID)
+		comparison.Employees = append(comparison.Employees, compareEmployee(before, payslip, thresholds))
+	}
+
+	removed := make([]entity.Payslip, 0, len(previousPayslips))
+	for _, payslip := range previousPayslips {
+		removed = append(removed, payslip)
+	}
+	for _, payslip := range sortedByEmployee(removed) {
+		comparison.RemovedEmployees = append(comparison.RemovedEmployees, payslipAmounts(payslip))
+	}
+
+	return comparison
+}
+
+func (p *comparedPayroll) response() v1.ComparedPayroll {
+	return v1.ComparedPayroll{
+		PayrollId: p.payrollID,
+		Preview:   p.payrollID == nil,
+		AttendancePeriod: v1.AttendancePeriod{
+			StartDate: openapi_types.Date{Time: p.attendancePeriod.StartDate},
+			EndDate:   openapi_types.Date{Time: p.attendancePeriod.EndDate},
+		},
+		ReportingCurrency: p.reportingCurrency,
+		TotalPayroll:      p.totalPayroll,
+	}
+}
+
+func compareEmployee(before, after entity.Payslip, thresholds v1.VarianceThresholds) v1.EmployeeComparison {
+	comparison := v1.EmployeeComparison{
+		EmployeeId: after.EmployeeID,
+		Previous:   payslipAmounts(before),
+		Current:    payslipAmounts(after),
+		Flagged:    []v1.EmployeeComparisonFlagged{},
+	}
+	if comparison.Previous.Currency != comparison.Current.Currency {
+		comparison.CurrencyChanged = true
+		return comparison
+	}
+
+	delta := v1.PayslipAmountDeltas{
+		BaseSalary:     after.BaseSalary - before.BaseSalary,
+		ProratedSalary: after.ProratedSalary - before.ProratedSalary,
+		Overtime:       after.OvertimeTotalPay - before.OvertimeTotalPay,
+		Reimbursement:  after.ReimbursementTotal - before.ReimbursementTotal,
+		TakeHome:       after.TotalTakeHome - before.TotalTakeHome,
+	}
+	comparison.Delta = &delta
+
+	for _, amount := range []struct {
+		name     v1.EmployeeComparisonFlagged
+		previous int64
+		delta    int64
+	}{
+		{v1.EmployeeComparisonFlaggedBaseSalary, before.BaseSalary, delta.BaseSalary},
+		{v1.EmployeeComparisonFlaggedProratedSalary, before.ProratedSalary, delta.ProratedSalary},
+		{v1.EmployeeComparisonFlaggedOvertime, before.OvertimeTotalPay, delta.Overtime},
+		{v1.EmployeeComparisonFlaggedReimbursement, before.ReimbursementTotal, delta.Reimbursement},
+		{v1.EmployeeComparisonFlaggedTakeHome, before.TotalTakeHome, delta.TakeHome},
+	} {
+		if exceedsThresholds(amount.previous, amount.delta, thresholds) {
+			comparison.Flagged = append(comparison.Flagged, amount.name)
+		}
+	}
+
+	return comparison
+}
+
+// exceedsThresholds reports whether a change is above both the amount and
+// the percentage of the previous amount. Any change to a zero amount is above
+// the percentage.
+func exceedsThresholds(previous, delta int64, thresholds v1.VarianceThresholds) bool {
+	change := abs(delta)
+	if change == 0 || change <= thresholds.VarianceAmount {
+		return false
+	}
+	return float64(change)*100 > thresholds.VariancePercent*float64(abs(previous))
+}
+
+func abs(amount int64) int64 {
+	if amount < 0 {
+		return -amount
+	}
+	return amount
+}
+
+func payslipAmounts(payslip entity.Payslip) v1.PayslipAmounts {
+	return v1.PayslipAmounts{
+		EmployeeId:     payslip.EmployeeID,
+		Currency:       string(currencyOf(payslip.Currency)),
+		BaseSalary:     payslip.BaseSalary,
+		ProratedSalary: payslip.ProratedSalary,
+		Overtime:       payslip.OvertimeTotalPay,
+		Reimbursement:  payslip.ReimbursementTotal,
+		TakeHome:       payslip.TotalTakeHome,
+	}
+}
+
+func sortedByEmployee(payslips []entity.Payslip) []entity.Payslip {
+	sorted := append([]entity.Payslip(nil), payslips...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].EmployeeID < sorted[j].EmployeeID
+	})
+	return sorted
+}
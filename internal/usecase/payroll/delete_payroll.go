@@ -0,0 +1,34 @@
+package payroll
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+)
+
+// DeletePayroll removes a draft payroll together with its payslips, so the
+// period can be run again.
+func (u *UsecaseImpl) DeletePayroll(ctx context.Context, payrollID int64) error {
+	payroll, err := u.findPayroll(ctx, payrollID)
+	if err != nil {
+		return err
+	}
+	if payroll.Status != entity.PayrollStatusDraft {
+		return httppkg.NewConflictError("only draft payrolls can be removed")
+	}
+
+	deleted, err := u.payrollRepo.DeleteWithPayslips(ctx, payroll, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to delete payroll", "payroll_id", payrollID, "error", err)
+		return httppkg.NewInternalServerError("failed to delete payroll")
+	}
+	if !deleted {
+		// Finalized or removed since it was loaded
+		return httppkg.NewConflictError("only draft payrolls can be removed")
+	}
+
+	logger.Info(ctx, "payroll removed", "payroll_id", payrollID, "attendance_period_id", payroll.AttendancePeriodID)
+	return nil
+}
@@ -0,0 +1,156 @@
+package payroll
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/money"
+)
+
+type currencyPair struct {
+	from money.Currency
+	to   money.Currency
+}
+
+// exchangeRates looks up the rates a payroll converts with, as they stand on
+// one date, and keeps the ones it used so they can be recorded with the
+// payroll.
+type exchangeRates struct {
+	repo  repository.ExchangeRateRepository
+	on    time.Time
+	rates map[currencyPair]money.Rate
+	used  []entity.PayrollExchangeRate
+}
+
+func (u *UsecaseImpl) newExchangeRates(on time.Time) *exchangeRates {
+	return &exchangeRates{
+		repo:  u.exchangeRateRepo,
+		on:    on,
+		rates: make(map[currencyPair]money.Rate),
+	}
+}
+
+// convert returns an amount in another currency, exactly, to be rounded by
+// the caller.
+func (r *exchangeRates) convert(ctx context.Context, amount money.Money, to money.Currency) (money.Exact, error) {
+	rate, err := r.rate(ctx, amount.Currency(), to)
+	if err != nil {
+		return money.Exact{}, err
+	}
+
+	converted, err := amount.Exact().Convert(rate)
+	if err != nil {
+		logger.Error(ctx, "failed to convert amount", "amount", amount.String(), "to", to, "error", err)
+		return money.Exact{}, httppkg.NewUnprocessableEntityError(fmt.Sprintf("failed to convert %s to %s", amount, to))
+	}
+	return converted, nil
+}
+
+// rate returns the rate from one currency to another. Without a rate for the
+// pair, the rate the other way round is inverted.
+func (r *exchangeRates) rate(ctx context.Context, from, to money.Currency) (money.Rate, error) {
+	if from == to {
+		return money.Identity(from), nil
+	}
+
+	pair := currencyPair{from: from, to: to}
+	if rate, ok := r.rates[pair]; ok {
+		return rate, nil
+	}
+
+	recorded, err := r.find(ctx, from, to)
+	if err != nil {
+		return money.Rate{}, err
+	}
+	inverted := false
+	if recorded == nil {
+		recorded, err = r.find(ctx, to, from)
+		if err != nil {
+			return money.Rate{}, err
+		}
+		inverted = true
+	}
+	if recorded == nil {
+		return money.Rate{}, httppkg.NewUnprocessableEntityError(fmt.Sprintf("no exchange rate from %s to %s on %s", from, to, r.on.Format("2006-01-02")))
+	}
+
+	rate, err := money.ParseRate(money.Currency(recorded.FromCurrency), money.Currency(recorded.ToCurrency), recorded.Rate)
+	if err != nil {
+		logger.Error(ctx, "invalid exchange rate", "exchange_rate_id", recorded.ID, "error", err)
+		return money.Rate{}, httppkg.NewInternalServerError("invalid exchange rate")
+	}
+	if inverted {
+		rate = rate.Invert()
+	}
+
+	r.rates[pair] = rate
+	r.used = append(r.used, entity.PayrollExchangeRate{
+		FromCurrency:  string(from),
+		ToCurrency:    string(to),
+		EffectiveDate: recorded.EffectiveDate,
+		Rate:          rate.String(),
+	})
+	return rate, nil
+}
+
+// find returns the latest rate for a pair that is effective on the date.
+func (r *exchangeRates) find(ctx context.Context, from, to money.Currency) (*entity.ExchangeRate, error) {
+	until := r.on.AddDate(0, 0, 1)
+	query := repository.NewQuery().
+		Where("from_currency", repository.OpEqual, string(from)).
+		Where("to_currency", repository.OpEqual, string(to)).
+		WhereDateRange("effective_date", repository.DateRange{To: &until}).
+		OrderBy("effective_date", true).
+		Limit(1)
+	rates, err := r.repo.Find(ctx, query, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find exchange rate", "from", from, "to", to, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find exchange rate")
+	}
+	if len(rates) == 0 {
+		return nil, nil
+	}
+	return &rates[0], nil
+}
+
+// currencyOf returns the currency of a record, which is the default currency
+// for records from before currencies were tracked.
+func currencyOf(code string) money.Currency {
+	if code == "" {
+		return entity.DefaultCurrency
+	}
+	return money.Currency(code)
+}
+
+// recordedRates are the rates a payroll was run with, to convert its
+// payslips to the reporting currency the same way again.
+type recordedRates map[currencyPair]money.Rate
+
+func newRecordedRates(records []entity.PayrollExchangeRate) (recordedRates, error) {
+	rates := make(recordedRates, len(records))
+	for _, record := range records {
+		rate, err := money.ParseRate(money.Currency(record.FromCurrency), money.Currency(record.ToCurrency), record.Rate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rate recorded for %s to %s: %w", record.FromCurrency, record.ToCurrency, err)
+		}
+		rates[currencyPair{from: rate.From, to: rate.To}] = rate
+	}
+	return rates, nil
+}
+
+func (r recordedRates) convert(amount money.Money, to money.Currency, rounding money.Rounding) (money.Money, error) {
+	if amount.Currency() == to {
+		return amount, nil
+	}
+
+	rate, ok := r[currencyPair{from: amount.Currency(), to: to}]
+	if !ok {
+		return money.Money{}, fmt.Errorf("no exchange rate recorded from %s to %s", amount.Currency(), to)
+	}
+	return amount.Convert(rate, rounding)
+}
@@ -0,0 +1,89 @@
+package payroll
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"gorm.io/gorm"
+)
+
+// ExplainPayslip returns the trace recorded when a payslip was calculated:
+// the records and rates it was calculated from and each step of the
+// calculation.
+func (u *UsecaseImpl) ExplainPayslip(ctx context.Context, payslipID int64) (*v1.PayslipExplanation, error) {
+	payslip, err := u.payslipRepo.FindByID(ctx, uint(payslipID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find payslip", "payslip_id", payslipID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find payslip")
+	}
+	if payslip == nil {
+		return nil, httppkg.NewNotFoundError("payslip not found")
+	}
+
+	trace, err := u.payslipTraceRepo.FindOneByTemplate(ctx, &entity.PayslipTrace{PayslipID: payslipID}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find payslip trace", "payslip_id", payslipID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find payslip trace")
+	}
+	if trace == nil {
+		// Payslips calculated before traces were recorded
+		return nil, httppkg.NewNotFoundError("no calculation recorded for this payslip")
+	}
+
+	calculation := trace.Calculation
+	explanation := &v1.PayslipExplanation{
+		PayslipId:          payslip.ID,
+		PayrollId:          payslip.PayrollID,
+		EmployeeId:         payslip.EmployeeID,
+		Currency:           calculation.Currency,
+		BaseSalary:         calculation.BaseSalary,
+		WorkingDays:        calculation.WorkingDays,
+		AttendanceIds:      calculation.AttendanceIDs,
+		Overtimes:          make([]v1.TracedOvertime, 0, len(calculation.Overtimes)),
+		Reimbursements:     make([]v1.TracedReimbursement, 0, len(calculation.Reimbursements)),
+		HourlyRate:         calculation.HourlyRate,
+		OvertimeMultiplier: calculation.OvertimeMultiplier,
+		ExchangeRates:      make([]v1.TracedExchangeRate, 0, len(calculation.ExchangeRates)),
+		Steps:              make([]v1.PayslipCalculationStep, 0, len(calculation.Steps)),
+		TotalTakeHome:      payslip.TotalTakeHome,
+	}
+	if explanation.AttendanceIds == nil {
+		explanation.AttendanceIds = []int64{}
+	}
+	for _, overtime := range calculation.Overtimes {
+		explanation.Overtimes = append(explanation.Overtimes, v1.TracedOvertime{Id: overtime.ID, Hours: overtime.Hours})
+	}
+	for _, reimbursement := range calculation.Reimbursements {
+		explanation.Reimbursements = append(explanation.Reimbursements, v1.TracedReimbursement{
+			Id:       reimbursement.ID,
+			Amount:   reimbursement.Amount,
+			Currency: reimbursement.Currency,
+		})
+	}
+	for _, rate := range calculation.ExchangeRates {
+		explanation.ExchangeRates = append(explanation.ExchangeRates, v1.TracedExchangeRate{
+			FromCurrency: rate.FromCurrency,
+			ToCurrency:   rate.ToCurrency,
+			Rate:         rate.Rate,
+		})
+	}
+	for _, step := range calculation.Steps {
+		item := v1.PayslipCalculationStep{
+			Name:    v1.PayslipCalculationStepName(step.Name),
+			Formula: step.Formula,
+			Exact:   step.Exact,
+			Result:  step.Result,
+		}
+		if step.RoundingMode != "" {
+			mode := v1.RoundingMode(step.RoundingMode)
+			item.RoundingMode = &mode
+		}
+		explanation.Steps = append(explanation.Steps, item)
+	}
+
+	return explanation, nil
+}
@@ -0,0 +1,55 @@
+package payroll
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) FinalizePayroll(ctx context.Context, payrollID int64) error {
+	userID := ctx.Value(constant.ContextKeyUserID)
+	if userID == nil {
+		return httppkg.NewUnauthorizedError("user not authenticated")
+	}
+
+	payroll, err := u.findPayroll(ctx, payrollID)
+	if err != nil {
+		return err
+	}
+	if payroll.Status != entity.PayrollStatusDraft {
+		return httppkg.NewConflictError("payroll is already finalized")
+	}
+
+	finalizedBy := cast.ToInt64(userID)
+	finalized, err := u.payrollRepo.Finalize(ctx, payroll, finalizedBy, time.Now(), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to finalize payroll", "payroll_id", payrollID, "error", err)
+		return httppkg.NewInternalServerError("failed to finalize payroll")
+	}
+	if !finalized {
+		// Finalized or removed since it was loaded
+		return httppkg.NewConflictError("payroll is no longer a draft")
+	}
+
+	logger.Info(ctx, "payroll finalized", "payroll_id", payrollID, "finalized_by", finalizedBy)
+	return nil
+}
+
+func (u *UsecaseImpl) findPayroll(ctx context.Context, payrollID int64) (*entity.Payroll, error) {
+	payroll, err := u.payrollRepo.FindByID(ctx, uint(payrollID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find payroll", "payroll_id", payrollID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find payroll")
+	}
+	if payroll == nil {
+		return nil, httppkg.NewNotFoundError("payroll not found")
+	}
+	return payroll, nil
+}
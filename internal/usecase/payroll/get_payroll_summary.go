@@ -2,44 +2,59 @@ package payroll
 
 import (
 	"context"
+	"errors"
 
 	"github.com/asyauqi15/payslip-system/internal/entity"
 	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
 	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/money"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
 )
 
-func (u *UsecaseImpl) GetPayrollSummary(ctx context.Context, payrollID int64) (*v1.AdminPayrollSummaryResponse, error) {
+func (u *UsecaseImpl) GetPayrollSummary(ctx context.Context, payrollID int64, departmentID *int64) (*v1.AdminPayrollSummaryResponse, error) {
 	// Get payroll record
-	payroll, err := u.payrollRepo.FindByID(ctx, uint(payrollID), nil)
+	payroll, err := u.findPayroll(ctx, payrollID)
 	if err != nil {
-		logger.Error(ctx, "failed to find payroll", "payroll_id", payrollID, "error", err)
-		return nil, httppkg.NewInternalServerError("failed to find payroll")
-	}
-	if payroll == nil {
-		return nil, httppkg.NewNotFoundError("payroll not found")
+		return nil, err
 	}
 
 	// Get attendance period
-	attendancePeriod, err := u.attendancePeriodRepo.FindByID(ctx, uint(payroll.AttendancePeriodID), nil)
+	attendancePeriod, err := u.validateAndGetAttendancePeriod(ctx, payroll.AttendancePeriodID)
 	if err != nil {
-		logger.Error(ctx, "failed to find attendance period", "attendance_period_id", payroll.AttendancePeriodID, "error", err)
-		return nil, httppkg.NewInternalServerError("failed to find attendance period")
-	}
-	if attendancePeriod == nil {
-		return nil, httppkg.NewInternalServerError("attendance period not found")
+		return nil, err
 	}
 
-	// Get all payslips for this payroll
+	// Get all payslips for this payroll, optionally narrowed to one department
 	payslips, err := u.payslipRepo.FindByTemplate(ctx, &entity.Payslip{
-		PayrollID: payrollID,
+		PayrollID:    payrollID,
+		DepartmentID: departmentID,
 	}, nil)
 	if err != nil {
 		logger.Error(ctx, "failed to find payslips", "payroll_id", payrollID, "error", err)
 		return nil, httppkg.NewInternalServerError("failed to find payslips")
 	}
 
+	// Get the rates the payroll was run with
+	exchangeRates, err := u.payrollExchangeRateRepo.FindByTemplate(ctx, &entity.PayrollExchangeRate{PayrollID: payrollID}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find payroll exchange rates", "payroll_id", payrollID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find payroll exchange rates")
+	}
+	rates, err := newRecordedRates(exchangeRates)
+	if err != nil {
+		logger.Error(ctx, "failed to read payroll exchange rates", "payroll_id", payrollID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to read payroll exchange rates")
+	}
+
+	// Get what rounding changed, and the modes the payroll rounded with
+	roundings, err := u.payrollRoundingRepo.FindByTemplate(ctx, &entity.PayrollRounding{PayrollID: payrollID}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find payroll roundings", "payroll_id", payrollID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find payroll roundings")
+	}
+
 	// Build payslip items with employee information
 	payslipItems := make([]v1.PayslipItem, 0, len(payslips))
 	for _, payslip := range payslips {
@@ -75,24 +90,146 @@ func (u *UsecaseImpl) GetPayrollSummary(ctx context.Context, payrollID int64) (*
 			OvertimePayment:       payslip.OvertimeTotalPay,
 			ReimbursementsPayment: payslip.ReimbursementTotal,
 			TotalPay:              payslip.TotalTakeHome,
+			Currency:              string(currencyOf(payslip.Currency)),
 		}
 
 		payslipItems = append(payslipItems, payslipItem)
 	}
 
+	reportingCurrency := currencyOf(payroll.ReportingCurrency)
+	departmentSubtotals, err := u.buildDepartmentSubtotals(ctx, payslips, rates, conversionRounding(roundings, reportingCurrency), reportingCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	employeesCount := payroll.TotalEmployees
+	totalPayroll := payroll.TotalPayroll
+	totalReimbursement := payroll.TotalReimbursement
+	totalOvertime := payroll.TotalOvertime
+	if departmentID != nil {
+		// The payroll totals cover every department; add up the payslips
+		// shown instead, as the department subtotals do
+		employeesCount, totalPayroll, totalReimbursement, totalOvertime = 0, 0, 0, 0
+		for _, subtotal := range departmentSubtotals {
+			employeesCount += subtotal.EmployeesCount
+			totalPayroll += subtotal.TotalPayroll
+			totalReimbursement += subtotal.TotalReimbursementsPay
+			totalOvertime += subtotal.TotalOvertimePay
+		}
+	}
+
+	exchangeRateItems := make([]v1.PayrollExchangeRate, 0, len(exchangeRates))
+	for _, rate := range exchangeRates {
+		exchangeRateItems = append(exchangeRateItems, v1.PayrollExchangeRate{
+			FromCurrency:  rate.FromCurrency,
+			ToCurrency:    rate.ToCurrency,
+			EffectiveDate: openapi_types.Date{Time: rate.EffectiveDate},
+			Rate:          rate.Rate,
+		})
+	}
+
+	roundingItems := make([]v1.PayrollRounding, 0, len(roundings))
+	for _, rounding := range roundings {
+		roundingItems = append(roundingItems, v1.PayrollRounding{
+			Calculation:  v1.PayrollRoundingCalculation(rounding.Calculation),
+			Currency:     rounding.Currency,
+			RoundingMode: v1.RoundingMode(rounding.RoundingMode),
+			Difference:   rounding.Difference,
+		})
+	}
+
 	// Build response
 	response := &v1.AdminPayrollSummaryResponse{
 		PayrollId: payroll.ID,
+		Status:    v1.PayrollStatus(payroll.Status),
 		AttendancePeriod: v1.AttendancePeriod{
 			StartDate: openapi_types.Date{Time: attendancePeriod.StartDate},
 			EndDate:   openapi_types.Date{Time: attendancePeriod.EndDate},
 		},
-		EmployeesCount:         payroll.TotalEmployees,
-		TotalPayroll:           payroll.TotalPayroll,
-		TotalReimbursementsPay: payroll.TotalReimbursement,
-		TotalOvertimePay:       payroll.TotalOvertime,
+		EmployeesCount:         employeesCount,
+		TotalPayroll:           totalPayroll,
+		TotalReimbursementsPay: totalReimbursement,
+		TotalOvertimePay:       totalOvertime,
+		ReportingCurrency:      string(reportingCurrency),
+		ExchangeRates:          exchangeRateItems,
+		Roundings:              roundingItems,
 		PayslipList:            payslipItems,
+		DepartmentSubtotals:    departmentSubtotals,
 	}
 
 	return response, nil
 }
+
+// buildDepartmentSubtotals groups payslips by the department recorded at
+// payroll time. Payslips without a department are grouped together. Amounts
+// are converted to the reporting currency one payslip at a time and rounded
+// the same way, as the payroll run did for its totals.
+func (u *UsecaseImpl) buildDepartmentSubtotals(ctx context.Context, payslips []entity.Payslip, rates recordedRates, rounding money.Rounding, reportingCurrency money.Currency) ([]v1.DepartmentSubtotal, error) {
+	subtotals := make([]v1.DepartmentSubtotal, 0)
+	indexByDepartment := make(map[int64]int)
+	unassignedIndex := -1
+
+	for _, payslip := range payslips {
+		var idx int
+		if payslip.DepartmentID == nil {
+			if unassignedIndex == -1 {
+				subtotals = append(subtotals, v1.DepartmentSubtotal{})
+				unassignedIndex = len(subtotals) - 1
+			}
+			idx = unassignedIndex
+		} else {
+			existing, ok := indexByDepartment[*payslip.DepartmentID]
+			if !ok {
+				subtotal, err := u.newDepartmentSubtotal(ctx, *payslip.DepartmentID)
+				if err != nil {
+					return nil, err
+				}
+				subtotals = append(subtotals, subtotal)
+				existing = len(subtotals) - 1
+				indexByDepartment[*payslip.DepartmentID] = existing
+			}
+			idx = existing
+		}
+
+		currency := currencyOf(payslip.Currency)
+		subtotals[idx].EmployeesCount++
+		for _, item := range []struct {
+			total  *int64
+			amount int64
+		}{
+			{&subtotals[idx].TotalProratedSalary, payslip.ProratedSalary},
+			{&subtotals[idx].TotalOvertimePay, payslip.OvertimeTotalPay},
+			{&subtotals[idx].TotalReimbursementsPay, payslip.ReimbursementTotal},
+			{&subtotals[idx].TotalPayroll, payslip.TotalTakeHome},
+		} {
+			converted, err := rates.convert(money.New(item.amount, currency), reportingCurrency, rounding)
+			if err != nil {
+				logger.Error(ctx, "failed to convert payslip", "payslip_id", payslip.ID, "error", err)
+				return nil, httppkg.NewInternalServerError("failed to convert payslip to the reporting currency")
+			}
+			*item.total += converted.Amount()
+		}
+	}
+
+	return subtotals, nil
+}
+
+func (u *UsecaseImpl) newDepartmentSubtotal(ctx context.Context, departmentID int64) (v1.DepartmentSubtotal, error) {
+	subtotal := v1.DepartmentSubtotal{DepartmentId: &departmentID}
+
+	department, err := u.departmentRepo.FindByID(ctx, uint(departmentID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find department", "department_id", departmentID, "error", err)
+		return subtotal, httppkg.NewInternalServerError("failed to find department")
+	}
+	if department == nil {
+		logger.Warn(ctx, "department not found for payslip", "department_id", departmentID)
+		return subtotal, nil
+	}
+
+	subtotal.DepartmentCode = &department.Code
+	subtotal.DepartmentName = &department.Name
+	subtotal.CostCenter = &department.CostCenter
+
+	return subtotal, nil
+}
@@ -21,6 +21,7 @@ import (
 type MockUsecase struct {
 	ctrl     *gomock.Controller
 	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
 }
 
 // MockUsecaseMockRecorder is the mock recorder for MockUsecase.
@@ -40,31 +41,89 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ComparePayrolls mocks base method.
+func (m *MockUsecase) ComparePayrolls(ctx context.Context, params v1.GetAdminPayrollComparisonParams) (*v1.PayrollComparison, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComparePayrolls", ctx, params)
+	ret0, _ := ret[0].(*v1.PayrollComparison)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ComparePayrolls indicates an expected call of ComparePayrolls.
+func (mr *MockUsecaseMockRecorder) ComparePayrolls(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComparePayrolls", reflect.TypeOf((*MockUsecase)(nil).ComparePayrolls), ctx, params)
+}
+
+// DeletePayroll mocks base method.
+func (m *MockUsecase) DeletePayroll(ctx context.Context, payrollID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePayroll", ctx, payrollID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePayroll indicates an expected call of DeletePayroll.
+func (mr *MockUsecaseMockRecorder) DeletePayroll(ctx, payrollID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePayroll", reflect.TypeOf((*MockUsecase)(nil).DeletePayroll), ctx, payrollID)
+}
+
+// ExplainPayslip mocks base method.
+func (m *MockUsecase) ExplainPayslip(ctx context.Context, payslipID int64) (*v1.PayslipExplanation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainPayslip", ctx, payslipID)
+	ret0, _ := ret[0].(*v1.PayslipExplanation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainPayslip indicates an expected call of ExplainPayslip.
+func (mr *MockUsecaseMockRecorder) ExplainPayslip(ctx, payslipID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainPayslip", reflect.TypeOf((*MockUsecase)(nil).ExplainPayslip), ctx, payslipID)
+}
+
+// FinalizePayroll mocks base method.
+func (m *MockUsecase) FinalizePayroll(ctx context.Context, payrollID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinalizePayroll", ctx, payrollID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinalizePayroll indicates an expected call of FinalizePayroll.
+func (mr *MockUsecaseMockRecorder) FinalizePayroll(ctx, payrollID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinalizePayroll", reflect.TypeOf((*MockUsecase)(nil).FinalizePayroll), ctx, payrollID)
+}
+
 // GetPayrollSummary mocks base method.
-func (m *MockUsecase) GetPayrollSummary(arg0 context.Context, arg1 int64) (*v1.AdminPayrollSummaryResponse, error) {
+func (m *MockUsecase) GetPayrollSummary(ctx context.Context, payrollID int64, departmentID *int64) (*v1.AdminPayrollSummaryResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPayrollSummary", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetPayrollSummary", ctx, payrollID, departmentID)
 	ret0, _ := ret[0].(*v1.AdminPayrollSummaryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPayrollSummary indicates an expected call of GetPayrollSummary.
-func (mr *MockUsecaseMockRecorder) GetPayrollSummary(arg0, arg1 any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) GetPayrollSummary(ctx, payrollID, departmentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayrollSummary", reflect.TypeOf((*MockUsecase)(nil).GetPayrollSummary), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayrollSummary", reflect.TypeOf((*MockUsecase)(nil).GetPayrollSummary), ctx, payrollID, departmentID)
 }
 
 // RunPayroll mocks base method.
-func (m *MockUsecase) RunPayroll(arg0 context.Context, arg1 v1.PostAdminPayrollsJSONRequestBody) error {
+func (m *MockUsecase) RunPayroll(ctx context.Context, req v1.PostAdminPayrollsJSONRequestBody) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RunPayroll", arg0, arg1)
+	ret := m.ctrl.Call(m, "RunPayroll", ctx, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RunPayroll indicates an expected call of RunPayroll.
-func (mr *MockUsecaseMockRecorder) RunPayroll(arg0, arg1 any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) RunPayroll(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPayroll", reflect.TypeOf((*MockUsecase)(nil).RunPayroll), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPayroll", reflect.TypeOf((*MockUsecase)(nil).RunPayroll), ctx, req)
 }
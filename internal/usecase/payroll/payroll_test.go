@@ -2,15 +2,23 @@ package payroll_test
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/asyauqi15/payslip-system/internal"
+	"github.com/asyauqi15/payslip-system/internal/constant"
 	"github.com/asyauqi15/payslip-system/internal/entity"
 	"github.com/asyauqi15/payslip-system/internal/repository/mock"
 	"github.com/asyauqi15/payslip-system/internal/usecase/payroll"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 	"go.uber.org/mock/gomock"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
 )
 
 func TestPayrollUsecase_RunPayroll(t *testing.T) {
@@ -25,6 +33,13 @@ func TestPayrollUsecase_RunPayroll(t *testing.T) {
 	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
 	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
 	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockDepartmentRepo := mock.NewMockDepartmentRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+	mockExchangeRateRepo := mock.NewMockExchangeRateRepository(ctrl)
+	mockPayrollExchangeRateRepo := mock.NewMockPayrollExchangeRateRepository(ctrl)
+	mockPayrollRoundingRepo := mock.NewMockPayrollRoundingRepository(ctrl)
+	mockPayslipTraceRepo := mock.NewMockPayslipTraceRepository(ctrl)
+	db, dbMock := newTestDB(t)
 
 	usecase := payroll.NewUsecase(
 		mockPayrollRepo,
@@ -35,13 +50,25 @@ func TestPayrollUsecase_RunPayroll(t *testing.T) {
 		mockOvertimeRepo,
 		mockReimbursementRepo,
 		mockUserRepo,
+		mockDepartmentRepo,
+		mockLocationRepo,
+		mockExchangeRateRepo,
+		mockPayrollExchangeRateRepo,
+		mockPayrollRoundingRepo,
+		mockPayslipTraceRepo,
+		db,
+		internal.PayrollConfig{ReportingCurrency: "IDR"},
 	)
 
+	utcSchedule := entity.DefaultWorkSchedule()
+	utcSchedule.Zone = time.UTC
+
 	tests := []struct {
-		name        string
-		request     v1.PostAdminPayrollsJSONRequestBody
-		setupMock   func()
-		expectError bool
+		name           string
+		request        v1.PostAdminPayrollsJSONRequestBody
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
 	}{
 		{
 			name: "successful payroll run",
@@ -49,6 +76,10 @@ func TestPayrollUsecase_RunPayroll(t *testing.T) {
 				AttendancePeriodId: 1,
 			},
 			setupMock: func() {
+				// The payroll is stored in one transaction
+				dbMock.ExpectBegin()
+				dbMock.ExpectCommit()
+
 				// Mock attendance period lookup
 				attendancePeriod := &entity.AttendancePeriod{
 					Base:      entity.Base{ID: 1},
@@ -88,41 +119,56 @@ func TestPayrollUsecase_RunPayroll(t *testing.T) {
 					TotalEmployees:     2,
 				}
 				mockPayrollRepo.EXPECT().
-					Create(gomock.Any(), gomock.Any(), nil).
+					Create(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
 					Return(createdPayroll, nil)
 
+				// Mock work schedule for each employee
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					Return(utcSchedule, nil).
+					Times(2)
+
 				// Mock attendance count for each employee
 				mockAttendanceRepo.EXPECT().
-					CountAttendanceInPeriod(gomock.Any(), int64(1), attendancePeriod.StartDate, attendancePeriod.EndDate, nil).
-					Return(int64(20), nil)
+					FindIDsInPeriod(gomock.Any(), int64(1), attendancePeriod.StartDate, attendancePeriod.EndDate, nil).
+					Return(attendanceIDs(20), nil)
 				mockAttendanceRepo.EXPECT().
-					CountAttendanceInPeriod(gomock.Any(), int64(2), attendancePeriod.StartDate, attendancePeriod.EndDate, nil).
-					Return(int64(20), nil)
+					FindIDsInPeriod(gomock.Any(), int64(2), attendancePeriod.StartDate, attendancePeriod.EndDate, nil).
+					Return(attendanceIDs(20), nil)
 
 				// Mock overtime lookup for each employee
 				mockOvertimeRepo.EXPECT().
-					FindByTemplate(gomock.Any(), gomock.Any(), nil).
+					Find(gomock.Any(), gomock.Any(), nil).
 					Return([]entity.Overtime{}, nil).Times(2) // No overtime for simplicity
 
 				// Mock reimbursement lookup for each employee
 				mockReimbursementRepo.EXPECT().
-					FindByTemplate(gomock.Any(), gomock.Any(), nil).
+					Find(gomock.Any(), gomock.Any(), nil).
 					Return([]entity.Reimbursement{}, nil).Times(2) // No reimbursements for simplicity
 
 				// Mock payslip creation for each employee
 				mockPayslipRepo.EXPECT().
-					Create(gomock.Any(), gomock.Any(), nil).
+					Create(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
 					Return(&entity.Payslip{Base: entity.Base{ID: 1}, TotalTakeHome: 4545454}, nil).
 					Times(1)
 				mockPayslipRepo.EXPECT().
-					Create(gomock.Any(), gomock.Any(), nil).
-					Return(&entity.Payslip{Base: entity.Base{ID: 2}, TotalTakeHome: 3636363}, nil).
+					Create(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					Return(&entity.Payslip{Base: entity.Base{ID: 2}, TotalTakeHome: 3636364}, nil).
 					Times(1)
 
-				// Mock payroll update
-				mockPayrollRepo.EXPECT().
-					Updates(gomock.Any(), createdPayroll, gomock.Any(), nil).
-					Return(createdPayroll, nil)
+				// Mock the calculation trace of each payslip
+				mockPayslipTraceRepo.EXPECT().
+					CreateAll(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					DoAndReturn(func(_ context.Context, traces []entity.PayslipTrace, _ *gorm.DB) error {
+						if len(traces) != 2 || traces[0].PayslipID != 1 || traces[1].PayslipID != 2 {
+							t.Errorf("Expected a trace for payslips 1 and 2 but got %+v", traces)
+						}
+						return nil
+					})
+
+				mockPayrollRoundingRepo.EXPECT().
+					CreateAll(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					Return(nil)
 			},
 			expectError: false,
 		},
@@ -199,6 +245,10 @@ func TestPayrollUsecase_RunPayroll(t *testing.T) {
 				AttendancePeriodId: 1,
 			},
 			setupMock: func() {
+				// The payroll is stored in one transaction
+				dbMock.ExpectBegin()
+				dbMock.ExpectCommit()
+
 				// Mock attendance period lookup
 				attendancePeriod := &entity.AttendancePeriod{
 					Base:      entity.Base{ID: 1},
@@ -233,13 +283,18 @@ func TestPayrollUsecase_RunPayroll(t *testing.T) {
 					TotalEmployees:     1,
 				}
 				mockPayrollRepo.EXPECT().
-					Create(gomock.Any(), gomock.Any(), nil).
+					Create(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
 					Return(createdPayroll, nil)
 
+				// Mock work schedule
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					Return(utcSchedule, nil)
+
 				// Mock attendance count
 				mockAttendanceRepo.EXPECT().
-					CountAttendanceInPeriod(gomock.Any(), int64(1), attendancePeriod.StartDate, attendancePeriod.EndDate, nil).
-					Return(int64(20), nil)
+					FindIDsInPeriod(gomock.Any(), int64(1), attendancePeriod.StartDate, attendancePeriod.EndDate, nil).
+					Return(attendanceIDs(20), nil)
 
 				// Mock overtime lookup (with overtime)
 				overtime := []entity.Overtime{
@@ -251,7 +306,7 @@ func TestPayrollUsecase_RunPayroll(t *testing.T) {
 					},
 				}
 				mockOvertimeRepo.EXPECT().
-					FindByTemplate(gomock.Any(), gomock.Any(), nil).
+					Find(gomock.Any(), gomock.Any(), nil).
 					Return(overtime, nil)
 
 				// Mock reimbursement lookup (with reimbursements)
@@ -264,27 +319,270 @@ func TestPayrollUsecase_RunPayroll(t *testing.T) {
 					},
 				}
 				mockReimbursementRepo.EXPECT().
-					FindByTemplate(gomock.Any(), gomock.Any(), nil).
+					Find(gomock.Any(), gomock.Any(), nil).
 					Return(reimbursements, nil)
 
-				// Mock payslip creation
-				payslip := &entity.Payslip{
-					Base:               entity.Base{ID: 1},
-					TotalTakeHome:      5145454,
-					OvertimeTotalPay:   500000,
-					ReimbursementTotal: 100000,
-				}
+				// Amounts are calculated exactly and rounded once: the prorated
+				// salary is 4347826.09 for 20 of 23 days and two hours of overtime 113636.36
 				mockPayslipRepo.EXPECT().
-					Create(gomock.Any(), gomock.Any(), nil).
-					Return(payslip, nil)
+					Create(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					DoAndReturn(func(_ context.Context, p *entity.Payslip, _ *gorm.DB) (*entity.Payslip, error) {
+						if p.ProratedSalary != 4347826 || p.OvertimeTotalPay != 113636 || p.ReimbursementTotal != 100000 || p.TotalTakeHome != 4561462 {
+							t.Errorf("Unexpected payslip: %+v", p)
+						}
+						p.ID = 7
+						return p, nil
+					})
+
+				// The trace keeps what the payslip was calculated from and
+				// each step before and after rounding
+				mockPayslipTraceRepo.EXPECT().
+					CreateAll(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					DoAndReturn(func(_ context.Context, traces []entity.PayslipTrace, _ *gorm.DB) error {
+						if len(traces) != 1 || traces[0].PayslipID != 7 {
+							t.Fatalf("Expected a trace for payslip 7 but got %+v", traces)
+						}
+						trace := traces[0].Calculation
+						if trace.WorkingDays != 23 || len(trace.AttendanceIDs) != 20 || trace.HourlyRate != "28409.0909090909" || trace.OvertimeMultiplier != 2 {
+							t.Errorf("Unexpected trace: %+v", trace)
+						}
+						if len(trace.Overtimes) != 1 || trace.Overtimes[0] != (entity.TracedOvertime{ID: 1, Hours: 2}) {
+							t.Errorf("Unexpected traced overtime: %+v", trace.Overtimes)
+						}
+						if len(trace.Reimbursements) != 1 || trace.Reimbursements[0] != (entity.TracedReimbursement{ID: 1, Amount: 100000, Currency: "IDR"}) {
+							t.Errorf("Unexpected traced reimbursements: %+v", trace.Reimbursements)
+						}
+
+						expectedSteps := []struct {
+							name   string
+							exact  string
+							result int64
+						}{
+							{"prorated_salary", "4347826.0869565217", 4347826},
+							{"hourly_rate", "28409.0909090909", 0},
+							{"overtime_pay", "113636.3636363636", 113636},
+							{"reimbursement_total", "100000", 100000},
+							{"total_take_home", "4561462", 4561462},
+						}
+						if len(trace.Steps) != len(expectedSteps) {
+							t.Fatalf("Expected %d steps but got %+v", len(expectedSteps), trace.Steps)
+						}
+						for i, expected := range expectedSteps {
+							step := trace.Steps[i]
+							if step.Name != expected.name || step.Exact != expected.exact {
+								t.Errorf("Unexpected step %d: %+v", i, step)
+							}
+							if expected.result != 0 && (step.Result == nil || *step.Result != expected.result) {
+								t.Errorf("Expected step %s to result in %d but got %v", step.Name, expected.result, step.Result)
+							}
+						}
+						if trace.Steps[0].Formula != "5000000 base salary × 20 attendance days / 23 working days" || trace.Steps[0].RoundingMode != "half_up" {
+							t.Errorf("Unexpected prorated salary step: %+v", trace.Steps[0])
+						}
+						return nil
+					})
+
+				mockPayrollRoundingRepo.EXPECT().
+					CreateAll(gomock.Any(), []entity.PayrollRounding{
+						{PayrollID: 1, Calculation: entity.RoundingProratedSalary, Currency: "IDR", RoundingMode: "half_up", Difference: "-0.0869565217"},
+						{PayrollID: 1, Calculation: entity.RoundingOvertime, Currency: "IDR", RoundingMode: "half_up", Difference: "-0.3636363636"},
+					}, gomock.Not(gomock.Nil())).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "amounts in other currencies are converted",
+			request: v1.PostAdminPayrollsJSONRequestBody{
+				AttendancePeriodId: 1,
+			},
+			setupMock: func() {
+				// The payroll is stored in one transaction
+				dbMock.ExpectBegin()
+				dbMock.ExpectCommit()
 
-				// Mock payroll update
+				attendancePeriod := &entity.AttendancePeriod{
+					Base:      entity.Base{ID: 1},
+					StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+				}
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(attendancePeriod, nil)
 				mockPayrollRepo.EXPECT().
-					Updates(gomock.Any(), createdPayroll, gomock.Any(), nil).
-					Return(createdPayroll, nil)
+					FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: int64(1)}, nil).
+					Return(nil, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.Employee{}, nil).
+					Return([]entity.Employee{{Base: entity.Base{ID: 1}, UserID: 1, BaseSalary: 3000, Currency: "USD"}}, nil)
+
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					Return(utcSchedule, nil)
+				mockAttendanceRepo.EXPECT().
+					FindIDsInPeriod(gomock.Any(), int64(1), attendancePeriod.StartDate, attendancePeriod.EndDate, nil).
+					Return(nil, nil)
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+				mockReimbursementRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Reimbursement{{Base: entity.Base{ID: 1}, EmployeeID: 1, Amount: 135, Currency: "SGD"}}, nil)
+
+				// SGD to USD is only known the other way round; USD to IDR
+				// is looked up once for all three totals
+				gomock.InOrder(
+					mockExchangeRateRepo.EXPECT().
+						Find(gomock.Any(), gomock.Any(), nil).
+						Return(nil, nil),
+					mockExchangeRateRepo.EXPECT().
+						Find(gomock.Any(), gomock.Any(), nil).
+						Return([]entity.ExchangeRate{{FromCurrency: "USD", ToCurrency: "SGD", EffectiveDate: attendancePeriod.StartDate, Rate: "1.35"}}, nil),
+					mockExchangeRateRepo.EXPECT().
+						Find(gomock.Any(), gomock.Any(), nil).
+						Return([]entity.ExchangeRate{{FromCurrency: "USD", ToCurrency: "IDR", EffectiveDate: attendancePeriod.EndDate, Rate: "16000"}}, nil),
+				)
+
+				mockPayrollRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					DoAndReturn(func(_ context.Context, p *entity.Payroll, _ *gorm.DB) (*entity.Payroll, error) {
+						if p.ReportingCurrency != "IDR" || p.TotalReimbursement != 1600000 || p.TotalPayroll != 1600000 {
+							t.Errorf("Unexpected payroll totals: %+v", p)
+						}
+						p.ID = 1
+						return p, nil
+					})
+				mockPayslipRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					DoAndReturn(func(_ context.Context, p *entity.Payslip, _ *gorm.DB) (*entity.Payslip, error) {
+						if p.PayrollID != 1 || p.Currency != "USD" || p.ReimbursementTotal != 100 || p.TotalTakeHome != 100 {
+							t.Errorf("Unexpected payslip: %+v", p)
+						}
+						return p, nil
+					})
+				mockPayslipTraceRepo.EXPECT().
+					CreateAll(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					DoAndReturn(func(_ context.Context, traces []entity.PayslipTrace, _ *gorm.DB) error {
+						trace := traces[0].Calculation
+						if len(trace.ExchangeRates) != 1 || trace.ExchangeRates[0] != (entity.TracedExchangeRate{FromCurrency: "SGD", ToCurrency: "USD", Rate: "0.7407407407"}) {
+							t.Errorf("Unexpected traced exchange rates: %+v", trace.ExchangeRates)
+						}
+						var converted *entity.PayslipCalculationStep
+						for i := range trace.Steps {
+							if trace.Steps[i].Name == "converted_reimbursements" {
+								converted = &trace.Steps[i]
+							}
+						}
+						if converted == nil || converted.Formula != "135 SGD × 0.7407407407" || converted.Exact != "99.9999999945" || *converted.Result != 100 {
+							t.Errorf("Unexpected conversion step: %+v", converted)
+						}
+						return nil
+					})
+				mockPayrollExchangeRateRepo.EXPECT().
+					CreateAll(gomock.Any(), []entity.PayrollExchangeRate{
+						{PayrollID: 1, FromCurrency: "SGD", ToCurrency: "USD", EffectiveDate: attendancePeriod.StartDate, Rate: "0.7407407407"},
+						{PayrollID: 1, FromCurrency: "USD", ToCurrency: "IDR", EffectiveDate: attendancePeriod.EndDate, Rate: "16000"},
+					}, gomock.Not(gomock.Nil())).
+					Return(nil)
+				mockPayrollRoundingRepo.EXPECT().
+					CreateAll(gomock.Any(), []entity.PayrollRounding{
+						{PayrollID: 1, Calculation: entity.RoundingProratedSalary, Currency: "USD", RoundingMode: "half_up", Difference: "0"},
+						{PayrollID: 1, Calculation: entity.RoundingOvertime, Currency: "USD", RoundingMode: "half_up", Difference: "0"},
+						{PayrollID: 1, Calculation: entity.RoundingConversion, Currency: "USD", RoundingMode: "half_up", Difference: "0.0000000055"},
+						{PayrollID: 1, Calculation: entity.RoundingConversion, Currency: "IDR", RoundingMode: "half_up", Difference: "0"},
+					}, gomock.Not(gomock.Nil())).
+					Return(nil)
 			},
 			expectError: false,
 		},
+		{
+			name: "failed payslip rolls back the payroll",
+			request: v1.PostAdminPayrollsJSONRequestBody{
+				AttendancePeriodId: 1,
+			},
+			setupMock: func() {
+				dbMock.ExpectBegin()
+				dbMock.ExpectRollback()
+
+				attendancePeriod := &entity.AttendancePeriod{
+					Base:      entity.Base{ID: 1},
+					StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+				}
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(attendancePeriod, nil)
+				mockPayrollRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: int64(1)}, nil).
+					Return(nil, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.Employee{}, nil).
+					Return([]entity.Employee{{Base: entity.Base{ID: 1}, UserID: 1, BaseSalary: 5000000}}, nil)
+
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					Return(utcSchedule, nil)
+				mockAttendanceRepo.EXPECT().
+					FindIDsInPeriod(gomock.Any(), int64(1), attendancePeriod.StartDate, attendancePeriod.EndDate, nil).
+					Return(attendanceIDs(20), nil)
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+				mockReimbursementRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+
+				mockPayrollRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					Return(&entity.Payroll{Base: entity.Base{ID: 1}}, nil)
+				mockPayslipRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					Return(nil, gorm.ErrInvalidDB)
+			},
+			expectError:    true,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "missing exchange rate stores nothing",
+			request: v1.PostAdminPayrollsJSONRequestBody{
+				AttendancePeriodId: 1,
+			},
+			setupMock: func() {
+				attendancePeriod := &entity.AttendancePeriod{
+					Base:      entity.Base{ID: 1},
+					StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+				}
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(attendancePeriod, nil)
+				mockPayrollRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: int64(1)}, nil).
+					Return(nil, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.Employee{}, nil).
+					Return([]entity.Employee{{Base: entity.Base{ID: 1}, UserID: 1, BaseSalary: 3000, Currency: "USD"}}, nil)
+
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					Return(utcSchedule, nil)
+				mockAttendanceRepo.EXPECT().
+					FindIDsInPeriod(gomock.Any(), int64(1), attendancePeriod.StartDate, attendancePeriod.EndDate, nil).
+					Return(attendanceIDs(20), nil)
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+				mockReimbursementRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+				mockExchangeRateRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil).
+					Times(2)
+			},
+			expectError:    true,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
 	}
 
 	for _, tt := range tests {
@@ -297,11 +595,18 @@ func TestPayrollUsecase_RunPayroll(t *testing.T) {
 				if err == nil {
 					t.Error("Expected error but got none")
 				}
+				var httpErr interface{ HTTPStatus() int }
+				if tt.expectedStatus != 0 && (!errors.As(err, &httpErr) || httpErr.HTTPStatus() != tt.expectedStatus) {
+					t.Errorf("Expected status %d but got: %v", tt.expectedStatus, err)
+				}
 			} else {
 				if err != nil {
 					t.Errorf("Expected no error but got: %v", err)
 				}
 			}
+			if err := dbMock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unexpected transaction: %v", err)
+			}
 		})
 	}
 }
@@ -318,6 +623,12 @@ func TestPayrollUsecase_GetPayrollSummary(t *testing.T) {
 	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
 	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
 	mockUserRepo := mock.NewMockUserRepository(ctrl)
+	mockDepartmentRepo := mock.NewMockDepartmentRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+	mockExchangeRateRepo := mock.NewMockExchangeRateRepository(ctrl)
+	mockPayrollExchangeRateRepo := mock.NewMockPayrollExchangeRateRepository(ctrl)
+	mockPayrollRoundingRepo := mock.NewMockPayrollRoundingRepository(ctrl)
+	mockPayslipTraceRepo := mock.NewMockPayslipTraceRepository(ctrl)
 
 	usecase := payroll.NewUsecase(
 		mockPayrollRepo,
@@ -328,13 +639,27 @@ func TestPayrollUsecase_GetPayrollSummary(t *testing.T) {
 		mockOvertimeRepo,
 		mockReimbursementRepo,
 		mockUserRepo,
+		mockDepartmentRepo,
+		mockLocationRepo,
+		mockExchangeRateRepo,
+		mockPayrollExchangeRateRepo,
+		mockPayrollRoundingRepo,
+		mockPayslipTraceRepo,
+		nil,
+		internal.PayrollConfig{ReportingCurrency: "IDR"},
 	)
 
+	departmentID := int64(3)
+
 	tests := []struct {
-		name        string
-		payrollID   int64
-		setupMock   func()
-		expectError bool
+		name                string
+		payrollID           int64
+		departmentID        *int64
+		setupMock           func()
+		expectError         bool
+		expectedStatus      int
+		expectedPayslips    int
+		expectedDepartments int
 	}{
 		{
 			name:      "successful payroll summary retrieval",
@@ -396,6 +721,13 @@ func TestPayrollUsecase_GetPayrollSummary(t *testing.T) {
 					FindByTemplate(gomock.Any(), &entity.Payslip{PayrollID: int64(1)}, nil).
 					Return(payslips, nil)
 
+				mockPayrollExchangeRateRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.PayrollExchangeRate{PayrollID: 1}, nil).
+					Return(nil, nil)
+				mockPayrollRoundingRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.PayrollRounding{PayrollID: 1}, nil).
+					Return(nil, nil)
+
 				// Mock employee lookups
 				employee1 := &entity.Employee{
 					Base:   entity.Base{ID: 1},
@@ -428,7 +760,132 @@ func TestPayrollUsecase_GetPayrollSummary(t *testing.T) {
 					FindByID(gomock.Any(), uint(2), nil).
 					Return(user2, nil)
 			},
-			expectError: false,
+			expectError:         false,
+			expectedPayslips:    2,
+			expectedDepartments: 1,
+		},
+		{
+			name:         "filtered by department with subtotals",
+			payrollID:    1,
+			departmentID: &departmentID,
+			setupMock: func() {
+				payroll := &entity.Payroll{
+					Base:               entity.Base{ID: 1},
+					AttendancePeriodID: 1,
+					TotalEmployees:     3,
+					TotalPayroll:       9000000,
+				}
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(payroll, nil)
+
+				attendancePeriod := &entity.AttendancePeriod{
+					Base:      entity.Base{ID: 1},
+					StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC),
+				}
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(attendancePeriod, nil)
+
+				payslips := []entity.Payslip{
+					{
+						Base:             entity.Base{ID: 1},
+						EmployeeID:       1,
+						PayrollID:        1,
+						ProratedSalary:   3000000,
+						OvertimeTotalPay: 100000,
+						TotalTakeHome:    3100000,
+						DepartmentID:     &departmentID,
+					},
+					{
+						Base:               entity.Base{ID: 2},
+						EmployeeID:         2,
+						PayrollID:          1,
+						ProratedSalary:     2000000,
+						ReimbursementTotal: 50000,
+						TotalTakeHome:      2050000,
+						DepartmentID:       &departmentID,
+					},
+				}
+				mockPayslipRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.Payslip{PayrollID: int64(1), DepartmentID: &departmentID}, nil).
+					Return(payslips, nil)
+
+				mockPayrollExchangeRateRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.PayrollExchangeRate{PayrollID: 1}, nil).
+					Return(nil, nil)
+				mockPayrollRoundingRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.PayrollRounding{PayrollID: 1}, nil).
+					Return(nil, nil)
+
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: 2}, UserID: 2}, nil)
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.User{Base: entity.Base{ID: 1}, Username: "john_doe"}, nil)
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2), nil).
+					Return(&entity.User{Base: entity.Base{ID: 2}, Username: "jane_smith"}, nil)
+
+				mockDepartmentRepo.EXPECT().
+					FindByID(gomock.Any(), uint(departmentID), nil).
+					Return(&entity.Department{Base: entity.Base{ID: departmentID}, Code: "ENG", Name: "Engineering", CostCenter: "CC-100"}, nil)
+			},
+			expectError:         false,
+			expectedPayslips:    2,
+			expectedDepartments: 1,
+		},
+		{
+			name:         "subtotals converted to the reporting currency",
+			payrollID:    1,
+			departmentID: &departmentID,
+			setupMock: func() {
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.Payroll{Base: entity.Base{ID: 1}, AttendancePeriodID: 1, ReportingCurrency: "IDR"}, nil)
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.AttendancePeriod{Base: entity.Base{ID: 1}}, nil)
+
+				payslips := []entity.Payslip{
+					{Base: entity.Base{ID: 1}, EmployeeID: 1, PayrollID: 1, TotalTakeHome: 3100000, Currency: "IDR", DepartmentID: &departmentID},
+					{Base: entity.Base{ID: 2}, EmployeeID: 2, PayrollID: 1, TotalTakeHome: 128, Currency: "USD", DepartmentID: &departmentID},
+				}
+				mockPayslipRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.Payslip{PayrollID: int64(1), DepartmentID: &departmentID}, nil).
+					Return(payslips, nil)
+				mockPayrollExchangeRateRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.PayrollExchangeRate{PayrollID: 1}, nil).
+					Return([]entity.PayrollExchangeRate{{PayrollID: 1, FromCurrency: "USD", ToCurrency: "IDR", Rate: "16015.6290000000"}}, nil)
+				mockPayrollRoundingRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.PayrollRounding{PayrollID: 1}, nil).
+					Return([]entity.PayrollRounding{{PayrollID: 1, Calculation: entity.RoundingConversion, Currency: "IDR", RoundingMode: "truncate", Difference: "-0.512"}}, nil)
+
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: 1}, UserID: 1}, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2), nil).
+					Return(&entity.Employee{Base: entity.Base{ID: 2}, UserID: 2}, nil)
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.User{Base: entity.Base{ID: 1}, Username: "john_doe"}, nil)
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2), nil).
+					Return(&entity.User{Base: entity.Base{ID: 2}, Username: "jane_smith"}, nil)
+
+				mockDepartmentRepo.EXPECT().
+					FindByID(gomock.Any(), uint(departmentID), nil).
+					Return(&entity.Department{Base: entity.Base{ID: departmentID}, Code: "ENG", Name: "Engineering", CostCenter: "CC-100"}, nil)
+			},
+			expectError:         false,
+			expectedPayslips:    2,
+			expectedDepartments: 1,
 		},
 		{
 			name:      "payroll not found",
@@ -438,7 +895,8 @@ func TestPayrollUsecase_GetPayrollSummary(t *testing.T) {
 					FindByID(gomock.Any(), uint(999), nil).
 					Return(nil, gorm.ErrRecordNotFound)
 			},
-			expectError: true,
+			expectError:    true,
+			expectedStatus: http.StatusNotFound,
 		},
 		{
 			name:      "attendance period not found",
@@ -462,7 +920,8 @@ func TestPayrollUsecase_GetPayrollSummary(t *testing.T) {
 					FindByID(gomock.Any(), uint(999), nil).
 					Return(nil, gorm.ErrRecordNotFound)
 			},
-			expectError: true,
+			expectError:    true,
+			expectedStatus: http.StatusNotFound,
 		},
 		{
 			name:      "no payslips found",
@@ -495,6 +954,13 @@ func TestPayrollUsecase_GetPayrollSummary(t *testing.T) {
 				mockPayslipRepo.EXPECT().
 					FindByTemplate(gomock.Any(), &entity.Payslip{PayrollID: int64(1)}, nil).
 					Return([]entity.Payslip{}, nil)
+
+				mockPayrollExchangeRateRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.PayrollExchangeRate{PayrollID: 1}, nil).
+					Return(nil, nil)
+				mockPayrollRoundingRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.PayrollRounding{PayrollID: 1}, nil).
+					Return(nil, nil)
 			},
 			expectError: false,
 		},
@@ -537,12 +1003,20 @@ func TestPayrollUsecase_GetPayrollSummary(t *testing.T) {
 					FindByTemplate(gomock.Any(), &entity.Payslip{PayrollID: int64(1)}, nil).
 					Return(payslips, nil)
 
+				mockPayrollExchangeRateRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.PayrollExchangeRate{PayrollID: 1}, nil).
+					Return(nil, nil)
+				mockPayrollRoundingRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.PayrollRounding{PayrollID: 1}, nil).
+					Return(nil, nil)
+
 				// Mock employee not found
 				mockEmployeeRepo.EXPECT().
 					FindByID(gomock.Any(), uint(999), nil).
 					Return(nil, gorm.ErrRecordNotFound)
 			},
-			expectError: false, // Should continue processing other payslips
+			expectError:         false, // Should continue processing other payslips
+			expectedDepartments: 1,     // Still counted under the unassigned subtotal
 		},
 	}
 
@@ -550,7 +1024,7 @@ func TestPayrollUsecase_GetPayrollSummary(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMock()
 
-			result, err := usecase.GetPayrollSummary(context.Background(), tt.payrollID)
+			result, err := usecase.GetPayrollSummary(context.Background(), tt.payrollID, tt.departmentID)
 
 			if tt.expectError {
 				if err == nil {
@@ -559,6 +1033,10 @@ func TestPayrollUsecase_GetPayrollSummary(t *testing.T) {
 				if result != nil {
 					t.Error("Expected nil result but got value")
 				}
+				var httpErr interface{ HTTPStatus() int }
+				if tt.expectedStatus != 0 && (!errors.As(err, &httpErr) || httpErr.HTTPStatus() != tt.expectedStatus) {
+					t.Errorf("Expected status %d but got: %v", tt.expectedStatus, err)
+				}
 			} else {
 				if err != nil {
 					t.Errorf("Expected no error but got: %v", err)
@@ -570,8 +1048,800 @@ func TestPayrollUsecase_GetPayrollSummary(t *testing.T) {
 					if result.PayrollId != tt.payrollID {
 						t.Errorf("Expected payroll ID %d but got %d", tt.payrollID, result.PayrollId)
 					}
+					if len(result.PayslipList) != tt.expectedPayslips {
+						t.Errorf("Expected %d payslips but got %d", tt.expectedPayslips, len(result.PayslipList))
+					}
+					if len(result.DepartmentSubtotals) != tt.expectedDepartments {
+						t.Errorf("Expected %d department subtotals but got %d", tt.expectedDepartments, len(result.DepartmentSubtotals))
+					}
+					// Filtered totals cover the department's payslips only
+					if tt.departmentID != nil && (result.EmployeesCount != 2 || result.TotalPayroll != 5150000) {
+						t.Errorf("Expected totals of the department but got %d employees and %d total payroll", result.EmployeesCount, result.TotalPayroll)
+					}
+					for _, subtotal := range result.DepartmentSubtotals {
+						if subtotal.DepartmentId != nil && *subtotal.DepartmentId == departmentID {
+							if subtotal.EmployeesCount != 2 || subtotal.TotalPayroll != 5150000 {
+								t.Errorf("Unexpected department subtotal: %+v", subtotal)
+							}
+							if subtotal.CostCenter == nil || *subtotal.CostCenter != "CC-100" {
+								t.Errorf("Expected cost center CC-100 but got %v", subtotal.CostCenter)
+							}
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPayrollUsecase_RunPayroll_RoundingModes(t *testing.T) {
+	utcSchedule := entity.DefaultWorkSchedule()
+	utcSchedule.Zone = time.UTC
+
+	attendancePeriod := &entity.AttendancePeriod{
+		Base:      entity.Base{ID: 1},
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	// An hour of overtime on a base salary of 5000028 pays 56818.5 exactly
+	tests := []struct {
+		name               string
+		rounding           internal.RoundingConfig
+		overtimeHours      int
+		expectError        bool
+		expectedOvertime   int64
+		expectedDifference string
+	}{
+		{
+			name:               "half up by default",
+			overtimeHours:      1,
+			expectedOvertime:   56819,
+			expectedDifference: "0.5",
+		},
+		{
+			name:               "bankers rounds a half down to even",
+			rounding:           internal.RoundingConfig{Overtime: "bankers"},
+			overtimeHours:      1,
+			expectedOvertime:   56818,
+			expectedDifference: "-0.5",
+		},
+		{
+			name:               "bankers rounds a half up to even",
+			rounding:           internal.RoundingConfig{Overtime: "bankers"},
+			overtimeHours:      3,
+			expectedOvertime:   170456,
+			expectedDifference: "0.5",
+		},
+		{
+			name:               "truncate",
+			rounding:           internal.RoundingConfig{Overtime: "truncate"},
+			overtimeHours:      3,
+			expectedOvertime:   170455,
+			expectedDifference: "-0.5",
+		},
+		{
+			name:        "unknown rounding mode",
+			rounding:    internal.RoundingConfig{ProratedSalary: "ceiling"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+			mockPayslipRepo := mock.NewMockPayslipRepository(ctrl)
+			mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+			mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+			mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+			mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+			mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+			mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+			mockPayrollRoundingRepo := mock.NewMockPayrollRoundingRepository(ctrl)
+			mockPayslipTraceRepo := mock.NewMockPayslipTraceRepository(ctrl)
+			db, dbMock := newTestDB(t)
+
+			usecase := payroll.NewUsecase(
+				mockPayrollRepo,
+				mockPayslipRepo,
+				mockEmployeeRepo,
+				mockAttendanceRepo,
+				mockAttendancePeriodRepo,
+				mockOvertimeRepo,
+				mockReimbursementRepo,
+				mock.NewMockUserRepository(ctrl),
+				mock.NewMockDepartmentRepository(ctrl),
+				mockLocationRepo,
+				mock.NewMockExchangeRateRepository(ctrl),
+				mock.NewMockPayrollExchangeRateRepository(ctrl),
+				mockPayrollRoundingRepo,
+				mockPayslipTraceRepo,
+				db,
+				internal.PayrollConfig{ReportingCurrency: "IDR", Rounding: tt.rounding},
+			)
+
+			mockAttendancePeriodRepo.EXPECT().
+				FindByID(gomock.Any(), uint(1), nil).
+				Return(attendancePeriod, nil)
+			mockPayrollRepo.EXPECT().
+				FindOneByTemplate(gomock.Any(), &entity.Payroll{AttendancePeriodID: int64(1)}, nil).
+				Return(nil, nil)
+
+			if !tt.expectError {
+				dbMock.ExpectBegin()
+				dbMock.ExpectCommit()
+				mockEmployeeRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.Employee{}, nil).
+					Return([]entity.Employee{{Base: entity.Base{ID: 1}, UserID: 1, BaseSalary: 5000028}}, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					Return(utcSchedule, nil)
+				mockAttendanceRepo.EXPECT().
+					FindIDsInPeriod(gomock.Any(), int64(1), attendancePeriod.StartDate, attendancePeriod.EndDate, nil).
+					Return(nil, nil)
+				start := time.Date(2025, 1, 15, 18, 0, 0, 0, time.UTC)
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Overtime{{EmployeeID: 1, StartAt: start, EndAt: start.Add(time.Duration(tt.overtimeHours) * time.Hour)}}, nil)
+				mockReimbursementRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+				mockPayrollRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					DoAndReturn(func(_ context.Context, p *entity.Payroll, _ *gorm.DB) (*entity.Payroll, error) {
+						p.ID = 1
+						return p, nil
+					})
+				mockPayslipRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					DoAndReturn(func(_ context.Context, p *entity.Payslip, _ *gorm.DB) (*entity.Payslip, error) {
+						if p.OvertimeTotalPay != tt.expectedOvertime || p.TotalTakeHome != tt.expectedOvertime {
+							t.Errorf("Expected overtime pay %d but got %+v", tt.expectedOvertime, p)
+						}
+						return p, nil
+					})
+				mockPayslipTraceRepo.EXPECT().
+					CreateAll(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					Return(nil)
+				mockPayrollRoundingRepo.EXPECT().
+					CreateAll(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					DoAndReturn(func(_ context.Context, records []entity.PayrollRounding, _ *gorm.DB) error {
+						if len(records) != 2 || records[1].Calculation != entity.RoundingOvertime || records[1].Difference != tt.expectedDifference {
+							t.Errorf("Expected an overtime rounding difference of %s but got %+v", tt.expectedDifference, records)
+						}
+						return nil
+					})
+			}
+
+			err := usecase.RunPayroll(context.Background(), v1.PostAdminPayrollsJSONRequestBody{AttendancePeriodId: 1})
+
+			if tt.expectError {
+				var httpErr interface{ HTTPStatus() int }
+				if !errors.As(err, &httpErr) || httpErr.HTTPStatus() != http.StatusInternalServerError {
+					t.Errorf("Expected status %d but got: %v", http.StatusInternalServerError, err)
 				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Expected no error but got: %v", err)
 			}
 		})
 	}
 }
+
+func TestPayrollUsecase_FinalizePayroll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+
+	usecase := payroll.NewUsecase(
+		mockPayrollRepo,
+		mock.NewMockPayslipRepository(ctrl),
+		mock.NewMockEmployeeRepository(ctrl),
+		mock.NewMockAttendanceRepository(ctrl),
+		mock.NewMockAttendancePeriodRepository(ctrl),
+		mock.NewMockOvertimeRepository(ctrl),
+		mock.NewMockReimbursementRepository(ctrl),
+		mock.NewMockUserRepository(ctrl),
+		mock.NewMockDepartmentRepository(ctrl),
+		mock.NewMockLocationRepository(ctrl),
+		mock.NewMockExchangeRateRepository(ctrl),
+		mock.NewMockPayrollExchangeRateRepository(ctrl),
+		mock.NewMockPayrollRoundingRepository(ctrl),
+		mock.NewMockPayslipTraceRepository(ctrl),
+		nil,
+		internal.PayrollConfig{},
+	)
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "5")
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "draft payroll is finalized",
+			setupMock: func() {
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.Payroll{Base: entity.Base{ID: 1}, Status: entity.PayrollStatusDraft}, nil)
+				mockPayrollRepo.EXPECT().
+					Finalize(gomock.Any(), gomock.Any(), int64(5), gomock.Any(), nil).
+					Return(true, nil)
+			},
+		},
+		{
+			name: "payroll finalized or removed concurrently",
+			setupMock: func() {
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.Payroll{Base: entity.Base{ID: 1}, Status: entity.PayrollStatusDraft}, nil)
+				mockPayrollRepo.EXPECT().
+					Finalize(gomock.Any(), gomock.Any(), int64(5), gomock.Any(), nil).
+					Return(false, nil)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "payroll already finalized",
+			setupMock: func() {
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.Payroll{Base: entity.Base{ID: 1}, Status: entity.PayrollStatusFinalized}, nil)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "payroll not found",
+			setupMock: func() {
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.FinalizePayroll(ctx, 1)
+
+			if tt.expectedStatus == 0 {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				return
+			}
+			var httpErr interface{ HTTPStatus() int }
+			if !errors.As(err, &httpErr) || httpErr.HTTPStatus() != tt.expectedStatus {
+				t.Errorf("Expected status %d but got: %v", tt.expectedStatus, err)
+			}
+		})
+	}
+}
+
+func TestPayrollUsecase_DeletePayroll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+
+	usecase := payroll.NewUsecase(
+		mockPayrollRepo,
+		mock.NewMockPayslipRepository(ctrl),
+		mock.NewMockEmployeeRepository(ctrl),
+		mock.NewMockAttendanceRepository(ctrl),
+		mock.NewMockAttendancePeriodRepository(ctrl),
+		mock.NewMockOvertimeRepository(ctrl),
+		mock.NewMockReimbursementRepository(ctrl),
+		mock.NewMockUserRepository(ctrl),
+		mock.NewMockDepartmentRepository(ctrl),
+		mock.NewMockLocationRepository(ctrl),
+		mock.NewMockExchangeRateRepository(ctrl),
+		mock.NewMockPayrollExchangeRateRepository(ctrl),
+		mock.NewMockPayrollRoundingRepository(ctrl),
+		mock.NewMockPayslipTraceRepository(ctrl),
+		nil,
+		internal.PayrollConfig{},
+	)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "draft payroll is removed with its payslips",
+			setupMock: func() {
+				draft := &entity.Payroll{Base: entity.Base{ID: 1}, AttendancePeriodID: 2, Status: entity.PayrollStatusDraft}
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(draft, nil)
+				mockPayrollRepo.EXPECT().
+					DeleteWithPayslips(gomock.Any(), draft, nil).
+					Return(true, nil)
+			},
+		},
+		{
+			name: "payroll finalized concurrently is kept",
+			setupMock: func() {
+				draft := &entity.Payroll{Base: entity.Base{ID: 1}, AttendancePeriodID: 2, Status: entity.PayrollStatusDraft}
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(draft, nil)
+				mockPayrollRepo.EXPECT().
+					DeleteWithPayslips(gomock.Any(), draft, nil).
+					Return(false, nil)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "finalized payroll is kept",
+			setupMock: func() {
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.Payroll{Base: entity.Base{ID: 1}, Status: entity.PayrollStatusFinalized}, nil)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.DeletePayroll(context.Background(), 1)
+
+			if tt.expectedStatus == 0 {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+				return
+			}
+			var httpErr interface{ HTTPStatus() int }
+			if !errors.As(err, &httpErr) || httpErr.HTTPStatus() != tt.expectedStatus {
+				t.Errorf("Expected status %d but got: %v", tt.expectedStatus, err)
+			}
+		})
+	}
+}
+
+func TestPayrollUsecase_ExplainPayslip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPayslipRepo := mock.NewMockPayslipRepository(ctrl)
+	mockPayslipTraceRepo := mock.NewMockPayslipTraceRepository(ctrl)
+
+	usecase := payroll.NewUsecase(
+		mock.NewMockPayrollRepository(ctrl),
+		mockPayslipRepo,
+		mock.NewMockEmployeeRepository(ctrl),
+		mock.NewMockAttendanceRepository(ctrl),
+		mock.NewMockAttendancePeriodRepository(ctrl),
+		mock.NewMockOvertimeRepository(ctrl),
+		mock.NewMockReimbursementRepository(ctrl),
+		mock.NewMockUserRepository(ctrl),
+		mock.NewMockDepartmentRepository(ctrl),
+		mock.NewMockLocationRepository(ctrl),
+		mock.NewMockExchangeRateRepository(ctrl),
+		mock.NewMockPayrollExchangeRateRepository(ctrl),
+		mock.NewMockPayrollRoundingRepository(ctrl),
+		mockPayslipTraceRepo,
+		nil,
+		internal.PayrollConfig{},
+	)
+
+	payslip := &entity.Payslip{Base: entity.Base{ID: 7}, PayrollID: 1, EmployeeID: 3, TotalTakeHome: 100}
+	result := int64(100)
+	trace := &entity.PayslipTrace{
+		PayslipID: 7,
+		Calculation: entity.PayslipCalculation{
+			Currency:           "USD",
+			BaseSalary:         3000,
+			WorkingDays:        23,
+			Reimbursements:     []entity.TracedReimbursement{{ID: 1, Amount: 135, Currency: "SGD"}},
+			HourlyRate:         "17.0454545455",
+			OvertimeMultiplier: 2,
+			ExchangeRates:      []entity.TracedExchangeRate{{FromCurrency: "SGD", ToCurrency: "USD", Rate: "0.7407407407"}},
+			Steps: []entity.PayslipCalculationStep{
+				{Name: "hourly_rate", Formula: "3000 base salary / (22 days × 8 hours)", Exact: "17.0454545455"},
+				{Name: "converted_reimbursements", Formula: "135 SGD × 0.7407407407", Exact: "99.9999999945", RoundingMode: "half_up", Result: &result},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "payslip with a recorded calculation",
+			setupMock: func() {
+				mockPayslipRepo.EXPECT().
+					FindByID(gomock.Any(), uint(7), nil).
+					Return(payslip, nil)
+				mockPayslipTraceRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.PayslipTrace{PayslipID: 7}, nil).
+					Return(trace, nil)
+			},
+		},
+		{
+			name: "payslip calculated before traces were recorded",
+			setupMock: func() {
+				mockPayslipRepo.EXPECT().
+					FindByID(gomock.Any(), uint(7), nil).
+					Return(payslip, nil)
+				mockPayslipTraceRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.PayslipTrace{PayslipID: 7}, nil).
+					Return(nil, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "payslip not found",
+			setupMock: func() {
+				mockPayslipRepo.EXPECT().
+					FindByID(gomock.Any(), uint(7), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			explanation, err := usecase.ExplainPayslip(context.Background(), 7)
+
+			if tt.expectedStatus != 0 {
+				var httpErr interface{ HTTPStatus() int }
+				if !errors.As(err, &httpErr) || httpErr.HTTPStatus() != tt.expectedStatus {
+					t.Errorf("Expected status %d but got: %v", tt.expectedStatus, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if explanation.PayrollId != 1 || explanation.EmployeeId != 3 || explanation.TotalTakeHome != 100 || explanation.Currency != "USD" {
+				t.Errorf("Unexpected explanation: %+v", explanation)
+			}
+			if explanation.AttendanceIds == nil || len(explanation.Overtimes) != 0 || len(explanation.Reimbursements) != 1 || len(explanation.ExchangeRates) != 1 {
+				t.Errorf("Unexpected traced records: %+v", explanation)
+			}
+			if len(explanation.Steps) != 2 || explanation.Steps[0].RoundingMode != nil || *explanation.Steps[1].RoundingMode != v1.HalfUp || *explanation.Steps[1].Result != 100 {
+				t.Errorf("Unexpected steps: %+v", explanation.Steps)
+			}
+		})
+	}
+}
+
+func TestPayrollUsecase_ComparePayrolls(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+	mockPayslipRepo := mock.NewMockPayslipRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+	mockLocationRepo := mock.NewMockLocationRepository(ctrl)
+
+	usecase := payroll.NewUsecase(
+		mockPayrollRepo,
+		mockPayslipRepo,
+		mockEmployeeRepo,
+		mockAttendanceRepo,
+		mockAttendancePeriodRepo,
+		mockOvertimeRepo,
+		mockReimbursementRepo,
+		mock.NewMockUserRepository(ctrl),
+		mock.NewMockDepartmentRepository(ctrl),
+		mockLocationRepo,
+		mock.NewMockExchangeRateRepository(ctrl),
+		mock.NewMockPayrollExchangeRateRepository(ctrl),
+		mock.NewMockPayrollRoundingRepository(ctrl),
+		mock.NewMockPayslipTraceRepository(ctrl),
+		nil,
+		internal.PayrollConfig{
+			ReportingCurrency: "IDR",
+			Comparison:        internal.ComparisonConfig{VariancePercent: 10, VarianceAmount: 100000},
+		},
+	)
+
+	utcSchedule := entity.DefaultWorkSchedule()
+	utcSchedule.Zone = time.UTC
+
+	january := &entity.AttendancePeriod{
+		Base:      entity.Base{ID: 1},
+		StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+	february := &entity.AttendancePeriod{
+		Base:      entity.Base{ID: 2},
+		StartDate: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+	}
+	previousPayroll := &entity.Payroll{Base: entity.Base{ID: 1}, AttendancePeriodID: 1, TotalPayroll: 12000000, ReportingCurrency: "IDR"}
+	currentPayroll := &entity.Payroll{Base: entity.Base{ID: 2}, AttendancePeriodID: 2, TotalPayroll: 12500000, ReportingCurrency: "IDR"}
+
+	expectPayroll := func(payroll *entity.Payroll, period *entity.AttendancePeriod, payslips []entity.Payslip) {
+		mockAttendancePeriodRepo.EXPECT().
+			FindByID(gomock.Any(), uint(period.ID), nil).
+			Return(period, nil)
+		mockPayslipRepo.EXPECT().
+			FindByTemplate(gomock.Any(), &entity.Payslip{PayrollID: payroll.ID}, nil).
+			Return(payslips, nil)
+	}
+	previousPayslips := []entity.Payslip{
+		{EmployeeID: 2, BaseSalary: 3000000, ProratedSalary: 3000000, TotalTakeHome: 3000000, Currency: "IDR"},
+		{EmployeeID: 1, BaseSalary: 5000000, ProratedSalary: 4347826, TotalTakeHome: 4347826, Currency: "IDR"},
+		{EmployeeID: 4, BaseSalary: 3000, ProratedSalary: 3000, TotalTakeHome: 3000, Currency: "USD"},
+	}
+
+	tests := []struct {
+		name           string
+		params         v1.GetAdminPayrollComparisonParams
+		setupMock      func()
+		expectedStatus int
+		check          func(t *testing.T, comparison *v1.PayrollComparison)
+	}{
+		{
+			name:   "payroll compared with a given payroll",
+			params: v1.GetAdminPayrollComparisonParams{PayrollId: int64Ptr(2), PreviousPayrollId: int64Ptr(1)},
+			setupMock: func() {
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2), nil).
+					Return(currentPayroll, nil)
+				expectPayroll(currentPayroll, february, []entity.Payslip{
+					{EmployeeID: 3, BaseSalary: 4000000, ProratedSalary: 4000000, TotalTakeHome: 4000000, Currency: "IDR"},
+					{EmployeeID: 1, BaseSalary: 5000000, ProratedSalary: 4500000, OvertimeTotalPay: 113636, ReimbursementTotal: 50000, TotalTakeHome: 4663636, Currency: "IDR"},
+					{EmployeeID: 4, BaseSalary: 50000000, ProratedSalary: 50000000, TotalTakeHome: 50000000, Currency: "IDR"},
+				})
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(previousPayroll, nil)
+				expectPayroll(previousPayroll, january, previousPayslips)
+			},
+			check: func(t *testing.T, comparison *v1.PayrollComparison) {
+				if comparison.Current.Preview || *comparison.Current.PayrollId != 2 || *comparison.Previous.PayrollId != 1 || comparison.Thresholds.VariancePercent != 10 {
+					t.Errorf("Unexpected payrolls compared: %+v", comparison)
+				}
+				if len(comparison.Employees) != 2 {
+					t.Fatalf("Expected 2 employees in both payrolls but got %+v", comparison.Employees)
+				}
+
+				// Take home grew by 315810, which is above 100000 but under
+				// 10%; overtime and reimbursement were zero before
+				changed := comparison.Employees[0]
+				if changed.EmployeeId != 1 || changed.Delta == nil || changed.Delta.ProratedSalary != 152174 || changed.Delta.TakeHome != 315810 {
+					t.Errorf("Unexpected comparison of employee 1: %+v", changed)
+				}
+				expectedFlagged := []v1.EmployeeComparisonFlagged{v1.EmployeeComparisonFlaggedOvertime}
+				if !reflect.DeepEqual(changed.Flagged, expectedFlagged) {
+					t.Errorf("Expected %v to be flagged but got %v", expectedFlagged, changed.Flagged)
+				}
+
+				recurrency := comparison.Employees[1]
+				if recurrency.EmployeeId != 4 || !recurrency.CurrencyChanged || recurrency.Delta != nil || len(recurrency.Flagged) != 0 {
+					t.Errorf("Expected employee 4 to be paid in another currency but got %+v", recurrency)
+				}
+
+				if len(comparison.AddedEmployees) != 1 || comparison.AddedEmployees[0].EmployeeId != 3 {
+					t.Errorf("Expected employee 3 to be added but got %+v", comparison.AddedEmployees)
+				}
+				if len(comparison.RemovedEmployees) != 1 || comparison.RemovedEmployees[0].EmployeeId != 2 {
+					t.Errorf("Expected employee 2 to be removed but got %+v", comparison.RemovedEmployees)
+				}
+			},
+		},
+		{
+			name:   "preview compared with the previous payroll",
+			params: v1.GetAdminPayrollComparisonParams{AttendancePeriodId: int64Ptr(2)},
+			setupMock: func() {
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2), nil).
+					Return(february, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.Employee{}, nil).
+					Return([]entity.Employee{{Base: entity.Base{ID: 1}, UserID: 1, BaseSalary: 5000000}}, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					Return(utcSchedule, nil)
+				mockAttendanceRepo.EXPECT().
+					FindIDsInPeriod(gomock.Any(), int64(1), february.StartDate, february.EndDate, nil).
+					Return(attendanceIDs(10), nil)
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+				mockReimbursementRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+
+				mockPayrollRepo.EXPECT().
+					FindPrevious(gomock.Any(), february.StartDate, nil).
+					Return(previousPayroll, nil)
+				expectPayroll(previousPayroll, january, previousPayslips)
+			},
+			check: func(t *testing.T, comparison *v1.PayrollComparison) {
+				if !comparison.Current.Preview || comparison.Current.PayrollId != nil || comparison.Current.TotalPayroll != 2500000 {
+					t.Errorf("Expected a preview paying 2500000 but got %+v", comparison.Current)
+				}
+
+				// 10 of 20 working days in February halve the prorated salary
+				if len(comparison.Employees) != 1 || comparison.Employees[0].Delta.TakeHome != -1847826 {
+					t.Fatalf("Unexpected employees in both payrolls: %+v", comparison.Employees)
+				}
+				expectedFlagged := []v1.EmployeeComparisonFlagged{v1.EmployeeComparisonFlaggedProratedSalary, v1.EmployeeComparisonFlaggedTakeHome}
+				if !reflect.DeepEqual(comparison.Employees[0].Flagged, expectedFlagged) {
+					t.Errorf("Expected %v to be flagged but got %v", expectedFlagged, comparison.Employees[0].Flagged)
+				}
+				if len(comparison.AddedEmployees) != 0 || len(comparison.RemovedEmployees) != 2 {
+					t.Errorf("Expected employees 2 and 4 to be removed but got %+v", comparison.RemovedEmployees)
+				}
+			},
+		},
+		{
+			name:   "no previous payroll",
+			params: v1.GetAdminPayrollComparisonParams{PayrollId: int64Ptr(1)},
+			setupMock: func() {
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(previousPayroll, nil)
+				expectPayroll(previousPayroll, january, previousPayslips)
+				mockPayrollRepo.EXPECT().
+					FindPrevious(gomock.Any(), january.StartDate, nil).
+					Return(nil, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:   "previous payroll of a later period",
+			params: v1.GetAdminPayrollComparisonParams{PayrollId: int64Ptr(1), PreviousPayrollId: int64Ptr(2)},
+			setupMock: func() {
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(previousPayroll, nil)
+				expectPayroll(previousPayroll, january, previousPayslips)
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2), nil).
+					Return(currentPayroll, nil)
+				expectPayroll(currentPayroll, february, nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "previous payroll of an overlapping period",
+			params: v1.GetAdminPayrollComparisonParams{AttendancePeriodId: int64Ptr(3), PreviousPayrollId: int64Ptr(1)},
+			setupMock: func() {
+				// A period starting on the day January ends
+				mockAttendancePeriodRepo.EXPECT().
+					FindByID(gomock.Any(), uint(3), nil).
+					Return(&entity.AttendancePeriod{
+						Base:      entity.Base{ID: 3},
+						StartDate: january.EndDate,
+						EndDate:   time.Date(2025, 2, 27, 0, 0, 0, 0, time.UTC),
+					}, nil)
+				mockEmployeeRepo.EXPECT().
+					FindByTemplate(gomock.Any(), &entity.Employee{}, nil).
+					Return([]entity.Employee{{Base: entity.Base{ID: 1}, UserID: 1, BaseSalary: 5000000}}, nil)
+				mockLocationRepo.EXPECT().
+					ScheduleFor(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+					Return(utcSchedule, nil)
+				mockAttendanceRepo.EXPECT().
+					FindIDsInPeriod(gomock.Any(), int64(1), gomock.Any(), gomock.Any(), nil).
+					Return(attendanceIDs(10), nil)
+				mockOvertimeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+				mockReimbursementRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return(nil, nil)
+				mockPayrollRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(previousPayroll, nil)
+				expectPayroll(previousPayroll, january, previousPayslips)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "neither a payroll nor a period",
+			params:         v1.GetAdminPayrollComparisonParams{PreviousPayrollId: int64Ptr(1)},
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "payroll compared with itself",
+			params:         v1.GetAdminPayrollComparisonParams{PayrollId: int64Ptr(1), PreviousPayrollId: int64Ptr(1)},
+			setupMock:      func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			comparison, err := usecase.ComparePayrolls(context.Background(), tt.params)
+
+			if tt.expectedStatus != 0 {
+				var httpErr interface{ HTTPStatus() int }
+				if !errors.As(err, &httpErr) || httpErr.HTTPStatus() != tt.expectedStatus {
+					t.Errorf("Expected status %d but got: %v", tt.expectedStatus, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			tt.check(t, comparison)
+		})
+	}
+}
+
+// attendanceIDs returns the IDs of n attendance records.
+// newTestDB returns a database for the transaction a payroll run is stored
+// in. It runs no queries since the repositories are mocked.
+func newTestDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	conn, dbMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: conn, DriverName: "postgres"}), &gorm.Config{
+		Logger: gormlogger.Default.LogMode(gormlogger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to open gorm: %v", err)
+	}
+	return db, dbMock
+}
+
+func attendanceIDs(n int) []int64 {
+	ids := make([]int64, n)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+	return ids
+}
+
+func TestValidateRounding(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      internal.RoundingConfig
+		expectError bool
+	}{
+		{name: "modes left out", config: internal.RoundingConfig{}},
+		{name: "every mode set", config: internal.RoundingConfig{ProratedSalary: "half_up", Overtime: "Bankers", Conversion: "truncate"}},
+		{name: "unknown mode", config: internal.RoundingConfig{Overtime: "ceiling"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := payroll.ValidateRounding(tt.config)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
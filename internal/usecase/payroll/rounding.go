@@ -0,0 +1,127 @@
+package payroll
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/asyauqi15/payslip-system/internal"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/money"
+)
+
+type roundingKey struct {
+	calculation string
+	currency    money.Currency
+}
+
+// roundings rounds the amounts a payroll calculates to whole units, with the
+// mode configured for each calculation, and adds up what the rounding changed
+// so it can be recorded with the payroll.
+type roundings struct {
+	modes       map[string]money.Rounding
+	differences map[roundingKey]money.Exact
+	order       []roundingKey
+}
+
+// ValidateRounding checks the rounding modes in the configuration, so the
+// server refuses to start with an invalid one instead of failing every
+// payroll run.
+func ValidateRounding(config internal.RoundingConfig) error {
+	_, err := roundingModes(config)
+	return err
+}
+
+// newRoundings reads the rounding mode of each calculation from the
+// configuration.
+func (u *UsecaseImpl) newRoundings(ctx context.Context) (*roundings, error) {
+	modes, err := roundingModes(u.config.Rounding)
+	if err != nil {
+		logger.Error(ctx, "invalid rounding mode", "error", err)
+		return nil, httppkg.NewInternalServerError("invalid rounding mode")
+	}
+
+	return &roundings{
+		modes:       modes,
+		differences: make(map[roundingKey]money.Exact),
+	}, nil
+}
+
+// roundingModes returns the rounding mode of each calculation. Calculations
+// left out of the configuration round half up.
+func roundingModes(config internal.RoundingConfig) (map[string]money.Rounding, error) {
+	modes := make(map[string]money.Rounding)
+	for _, calculation := range []struct {
+		name string
+		mode string
+	}{
+		{entity.RoundingProratedSalary, config.ProratedSalary},
+		{entity.RoundingOvertime, config.Overtime},
+		{entity.RoundingConversion, config.Conversion},
+	} {
+		if calculation.mode == "" {
+			modes[calculation.name] = money.RoundHalfUp
+			continue
+		}
+		mode, err := money.ParseRounding(calculation.mode)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", calculation.name, err)
+		}
+		modes[calculation.name] = mode
+	}
+
+	return modes, nil
+}
+
+// round rounds the result of a calculation and keeps the difference.
+func (r *roundings) round(calculation string, exact money.Exact) (money.Money, error) {
+	rounded, err := exact.Round(r.modes[calculation])
+	if err != nil {
+		return money.Money{}, fmt.Errorf("failed to round %s: %w", calculation, err)
+	}
+
+	difference, err := rounded.Exact().Sub(exact)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	key := roundingKey{calculation: calculation, currency: exact.Currency()}
+	total, ok := r.differences[key]
+	if !ok {
+		r.order = append(r.order, key)
+		total = money.Zero(key.currency).Exact()
+	}
+	if r.differences[key], err = total.Add(difference); err != nil {
+		return money.Money{}, err
+	}
+
+	return rounded, nil
+}
+
+// records returns the differences to store, in the order the calculations
+// first rounded.
+func (r *roundings) records() []entity.PayrollRounding {
+	records := make([]entity.PayrollRounding, 0, len(r.order))
+	for _, key := range r.order {
+		records = append(records, entity.PayrollRounding{
+			Calculation:  key.calculation,
+			Currency:     string(key.currency),
+			RoundingMode: string(r.modes[key.calculation]),
+			Difference:   r.differences[key].Decimal(),
+		})
+	}
+	return records
+}
+
+// conversionRounding returns the mode a payroll converted to its reporting
+// currency with. Payrolls that converted nothing, or ran before rounding was
+// recorded, converted halves away from zero.
+func conversionRounding(records []entity.PayrollRounding, reportingCurrency money.Currency) money.Rounding {
+	for _, record := range records {
+		if record.Calculation == entity.RoundingConversion && record.Currency == string(reportingCurrency) {
+			return money.Rounding(record.RoundingMode)
+		}
+	}
+	return money.RoundHalfUp
+}
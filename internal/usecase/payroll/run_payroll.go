@@ -2,20 +2,25 @@ package payroll
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
 	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
 	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/money"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 	"gorm.io/gorm"
 )
 
+// PayrollTotals are the totals of a payroll in its reporting currency.
 type PayrollTotals struct {
-	TotalPayroll       int64
-	TotalReimbursement int64
-	TotalOvertime      int64
+	TotalPayroll       money.Money
+	TotalReimbursement money.Money
+	TotalOvertime      money.Money
 }
 
 func (u *UsecaseImpl) RunPayroll(ctx context.Context, req v1.PostAdminPayrollsJSONRequestBody) error {
@@ -28,165 +33,300 @@ func (u *UsecaseImpl) RunPayroll(ctx context.Context, req v1.PostAdminPayrollsJS
 		return err
 	}
 
-	employees, err := u.getEmployees(ctx)
+	// Every payslip is calculated before anything is stored, so a missing
+	// rate leaves no partial payroll behind.
+	calculated, err := u.calculatePayroll(ctx, attendancePeriod)
 	if err != nil {
 		return err
 	}
 
-	totalWorkingDays := u.calculateWorkingDays(attendancePeriod.StartDate, attendancePeriod.EndDate)
+	// The payroll is stored with its payslips, rates and roundings in one
+	// transaction, so a failed insert stores none of them.
+	var createdPayroll *entity.Payroll
+	err = u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		createdPayroll, err = u.createPayrollRecord(ctx, int64(req.AttendancePeriodId), len(calculated.payslips), calculated.totals, tx)
+		if err != nil {
+			return err
+		}
 
-	createdPayroll, err := u.createPayrollRecord(ctx, int64(req.AttendancePeriodId), len(employees))
-	if err != nil {
-		return err
-	}
+		if err := u.savePayslips(ctx, createdPayroll.ID, calculated.payslips, tx); err != nil {
+			return err
+		}
 
-	payrollTotals, err := u.processAllEmployeePayslips(ctx, employees, createdPayroll.ID, attendancePeriod, totalWorkingDays)
-	if err != nil {
-		return err
-	}
+		if err := u.recordExchangeRates(ctx, createdPayroll.ID, calculated.rates, tx); err != nil {
+			return err
+		}
 
-	if err := u.updatePayrollTotals(ctx, createdPayroll, payrollTotals); err != nil {
-		return err
+		return u.recordRoundings(ctx, createdPayroll.ID, calculated.roundings, tx)
+	})
+	if err != nil {
+		var httpErr *httppkg.ErrorWrapper
+		if errors.As(err, &httpErr) {
+			return httpErr
+		}
+		logger.Error(ctx, "failed to store payroll", "attendance_period_id", req.AttendancePeriodId, "error", err)
+		return httppkg.NewInternalServerError("failed to store payroll")
 	}
 
-	u.logPayrollSuccess(ctx, createdPayroll.ID, int64(req.AttendancePeriodId), len(employees), payrollTotals.TotalPayroll)
+	u.logPayrollSuccess(ctx, createdPayroll.ID, int64(req.AttendancePeriodId), len(calculated.payslips), calculated.totals.TotalPayroll)
 	return nil
 }
 
-func (u *UsecaseImpl) processEmployeePayslip(ctx context.Context, employee entity.Employee, payrollID int64, attendancePeriod *entity.AttendancePeriod, totalWorkingDays int) (*entity.Payslip, error) {
-	// Count employee attendance
-	attendanceCount, err := u.countEmployeeAttendance(ctx, employee.ID, attendancePeriod.StartDate, attendancePeriod.EndDate)
+// calculatedPayroll is a payroll calculated for an attendance period, with
+// the rates and rounding it used, before any of it is stored.
+type calculatedPayroll struct {
+	payslips  []calculatedPayslip
+	totals    *PayrollTotals
+	rates     *exchangeRates
+	roundings *roundings
+}
+
+// calculatePayroll calculates the payslip of every employee for an attendance
+// period without storing anything.
+func (u *UsecaseImpl) calculatePayroll(ctx context.Context, attendancePeriod *entity.AttendancePeriod) (*calculatedPayroll, error) {
+	reportingCurrency, err := u.reportingCurrency(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count attendance for employee %d: %w", employee.ID, err)
+		return nil, err
 	}
 
-	// Calculate prorated salary based on attendance
-	proratedSalary := u.calculateProratedSalary(employee.BaseSalary, attendanceCount, totalWorkingDays)
+	roundings, err := u.newRoundings(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	// Calculate overtime pay
-	overtimeHours, overtimePay, err := u.calculateOvertimePay(ctx, employee.ID, employee.BaseSalary, attendancePeriod.StartDate, attendancePeriod.EndDate)
+	employees, err := u.getEmployees(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to calculate overtime for employee %d: %w", employee.ID, err)
+		return nil, err
 	}
 
-	// Calculate reimbursement total
-	reimbursementTotal, err := u.calculateReimbursementTotal(ctx, employee.ID, attendancePeriod.StartDate, attendancePeriod.EndDate)
+	// Amounts are converted at the rates effective on the last day of the
+	// period.
+	rates := u.newExchangeRates(attendancePeriod.EndDate)
+
+	payslips, totals, err := u.processAllEmployeePayslips(ctx, employees, attendancePeriod, rates, roundings, reportingCurrency)
 	if err != nil {
-		return nil, fmt.Errorf("failed to calculate reimbursement for employee %d: %w", employee.ID, err)
+		return nil, err
 	}
 
-	// Calculate total take home
-	totalTakeHome := proratedSalary + overtimePay + reimbursementTotal
+	return &calculatedPayroll{
+		payslips:  payslips,
+		totals:    totals,
+		rates:     rates,
+		roundings: roundings,
+	}, nil
+}
 
-	// Create payslip
-	payslip := &entity.Payslip{
-		EmployeeID:         employee.ID,
-		PayrollID:          payrollID,
+// processEmployeePayslip calculates the payslip of an employee in the
+// currency of their base salary, tracing what it was calculated from and each
+// step. It is stored once every payslip of the payroll has been calculated.
+// Each amount is calculated exactly and rounded once, so the take home pay
+// adds up from the amounts on the payslip.
+func (u *UsecaseImpl) processEmployeePayslip(ctx context.Context, employee entity.Employee, attendancePeriod *entity.AttendancePeriod, rates *exchangeRates, roundings *roundings) (*calculatedPayslip, error) {
+	currency := currencyOf(employee.Currency)
+	baseSalary := money.New(employee.BaseSalary, currency)
+	trace := &entity.PayslipCalculation{
+		Currency:           string(currency),
 		BaseSalary:         employee.BaseSalary,
-		AttendanceCount:    attendanceCount,
-		TotalWorkingDays:   totalWorkingDays,
-		ProratedSalary:     proratedSalary,
-		OvertimeTotalHours: overtimeHours,
-		OvertimeTotalPay:   overtimePay,
-		ReimbursementTotal: reimbursementTotal,
-		TotalTakeHome:      totalTakeHome,
+		AttendanceIDs:      []int64{},
+		Overtimes:          []entity.TracedOvertime{},
+		Reimbursements:     []entity.TracedReimbursement{},
+		OvertimeMultiplier: overtimeMultiplier,
+		ExchangeRates:      []entity.TracedExchangeRate{},
 	}
 
-	createdPayslip, err := u.payslipRepo.Create(ctx, payslip, nil)
+	// Working days and overtime days follow the employee's location. The day
+	// before the period is loaded for night shifts that end inside it.
+	schedule, err := u.locationRepo.ScheduleFor(ctx, &employee, repository.DaysBetween(attendancePeriod.StartDate.AddDate(0, 0, -1), attendancePeriod.EndDate), nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create payslip for employee %d: %w", employee.ID, err)
+		return nil, fmt.Errorf("failed to find work schedule for employee %d: %w", employee.ID, err)
 	}
 
-	return createdPayslip, nil
-}
+	totalWorkingDays := schedule.WorkingDays(attendancePeriod.StartDate, attendancePeriod.EndDate)
+	trace.WorkingDays = totalWorkingDays
 
-func (u *UsecaseImpl) calculateWorkingDays(startDate, endDate time.Time) int {
-	count := 0
-	current := startDate
+	// Find the attendance that counts towards the salary
+	attendanceCount, err := u.countEmployeeAttendance(ctx, employee.ID, attendancePeriod.StartDate, attendancePeriod.EndDate, trace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count attendance for employee %d: %w", employee.ID, err)
+	}
 
-	for current.Before(endDate) || current.Equal(endDate) {
-		// Only count weekdays (Monday to Friday)
-		if current.Weekday() >= time.Monday && current.Weekday() <= time.Friday {
-			count++
-		}
-		current = current.AddDate(0, 0, 1)
+	// Calculate prorated salary based on attendance
+	proratedSalary, err := roundedStep(trace, roundings, "prorated_salary", entity.RoundingProratedSalary,
+		fmt.Sprintf("%d base salary × %d attendance days / %d working days", employee.BaseSalary, attendanceCount, totalWorkingDays),
+		u.calculateProratedSalary(baseSalary, attendanceCount, totalWorkingDays))
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate prorated salary for employee %d: %w", employee.ID, err)
+	}
+
+	// Calculate overtime pay
+	overtimeHours, overtimeAmount, err := u.calculateOvertimePay(ctx, employee.ID, baseSalary, schedule, attendancePeriod.StartDate, attendancePeriod.EndDate, trace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate overtime for employee %d: %w", employee.ID, err)
+	}
+	overtimePay, err := roundedStep(trace, roundings, "overtime_pay", entity.RoundingOvertime,
+		fmt.Sprintf("%s hourly rate × %d hours × %d", trace.HourlyRate, overtimeHours, overtimeMultiplier),
+		overtimeAmount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate overtime for employee %d: %w", employee.ID, err)
 	}
 
-	return count
+	// Calculate reimbursement total, converted to the payslip currency
+	reimbursementTotal, err := u.calculateReimbursementTotal(ctx, employee.ID, currency, attendancePeriod.StartDate, attendancePeriod.EndDate, rates, roundings, trace)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate total take home
+	totalTakeHome, err := money.Sum(currency, proratedSalary, overtimePay, reimbursementTotal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate take home pay for employee %d: %w", employee.ID, err)
+	}
+	amountStep(trace, "total_take_home", totalTakeHome, proratedSalary, overtimePay, reimbursementTotal)
+
+	return &calculatedPayslip{
+		payslip: entity.Payslip{
+			EmployeeID:         employee.ID,
+			BaseSalary:         employee.BaseSalary,
+			AttendanceCount:    attendanceCount,
+			TotalWorkingDays:   totalWorkingDays,
+			ProratedSalary:     proratedSalary.Amount(),
+			OvertimeTotalHours: overtimeHours,
+			OvertimeTotalPay:   overtimePay.Amount(),
+			ReimbursementTotal: reimbursementTotal.Amount(),
+			TotalTakeHome:      totalTakeHome.Amount(),
+			Currency:           string(currency),
+			DepartmentID:       employee.DepartmentID,
+		},
+		trace: *trace,
+	}, nil
 }
 
-func (u *UsecaseImpl) countEmployeeAttendance(ctx context.Context, employeeID int64, startDate, endDate time.Time) (int, error) {
-	// Use repository's count method for efficient counting
-	count, err := u.attendanceRepo.CountAttendanceInPeriod(ctx, employeeID, startDate, endDate, nil)
+func (u *UsecaseImpl) countEmployeeAttendance(ctx context.Context, employeeID int64, startDate, endDate time.Time, trace *entity.PayslipCalculation) (int, error) {
+	ids, err := u.attendanceRepo.FindIDsInPeriod(ctx, employeeID, startDate, endDate, nil)
 	if err != nil {
 		return 0, err
 	}
 
-	return int(count), nil
+	trace.AttendanceIDs = append(trace.AttendanceIDs, ids...)
+	return len(ids), nil
 }
 
-func (u *UsecaseImpl) calculateProratedSalary(baseSalary int64, attendanceCount, totalWorkingDays int) int64 {
+func (u *UsecaseImpl) calculateProratedSalary(baseSalary money.Money, attendanceCount, totalWorkingDays int) money.Exact {
 	if totalWorkingDays == 0 {
-		return 0
+		return money.Zero(baseSalary.Currency()).Exact()
 	}
-	return baseSalary * int64(attendanceCount) / int64(totalWorkingDays)
+	return baseSalary.Exact().Mul(int64(attendanceCount)).Div(int64(totalWorkingDays))
 }
 
-func (u *UsecaseImpl) calculateOvertimePay(ctx context.Context, employeeID, baseSalary int64, startDate, endDate time.Time) (int, int64, error) {
-	// Get all overtime records for the employee in the period
-	overtimes, err := u.overtimeRepo.FindByTemplate(ctx, &entity.Overtime{
-		EmployeeID: employeeID,
-	}, nil)
+func (u *UsecaseImpl) calculateOvertimePay(ctx context.Context, employeeID int64, baseSalary money.Money, schedule *entity.WorkSchedule, startDate, endDate time.Time, trace *entity.PayslipCalculation) (int, money.Exact, error) {
+	// Get the approved overtime records for the employee whose work day is in
+	// the period, with days counted in the schedule's zone. Overtime after a
+	// night shift starts on the day after its work day, so one more day is
+	// fetched and filtered below.
+	first := schedule.Midnight(startDate)
+	last := schedule.Midnight(endDate)
+	query := repository.NewQuery().
+		Where("employee_id", repository.OpEqual, employeeID).
+		Where("status", repository.OpEqual, entity.ApprovalStatusApproved).
+		WhereDateRange("start_at", repository.DaysBetween(first, last.AddDate(0, 0, 1)))
+	overtimes, err := u.overtimeRepo.Find(ctx, query, nil)
 	if err != nil {
-		return 0, 0, err
+		return 0, money.Exact{}, err
 	}
 
 	totalHours := 0
-	totalPay := int64(0)
-
-	// Calculate hourly rate (assuming 8 hours per day, 22 working days per month)
-	hourlyRate := baseSalary / (22 * 8)
 
 	for _, overtime := range overtimes {
-		// Check if overtime is within the period
-		if overtime.StartAt.After(startDate.AddDate(0, 0, -1)) && overtime.StartAt.Before(endDate.AddDate(0, 0, 1)) {
-			duration := overtime.EndAt.Sub(overtime.StartAt)
-			hours := int(duration.Hours())
+		workDay := schedule.WorkDay(overtime.StartAt)
+		if workDay.Before(first) || workDay.After(last) {
+			continue
+		}
 
-			// Overtime is paid twice the hourly rate
-			overtimePay := int64(hours) * hourlyRate * 2
+		duration := overtime.EndAt.Sub(overtime.StartAt)
+		hours := int(duration.Hours())
 
-			totalHours += hours
-			totalPay += overtimePay
-		}
+		totalHours += hours
+		trace.Overtimes = append(trace.Overtimes, entity.TracedOvertime{ID: overtime.ID, Hours: hours})
 	}
 
+	// The hourly rate is not rounded on its own
+	hourlyRate := baseSalary.Exact().Div(workingDaysPerMonth * hoursPerWorkingDay)
+	trace.HourlyRate = hourlyRate.Decimal()
+	step(trace, "hourly_rate", fmt.Sprintf("%d base salary / (%d days × %d hours)", baseSalary.Amount(), workingDaysPerMonth, hoursPerWorkingDay), hourlyRate)
+
+	totalPay := hourlyRate.Mul(int64(totalHours)).Mul(overtimeMultiplier)
+
 	return totalHours, totalPay, nil
 }
 
-func (u *UsecaseImpl) calculateReimbursementTotal(ctx context.Context, employeeID int64, startDate, endDate time.Time) (int64, error) {
-	// Get all reimbursement records for the employee in the period
-	reimbursements, err := u.reimbursementRepo.FindByTemplate(ctx, &entity.Reimbursement{
-		EmployeeID: employeeID,
-	}, nil)
+// calculateReimbursementTotal adds up the approved reimbursements of an
+// employee in the payslip currency. Those in other currencies are converted
+// together and rounded once.
+func (u *UsecaseImpl) calculateReimbursementTotal(ctx context.Context, employeeID int64, currency money.Currency, startDate, endDate time.Time, rates *exchangeRates, roundings *roundings, trace *entity.PayslipCalculation) (money.Money, error) {
+	// Get the approved reimbursement records for the employee dated in the period
+	query := repository.NewQuery().
+		Where("employee_id", repository.OpEqual, employeeID).
+		Where("status", repository.OpEqual, entity.ApprovalStatusApproved).
+		WhereDateRange("date", repository.DaysBetween(startDate, endDate))
+	reimbursements, err := u.reimbursementRepo.Find(ctx, query, nil)
 	if err != nil {
-		return 0, err
+		return money.Money{}, fmt.Errorf("failed to calculate reimbursement for employee %d: %w", employeeID, err)
 	}
 
-	total := int64(0)
+	total := money.Zero(currency)
+	converted := money.Zero(currency).Exact()
+	var parts []money.Money
+	var conversions []string
 	for _, reimbursement := range reimbursements {
-		// Check if reimbursement is within the period
-		if reimbursement.Date.After(startDate.AddDate(0, 0, -1)) && reimbursement.Date.Before(endDate.AddDate(0, 0, 1)) {
-			total += reimbursement.Amount
+		amount := money.New(reimbursement.Amount, currencyOf(reimbursement.Currency))
+		trace.Reimbursements = append(trace.Reimbursements, entity.TracedReimbursement{
+			ID:       reimbursement.ID,
+			Amount:   amount.Amount(),
+			Currency: string(amount.Currency()),
+		})
+
+		if amount.Currency() == currency {
+			if total, err = total.Add(amount); err != nil {
+				return money.Money{}, fmt.Errorf("failed to calculate reimbursement for employee %d: %w", employeeID, err)
+			}
+			parts = append(parts, amount)
+			continue
+		}
+
+		rate, err := rates.rate(ctx, amount.Currency(), currency)
+		if err != nil {
+			return money.Money{}, err
+		}
+		traceRate(trace, rate)
+		exact, err := rates.convert(ctx, amount, currency)
+		if err != nil {
+			return money.Money{}, err
+		}
+		if converted, err = converted.Add(exact); err != nil {
+			return money.Money{}, fmt.Errorf("failed to calculate reimbursement for employee %d: %w", employeeID, err)
+		}
+		conversions = append(conversions, fmt.Sprintf("%s × %s", amount, rate))
+	}
+
+	if len(conversions) > 0 {
+		rounded, err := roundedStep(trace, roundings, "converted_reimbursements", entity.RoundingConversion, strings.Join(conversions, " + "), converted)
+		if err != nil {
+			return money.Money{}, fmt.Errorf("failed to calculate reimbursement for employee %d: %w", employeeID, err)
 		}
+		if total, err = total.Add(rounded); err != nil {
+			return money.Money{}, fmt.Errorf("failed to calculate reimbursement for employee %d: %w", employeeID, err)
+		}
+		parts = append(parts, rounded)
 	}
+	amountStep(trace, "reimbursement_total", total, parts...)
 
 	return total, nil
 }
 
 func (u *UsecaseImpl) validateAndGetAttendancePeriod(ctx context.Context, attendancePeriodID int64) (*entity.AttendancePeriod, error) {
 	attendancePeriod, err := u.attendancePeriodRepo.FindByID(ctx, uint(attendancePeriodID), nil)
-	if err != nil {
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		logger.Error(ctx, "failed to find attendance period", "id", attendancePeriodID, "error", err)
 		return nil, httppkg.NewInternalServerError("failed to find attendance period")
 	}
@@ -224,16 +364,32 @@ func (u *UsecaseImpl) getEmployees(ctx context.Context) ([]entity.Employee, erro
 	return employees, nil
 }
 
-func (u *UsecaseImpl) createPayrollRecord(ctx context.Context, attendancePeriodID int64, employeeCount int) (*entity.Payroll, error) {
+// reportingCurrency returns the currency payroll totals are converted to.
+func (u *UsecaseImpl) reportingCurrency(ctx context.Context) (money.Currency, error) {
+	if u.config.ReportingCurrency == "" {
+		return entity.DefaultCurrency, nil
+	}
+
+	currency, err := money.ParseCurrency(u.config.ReportingCurrency)
+	if err != nil {
+		logger.Error(ctx, "invalid reporting currency", "reporting_currency", u.config.ReportingCurrency, "error", err)
+		return "", httppkg.NewInternalServerError("invalid reporting currency")
+	}
+	return currency, nil
+}
+
+func (u *UsecaseImpl) createPayrollRecord(ctx context.Context, attendancePeriodID int64, employeeCount int, totals *PayrollTotals, tx *gorm.DB) (*entity.Payroll, error) {
 	payroll := &entity.Payroll{
 		AttendancePeriodID: attendancePeriodID,
 		TotalEmployees:     int64(employeeCount),
-		TotalReimbursement: 0,
-		TotalOvertime:      0,
-		TotalPayroll:       0,
+		TotalReimbursement: totals.TotalReimbursement.Amount(),
+		TotalOvertime:      totals.TotalOvertime.Amount(),
+		TotalPayroll:       totals.TotalPayroll.Amount(),
+		ReportingCurrency:  string(totals.TotalPayroll.Currency()),
+		Status:             entity.PayrollStatusDraft,
 	}
 
-	createdPayroll, err := u.payrollRepo.Create(ctx, payroll, nil)
+	createdPayroll, err := u.payrollRepo.Create(ctx, payroll, tx)
 	if err != nil {
 		logger.Error(ctx, "failed to create payroll", "error", err)
 		return nil, httppkg.NewInternalServerError("failed to create payroll")
@@ -242,44 +398,136 @@ func (u *UsecaseImpl) createPayrollRecord(ctx context.Context, attendancePeriodI
 	return createdPayroll, nil
 }
 
-func (u *UsecaseImpl) processAllEmployeePayslips(ctx context.Context, employees []entity.Employee, payrollID int64, attendancePeriod *entity.AttendancePeriod, totalWorkingDays int) (*PayrollTotals, error) {
-	totals := &PayrollTotals{}
+// processAllEmployeePayslips calculates the payslip of every employee and
+// adds them up in the reporting currency. Each payslip is converted on its
+// own, so department subtotals add up to the payroll totals.
+func (u *UsecaseImpl) processAllEmployeePayslips(ctx context.Context, employees []entity.Employee, attendancePeriod *entity.AttendancePeriod, rates *exchangeRates, roundings *roundings, reportingCurrency money.Currency) ([]calculatedPayslip, *PayrollTotals, error) {
+	payslips := make([]calculatedPayslip, 0, len(employees))
+	totals := &PayrollTotals{
+		TotalPayroll:       money.Zero(reportingCurrency),
+		TotalReimbursement: money.Zero(reportingCurrency),
+		TotalOvertime:      money.Zero(reportingCurrency),
+	}
 
 	for _, employee := range employees {
-		payslip, err := u.processEmployeePayslip(ctx, employee, payrollID, attendancePeriod, totalWorkingDays)
+		payslip, err := u.processEmployeePayslip(ctx, employee, attendancePeriod, rates, roundings)
 		if err != nil {
 			logger.Error(ctx, "failed to process employee payslip", "employee_id", employee.ID, "error", err)
-			return nil, fmt.Errorf("failed to process employee %d payslip: %w", employee.ID, err)
+			var httpErr *httppkg.ErrorWrapper
+			if errors.As(err, &httpErr) {
+				return nil, nil, httpErr
+			}
+			return nil, nil, fmt.Errorf("failed to process employee %d payslip: %w", employee.ID, err)
+		}
+
+		if err := u.addToTotals(ctx, totals, &payslip.payslip, rates, roundings); err != nil {
+			return nil, nil, err
+		}
+		payslips = append(payslips, *payslip)
+	}
+
+	return payslips, totals, nil
+}
+
+func (u *UsecaseImpl) addToTotals(ctx context.Context, totals *PayrollTotals, payslip *entity.Payslip, rates *exchangeRates, roundings *roundings) error {
+	currency := currencyOf(payslip.Currency)
+	to := totals.TotalPayroll.Currency()
+
+	for _, item := range []struct {
+		total  *money.Money
+		amount int64
+	}{
+		{&totals.TotalPayroll, payslip.TotalTakeHome},
+		{&totals.TotalReimbursement, payslip.ReimbursementTotal},
+		{&totals.TotalOvertime, payslip.OvertimeTotalPay},
+	} {
+		converted := money.New(item.amount, currency)
+		if currency != to {
+			exact, err := rates.convert(ctx, converted, to)
+			if err != nil {
+				return err
+			}
+			if converted, err = roundings.round(entity.RoundingConversion, exact); err != nil {
+				logger.Error(ctx, "failed to convert payslip", "employee_id", payslip.EmployeeID, "error", err)
+				return httppkg.NewInternalServerError("failed to convert payslip to the reporting currency")
+			}
 		}
 
-		totals.TotalPayroll += payslip.TotalTakeHome
-		totals.TotalReimbursement += payslip.ReimbursementTotal
-		totals.TotalOvertime += payslip.OvertimeTotalPay
+		var err error
+		if *item.total, err = item.total.Add(converted); err != nil {
+			logger.Error(ctx, "failed to add up payroll totals", "employee_id", payslip.EmployeeID, "error", err)
+			return httppkg.NewInternalServerError("failed to add up payroll totals")
+		}
 	}
 
-	return totals, nil
+	return nil
 }
 
-func (u *UsecaseImpl) updatePayrollTotals(ctx context.Context, payroll *entity.Payroll, totals *PayrollTotals) error {
-	updatedPayroll := entity.Payroll{
-		TotalReimbursement: totals.TotalReimbursement,
-		TotalOvertime:      totals.TotalOvertime,
-		TotalPayroll:       totals.TotalPayroll,
+// savePayslips stores the payslips of a payroll, then the traces of how they
+// were calculated.
+func (u *UsecaseImpl) savePayslips(ctx context.Context, payrollID int64, payslips []calculatedPayslip, tx *gorm.DB) error {
+	traces := make([]entity.PayslipTrace, 0, len(payslips))
+	for i := range payslips {
+		payslip := &payslips[i].payslip
+		payslip.PayrollID = payrollID
+		created, err := u.payslipRepo.Create(ctx, payslip, tx)
+		if err != nil {
+			logger.Error(ctx, "failed to create payslip", "payroll_id", payrollID, "employee_id", payslip.EmployeeID, "error", err)
+			return httppkg.NewInternalServerError("failed to create payslip")
+		}
+		traces = append(traces, entity.PayslipTrace{PayslipID: created.ID, Calculation: payslips[i].trace})
 	}
 
-	_, err := u.payrollRepo.Updates(ctx, payroll, updatedPayroll, nil)
-	if err != nil {
-		logger.Error(ctx, "failed to update payroll totals", "payroll_id", payroll.ID, "error", err)
-		return httppkg.NewInternalServerError("failed to update payroll totals")
+	if err := u.payslipTraceRepo.CreateAll(ctx, traces, tx); err != nil {
+		logger.Error(ctx, "failed to record payslip traces", "payroll_id", payrollID, "error", err)
+		return httppkg.NewInternalServerError("failed to record payslip traces")
+	}
+
+	return nil
+}
+
+// recordExchangeRates stores the rates the payroll converted with.
+func (u *UsecaseImpl) recordExchangeRates(ctx context.Context, payrollID int64, rates *exchangeRates, tx *gorm.DB) error {
+	if len(rates.used) == 0 {
+		return nil
+	}
+
+	for i := range rates.used {
+		rates.used[i].PayrollID = payrollID
+	}
+
+	if err := u.payrollExchangeRateRepo.CreateAll(ctx, rates.used, tx); err != nil {
+		logger.Error(ctx, "failed to record payroll exchange rates", "payroll_id", payrollID, "error", err)
+		return httppkg.NewInternalServerError("failed to record payroll exchange rates")
+	}
+
+	return nil
+}
+
+// recordRoundings stores what rounding changed in the payroll.
+func (u *UsecaseImpl) recordRoundings(ctx context.Context, payrollID int64, roundings *roundings, tx *gorm.DB) error {
+	records := roundings.records()
+	if len(records) == 0 {
+		return nil
+	}
+
+	for i := range records {
+		records[i].PayrollID = payrollID
+	}
+
+	if err := u.payrollRoundingRepo.CreateAll(ctx, records, tx); err != nil {
+		logger.Error(ctx, "failed to record payroll roundings", "payroll_id", payrollID, "error", err)
+		return httppkg.NewInternalServerError("failed to record payroll roundings")
 	}
 
 	return nil
 }
 
-func (u *UsecaseImpl) logPayrollSuccess(ctx context.Context, payrollID int64, attendancePeriodID int64, employeeCount int, totalPayout int64) {
+func (u *UsecaseImpl) logPayrollSuccess(ctx context.Context, payrollID int64, attendancePeriodID int64, employeeCount int, totalPayout money.Money) {
 	logger.Info(ctx, "payroll generated successfully",
 		"payroll_id", payrollID,
 		"attendance_period_id", attendancePeriodID,
 		"total_employees", employeeCount,
-		"total_payout", totalPayout)
+		"total_payout", totalPayout.Amount(),
+		"reporting_currency", totalPayout.Currency())
 }
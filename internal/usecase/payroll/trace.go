@@ -0,0 +1,85 @@
+package payroll
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/pkg/money"
+)
+
+// calculatedPayslip is a payslip together with the trace of how it was
+// calculated, which is stored with it.
+type calculatedPayslip struct {
+	payslip entity.Payslip
+	trace   entity.PayslipCalculation
+}
+
+// Overtime is paid at twice the hourly rate, which assumes 8 hours per day
+// and 22 working days per month.
+const (
+	overtimeMultiplier  = 2
+	workingDaysPerMonth = 22
+	hoursPerWorkingDay  = 8
+)
+
+// step records a step whose result stays exact.
+func step(trace *entity.PayslipCalculation, name, formula string, exact money.Exact) {
+	trace.Steps = append(trace.Steps, entity.PayslipCalculationStep{
+		Name:    name,
+		Formula: formula,
+		Exact:   exact.Decimal(),
+	})
+}
+
+// amountStep records a step that adds up whole amounts.
+func amountStep(trace *entity.PayslipCalculation, name string, result money.Money, amounts ...money.Money) {
+	terms := make([]string, 0, len(amounts))
+	for _, amount := range amounts {
+		terms = append(terms, fmt.Sprint(amount.Amount()))
+	}
+	formula := strings.Join(terms, " + ")
+	if formula == "" {
+		formula = "0"
+	}
+
+	value := result.Amount()
+	trace.Steps = append(trace.Steps, entity.PayslipCalculationStep{
+		Name:    name,
+		Formula: formula,
+		Exact:   result.Exact().Decimal(),
+		Result:  &value,
+	})
+}
+
+// roundedStep rounds the result of a calculation and records it as a step.
+func roundedStep(trace *entity.PayslipCalculation, roundings *roundings, name, calculation, formula string, exact money.Exact) (money.Money, error) {
+	rounded, err := roundings.round(calculation, exact)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	value := rounded.Amount()
+	trace.Steps = append(trace.Steps, entity.PayslipCalculationStep{
+		Name:         name,
+		Formula:      formula,
+		Exact:        exact.Decimal(),
+		RoundingMode: string(roundings.modes[calculation]),
+		Result:       &value,
+	})
+	return rounded, nil
+}
+
+// traceRate records a rate the payslip converted with, once per pair.
+func traceRate(trace *entity.PayslipCalculation, rate money.Rate) {
+	for _, traced := range trace.ExchangeRates {
+		if traced.FromCurrency == string(rate.From) && traced.ToCurrency == string(rate.To) {
+			return
+		}
+	}
+	trace.ExchangeRates = append(trace.ExchangeRates, entity.TracedExchangeRate{
+		FromCurrency: string(rate.From),
+		ToCurrency:   string(rate.To),
+		Rate:         rate.String(),
+	})
+}
@@ -3,25 +3,39 @@ package payroll
 import (
 	"context"
 
+	"github.com/asyauqi15/payslip-system/internal"
 	"github.com/asyauqi15/payslip-system/internal/repository"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"gorm.io/gorm"
 )
 
 //go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/payroll Usecase
 type Usecase interface {
 	RunPayroll(ctx context.Context, req v1.PostAdminPayrollsJSONRequestBody) error
-	GetPayrollSummary(ctx context.Context, payrollID int64) (*v1.AdminPayrollSummaryResponse, error)
+	GetPayrollSummary(ctx context.Context, payrollID int64, departmentID *int64) (*v1.AdminPayrollSummaryResponse, error)
+	FinalizePayroll(ctx context.Context, payrollID int64) error
+	DeletePayroll(ctx context.Context, payrollID int64) error
+	ExplainPayslip(ctx context.Context, payslipID int64) (*v1.PayslipExplanation, error)
+	ComparePayrolls(ctx context.Context, params v1.GetAdminPayrollComparisonParams) (*v1.PayrollComparison, error)
 }
 
 type UsecaseImpl struct {
-	payrollRepo          repository.PayrollRepository
-	payslipRepo          repository.PayslipRepository
-	employeeRepo         repository.EmployeeRepository
-	attendanceRepo       repository.AttendanceRepository
-	attendancePeriodRepo repository.AttendancePeriodRepository
-	overtimeRepo         repository.OvertimeRepository
-	reimbursementRepo    repository.ReimbursementRepository
-	userRepo             repository.UserRepository
+	payrollRepo             repository.PayrollRepository
+	payslipRepo             repository.PayslipRepository
+	employeeRepo            repository.EmployeeRepository
+	attendanceRepo          repository.AttendanceRepository
+	attendancePeriodRepo    repository.AttendancePeriodRepository
+	overtimeRepo            repository.OvertimeRepository
+	reimbursementRepo       repository.ReimbursementRepository
+	userRepo                repository.UserRepository
+	departmentRepo          repository.DepartmentRepository
+	locationRepo            repository.LocationRepository
+	exchangeRateRepo        repository.ExchangeRateRepository
+	payrollExchangeRateRepo repository.PayrollExchangeRateRepository
+	payrollRoundingRepo     repository.PayrollRoundingRepository
+	payslipTraceRepo        repository.PayslipTraceRepository
+	db                      *gorm.DB
+	config                  internal.PayrollConfig
 }
 
 func NewUsecase(
@@ -33,15 +47,31 @@ func NewUsecase(
 	overtimeRepo repository.OvertimeRepository,
 	reimbursementRepo repository.ReimbursementRepository,
 	userRepo repository.UserRepository,
+	departmentRepo repository.DepartmentRepository,
+	locationRepo repository.LocationRepository,
+	exchangeRateRepo repository.ExchangeRateRepository,
+	payrollExchangeRateRepo repository.PayrollExchangeRateRepository,
+	payrollRoundingRepo repository.PayrollRoundingRepository,
+	payslipTraceRepo repository.PayslipTraceRepository,
+	db *gorm.DB,
+	config internal.PayrollConfig,
 ) Usecase {
 	return &UsecaseImpl{
-		payrollRepo:          payrollRepo,
-		payslipRepo:          payslipRepo,
-		employeeRepo:         employeeRepo,
-		attendanceRepo:       attendanceRepo,
-		attendancePeriodRepo: attendancePeriodRepo,
-		overtimeRepo:         overtimeRepo,
-		reimbursementRepo:    reimbursementRepo,
-		userRepo:             userRepo,
+		payrollRepo:             payrollRepo,
+		payslipRepo:             payslipRepo,
+		employeeRepo:            employeeRepo,
+		attendanceRepo:          attendanceRepo,
+		attendancePeriodRepo:    attendancePeriodRepo,
+		overtimeRepo:            overtimeRepo,
+		reimbursementRepo:       reimbursementRepo,
+		userRepo:                userRepo,
+		departmentRepo:          departmentRepo,
+		locationRepo:            locationRepo,
+		exchangeRateRepo:        exchangeRateRepo,
+		payrollExchangeRateRepo: payrollExchangeRateRepo,
+		payrollRoundingRepo:     payrollRoundingRepo,
+		payslipTraceRepo:        payslipTraceRepo,
+		db:                      db,
+		config:                  config,
 	}
 }
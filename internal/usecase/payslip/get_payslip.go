@@ -6,6 +6,7 @@ import (
 
 	"github.com/asyauqi15/payslip-system/internal/constant"
 	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
 	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
 	"github.com/asyauqi15/payslip-system/pkg/logger"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
@@ -65,26 +66,26 @@ func (u *UsecaseImpl) GetPayslip(ctx context.Context, payrollID int64) (*v1.Pays
 		return nil, httppkg.NewNotFoundError("payslip not found for this payroll")
 	}
 
-	// Get reimbursements for this employee in the attendance period
-	reimbursements, err := u.reimbursementRepo.FindByTemplate(ctx, &entity.Reimbursement{
-		EmployeeID: employee.ID,
-	}, nil)
+	// Get approved reimbursements for this employee in the attendance period
+	query := repository.NewQuery().
+		Where("employee_id", repository.OpEqual, employee.ID).
+		Where("status", repository.OpEqual, entity.ApprovalStatusApproved).
+		WhereDateRange("date", repository.DaysBetween(attendancePeriod.StartDate, attendancePeriod.EndDate)).
+		OrderBy("date", false)
+	reimbursements, err := u.reimbursementRepo.Find(ctx, query, nil)
 	if err != nil {
 		logger.Error(ctx, "failed to find reimbursements", "employee_id", employee.ID, "error", err)
 		return nil, httppkg.NewInternalServerError("failed to find reimbursements")
 	}
 
-	// Filter reimbursements by date range
 	var reimbursementItems []v1.ReimbursementItem
 	for _, reimbursement := range reimbursements {
-		if reimbursement.Date.After(attendancePeriod.StartDate.AddDate(0, 0, -1)) &&
-			reimbursement.Date.Before(attendancePeriod.EndDate.AddDate(0, 0, 1)) {
-			reimbursementItems = append(reimbursementItems, v1.ReimbursementItem{
-				Date:        datePtr(reimbursement.Date),
-				Amount:      intPtr(int(reimbursement.Amount)),
-				Description: stringPtr(reimbursement.Description),
-			})
-		}
+		reimbursementItems = append(reimbursementItems, v1.ReimbursementItem{
+			Date:        datePtr(reimbursement.Date),
+			Amount:      intPtr(int(reimbursement.Amount)),
+			Currency:    stringPtr(reimbursement.Currency),
+			Description: stringPtr(reimbursement.Description),
+		})
 	}
 
 	// Build response
@@ -104,6 +105,7 @@ func (u *UsecaseImpl) GetPayslip(ctx context.Context, payrollID int64) (*v1.Pays
 		Reimbursements:      reimbursementItems,
 		ReimbursementsTotal: payslip.ReimbursementTotal,
 		TotalTakeHome:       payslip.TotalTakeHome,
+		Currency:            payslip.Currency,
 	}
 
 	return response, nil
@@ -114,7 +114,7 @@ func TestPayslipUsecase_GetPayslip(t *testing.T) {
 					},
 				}
 				mockReimbursementRepo.EXPECT().
-					FindByTemplate(gomock.Any(), gomock.Any(), nil).
+					Find(gomock.Any(), gomock.Any(), nil).
 					Return(reimbursements, nil)
 			},
 			expectError: false,
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	entity "github.com/asyauqi15/payslip-system/internal/entity"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -21,6 +22,7 @@ import (
 type MockUsecase struct {
 	ctrl     *gomock.Controller
 	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
 }
 
 // MockUsecaseMockRecorder is the mock recorder for MockUsecase.
@@ -40,16 +42,31 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// PrepareReimbursement mocks base method.
+func (m *MockUsecase) PrepareReimbursement(ctx context.Context, employee *entity.Employee, req v1.ReimbursementRequest) (*entity.Reimbursement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareReimbursement", ctx, employee, req)
+	ret0, _ := ret[0].(*entity.Reimbursement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareReimbursement indicates an expected call of PrepareReimbursement.
+func (mr *MockUsecaseMockRecorder) PrepareReimbursement(ctx, employee, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareReimbursement", reflect.TypeOf((*MockUsecase)(nil).PrepareReimbursement), ctx, employee, req)
+}
+
 // SubmitReimbursement mocks base method.
-func (m *MockUsecase) SubmitReimbursement(arg0 context.Context, arg1 v1.ReimbursementRequest) error {
+func (m *MockUsecase) SubmitReimbursement(ctx context.Context, req v1.ReimbursementRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubmitReimbursement", arg0, arg1)
+	ret := m.ctrl.Call(m, "SubmitReimbursement", ctx, req)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SubmitReimbursement indicates an expected call of SubmitReimbursement.
-func (mr *MockUsecaseMockRecorder) SubmitReimbursement(arg0, arg1 any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) SubmitReimbursement(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitReimbursement", reflect.TypeOf((*MockUsecase)(nil).SubmitReimbursement), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitReimbursement", reflect.TypeOf((*MockUsecase)(nil).SubmitReimbursement), ctx, req)
 }
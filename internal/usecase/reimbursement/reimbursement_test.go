@@ -192,6 +192,51 @@ func TestReimbursementUsecase_SubmitReimbursement(t *testing.T) {
 			},
 			expectError: true, // Negative amount should be invalid
 		},
+		{
+			name: "reimbursement in another currency",
+			request: v1.ReimbursementRequest{
+				Amount:      120,
+				Currency:    stringPtr("sgd"),
+				Date:        openapi_types.Date{Time: reimbursementDate},
+				Description: "Conference ticket",
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(&entity.Employee{Base: entity.Base{ID: 1}, UserID: 1, Currency: "IDR"}, nil)
+
+				mockReimbursementRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, r *entity.Reimbursement, _ *gorm.DB) (*entity.Reimbursement, error) {
+						if r.Currency != "SGD" || r.Amount != 120 {
+							t.Errorf("Expected 120 SGD but got %d %s", r.Amount, r.Currency)
+						}
+						return r, nil
+					})
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid currency",
+			request: v1.ReimbursementRequest{
+				Amount:      120,
+				Currency:    stringPtr("S$"),
+				Date:        openapi_types.Date{Time: reimbursementDate},
+				Description: "Conference ticket",
+			},
+			setupContext: func() context.Context {
+				return context.WithValue(context.Background(), constant.ContextKeyUserID, int64(1))
+			},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Employee{UserID: int64(1)}, nil).
+					Return(&entity.Employee{Base: entity.Base{ID: 1}, UserID: 1, Currency: "IDR"}, nil)
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -213,3 +258,7 @@ func TestReimbursementUsecase_SubmitReimbursement(t *testing.T) {
 		})
 	}
 }
+
+func stringPtr(s string) *string {
+	return &s
+}
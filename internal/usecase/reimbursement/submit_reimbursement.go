@@ -8,6 +8,7 @@ import (
 	"github.com/asyauqi15/payslip-system/internal/entity"
 	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
 	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"github.com/asyauqi15/payslip-system/pkg/money"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 	"github.com/spf13/cast"
 )
@@ -31,23 +32,9 @@ func (u *UsecaseImpl) SubmitReimbursement(ctx context.Context, req v1.Reimbursem
 		return httppkg.NewNotFoundError("employee not found")
 	}
 
-	// Validate reimbursement amount
-	if req.Amount <= 0 {
-		return httppkg.NewBadRequestError("reimbursement amount must be greater than 0")
-	}
-
-	// Validate that the date is not in the future
-	reimbursementDate := time.Time(req.Date.Time)
-	if reimbursementDate.After(time.Now()) {
-		return httppkg.NewBadRequestError("reimbursement date cannot be in the future")
-	}
-
-	// Create reimbursement record
-	reimbursement := &entity.Reimbursement{
-		EmployeeID:  employee.ID,
-		Amount:      int64(req.Amount),
-		Date:        reimbursementDate,
-		Description: req.Description,
+	reimbursement, err := u.PrepareReimbursement(ctx, employee, req)
+	if err != nil {
+		return err
 	}
 
 	_, err = u.reimbursementRepo.Create(ctx, reimbursement, nil)
@@ -59,8 +46,44 @@ func (u *UsecaseImpl) SubmitReimbursement(ctx context.Context, req v1.Reimbursem
 	logger.Info(ctx, "reimbursement submitted successfully",
 		"employee_id", employee.ID,
 		"amount", req.Amount,
-		"date", reimbursementDate.Format("2006-01-02"),
+		"currency", reimbursement.Currency,
+		"date", reimbursement.Date.Format("2006-01-02"),
 		"description", req.Description)
 
 	return nil
 }
+
+// PrepareReimbursement checks a request against the reimbursement rules and
+// returns the reimbursement it would record for the employee, pending review.
+// Nothing is stored.
+func (u *UsecaseImpl) PrepareReimbursement(ctx context.Context, employee *entity.Employee, req v1.ReimbursementRequest) (*entity.Reimbursement, error) {
+	// Validate reimbursement amount
+	if req.Amount <= 0 {
+		return nil, httppkg.NewBadRequestError("reimbursement amount must be greater than 0")
+	}
+
+	// Validate that the date is not in the future
+	reimbursementDate := time.Time(req.Date.Time)
+	if reimbursementDate.After(time.Now()) {
+		return nil, httppkg.NewBadRequestError("reimbursement date cannot be in the future")
+	}
+
+	// Amounts are in the currency of the employee's salary unless stated
+	currency := employee.Currency
+	if req.Currency != nil {
+		parsed, err := money.ParseCurrency(*req.Currency)
+		if err != nil {
+			return nil, httppkg.NewBadRequestError(err.Error())
+		}
+		currency = string(parsed)
+	}
+
+	return &entity.Reimbursement{
+		EmployeeID:  employee.ID,
+		Amount:      int64(req.Amount),
+		Currency:    currency,
+		Date:        reimbursementDate,
+		Description: req.Description,
+		Status:      entity.ApprovalStatusPending,
+	}, nil
+}
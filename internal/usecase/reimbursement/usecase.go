@@ -3,6 +3,7 @@ package reimbursement
 import (
 	"context"
 
+	"github.com/asyauqi15/payslip-system/internal/entity"
 	"github.com/asyauqi15/payslip-system/internal/repository"
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 )
@@ -10,6 +11,7 @@ import (
 //go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/reimbursement Usecase
 type Usecase interface {
 	SubmitReimbursement(ctx context.Context, req v1.ReimbursementRequest) error
+	PrepareReimbursement(ctx context.Context, employee *entity.Employee, req v1.ReimbursementRequest) (*entity.Reimbursement, error)
 }
 
 type UsecaseImpl struct {
@@ -0,0 +1,55 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) AssignUserRole(ctx context.Context, userID int64, req v1.UserRoleRequest) error {
+	roleName := strings.TrimSpace(req.Role)
+	if roleName == "" {
+		return httppkg.NewBadRequestError("role is required")
+	}
+
+	// Prevent admins from locking themselves out by accident
+	if cast.ToInt64(ctx.Value(constant.ContextKeyUserID)) == userID {
+		return httppkg.NewUnprocessableEntityError("cannot change your own role")
+	}
+
+	role, err := u.roleRepo.FindOneByTemplate(ctx, &entity.Role{Name: roleName}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find role", "name", roleName, "error", err)
+		return httppkg.NewInternalServerError("failed to find role")
+	}
+	if role == nil {
+		return httppkg.NewNotFoundError("role not found")
+	}
+
+	user, err := u.userRepo.FindByID(ctx, uint(userID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find user", "user_id", userID, "error", err)
+		return httppkg.NewInternalServerError("failed to find user")
+	}
+	if user == nil {
+		return httppkg.NewNotFoundError("user not found")
+	}
+
+	user.Role = role.Name
+	if err := u.userRepo.Save(ctx, user, nil); err != nil {
+		logger.Error(ctx, "failed to assign user role", "user_id", userID, "role", role.Name, "error", err)
+		return httppkg.NewInternalServerError("failed to assign user role")
+	}
+
+	logger.Info(ctx, "user role assigned", "user_id", userID, "role", role.Name)
+
+	return nil
+}
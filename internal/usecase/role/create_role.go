@@ -0,0 +1,111 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) CreateRole(ctx context.Context, req v1.RoleRequest) (*v1.Role, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, httppkg.NewBadRequestError("role name is required")
+	}
+
+	permissions, err := normalizePermissions(ctx, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := u.roleRepo.FindOneByTemplate(ctx, &entity.Role{Name: name}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to check existing role", "name", name, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to check existing role")
+	}
+	if existing != nil {
+		return nil, httppkg.NewConflictError("role name already exists")
+	}
+
+	role := &entity.Role{
+		Name:             name,
+		Description:      descriptionOf(req.Description),
+		RequireTwoFactor: req.RequireTwoFactor != nil && *req.RequireTwoFactor,
+	}
+
+	// The role is created with its permissions, so a failure leaves no role
+	// without permissions behind
+	var created *entity.Role
+	err = u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		created, err = u.roleRepo.Create(ctx, role, tx)
+		if err != nil {
+			logger.Error(ctx, "failed to create role", "name", name, "error", err)
+			return httppkg.NewInternalServerError("failed to create role")
+		}
+
+		if err := u.rolePermissionRepo.ReplacePermissions(ctx, created.ID, permissions, tx); err != nil {
+			logger.Error(ctx, "failed to set role permissions", "role_id", created.ID, "error", err)
+			return httppkg.NewInternalServerError("failed to set role permissions")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, transactionError(ctx, err, "failed to create role")
+	}
+
+	logger.Info(ctx, "role created", "role_id", created.ID, "name", name, "permissions", permissions)
+
+	response := toRoleResponse(created, permissions)
+	return &response, nil
+}
+
+// normalizePermissions rejects unknown permissions and permissions the caller
+// does not hold itself, so a role can never grant more than its editor has,
+// and removes duplicates.
+func normalizePermissions(ctx context.Context, permissions []string) ([]string, error) {
+	granted := cast.ToStringSlice(ctx.Value(constant.ContextKeyUserPermissions))
+
+	normalized := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		permission = strings.TrimSpace(permission)
+		if !entity.IsValidPermission(permission) {
+			return nil, httppkg.NewBadRequestError("unknown permission: " + permission)
+		}
+		if !entity.HasPermission(granted, permission) {
+			return nil, httppkg.NewForbiddenError("cannot grant a permission you do not have: " + permission)
+		}
+		if !slices.Contains(normalized, permission) {
+			normalized = append(normalized, permission)
+		}
+	}
+	slices.Sort(normalized)
+
+	return normalized, nil
+}
+
+// transactionError returns the error a write inside a transaction failed
+// with, or an internal server error when the transaction itself failed.
+func transactionError(ctx context.Context, err error, message string) error {
+	var httpErr *httppkg.ErrorWrapper
+	if errors.As(err, &httpErr) {
+		return httpErr
+	}
+	logger.Error(ctx, message, "error", err)
+	return httppkg.NewInternalServerError(message)
+}
+
+func descriptionOf(description *string) string {
+	if description == nil {
+		return ""
+	}
+	return strings.TrimSpace(*description)
+}
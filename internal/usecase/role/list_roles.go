@@ -0,0 +1,60 @@
+package role
+
+import (
+	"context"
+	"slices"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+func (u *UsecaseImpl) ListPermissions(ctx context.Context) []string {
+	return slices.Clone(entity.Permissions)
+}
+
+func (u *UsecaseImpl) ListRoles(ctx context.Context) ([]v1.Role, error) {
+	roles, err := u.roleRepo.FindByTemplate(ctx, &entity.Role{}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to list roles", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to list roles")
+	}
+
+	response := make([]v1.Role, 0, len(roles))
+	for _, role := range roles {
+		permissions, err := u.findRolePermissions(ctx, role.ID)
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, toRoleResponse(&role, permissions))
+	}
+
+	return response, nil
+}
+
+func (u *UsecaseImpl) findRolePermissions(ctx context.Context, roleID int64) ([]string, error) {
+	rolePermissions, err := u.rolePermissionRepo.FindByTemplate(ctx, &entity.RolePermission{RoleID: roleID}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find role permissions", "role_id", roleID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find role permissions")
+	}
+
+	permissions := make([]string, 0, len(rolePermissions))
+	for _, rolePermission := range rolePermissions {
+		permissions = append(permissions, rolePermission.Permission)
+	}
+	slices.Sort(permissions)
+
+	return permissions, nil
+}
+
+func toRoleResponse(role *entity.Role, permissions []string) v1.Role {
+	return v1.Role{
+		Id:               role.ID,
+		Name:             role.Name,
+		Description:      role.Description,
+		RequireTwoFactor: role.RequireTwoFactor,
+		Permissions:      permissions,
+	}
+}
@@ -0,0 +1,392 @@
+package role_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/role"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// callerPermissions are the permissions of the admin editing roles in the
+// tests.
+var callerPermissions = []string{
+	entity.PermissionRoleManage,
+	entity.PermissionPayrollRead,
+	entity.PermissionPayrollRun,
+	entity.PermissionSubmissionReviewTeam,
+}
+
+// newTestDB returns a database for the transactions roles are written in. It
+// runs no queries since the repositories are mocked.
+func newTestDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	conn, dbMock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: conn, DriverName: "postgres"}), &gorm.Config{
+		Logger: gormlogger.Default.LogMode(gormlogger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to open gorm: %v", err)
+	}
+	return db, dbMock
+}
+
+func TestRoleUsecase_CreateRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockRolePermissionRepo := mock.NewMockRolePermissionRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+
+	db, dbMock := newTestDB(t)
+
+	usecase := role.NewUsecase(mockRoleRepo, mockRolePermissionRepo, mockUserRepo, db)
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserPermissions, callerPermissions)
+
+	tests := []struct {
+		name           string
+		request        v1.RoleRequest
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name: "successful creation",
+			request: v1.RoleRequest{
+				Name:        "payroll_officer",
+				Permissions: []string{entity.PermissionPayrollRun, entity.PermissionPayrollRead, entity.PermissionPayrollRun},
+			},
+			setupMock: func() {
+				mockRoleRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Role{Name: "payroll_officer"}, nil).
+					Return(nil, nil)
+				// The role is created with its permissions in one transaction
+				dbMock.ExpectBegin()
+				mockRoleRepo.EXPECT().
+					Create(gomock.Any(), &entity.Role{Name: "payroll_officer"}, gomock.Not(gomock.Nil())).
+					DoAndReturn(func(_ context.Context, r *entity.Role, _ interface{}) (*entity.Role, error) {
+						r.ID = 4
+						return r, nil
+					})
+				// Duplicates are dropped and the set is sorted
+				mockRolePermissionRepo.EXPECT().
+					ReplacePermissions(gomock.Any(), int64(4), []string{entity.PermissionPayrollRead, entity.PermissionPayrollRun}, gomock.Not(gomock.Nil())).
+					Return(nil)
+				dbMock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name: "failed permissions roll back the role",
+			request: v1.RoleRequest{
+				Name:        "payroll_officer",
+				Permissions: []string{entity.PermissionPayrollRun},
+			},
+			setupMock: func() {
+				mockRoleRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Role{Name: "payroll_officer"}, nil).
+					Return(nil, nil)
+				dbMock.ExpectBegin()
+				mockRoleRepo.EXPECT().
+					Create(gomock.Any(), &entity.Role{Name: "payroll_officer"}, gomock.Not(gomock.Nil())).
+					DoAndReturn(func(_ context.Context, r *entity.Role, _ interface{}) (*entity.Role, error) {
+						r.ID = 4
+						return r, nil
+					})
+				mockRolePermissionRepo.EXPECT().
+					ReplacePermissions(gomock.Any(), int64(4), []string{entity.PermissionPayrollRun}, gomock.Not(gomock.Nil())).
+					Return(gorm.ErrInvalidDB)
+				dbMock.ExpectRollback()
+			},
+			expectError:    true,
+			expectedStatus: 500,
+		},
+		{
+			name: "permission the caller does not hold",
+			request: v1.RoleRequest{
+				Name:        "payroll_officer",
+				Permissions: []string{entity.PermissionPayrollRun, entity.PermissionEmployeeWrite},
+			},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 403,
+		},
+		{
+			name: "unknown permission",
+			request: v1.RoleRequest{
+				Name:        "payroll_officer",
+				Permissions: []string{"payroll:delete"},
+			},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name: "duplicate name",
+			request: v1.RoleRequest{
+				Name:        entity.UserRoleManager,
+				Permissions: []string{entity.PermissionSubmissionReviewTeam},
+			},
+			setupMock: func() {
+				mockRoleRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Role{Name: entity.UserRoleManager}, nil).
+					Return(&entity.Role{Base: entity.Base{ID: 2}, Name: entity.UserRoleManager}, nil)
+			},
+			expectError:    true,
+			expectedStatus: 409,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.CreateRole(ctx, tt.request)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if result.Id != 4 || len(result.Permissions) != 2 {
+					t.Errorf("Unexpected role response: %+v", result)
+				}
+			}
+			if err := dbMock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unexpected transaction: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoleUsecase_UpdateRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockRolePermissionRepo := mock.NewMockRolePermissionRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+
+	db, dbMock := newTestDB(t)
+
+	usecase := role.NewUsecase(mockRoleRepo, mockRolePermissionRepo, mockUserRepo, db)
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserPermissions, callerPermissions)
+	requireTwoFactor := true
+
+	tests := []struct {
+		name        string
+		roleID      int64
+		request     v1.RoleUpdateRequest
+		setupMock   func()
+		expectError bool
+	}{
+		{
+			name:   "successful update",
+			roleID: 2,
+			request: v1.RoleUpdateRequest{
+				Permissions: []string{entity.PermissionSubmissionReviewTeam, entity.PermissionPayrollRead},
+			},
+			setupMock: func() {
+				mockRoleRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2), nil).
+					Return(&entity.Role{Base: entity.Base{ID: 2}, Name: entity.UserRoleManager}, nil)
+				dbMock.ExpectBegin()
+				mockRolePermissionRepo.EXPECT().
+					ReplacePermissions(gomock.Any(), int64(2), []string{entity.PermissionPayrollRead, entity.PermissionSubmissionReviewTeam}, gomock.Not(gomock.Nil())).
+					Return(nil)
+				dbMock.ExpectCommit()
+			},
+			expectError: false,
+		},
+		{
+			name:   "enforce two-factor authentication",
+			roleID: 2,
+			request: v1.RoleUpdateRequest{
+				RequireTwoFactor: &requireTwoFactor,
+				Permissions:      []string{entity.PermissionPayrollRead},
+			},
+			setupMock: func() {
+				mockRoleRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2), nil).
+					Return(&entity.Role{Base: entity.Base{ID: 2}, Name: entity.UserRoleManager}, nil)
+				dbMock.ExpectBegin()
+				mockRoleRepo.EXPECT().
+					Save(gomock.Any(), &entity.Role{Base: entity.Base{ID: 2}, Name: entity.UserRoleManager, RequireTwoFactor: true}, gomock.Not(gomock.Nil())).
+					Return(nil)
+				mockRolePermissionRepo.EXPECT().
+					ReplacePermissions(gomock.Any(), int64(2), []string{entity.PermissionPayrollRead}, gomock.Not(gomock.Nil())).
+					Return(nil)
+				dbMock.ExpectCommit()
+			},
+		},
+		{
+			name:   "failed permissions roll back the role",
+			roleID: 2,
+			request: v1.RoleUpdateRequest{
+				RequireTwoFactor: &requireTwoFactor,
+				Permissions:      []string{entity.PermissionPayrollRead},
+			},
+			setupMock: func() {
+				mockRoleRepo.EXPECT().
+					FindByID(gomock.Any(), uint(2), nil).
+					Return(&entity.Role{Base: entity.Base{ID: 2}, Name: entity.UserRoleManager}, nil)
+				dbMock.ExpectBegin()
+				mockRoleRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any(), gomock.Not(gomock.Nil())).
+					Return(nil)
+				mockRolePermissionRepo.EXPECT().
+					ReplacePermissions(gomock.Any(), int64(2), []string{entity.PermissionPayrollRead}, gomock.Not(gomock.Nil())).
+					Return(gorm.ErrInvalidDB)
+				dbMock.ExpectRollback()
+			},
+			expectError: true,
+		},
+		{
+			name:   "permission the caller does not hold",
+			roleID: 2,
+			request: v1.RoleUpdateRequest{
+				Permissions: []string{entity.PermissionServiceAccountManage},
+			},
+			setupMock:   func() {},
+			expectError: true,
+		},
+		{
+			name:   "admin must keep role management",
+			roleID: 1,
+			request: v1.RoleUpdateRequest{
+				Permissions: []string{entity.PermissionPayrollRun},
+			},
+			setupMock: func() {
+				mockRoleRepo.EXPECT().
+					FindByID(gomock.Any(), uint(1), nil).
+					Return(&entity.Role{Base: entity.Base{ID: 1}, Name: entity.UserRoleAdmin}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name:   "role not found",
+			roleID: 99,
+			request: v1.RoleUpdateRequest{
+				Permissions: []string{entity.PermissionPayrollRun},
+			},
+			setupMock: func() {
+				mockRoleRepo.EXPECT().
+					FindByID(gomock.Any(), uint(99), nil).
+					Return(nil, gorm.ErrRecordNotFound)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			_, err := usecase.UpdateRole(ctx, tt.roleID, tt.request)
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+			if err := dbMock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unexpected transaction: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoleUsecase_AssignUserRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRoleRepo := mock.NewMockRoleRepository(ctrl)
+	mockRolePermissionRepo := mock.NewMockRolePermissionRepository(ctrl)
+	mockUserRepo := mock.NewMockUserRepository(ctrl)
+
+	usecase := role.NewUsecase(mockRoleRepo, mockRolePermissionRepo, mockUserRepo, nil)
+
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "1")
+
+	tests := []struct {
+		name        string
+		userID      int64
+		request     v1.UserRoleRequest
+		setupMock   func()
+		expectError bool
+	}{
+		{
+			name:    "successful assignment",
+			userID:  5,
+			request: v1.UserRoleRequest{Role: entity.UserRoleManager},
+			setupMock: func() {
+				mockRoleRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Role{Name: entity.UserRoleManager}, nil).
+					Return(&entity.Role{Base: entity.Base{ID: 2}, Name: entity.UserRoleManager}, nil)
+				mockUserRepo.EXPECT().
+					FindByID(gomock.Any(), uint(5), nil).
+					Return(&entity.User{Base: entity.Base{ID: 5}, Role: entity.UserRoleDefault}, nil)
+				mockUserRepo.EXPECT().
+					Save(gomock.Any(), &entity.User{Base: entity.Base{ID: 5}, Role: entity.UserRoleManager}, nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name:        "cannot change own role",
+			userID:      1,
+			request:     v1.UserRoleRequest{Role: entity.UserRoleDefault},
+			setupMock:   func() {},
+			expectError: true,
+		},
+		{
+			name:    "unknown role",
+			userID:  5,
+			request: v1.UserRoleRequest{Role: "auditor"},
+			setupMock: func() {
+				mockRoleRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Role{Name: "auditor"}, nil).
+					Return(nil, nil)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			err := usecase.AssignUserRole(ctx, tt.userID, tt.request)
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package role
+
+import (
+	"context"
+	"errors"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"gorm.io/gorm"
+)
+
+func (u *UsecaseImpl) UpdateRole(ctx context.Context, roleID int64, req v1.RoleUpdateRequest) (*v1.Role, error) {
+	permissions, err := normalizePermissions(ctx, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := u.roleRepo.FindByID(ctx, uint(roleID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find role", "role_id", roleID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find role")
+	}
+	if role == nil {
+		return nil, httppkg.NewNotFoundError("role not found")
+	}
+
+	// Removing role management from admin would leave nobody able to fix roles
+	if role.Name == entity.UserRoleAdmin && !entity.HasPermission(permissions, entity.PermissionRoleManage) {
+		return nil, httppkg.NewUnprocessableEntityError("admin role must keep the role:manage permission")
+	}
+
+	if req.Description != nil {
+		role.Description = descriptionOf(req.Description)
+	}
+	if req.RequireTwoFactor != nil {
+		role.RequireTwoFactor = *req.RequireTwoFactor
+	}
+
+	// The role and its permissions change together or not at all
+	err = u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if req.Description != nil || req.RequireTwoFactor != nil {
+			if err := u.roleRepo.Save(ctx, role, tx); err != nil {
+				logger.Error(ctx, "failed to update role", "role_id", roleID, "error", err)
+				return httppkg.NewInternalServerError("failed to update role")
+			}
+		}
+
+		if err := u.rolePermissionRepo.ReplacePermissions(ctx, role.ID, permissions, tx); err != nil {
+			logger.Error(ctx, "failed to set role permissions", "role_id", roleID, "error", err)
+			return httppkg.NewInternalServerError("failed to set role permissions")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, transactionError(ctx, err, "failed to update role")
+	}
+
+	logger.Info(ctx, "role updated", "role_id", roleID, "name", role.Name, "permissions", permissions)
+
+	response := toRoleResponse(role, permissions)
+	return &response, nil
+}
@@ -0,0 +1,39 @@
+package role
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/role Usecase
+type Usecase interface {
+	ListPermissions(ctx context.Context) []string
+	ListRoles(ctx context.Context) ([]v1.Role, error)
+	CreateRole(ctx context.Context, req v1.RoleRequest) (*v1.Role, error)
+	UpdateRole(ctx context.Context, roleID int64, req v1.RoleUpdateRequest) (*v1.Role, error)
+	AssignUserRole(ctx context.Context, userID int64, req v1.UserRoleRequest) error
+}
+
+type UsecaseImpl struct {
+	roleRepo           repository.RoleRepository
+	rolePermissionRepo repository.RolePermissionRepository
+	userRepo           repository.UserRepository
+	db                 *gorm.DB
+}
+
+func NewUsecase(
+	roleRepo repository.RoleRepository,
+	rolePermissionRepo repository.RolePermissionRepository,
+	userRepo repository.UserRepository,
+	db *gorm.DB,
+) Usecase {
+	return &UsecaseImpl{
+		roleRepo:           roleRepo,
+		rolePermissionRepo: rolePermissionRepo,
+		userRepo:           userRepo,
+		db:                 db,
+	}
+}
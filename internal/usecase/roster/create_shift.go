@@ -0,0 +1,59 @@
+package roster
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+func (u *UsecaseImpl) CreateShift(ctx context.Context, req v1.ShiftRequest) (*v1.Shift, error) {
+	shift := &entity.Shift{
+		Name:      strings.TrimSpace(req.Name),
+		StartTime: strings.TrimSpace(req.StartTime),
+		EndTime:   strings.TrimSpace(req.EndTime),
+	}
+	if shift.Name == "" {
+		return nil, httppkg.NewBadRequestError("shift name is required")
+	}
+
+	hours, err := shift.Hours()
+	if err != nil {
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+	// A shift ending before it starts crosses midnight, but it cannot last
+	// the whole day
+	if hours.End-hours.Start == 24*time.Hour {
+		return nil, httppkg.NewBadRequestError("shift start and end must differ")
+	}
+
+	existing, err := u.shiftRepo.FindOneByTemplate(ctx, &entity.Shift{Name: shift.Name}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to check existing shift", "name", shift.Name, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to check existing shift")
+	}
+	if existing != nil {
+		return nil, httppkg.NewConflictError("shift name already exists")
+	}
+
+	created, err := u.shiftRepo.Create(ctx, shift, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to create shift", "name", shift.Name, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to create shift")
+	}
+
+	return toShiftResponse(created), nil
+}
+
+func toShiftResponse(shift *entity.Shift) *v1.Shift {
+	return &v1.Shift{
+		Id:        shift.ID,
+		Name:      shift.Name,
+		StartTime: shift.StartTime,
+		EndTime:   shift.EndTime,
+	}
+}
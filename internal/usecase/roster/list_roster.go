@@ -0,0 +1,66 @@
+package roster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// maxRosterDays bounds the dates listed at once.
+const maxRosterDays = 92
+
+// ListRoster returns the roster entries between two dates, ordered by date,
+// optionally for one employee.
+func (u *UsecaseImpl) ListRoster(ctx context.Context, params v1.GetAdminRostersParams) ([]v1.RosterEntry, error) {
+	from, to := params.From.Time, params.To.Time
+	if to.Before(from) {
+		return nil, httppkg.NewBadRequestError("to must not be before from")
+	}
+	if to.After(from.AddDate(0, 0, maxRosterDays)) {
+		return nil, httppkg.NewBadRequestError(fmt.Sprintf("at most %d days can be listed at once", maxRosterDays))
+	}
+
+	query := repository.NewQuery().WhereDateRange("date", repository.DaysBetween(from, to)).OrderBy("date", false)
+	if params.EmployeeId != nil {
+		query.Where("employee_id", repository.OpEqual, *params.EmployeeId)
+	}
+
+	entries, err := u.rosterEntryRepo.Find(ctx, query, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to list roster entries", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to list roster entries")
+	}
+
+	response := make([]v1.RosterEntry, 0, len(entries))
+	if len(entries) == 0 {
+		return response, nil
+	}
+
+	shiftIDs := make(map[int64]bool)
+	for _, entry := range entries {
+		shiftIDs[entry.ShiftID] = true
+	}
+	shifts, err := u.findShifts(ctx, keys(shiftIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		shift := shifts[entry.ShiftID]
+		response = append(response, v1.RosterEntry{
+			Id:         entry.ID,
+			EmployeeId: entry.EmployeeID,
+			Date:       openapi_types.Date{Time: entry.Date},
+			ShiftId:    entry.ShiftID,
+			ShiftName:  shift.Name,
+			StartTime:  shift.StartTime,
+			EndTime:    shift.EndTime,
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,24 @@
+package roster
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+func (u *UsecaseImpl) ListShifts(ctx context.Context) ([]v1.Shift, error) {
+	shifts, err := u.shiftRepo.FindByTemplate(ctx, &entity.Shift{}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to list shifts", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to list shifts")
+	}
+
+	response := make([]v1.Shift, 0, len(shifts))
+	for i := range shifts {
+		response = append(response, *toShiftResponse(&shifts[i]))
+	}
+	return response, nil
+}
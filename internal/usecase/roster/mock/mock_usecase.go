@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/asyauqi15/payslip-system/internal/usecase/roster (interfaces: Usecase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/roster Usecase
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateShift mocks base method.
+func (m *MockUsecase) CreateShift(ctx context.Context, req v1.ShiftRequest) (*v1.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShift", ctx, req)
+	ret0, _ := ret[0].(*v1.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShift indicates an expected call of CreateShift.
+func (mr *MockUsecaseMockRecorder) CreateShift(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShift", reflect.TypeOf((*MockUsecase)(nil).CreateShift), ctx, req)
+}
+
+// ListRoster mocks base method.
+func (m *MockUsecase) ListRoster(ctx context.Context, params v1.GetAdminRostersParams) ([]v1.RosterEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoster", ctx, params)
+	ret0, _ := ret[0].([]v1.RosterEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoster indicates an expected call of ListRoster.
+func (mr *MockUsecaseMockRecorder) ListRoster(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoster", reflect.TypeOf((*MockUsecase)(nil).ListRoster), ctx, params)
+}
+
+// ListShifts mocks base method.
+func (m *MockUsecase) ListShifts(ctx context.Context) ([]v1.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShifts", ctx)
+	ret0, _ := ret[0].([]v1.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShifts indicates an expected call of ListShifts.
+func (mr *MockUsecaseMockRecorder) ListShifts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShifts", reflect.TypeOf((*MockUsecase)(nil).ListShifts), ctx)
+}
+
+// UploadRoster mocks base method.
+func (m *MockUsecase) UploadRoster(ctx context.Context, req v1.RosterUploadRequest) (*v1.RosterUploadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadRoster", ctx, req)
+	ret0, _ := ret[0].(*v1.RosterUploadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadRoster indicates an expected call of UploadRoster.
+func (mr *MockUsecaseMockRecorder) UploadRoster(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadRoster", reflect.TypeOf((*MockUsecase)(nil).UploadRoster), ctx, req)
+}
@@ -0,0 +1,230 @@
+package roster_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/roster"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRosterUsecase_CreateShift(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockShiftRepo := mock.NewMockShiftRepository(ctrl)
+	mockRosterEntryRepo := mock.NewMockRosterEntryRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+
+	usecase := roster.NewUsecase(mockShiftRepo, mockRosterEntryRepo, mockEmployeeRepo)
+
+	validRequest := v1.ShiftRequest{Name: "Late", StartTime: "14:00", EndTime: "22:00"}
+
+	tests := []struct {
+		name           string
+		request        v1.ShiftRequest
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name:    "successful creation",
+			request: validRequest,
+			setupMock: func() {
+				mockShiftRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Shift{Name: "Late"}, nil).
+					Return(nil, nil)
+				mockShiftRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, s *entity.Shift, _ interface{}) (*entity.Shift, error) {
+						s.ID = 3
+						return s, nil
+					})
+			},
+			expectError: false,
+		},
+		{
+			name:           "invalid time",
+			request:        v1.ShiftRequest{Name: "Late", StartTime: "2pm", EndTime: "22:00"},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:    "night shift crossing midnight",
+			request: v1.ShiftRequest{Name: "Night", StartTime: "22:00", EndTime: "06:00"},
+			setupMock: func() {
+				mockShiftRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Shift{Name: "Night"}, nil).
+					Return(nil, nil)
+				mockShiftRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, s *entity.Shift, _ interface{}) (*entity.Shift, error) {
+						s.ID = 3
+						return s, nil
+					})
+			},
+			expectError: false,
+		},
+		{
+			name:           "start equals end",
+			request:        v1.ShiftRequest{Name: "Late", StartTime: "14:00", EndTime: "14:00"},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:    "duplicate name",
+			request: validRequest,
+			setupMock: func() {
+				mockShiftRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.Shift{Name: "Late"}, nil).
+					Return(&entity.Shift{Base: entity.Base{ID: 1}, Name: "Late"}, nil)
+			},
+			expectError:    true,
+			expectedStatus: 409,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.CreateShift(context.Background(), tt.request)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if result.Id != 3 || result.EndTime != tt.request.EndTime {
+					t.Errorf("Unexpected shift response: %+v", result)
+				}
+			}
+		})
+	}
+}
+
+func TestRosterUsecase_UploadRoster(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockShiftRepo := mock.NewMockShiftRepository(ctrl)
+	mockRosterEntryRepo := mock.NewMockRosterEntryRepository(ctrl)
+	mockEmployeeRepo := mock.NewMockEmployeeRepository(ctrl)
+
+	usecase := roster.NewUsecase(mockShiftRepo, mockRosterEntryRepo, mockEmployeeRepo)
+
+	saturday := openapi_types.Date{Time: time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)}
+	sunday := openapi_types.Date{Time: time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)}
+	shiftID := int64(3)
+
+	tests := []struct {
+		name           string
+		request        v1.RosterUploadRequest
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name: "assign and clear",
+			request: v1.RosterUploadRequest{Entries: []v1.RosterEntryRequest{
+				{EmployeeId: 1, Date: saturday, ShiftId: &shiftID},
+				{EmployeeId: 1, Date: sunday},
+			}},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Employee{{Base: entity.Base{ID: 1}}}, nil)
+				mockShiftRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Shift{{Base: entity.Base{ID: shiftID}}}, nil)
+				mockRosterEntryRepo.EXPECT().
+					Replace(gomock.Any(), []entity.RosterEntry{
+						{EmployeeID: 1, Date: saturday.Time, ShiftID: shiftID},
+						{EmployeeID: 1, Date: sunday.Time},
+					}, nil).
+					Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "date listed twice",
+			request: v1.RosterUploadRequest{Entries: []v1.RosterEntryRequest{
+				{EmployeeId: 1, Date: saturday, ShiftId: &shiftID},
+				{EmployeeId: 1, Date: saturday},
+			}},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name: "unknown employee",
+			request: v1.RosterUploadRequest{Entries: []v1.RosterEntryRequest{
+				{EmployeeId: 9, Date: saturday, ShiftId: &shiftID},
+			}},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Employee{}, nil)
+			},
+			expectError:    true,
+			expectedStatus: 404,
+		},
+		{
+			name: "unknown shift",
+			request: v1.RosterUploadRequest{Entries: []v1.RosterEntryRequest{
+				{EmployeeId: 1, Date: saturday, ShiftId: &shiftID},
+			}},
+			setupMock: func() {
+				mockEmployeeRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Employee{{Base: entity.Base{ID: 1}}}, nil)
+				mockShiftRepo.EXPECT().
+					Find(gomock.Any(), gomock.Any(), nil).
+					Return([]entity.Shift{}, nil)
+			},
+			expectError:    true,
+			expectedStatus: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.UploadRoster(context.Background(), tt.request)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if result.Assigned != 1 || result.Cleared != 1 {
+					t.Errorf("Unexpected upload response: %+v", result)
+				}
+			}
+		})
+	}
+}
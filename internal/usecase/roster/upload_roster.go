@@ -0,0 +1,132 @@
+package roster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+// maxRosterEntries bounds one upload, as in the API schema.
+const maxRosterEntries = 1000
+
+// UploadRoster assigns shifts to employees per date. Every entry replaces the
+// employee's shift on that date, and an entry without a shift clears the date
+// to a day off. The upload is applied all together or not at all.
+func (u *UsecaseImpl) UploadRoster(ctx context.Context, req v1.RosterUploadRequest) (*v1.RosterUploadResponse, error) {
+	if len(req.Entries) == 0 {
+		return nil, httppkg.NewBadRequestError("at least one roster entry is required")
+	}
+	if len(req.Entries) > maxRosterEntries {
+		return nil, httppkg.NewBadRequestError(fmt.Sprintf("at most %d roster entries can be uploaded at once", maxRosterEntries))
+	}
+
+	entries := make([]entity.RosterEntry, 0, len(req.Entries))
+	employeeIDs := make(map[int64]bool)
+	shiftIDs := make(map[int64]bool)
+	seen := make(map[string]bool)
+	response := &v1.RosterUploadResponse{}
+
+	for _, e := range req.Entries {
+		date := e.Date.Format("2006-01-02")
+		key := fmt.Sprintf("%d/%s", e.EmployeeId, date)
+		if seen[key] {
+			return nil, httppkg.NewBadRequestError(fmt.Sprintf("employee %d is listed more than once for %s", e.EmployeeId, date))
+		}
+		seen[key] = true
+		employeeIDs[e.EmployeeId] = true
+
+		entry := entity.RosterEntry{EmployeeID: e.EmployeeId, Date: e.Date.Time}
+		if e.ShiftId != nil {
+			entry.ShiftID = *e.ShiftId
+			shiftIDs[*e.ShiftId] = true
+			response.Assigned++
+		} else {
+			response.Cleared++
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := u.validateEmployees(ctx, employeeIDs); err != nil {
+		return nil, err
+	}
+	if err := u.validateShifts(ctx, shiftIDs); err != nil {
+		return nil, err
+	}
+
+	if err := u.rosterEntryRepo.Replace(ctx, entries, nil); err != nil {
+		logger.Error(ctx, "failed to store roster", "entries", len(entries), "error", err)
+		return nil, httppkg.NewInternalServerError("failed to store roster")
+	}
+
+	logger.Info(ctx, "roster uploaded",
+		"assigned", response.Assigned,
+		"cleared", response.Cleared)
+
+	return response, nil
+}
+
+// validateEmployees makes sure every employee of an upload exists.
+func (u *UsecaseImpl) validateEmployees(ctx context.Context, ids map[int64]bool) error {
+	employees, err := u.employeeRepo.Find(ctx, repository.NewQuery().Where("id", repository.OpIn, keys(ids)), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find employees", "error", err)
+		return httppkg.NewInternalServerError("failed to find employees")
+	}
+
+	found := make(map[int64]bool, len(employees))
+	for _, employee := range employees {
+		found[employee.ID] = true
+	}
+	for id := range ids {
+		if !found[id] {
+			return httppkg.NewNotFoundError(fmt.Sprintf("employee %d not found", id))
+		}
+	}
+	return nil
+}
+
+// validateShifts makes sure every shift of an upload exists.
+func (u *UsecaseImpl) validateShifts(ctx context.Context, ids map[int64]bool) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	shifts, err := u.findShifts(ctx, keys(ids))
+	if err != nil {
+		return err
+	}
+	for id := range ids {
+		if _, ok := shifts[id]; !ok {
+			return httppkg.NewNotFoundError(fmt.Sprintf("shift %d not found", id))
+		}
+	}
+	return nil
+}
+
+// findShifts loads shifts by id, keyed by id.
+func (u *UsecaseImpl) findShifts(ctx context.Context, ids []int64) (map[int64]entity.Shift, error) {
+	shifts, err := u.shiftRepo.Find(ctx, repository.NewQuery().Where("id", repository.OpIn, ids), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find shifts", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find shifts")
+	}
+
+	byID := make(map[int64]entity.Shift, len(shifts))
+	for _, shift := range shifts {
+		byID[shift.ID] = shift
+	}
+	return byID, nil
+}
+
+func keys(set map[int64]bool) []int64 {
+	ids := make([]int64, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	return ids
+}
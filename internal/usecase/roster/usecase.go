@@ -0,0 +1,34 @@
+package roster
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+//go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/roster Usecase
+type Usecase interface {
+	CreateShift(ctx context.Context, req v1.ShiftRequest) (*v1.Shift, error)
+	ListShifts(ctx context.Context) ([]v1.Shift, error)
+	UploadRoster(ctx context.Context, req v1.RosterUploadRequest) (*v1.RosterUploadResponse, error)
+	ListRoster(ctx context.Context, params v1.GetAdminRostersParams) ([]v1.RosterEntry, error)
+}
+
+type UsecaseImpl struct {
+	shiftRepo       repository.ShiftRepository
+	rosterEntryRepo repository.RosterEntryRepository
+	employeeRepo    repository.EmployeeRepository
+}
+
+func NewUsecase(
+	shiftRepo repository.ShiftRepository,
+	rosterEntryRepo repository.RosterEntryRepository,
+	employeeRepo repository.EmployeeRepository,
+) Usecase {
+	return &UsecaseImpl{
+		shiftRepo:       shiftRepo,
+		rosterEntryRepo: rosterEntryRepo,
+		employeeRepo:    employeeRepo,
+	}
+}
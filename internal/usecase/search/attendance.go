@@ -0,0 +1,54 @@
+package search
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+func (u *UsecaseImpl) ListAttendances(ctx context.Context, params v1.GetAdminAttendancesParams) (*v1.AttendanceListResponse, error) {
+	query, limit, err := newQuery(params.Limit, params.Cursor, stringValue(params.Sort), stringValue(params.Order), "clock_in_time", "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := dateRange(params.From, params.To)
+	if err != nil {
+		return nil, err
+	}
+	repository.WhereRange(query, "clock_in_time", repository.ClockInRange(dates))
+	if params.EmployeeId != nil {
+		query.Where("employee_id", repository.OpEqual, *params.EmployeeId)
+	}
+
+	page, err := u.attendanceRepo.List(ctx, query, nil)
+	if err != nil {
+		return nil, listError(ctx, "attendances", err)
+	}
+
+	data := make([]v1.AttendanceRecord, 0, len(page.Items))
+	for _, attendance := range page.Items {
+		data = append(data, toAttendanceRecord(&attendance))
+	}
+
+	return &v1.AttendanceListResponse{
+		Data:       data,
+		Pagination: toCursorPagination(limit, page.NextCursor),
+	}, nil
+}
+
+func toAttendanceRecord(attendance *entity.Attendance) v1.AttendanceRecord {
+	record := v1.AttendanceRecord{
+		Id:           attendance.ID,
+		EmployeeId:   attendance.EmployeeID,
+		ClockInTime:  attendance.ClockInTime,
+		Corrected:    attendance.Corrected,
+		CorrectionId: attendance.CorrectionID,
+	}
+	if attendance.ClockOutTime != "" {
+		record.ClockOutTime = &attendance.ClockOutTime
+	}
+	return record
+}
@@ -0,0 +1,54 @@
+package search
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// ListAttendancePeriods lists attendance periods. A date range matches every
+// period that overlaps it.
+func (u *UsecaseImpl) ListAttendancePeriods(ctx context.Context, params v1.GetAdminAttendancePeriodsParams) (*v1.AttendancePeriodListResponse, error) {
+	query, limit, err := newQuery(params.Limit, params.Cursor, stringValue(params.Sort), stringValue(params.Order), "start_date", "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := dateRange(params.From, params.To)
+	if err != nil {
+		return nil, err
+	}
+	if dates.From != nil {
+		query.Where("end_date", repository.OpGreaterOrEqual, *dates.From)
+	}
+	if dates.To != nil {
+		query.Where("start_date", repository.OpLess, *dates.To)
+	}
+
+	page, err := u.attendancePeriodRepo.List(ctx, query, nil)
+	if err != nil {
+		return nil, listError(ctx, "attendance periods", err)
+	}
+
+	data := make([]v1.AttendancePeriodItem, 0, len(page.Items))
+	for _, period := range page.Items {
+		data = append(data, toAttendancePeriodItem(&period))
+	}
+
+	return &v1.AttendancePeriodListResponse{
+		Data:       data,
+		Pagination: toCursorPagination(limit, page.NextCursor),
+	}, nil
+}
+
+func toAttendancePeriodItem(period *entity.AttendancePeriod) v1.AttendancePeriodItem {
+	return v1.AttendancePeriodItem{
+		Id:        period.ID,
+		StartDate: openapi_types.Date{Time: period.StartDate},
+		EndDate:   openapi_types.Date{Time: period.EndDate},
+		CreatedAt: period.CreatedAt,
+	}
+}
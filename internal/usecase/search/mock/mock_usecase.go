@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/asyauqi15/payslip-system/internal/usecase/search (interfaces: Usecase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/search Usecase
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ListAttendancePeriods mocks base method.
+func (m *MockUsecase) ListAttendancePeriods(ctx context.Context, params v1.GetAdminAttendancePeriodsParams) (*v1.AttendancePeriodListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttendancePeriods", ctx, params)
+	ret0, _ := ret[0].(*v1.AttendancePeriodListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttendancePeriods indicates an expected call of ListAttendancePeriods.
+func (mr *MockUsecaseMockRecorder) ListAttendancePeriods(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttendancePeriods", reflect.TypeOf((*MockUsecase)(nil).ListAttendancePeriods), ctx, params)
+}
+
+// ListAttendances mocks base method.
+func (m *MockUsecase) ListAttendances(ctx context.Context, params v1.GetAdminAttendancesParams) (*v1.AttendanceListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttendances", ctx, params)
+	ret0, _ := ret[0].(*v1.AttendanceListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttendances indicates an expected call of ListAttendances.
+func (mr *MockUsecaseMockRecorder) ListAttendances(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttendances", reflect.TypeOf((*MockUsecase)(nil).ListAttendances), ctx, params)
+}
+
+// ListOvertimes mocks base method.
+func (m *MockUsecase) ListOvertimes(ctx context.Context, params v1.GetAdminOvertimesParams) (*v1.OvertimeListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOvertimes", ctx, params)
+	ret0, _ := ret[0].(*v1.OvertimeListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOvertimes indicates an expected call of ListOvertimes.
+func (mr *MockUsecaseMockRecorder) ListOvertimes(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOvertimes", reflect.TypeOf((*MockUsecase)(nil).ListOvertimes), ctx, params)
+}
+
+// ListPayrolls mocks base method.
+func (m *MockUsecase) ListPayrolls(ctx context.Context, params v1.GetAdminPayrollsParams) (*v1.PayrollListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPayrolls", ctx, params)
+	ret0, _ := ret[0].(*v1.PayrollListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPayrolls indicates an expected call of ListPayrolls.
+func (mr *MockUsecaseMockRecorder) ListPayrolls(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayrolls", reflect.TypeOf((*MockUsecase)(nil).ListPayrolls), ctx, params)
+}
+
+// ListReimbursements mocks base method.
+func (m *MockUsecase) ListReimbursements(ctx context.Context, params v1.GetAdminReimbursementsParams) (*v1.ReimbursementListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReimbursements", ctx, params)
+	ret0, _ := ret[0].(*v1.ReimbursementListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReimbursements indicates an expected call of ListReimbursements.
+func (mr *MockUsecaseMockRecorder) ListReimbursements(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReimbursements", reflect.TypeOf((*MockUsecase)(nil).ListReimbursements), ctx, params)
+}
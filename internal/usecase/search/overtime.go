@@ -0,0 +1,67 @@
+package search
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+func (u *UsecaseImpl) ListOvertimes(ctx context.Context, params v1.GetAdminOvertimesParams) (*v1.OvertimeListResponse, error) {
+	status := stringValue(params.Status)
+	// An empty status lists every status
+	if err := entity.ValidateApprovalStatusFilter(status); err != nil {
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+
+	query, limit, err := newQuery(params.Limit, params.Cursor, stringValue(params.Sort), stringValue(params.Order), "start_at", "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := dateRange(params.From, params.To)
+	if err != nil {
+		return nil, err
+	}
+	query.WhereDateRange("start_at", dates)
+	if params.EmployeeId != nil {
+		query.Where("employee_id", repository.OpEqual, *params.EmployeeId)
+	}
+	if status != "" {
+		query.Where("status", repository.OpEqual, status)
+	}
+
+	page, err := u.overtimeRepo.List(ctx, query, nil)
+	if err != nil {
+		return nil, listError(ctx, "overtimes", err)
+	}
+
+	data := make([]v1.OvertimeSubmission, 0, len(page.Items))
+	for _, overtime := range page.Items {
+		data = append(data, toOvertimeSubmission(&overtime))
+	}
+
+	return &v1.OvertimeListResponse{
+		Data:       data,
+		Pagination: toCursorPagination(limit, page.NextCursor),
+	}, nil
+}
+
+func toOvertimeSubmission(overtime *entity.Overtime) v1.OvertimeSubmission {
+	submission := v1.OvertimeSubmission{
+		Id:          overtime.ID,
+		EmployeeId:  overtime.EmployeeID,
+		StartTime:   overtime.StartAt,
+		EndTime:     overtime.EndAt,
+		Description: overtime.Description,
+		Status:      v1.OvertimeSubmissionStatus(overtime.Status),
+		ReviewedBy:  overtime.ReviewedBy,
+		ReviewedAt:  overtime.ReviewedAt,
+	}
+	if overtime.ReviewNote != "" {
+		submission.ReviewNote = &overtime.ReviewNote
+	}
+	return submission
+}
@@ -0,0 +1,57 @@
+package search
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+// ListPayrolls lists payrolls. The date range applies to when the payroll
+// was run.
+func (u *UsecaseImpl) ListPayrolls(ctx context.Context, params v1.GetAdminPayrollsParams) (*v1.PayrollListResponse, error) {
+	query, limit, err := newQuery(params.Limit, params.Cursor, stringValue(params.Sort), stringValue(params.Order), "created_at", "total_payroll")
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := dateRange(params.From, params.To)
+	if err != nil {
+		return nil, err
+	}
+	query.WhereDateRange("created_at", dates)
+	if params.AttendancePeriodId != nil {
+		query.Where("attendance_period_id", repository.OpEqual, *params.AttendancePeriodId)
+	}
+
+	page, err := u.payrollRepo.List(ctx, query, nil)
+	if err != nil {
+		return nil, listError(ctx, "payrolls", err)
+	}
+
+	data := make([]v1.PayrollItem, 0, len(page.Items))
+	for _, payroll := range page.Items {
+		data = append(data, toPayrollItem(&payroll))
+	}
+
+	return &v1.PayrollListResponse{
+		Data:       data,
+		Pagination: toCursorPagination(limit, page.NextCursor),
+	}, nil
+}
+
+func toPayrollItem(payroll *entity.Payroll) v1.PayrollItem {
+	return v1.PayrollItem{
+		Id:                     payroll.ID,
+		AttendancePeriodId:     payroll.AttendancePeriodID,
+		Status:                 v1.PayrollStatus(payroll.Status),
+		FinalizedAt:            payroll.FinalizedAt,
+		EmployeesCount:         payroll.TotalEmployees,
+		TotalPayroll:           payroll.TotalPayroll,
+		TotalReimbursementsPay: payroll.TotalReimbursement,
+		TotalOvertimePay:       payroll.TotalOvertime,
+		ReportingCurrency:      payroll.ReportingCurrency,
+		CreatedAt:              payroll.CreatedAt,
+	}
+}
@@ -0,0 +1,99 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// newQuery builds the paging and sorting shared by the listings. The sort
+// fields double as column names, so only the listed ones are accepted; the
+// first is the default.
+func newQuery(limit *int, cursor *string, sort, order string, sortFields ...string) (*repository.Query, int, error) {
+	pageSize := defaultLimit
+	if limit != nil {
+		if *limit < 1 || *limit > maxLimit {
+			return nil, 0, httppkg.NewBadRequestError("limit must be between 1 and 100")
+		}
+		pageSize = *limit
+	}
+
+	if sort == "" {
+		sort = sortFields[0]
+	}
+	if !slices.Contains(sortFields, sort) {
+		return nil, 0, httppkg.NewBadRequestError("invalid sort field")
+	}
+
+	var desc bool
+	switch order {
+	case "", "desc":
+		desc = true
+	case "asc":
+		desc = false
+	default:
+		return nil, 0, httppkg.NewBadRequestError("order must be asc or desc")
+	}
+
+	query := repository.NewQuery().OrderBy(sort, desc).Limit(pageSize)
+	if cursor != nil && *cursor != "" {
+		query.After(*cursor)
+	}
+
+	return query, pageSize, nil
+}
+
+// dateRange turns the inclusive from and to dates into a range whose end is
+// exclusive.
+func dateRange(from, to *openapi_types.Date) (repository.DateRange, error) {
+	var dates repository.DateRange
+
+	if from != nil && to != nil && to.Time.Before(from.Time) {
+		return dates, httppkg.NewBadRequestError("from must not be after to")
+	}
+	if from != nil {
+		dates.From = &from.Time
+	}
+	if to != nil {
+		end := to.Time.AddDate(0, 0, 1)
+		dates.To = &end
+	}
+
+	return dates, nil
+}
+
+func listError(ctx context.Context, resource string, err error) error {
+	if errors.Is(err, repository.ErrInvalidCursor) {
+		return httppkg.NewBadRequestError("invalid cursor")
+	}
+
+	logger.Error(ctx, "failed to list "+resource, "error", err)
+	return httppkg.NewInternalServerError("failed to list " + resource)
+}
+
+func toCursorPagination(limit int, nextCursor string) v1.CursorPagination {
+	pagination := v1.CursorPagination{Limit: limit}
+	if nextCursor != "" {
+		pagination.NextCursor = &nextCursor
+	}
+	return pagination
+}
+
+// stringValue reads an optional enum parameter, empty when it is absent.
+func stringValue[S ~string](value *S) string {
+	if value == nil {
+		return ""
+	}
+	return string(*value)
+}
@@ -0,0 +1,69 @@
+package search
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (u *UsecaseImpl) ListReimbursements(ctx context.Context, params v1.GetAdminReimbursementsParams) (*v1.ReimbursementListResponse, error) {
+	status := stringValue(params.Status)
+	// An empty status lists every status
+	if err := entity.ValidateApprovalStatusFilter(status); err != nil {
+		return nil, httppkg.NewBadRequestError(err.Error())
+	}
+
+	query, limit, err := newQuery(params.Limit, params.Cursor, stringValue(params.Sort), stringValue(params.Order), "date", "amount", "created_at")
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := dateRange(params.From, params.To)
+	if err != nil {
+		return nil, err
+	}
+	query.WhereDateRange("date", dates)
+	if params.EmployeeId != nil {
+		query.Where("employee_id", repository.OpEqual, *params.EmployeeId)
+	}
+	if status != "" {
+		query.Where("status", repository.OpEqual, status)
+	}
+
+	page, err := u.reimbursementRepo.List(ctx, query, nil)
+	if err != nil {
+		return nil, listError(ctx, "reimbursements", err)
+	}
+
+	data := make([]v1.ReimbursementSubmission, 0, len(page.Items))
+	for _, reimbursement := range page.Items {
+		data = append(data, toReimbursementSubmission(&reimbursement))
+	}
+
+	return &v1.ReimbursementListResponse{
+		Data:       data,
+		Pagination: toCursorPagination(limit, page.NextCursor),
+	}, nil
+}
+
+func toReimbursementSubmission(reimbursement *entity.Reimbursement) v1.ReimbursementSubmission {
+	submission := v1.ReimbursementSubmission{
+		Id:          reimbursement.ID,
+		EmployeeId:  reimbursement.EmployeeID,
+		Date:        openapi_types.Date{Time: reimbursement.Date},
+		Amount:      reimbursement.Amount,
+		Currency:    reimbursement.Currency,
+		Description: reimbursement.Description,
+		Status:      v1.ReimbursementSubmissionStatus(reimbursement.Status),
+		ReviewedBy:  reimbursement.ReviewedBy,
+		ReviewedAt:  reimbursement.ReviewedAt,
+	}
+	if reimbursement.ReviewNote != "" {
+		submission.ReviewNote = &reimbursement.ReviewNote
+	}
+	return submission
+}
@@ -0,0 +1,221 @@
+package search_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	"github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/search"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSearchUsecase_ListOvertimes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+
+	usecase := search.NewUsecase(mockAttendancePeriodRepo, mockPayrollRepo, mockAttendanceRepo, mockOvertimeRepo, mockReimbursementRepo)
+
+	ctx := context.Background()
+	from := openapi_types.Date{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	to := openapi_types.Date{Time: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)}
+	startTime := time.Date(2025, 1, 10, 18, 0, 0, 0, time.UTC)
+
+	limit := 10
+	tooLarge := 101
+	employeeID := int64(2)
+	cursor := "next"
+	pending := v1.GetAdminOvertimesParamsStatusPending
+	createdAt := v1.GetAdminOvertimesParamsSortCreatedAt
+	asc := v1.GetAdminOvertimesParamsOrderAsc
+	invalidSort := v1.GetAdminOvertimesParamsSort("description")
+	invalidStatus := v1.GetAdminOvertimesParamsStatus("cancelled")
+
+	tests := []struct {
+		name           string
+		params         v1.GetAdminOvertimesParams
+		setupMock      func()
+		expectError    bool
+		expectedStatus int
+		expectedCursor *string
+	}{
+		{
+			name: "filters, sort and cursor",
+			params: v1.GetAdminOvertimesParams{
+				Limit:      &limit,
+				Cursor:     &cursor,
+				Sort:       &createdAt,
+				Order:      &asc,
+				EmployeeId: &employeeID,
+				Status:     &pending,
+				From:       &from,
+				To:         &to,
+			},
+			setupMock: func() {
+				end := to.Time.AddDate(0, 0, 1)
+				expected := repository.NewQuery().
+					OrderBy("created_at", false).
+					Limit(10).
+					After("next").
+					WhereDateRange("start_at", repository.DateRange{From: &from.Time, To: &end}).
+					Where("employee_id", repository.OpEqual, int64(2)).
+					Where("status", repository.OpEqual, entity.ApprovalStatusPending)
+				mockOvertimeRepo.EXPECT().
+					List(gomock.Any(), expected, nil).
+					Return(&repository.CursorPage[entity.Overtime]{
+						Items: []entity.Overtime{{
+							Base:       entity.Base{ID: 7},
+							EmployeeID: 2,
+							StartAt:    startTime,
+							EndAt:      startTime.Add(2 * time.Hour),
+							Status:     entity.ApprovalStatusPending,
+						}},
+						NextCursor: "after-7",
+					}, nil)
+			},
+			expectedCursor: func() *string { s := "after-7"; return &s }(),
+		},
+		{
+			name:   "defaults to newest start first",
+			params: v1.GetAdminOvertimesParams{},
+			setupMock: func() {
+				mockOvertimeRepo.EXPECT().
+					List(gomock.Any(), repository.NewQuery().OrderBy("start_at", true).Limit(20), nil).
+					Return(&repository.CursorPage[entity.Overtime]{}, nil)
+			},
+		},
+		{
+			name:           "limit above maximum",
+			params:         v1.GetAdminOvertimesParams{Limit: &tooLarge},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:           "unknown status",
+			params:         v1.GetAdminOvertimesParams{Status: &invalidStatus},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:           "unsupported sort field",
+			params:         v1.GetAdminOvertimesParams{Sort: &invalidSort},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:           "from after to",
+			params:         v1.GetAdminOvertimesParams{From: &to, To: &from},
+			setupMock:      func() {},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:   "invalid cursor",
+			params: v1.GetAdminOvertimesParams{Cursor: &cursor},
+			setupMock: func() {
+				mockOvertimeRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), nil).
+					Return(nil, repository.ErrInvalidCursor)
+			},
+			expectError:    true,
+			expectedStatus: 400,
+		},
+		{
+			name:   "database error",
+			params: v1.GetAdminOvertimesParams{},
+			setupMock: func() {
+				mockOvertimeRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), nil).
+					Return(nil, errors.New("connection refused"))
+			},
+			expectError:    true,
+			expectedStatus: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			result, err := usecase.ListOvertimes(ctx, tt.params)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				var httpErr *httppkg.ErrorWrapper
+				if errors.As(err, &httpErr) && httpErr.StatusCode != tt.expectedStatus {
+					t.Errorf("Expected status %d but got %d", tt.expectedStatus, httpErr.StatusCode)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %v", err)
+				}
+				if result.Data == nil {
+					t.Error("Expected a non-nil data slice")
+				}
+				if (tt.expectedCursor == nil) != (result.Pagination.NextCursor == nil) ||
+					(tt.expectedCursor != nil && *tt.expectedCursor != *result.Pagination.NextCursor) {
+					t.Errorf("Unexpected next cursor: %v", result.Pagination.NextCursor)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchUsecase_ListAttendancePeriods(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAttendancePeriodRepo := mock.NewMockAttendancePeriodRepository(ctrl)
+	mockPayrollRepo := mock.NewMockPayrollRepository(ctrl)
+	mockAttendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	mockOvertimeRepo := mock.NewMockOvertimeRepository(ctrl)
+	mockReimbursementRepo := mock.NewMockReimbursementRepository(ctrl)
+
+	usecase := search.NewUsecase(mockAttendancePeriodRepo, mockPayrollRepo, mockAttendanceRepo, mockOvertimeRepo, mockReimbursementRepo)
+
+	from := openapi_types.Date{Time: time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)}
+	to := openapi_types.Date{Time: time.Date(2025, 2, 20, 0, 0, 0, 0, time.UTC)}
+	periodStart := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+
+	// A range matches every period that overlaps it
+	end := to.Time.AddDate(0, 0, 1)
+	expected := repository.NewQuery().
+		OrderBy("start_date", true).
+		Limit(20).
+		Where("end_date", repository.OpGreaterOrEqual, from.Time).
+		Where("start_date", repository.OpLess, end)
+	mockAttendancePeriodRepo.EXPECT().
+		List(gomock.Any(), expected, nil).
+		Return(&repository.CursorPage[entity.AttendancePeriod]{
+			Items: []entity.AttendancePeriod{{Base: entity.Base{ID: 3}, StartDate: periodStart, EndDate: periodEnd}},
+		}, nil)
+
+	result, err := usecase.ListAttendancePeriods(context.Background(), v1.GetAdminAttendancePeriodsParams{From: &from, To: &to})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if len(result.Data) != 1 || result.Data[0].Id != 3 || !result.Data[0].EndDate.Time.Equal(periodEnd) {
+		t.Errorf("Unexpected periods: %+v", result.Data)
+	}
+	if result.Pagination.Limit != 20 || result.Pagination.NextCursor != nil {
+		t.Errorf("Unexpected pagination: %+v", result.Pagination)
+	}
+}
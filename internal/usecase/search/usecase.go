@@ -0,0 +1,41 @@
+package search
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+//go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/search Usecase
+type Usecase interface {
+	ListAttendancePeriods(ctx context.Context, params v1.GetAdminAttendancePeriodsParams) (*v1.AttendancePeriodListResponse, error)
+	ListPayrolls(ctx context.Context, params v1.GetAdminPayrollsParams) (*v1.PayrollListResponse, error)
+	ListAttendances(ctx context.Context, params v1.GetAdminAttendancesParams) (*v1.AttendanceListResponse, error)
+	ListOvertimes(ctx context.Context, params v1.GetAdminOvertimesParams) (*v1.OvertimeListResponse, error)
+	ListReimbursements(ctx context.Context, params v1.GetAdminReimbursementsParams) (*v1.ReimbursementListResponse, error)
+}
+
+type UsecaseImpl struct {
+	attendancePeriodRepo repository.AttendancePeriodRepository
+	payrollRepo          repository.PayrollRepository
+	attendanceRepo       repository.AttendanceRepository
+	overtimeRepo         repository.OvertimeRepository
+	reimbursementRepo    repository.ReimbursementRepository
+}
+
+func NewUsecase(
+	attendancePeriodRepo repository.AttendancePeriodRepository,
+	payrollRepo repository.PayrollRepository,
+	attendanceRepo repository.AttendanceRepository,
+	overtimeRepo repository.OvertimeRepository,
+	reimbursementRepo repository.ReimbursementRepository,
+) Usecase {
+	return &UsecaseImpl{
+		attendancePeriodRepo: attendancePeriodRepo,
+		payrollRepo:          payrollRepo,
+		attendanceRepo:       attendanceRepo,
+		overtimeRepo:         overtimeRepo,
+		reimbursementRepo:    reimbursementRepo,
+	}
+}
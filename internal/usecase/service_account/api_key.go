@@ -0,0 +1,213 @@
+package service_account
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+// apiKeyPrefix marks API keys so they are recognizable in configuration
+// files and secret scanners.
+const apiKeyPrefix = "psk_"
+
+// CreateAPIKey issues a key for a service account. The full key is only
+// returned here; afterwards it is identified by its prefix.
+func (u *UsecaseImpl) CreateAPIKey(ctx context.Context, serviceAccountID int64, req v1.APIKeyRequest) (*v1.APIKeyCreatedResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, httppkg.NewBadRequestError("api key name is required")
+	}
+
+	scopes, err := u.normalizeScopes(ctx, req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		return nil, httppkg.NewBadRequestError("expires_at must be in the future")
+	}
+
+	account, err := u.findServiceAccount(ctx, serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, secret, err := newAPIKey()
+	if err != nil {
+		logger.Error(ctx, "failed to generate api key", "user_id", account.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to create api key")
+	}
+
+	apiKey := &entity.APIKey{
+		UserID:     account.ID,
+		Name:       name,
+		Prefix:     prefix,
+		SecretHash: hashSecret(secret),
+		ExpiresAt:  req.ExpiresAt,
+		CreatedBy:  cast.ToInt64(ctx.Value(constant.ContextKeyUserID)),
+	}
+	if err := u.apiKeyRepo.CreateWithScopes(ctx, apiKey, scopes, nil); err != nil {
+		logger.Error(ctx, "failed to create api key", "user_id", account.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to create api key")
+	}
+
+	logger.Info(ctx, "api key created", "user_id", account.ID, "api_key_id", apiKey.ID, "prefix", prefix, "scopes", scopes)
+
+	return &v1.APIKeyCreatedResponse{
+		ApiKey: toAPIKeyResponse(apiKey, scopes),
+		Key:    prefix + "." + secret,
+	}, nil
+}
+
+func (u *UsecaseImpl) ListAPIKeys(ctx context.Context, serviceAccountID int64) ([]v1.APIKey, error) {
+	account, err := u.findServiceAccount(ctx, serviceAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := u.apiKeyRepo.FindByTemplate(ctx, &entity.APIKey{UserID: account.ID}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to list api keys", "user_id", account.ID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to list api keys")
+	}
+
+	response := make([]v1.APIKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		scopes, err := u.findScopes(ctx, apiKey.ID)
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, toAPIKeyResponse(&apiKey, scopes))
+	}
+
+	return response, nil
+}
+
+// RevokeAPIKey stops a key from authenticating further requests.
+func (u *UsecaseImpl) RevokeAPIKey(ctx context.Context, serviceAccountID int64, apiKeyID int64) error {
+	account, err := u.findServiceAccount(ctx, serviceAccountID)
+	if err != nil {
+		return err
+	}
+
+	apiKey, err := u.apiKeyRepo.FindByID(ctx, uint(apiKeyID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find api key", "api_key_id", apiKeyID, "error", err)
+		return httppkg.NewInternalServerError("failed to find api key")
+	}
+	if apiKey == nil || apiKey.UserID != account.ID {
+		return httppkg.NewNotFoundError("api key not found")
+	}
+
+	revoked, err := u.apiKeyRepo.Revoke(ctx, apiKey.ID, time.Now(), nil)
+	if err != nil {
+		logger.Error(ctx, "failed to revoke api key", "api_key_id", apiKey.ID, "error", err)
+		return httppkg.NewInternalServerError("failed to revoke api key")
+	}
+	if !revoked {
+		return httppkg.NewConflictError("api key is already revoked")
+	}
+
+	u.audit(ctx, apiKey, entity.AuditLogActionAPIKeyRevoke, entity.JSONMap{"prefix": apiKey.Prefix}, cast.ToString(ctx.Value(constant.ContextKeyUserID)))
+
+	logger.Info(ctx, "api key revoked", "user_id", account.ID, "api_key_id", apiKey.ID)
+
+	return nil
+}
+
+// normalizeScopes rejects unknown scopes and scopes the caller does not hold
+// itself, so a key can never grant more than its creator has.
+func (u *UsecaseImpl) normalizeScopes(ctx context.Context, scopes []string) ([]string, error) {
+	granted := cast.ToStringSlice(ctx.Value(constant.ContextKeyUserPermissions))
+
+	normalized := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !entity.IsValidPermission(scope) {
+			return nil, httppkg.NewBadRequestError("unknown scope: " + scope)
+		}
+		if !entity.HasPermission(granted, scope) {
+			return nil, httppkg.NewForbiddenError("cannot grant a scope you do not have: " + scope)
+		}
+		if !slices.Contains(normalized, scope) {
+			normalized = append(normalized, scope)
+		}
+	}
+	if len(normalized) == 0 {
+		return nil, httppkg.NewBadRequestError("at least one scope is required")
+	}
+	slices.Sort(normalized)
+
+	return normalized, nil
+}
+
+func (u *UsecaseImpl) findScopes(ctx context.Context, apiKeyID int64) ([]string, error) {
+	scopes, err := u.apiKeyRepo.FindScopes(ctx, apiKeyID, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find api key scopes", "api_key_id", apiKeyID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find api key scopes")
+	}
+
+	return scopes, nil
+}
+
+func (u *UsecaseImpl) audit(ctx context.Context, apiKey *entity.APIKey, action string, data entity.JSONMap, userID string) {
+	err := u.auditLogRepo.Create(ctx, &entity.AuditLog{
+		TableName: "api_keys",
+		RecordID:  apiKey.ID,
+		Action:    action,
+		DataAfter: data,
+		UserID:    userID,
+		IPAddress: cast.ToString(ctx.Value(constant.ContextKeyIPAddress)),
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to write api key audit log", "api_key_id", apiKey.ID, "action", action, "error", err)
+	}
+}
+
+// newAPIKey returns a random key prefix and secret.
+func newAPIKey() (string, string, error) {
+	prefix := make([]byte, 6)
+	if _, err := rand.Read(prefix); err != nil {
+		return "", "", err
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+
+	return apiKeyPrefix + hex.EncodeToString(prefix), base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func toAPIKeyResponse(apiKey *entity.APIKey, scopes []string) v1.APIKey {
+	return v1.APIKey{
+		Id:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scopes:     scopes,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
@@ -0,0 +1,79 @@
+package service_account
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	"gorm.io/gorm"
+)
+
+// Principal is the service account a request was authenticated as. Its
+// permissions are the scopes of the API key.
+type Principal struct {
+	UserID      int64
+	Username    string
+	Role        string
+	Permissions []string
+}
+
+// AuthenticateAPIKey resolves the service account of an API key. Every
+// authenticated request is recorded in the audit log under the service
+// account together with the key that was used.
+func (u *UsecaseImpl) AuthenticateAPIKey(ctx context.Context, key string, method string, path string) (*Principal, error) {
+	prefix, secret, ok := strings.Cut(key, ".")
+	if !ok || !strings.HasPrefix(prefix, apiKeyPrefix) || secret == "" {
+		return nil, httppkg.NewUnauthorizedError("invalid api key")
+	}
+
+	apiKey, err := u.apiKeyRepo.FindOneByTemplate(ctx, &entity.APIKey{Prefix: prefix}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to find api key", "prefix", prefix, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to authenticate api key")
+	}
+	if apiKey == nil || subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(apiKey.SecretHash)) != 1 {
+		return nil, httppkg.NewUnauthorizedError("invalid api key")
+	}
+
+	now := time.Now()
+	if !apiKey.Active(now) {
+		return nil, httppkg.NewUnauthorizedError("api key has been revoked or has expired")
+	}
+
+	user, err := u.userRepo.FindByID(ctx, uint(apiKey.UserID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find user", "user_id", apiKey.UserID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to authenticate api key")
+	}
+	if user == nil || !user.IsServiceAccount {
+		return nil, httppkg.NewUnauthorizedError("invalid api key")
+	}
+
+	scopes, err := u.findScopes(ctx, apiKey.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Failing to record usage does not fail the request
+	if err := u.apiKeyRepo.MarkUsed(ctx, apiKey.ID, now, nil); err != nil {
+		logger.Error(ctx, "failed to record api key usage", "api_key_id", apiKey.ID, "error", err)
+	}
+	u.audit(ctx, apiKey, entity.AuditLogActionAPIKeyRequest, entity.JSONMap{
+		"prefix": apiKey.Prefix,
+		"method": method,
+		"path":   path,
+	}, strconv.FormatInt(user.ID, 10))
+
+	return &Principal{
+		UserID:      user.ID,
+		Username:    user.Username,
+		Role:        user.Role,
+		Permissions: scopes,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package service_account
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	httppkg "github.com/asyauqi15/payslip-system/pkg/http"
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"gorm.io/gorm"
+)
+
+// CreateServiceAccount creates a user for a machine integration. It has no
+// password and no role permissions, so it can only act through API keys.
+func (u *UsecaseImpl) CreateServiceAccount(ctx context.Context, req v1.ServiceAccountRequest) (*v1.ServiceAccount, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, httppkg.NewBadRequestError("service account name is required")
+	}
+
+	existing, err := u.userRepo.FindOneByTemplate(ctx, &entity.User{Username: name}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to check existing user", "username", name, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to check existing user")
+	}
+	if existing != nil {
+		return nil, httppkg.NewConflictError("username already exists")
+	}
+
+	created, err := u.userRepo.Create(ctx, &entity.User{
+		Username:         name,
+		Role:             entity.UserRoleServiceAccount,
+		IsServiceAccount: true,
+	}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to create service account", "name", name, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to create service account")
+	}
+
+	logger.Info(ctx, "service account created", "user_id", created.ID, "name", name)
+
+	response := toServiceAccountResponse(created)
+	return &response, nil
+}
+
+func (u *UsecaseImpl) ListServiceAccounts(ctx context.Context) ([]v1.ServiceAccount, error) {
+	users, err := u.userRepo.FindByTemplate(ctx, &entity.User{IsServiceAccount: true}, nil)
+	if err != nil {
+		logger.Error(ctx, "failed to list service accounts", "error", err)
+		return nil, httppkg.NewInternalServerError("failed to list service accounts")
+	}
+
+	response := make([]v1.ServiceAccount, 0, len(users))
+	for _, user := range users {
+		response = append(response, toServiceAccountResponse(&user))
+	}
+
+	return response, nil
+}
+
+func (u *UsecaseImpl) findServiceAccount(ctx context.Context, serviceAccountID int64) (*entity.User, error) {
+	user, err := u.userRepo.FindByID(ctx, uint(serviceAccountID), nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.Error(ctx, "failed to find user", "user_id", serviceAccountID, "error", err)
+		return nil, httppkg.NewInternalServerError("failed to find service account")
+	}
+	if user == nil || !user.IsServiceAccount {
+		return nil, httppkg.NewNotFoundError("service account not found")
+	}
+
+	return user, nil
+}
+
+func toServiceAccountResponse(user *entity.User) v1.ServiceAccount {
+	return v1.ServiceAccount{
+		Id:        user.ID,
+		Name:      user.Username,
+		CreatedAt: user.CreatedAt,
+	}
+}
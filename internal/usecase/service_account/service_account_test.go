@@ -0,0 +1,391 @@
+package service_account_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal/constant"
+	"github.com/asyauqi15/payslip-system/internal/entity"
+	"github.com/asyauqi15/payslip-system/internal/repository/mock"
+	"github.com/asyauqi15/payslip-system/internal/usecase/service_account"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+type serviceAccountMocks struct {
+	userRepo     *mock.MockUserRepository
+	apiKeyRepo   *mock.MockAPIKeyRepository
+	auditLogRepo *mock.MockAuditLogRepository
+}
+
+func newServiceAccountUsecase(ctrl *gomock.Controller) (service_account.Usecase, *serviceAccountMocks) {
+	m := &serviceAccountMocks{
+		userRepo:     mock.NewMockUserRepository(ctrl),
+		apiKeyRepo:   mock.NewMockAPIKeyRepository(ctrl),
+		auditLogRepo: mock.NewMockAuditLogRepository(ctrl),
+	}
+
+	return service_account.NewUsecase(m.userRepo, m.apiKeyRepo, m.auditLogRepo), m
+}
+
+// adminContext is the context of an admin holding the given permissions.
+func adminContext(permissions ...string) context.Context {
+	ctx := context.WithValue(context.Background(), constant.ContextKeyUserID, "1")
+	return context.WithValue(ctx, constant.ContextKeyUserPermissions, permissions)
+}
+
+func expectStatus(t *testing.T, err error, status int) {
+	t.Helper()
+
+	if status == 0 {
+		if err != nil {
+			t.Errorf("Expected no error but got: %v", err)
+		}
+		return
+	}
+
+	var httpErr interface{ HTTPStatus() int }
+	if !errors.As(err, &httpErr) || httpErr.HTTPStatus() != status {
+		t.Errorf("Expected status %d but got error: %v", status, err)
+	}
+}
+
+func TestServiceAccountUsecase_CreateServiceAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, m := newServiceAccountUsecase(ctrl)
+	ctx := adminContext(entity.PermissionServiceAccountManage)
+
+	t.Run("service account created without password", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.User{Username: "hris-sync"}, nil).
+			Return(nil, nil)
+		m.userRepo.EXPECT().
+			Create(gomock.Any(), &entity.User{Username: "hris-sync", Role: entity.UserRoleServiceAccount, IsServiceAccount: true}, nil).
+			DoAndReturn(func(_ context.Context, user *entity.User, _ *gorm.DB) (*entity.User, error) {
+				user.ID = 30
+				return user, nil
+			})
+
+		account, err := usecase.CreateServiceAccount(ctx, v1.ServiceAccountRequest{Name: " hris-sync "})
+		expectStatus(t, err, 0)
+		if account == nil || account.Id != 30 || account.Name != "hris-sync" {
+			t.Errorf("Unexpected service account: %+v", account)
+		}
+	})
+
+	t.Run("name taken", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindOneByTemplate(gomock.Any(), &entity.User{Username: "hris-sync"}, nil).
+			Return(&entity.User{Base: entity.Base{ID: 30}, Username: "hris-sync"}, nil)
+
+		_, err := usecase.CreateServiceAccount(ctx, v1.ServiceAccountRequest{Name: "hris-sync"})
+		expectStatus(t, err, http.StatusConflict)
+	})
+
+	t.Run("name missing", func(t *testing.T) {
+		_, err := usecase.CreateServiceAccount(ctx, v1.ServiceAccountRequest{Name: "  "})
+		expectStatus(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestServiceAccountUsecase_CreateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, m := newServiceAccountUsecase(ctrl)
+	ctx := adminContext(entity.PermissionServiceAccountManage, entity.PermissionPayrollRead, entity.PermissionDepartmentRead)
+	account := &entity.User{Base: entity.Base{ID: 30}, Username: "hris-sync", Role: entity.UserRoleServiceAccount, IsServiceAccount: true}
+
+	t.Run("key stored hashed with sorted scopes", func(t *testing.T) {
+		var stored *entity.APIKey
+
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(30), nil).
+			Return(account, nil)
+		m.apiKeyRepo.EXPECT().
+			CreateWithScopes(gomock.Any(), gomock.Any(), []string{entity.PermissionDepartmentRead, entity.PermissionPayrollRead}, nil).
+			DoAndReturn(func(_ context.Context, key *entity.APIKey, _ []string, _ *gorm.DB) error {
+				key.ID = 4
+				stored = key
+				return nil
+			})
+
+		expiresAt := time.Now().Add(24 * time.Hour)
+		result, err := usecase.CreateAPIKey(ctx, 30, v1.APIKeyRequest{
+			Name:      "payroll export",
+			Scopes:    []string{entity.PermissionPayrollRead, entity.PermissionDepartmentRead, entity.PermissionPayrollRead},
+			ExpiresAt: &expiresAt,
+		})
+		expectStatus(t, err, 0)
+		if result == nil || stored == nil {
+			t.Fatal("Expected a created key")
+		}
+
+		prefix, secret, ok := strings.Cut(result.Key, ".")
+		if !ok || prefix != stored.Prefix || !strings.HasPrefix(prefix, "psk_") {
+			t.Errorf("Unexpected key %q for prefix %q", result.Key, stored.Prefix)
+		}
+		if stored.SecretHash == "" || strings.Contains(stored.SecretHash, secret) {
+			t.Error("Only the hash of the secret must be stored")
+		}
+		if stored.UserID != 30 || stored.CreatedBy != 1 || stored.ExpiresAt == nil {
+			t.Errorf("Unexpected stored key: %+v", stored)
+		}
+		if result.ApiKey.Id != 4 || len(result.ApiKey.Scopes) != 2 {
+			t.Errorf("Unexpected key response: %+v", result.ApiKey)
+		}
+	})
+
+	t.Run("scope the caller does not hold", func(t *testing.T) {
+		_, err := usecase.CreateAPIKey(ctx, 30, v1.APIKeyRequest{
+			Name:   "payroll runner",
+			Scopes: []string{entity.PermissionPayrollRun},
+		})
+		expectStatus(t, err, http.StatusForbidden)
+	})
+
+	t.Run("unknown scope", func(t *testing.T) {
+		_, err := usecase.CreateAPIKey(ctx, 30, v1.APIKeyRequest{
+			Name:   "payroll export",
+			Scopes: []string{"payroll:delete"},
+		})
+		expectStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("no scopes", func(t *testing.T) {
+		_, err := usecase.CreateAPIKey(ctx, 30, v1.APIKeyRequest{Name: "payroll export"})
+		expectStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("expiry in the past", func(t *testing.T) {
+		expiresAt := time.Now().Add(-time.Hour)
+		_, err := usecase.CreateAPIKey(ctx, 30, v1.APIKeyRequest{
+			Name:      "payroll export",
+			Scopes:    []string{entity.PermissionPayrollRead},
+			ExpiresAt: &expiresAt,
+		})
+		expectStatus(t, err, http.StatusBadRequest)
+	})
+
+	t.Run("user is not a service account", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(7), nil).
+			Return(&entity.User{Base: entity.Base{ID: 7}, Username: "employee006", Role: entity.UserRoleDefault}, nil)
+
+		_, err := usecase.CreateAPIKey(ctx, 7, v1.APIKeyRequest{
+			Name:   "payroll export",
+			Scopes: []string{entity.PermissionPayrollRead},
+		})
+		expectStatus(t, err, http.StatusNotFound)
+	})
+}
+
+func TestServiceAccountUsecase_RevokeAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, m := newServiceAccountUsecase(ctrl)
+	ctx := adminContext(entity.PermissionServiceAccountManage)
+	account := &entity.User{Base: entity.Base{ID: 30}, Username: "hris-sync", IsServiceAccount: true}
+	apiKey := &entity.APIKey{Base: entity.Base{ID: 4}, UserID: 30, Prefix: "psk_0123456789ab"}
+
+	t.Run("key revoked and audited", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(30), nil).
+			Return(account, nil)
+		m.apiKeyRepo.EXPECT().
+			FindByID(gomock.Any(), uint(4), nil).
+			Return(apiKey, nil)
+		m.apiKeyRepo.EXPECT().
+			Revoke(gomock.Any(), int64(4), gomock.Any(), nil).
+			Return(true, nil)
+		m.auditLogRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any(), nil).
+			DoAndReturn(func(_ context.Context, log *entity.AuditLog, _ *gorm.DB) error {
+				if log.Action != entity.AuditLogActionAPIKeyRevoke || log.RecordID != 4 || log.UserID != "1" {
+					t.Errorf("Unexpected audit log: %+v", log)
+				}
+				return nil
+			})
+
+		expectStatus(t, usecase.RevokeAPIKey(ctx, 30, 4), 0)
+	})
+
+	t.Run("key of another service account", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(30), nil).
+			Return(account, nil)
+		m.apiKeyRepo.EXPECT().
+			FindByID(gomock.Any(), uint(9), nil).
+			Return(&entity.APIKey{Base: entity.Base{ID: 9}, UserID: 31, Prefix: "psk_ba9876543210"}, nil)
+
+		expectStatus(t, usecase.RevokeAPIKey(ctx, 30, 9), http.StatusNotFound)
+	})
+
+	t.Run("key not found", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(30), nil).
+			Return(account, nil)
+		m.apiKeyRepo.EXPECT().
+			FindByID(gomock.Any(), uint(10), nil).
+			Return(nil, gorm.ErrRecordNotFound)
+
+		expectStatus(t, usecase.RevokeAPIKey(ctx, 30, 10), http.StatusNotFound)
+	})
+
+	t.Run("already revoked", func(t *testing.T) {
+		m.userRepo.EXPECT().
+			FindByID(gomock.Any(), uint(30), nil).
+			Return(account, nil)
+		m.apiKeyRepo.EXPECT().
+			FindByID(gomock.Any(), uint(4), nil).
+			Return(apiKey, nil)
+		m.apiKeyRepo.EXPECT().
+			Revoke(gomock.Any(), int64(4), gomock.Any(), nil).
+			Return(false, nil)
+
+		expectStatus(t, usecase.RevokeAPIKey(ctx, 30, 4), http.StatusConflict)
+	})
+}
+
+func TestServiceAccountUsecase_AuthenticateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase, m := newServiceAccountUsecase(ctrl)
+	account := &entity.User{Base: entity.Base{ID: 30}, Username: "hris-sync", Role: entity.UserRoleServiceAccount, IsServiceAccount: true}
+
+	// Issue a real key so its stored hash can be looked up below
+	var stored entity.APIKey
+	m.userRepo.EXPECT().
+		FindByID(gomock.Any(), uint(30), nil).
+		Return(account, nil)
+	m.apiKeyRepo.EXPECT().
+		CreateWithScopes(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+		DoAndReturn(func(_ context.Context, key *entity.APIKey, _ []string, _ *gorm.DB) error {
+			key.ID = 4
+			stored = *key
+			return nil
+		})
+	created, err := usecase.CreateAPIKey(adminContext(entity.PermissionPayrollRead), 30, v1.APIKeyRequest{
+		Name:   "payroll export",
+		Scopes: []string{entity.PermissionPayrollRead},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create api key: %v", err)
+	}
+	key := created.Key
+	prefix, _, _ := strings.Cut(key, ".")
+
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name           string
+		key            string
+		setupMock      func()
+		expectedStatus int
+	}{
+		{
+			name: "valid key",
+			key:  key,
+			setupMock: func() {
+				m.apiKeyRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.APIKey{Prefix: prefix}, nil).
+					Return(&stored, nil)
+				m.userRepo.EXPECT().
+					FindByID(gomock.Any(), uint(30), nil).
+					Return(account, nil)
+				m.apiKeyRepo.EXPECT().
+					FindScopes(gomock.Any(), int64(4), nil).
+					Return([]string{entity.PermissionPayrollRead}, nil)
+				m.apiKeyRepo.EXPECT().
+					MarkUsed(gomock.Any(), int64(4), gomock.Any(), nil).
+					Return(nil)
+				// The request is audited under the service account
+				m.auditLogRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any(), nil).
+					DoAndReturn(func(_ context.Context, log *entity.AuditLog, _ *gorm.DB) error {
+						if log.Action != entity.AuditLogActionAPIKeyRequest || log.UserID != "30" || log.DataAfter["path"] != "/admin/payrolls/3" {
+							t.Errorf("Unexpected audit log: %+v", log)
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name: "wrong secret",
+			key:  prefix + ".wrong",
+			setupMock: func() {
+				m.apiKeyRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.APIKey{Prefix: prefix}, nil).
+					Return(&stored, nil)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "revoked key",
+			key:  key,
+			setupMock: func() {
+				revoked := stored
+				revoked.RevokedAt = &past
+				m.apiKeyRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.APIKey{Prefix: prefix}, nil).
+					Return(&revoked, nil)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "expired key",
+			key:  key,
+			setupMock: func() {
+				expired := stored
+				expired.ExpiresAt = &past
+				m.apiKeyRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.APIKey{Prefix: prefix}, nil).
+					Return(&expired, nil)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "malformed key",
+			key:            "not-an-api-key",
+			setupMock:      func() {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "unknown prefix",
+			key:  "psk_000000000000.secret",
+			setupMock: func() {
+				m.apiKeyRepo.EXPECT().
+					FindOneByTemplate(gomock.Any(), &entity.APIKey{Prefix: "psk_000000000000"}, nil).
+					Return(nil, nil)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			principal, err := usecase.AuthenticateAPIKey(context.Background(), tt.key, http.MethodGet, "/admin/payrolls/3")
+			expectStatus(t, err, tt.expectedStatus)
+
+			if tt.expectedStatus == 0 {
+				if principal == nil || principal.UserID != 30 || principal.Role != entity.UserRoleServiceAccount {
+					t.Fatalf("Unexpected principal: %+v", principal)
+				}
+				if len(principal.Permissions) != 1 || principal.Permissions[0] != entity.PermissionPayrollRead {
+					t.Errorf("Expected the key scopes as permissions but got %v", principal.Permissions)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package service_account
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/internal/repository"
+	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
+)
+
+//go:generate mockgen -destination=./mock/mock_usecase.go -package=mock github.com/asyauqi15/payslip-system/internal/usecase/service_account Usecase
+type Usecase interface {
+	CreateServiceAccount(ctx context.Context, req v1.ServiceAccountRequest) (*v1.ServiceAccount, error)
+	ListServiceAccounts(ctx context.Context) ([]v1.ServiceAccount, error)
+	CreateAPIKey(ctx context.Context, serviceAccountID int64, req v1.APIKeyRequest) (*v1.APIKeyCreatedResponse, error)
+	ListAPIKeys(ctx context.Context, serviceAccountID int64) ([]v1.APIKey, error)
+	RevokeAPIKey(ctx context.Context, serviceAccountID int64, apiKeyID int64) error
+	AuthenticateAPIKey(ctx context.Context, key string, method string, path string) (*Principal, error)
+}
+
+type UsecaseImpl struct {
+	userRepo     repository.UserRepository
+	apiKeyRepo   repository.APIKeyRepository
+	auditLogRepo repository.AuditLogRepository
+}
+
+func NewUsecase(
+	userRepo repository.UserRepository,
+	apiKeyRepo repository.APIKeyRepository,
+	auditLogRepo repository.AuditLogRepository,
+) Usecase {
+	return &UsecaseImpl{
+		userRepo:     userRepo,
+		apiKeyRepo:   apiKeyRepo,
+		auditLogRepo: auditLogRepo,
+	}
+}
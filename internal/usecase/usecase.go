@@ -1,17 +1,36 @@
 package usecase
 
 import (
+	"net/http"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal"
 	"github.com/asyauqi15/payslip-system/internal/repository"
+	"github.com/asyauqi15/payslip-system/internal/usecase/approval"
 	"github.com/asyauqi15/payslip-system/internal/usecase/attendance"
+	"github.com/asyauqi15/payslip-system/internal/usecase/attendance_import"
 	"github.com/asyauqi15/payslip-system/internal/usecase/attendance_period"
 	authusecase "github.com/asyauqi15/payslip-system/internal/usecase/auth"
+	"github.com/asyauqi15/payslip-system/internal/usecase/bulk_import"
+	"github.com/asyauqi15/payslip-system/internal/usecase/exchange_rate"
+	"github.com/asyauqi15/payslip-system/internal/usecase/history"
+	"github.com/asyauqi15/payslip-system/internal/usecase/organization"
 	"github.com/asyauqi15/payslip-system/internal/usecase/overtime"
+	"github.com/asyauqi15/payslip-system/internal/usecase/password"
 	"github.com/asyauqi15/payslip-system/internal/usecase/payroll"
 	"github.com/asyauqi15/payslip-system/internal/usecase/payslip"
 	"github.com/asyauqi15/payslip-system/internal/usecase/reimbursement"
+	"github.com/asyauqi15/payslip-system/internal/usecase/role"
+	"github.com/asyauqi15/payslip-system/internal/usecase/roster"
+	"github.com/asyauqi15/payslip-system/internal/usecase/search"
+	"github.com/asyauqi15/payslip-system/internal/usecase/service_account"
 	jwtauth "github.com/asyauqi15/payslip-system/pkg/jwt-auth"
+	"github.com/asyauqi15/payslip-system/pkg/notifier"
+	"github.com/asyauqi15/payslip-system/pkg/oidc"
 )
 
+const oidcRequestTimeout = 10 * time.Second
+
 type Registry struct {
 	Auth                   authusecase.Usecase
 	CreateAttendancePeriod attendance_period.Usecase
@@ -20,16 +39,49 @@ type Registry struct {
 	SubmitReimbursement    reimbursement.Usecase
 	PayrollUsecase         payroll.Usecase
 	GetPayslip             payslip.Usecase
+	Organization           organization.Usecase
+	Approval               approval.Usecase
+	Role                   role.Usecase
+	Password               password.Usecase
+	ServiceAccount         service_account.Usecase
+	History                history.Usecase
+	Search                 search.Usecase
+	Roster                 roster.Usecase
+	AttendanceImport       attendance_import.Usecase
+	BulkImport             bulk_import.Usecase
+	ExchangeRate           exchange_rate.Usecase
 }
 
-func InitializeUseCase(repository *repository.Registry, jwt *jwtauth.JWTAuthentication) *Registry {
+func InitializeUseCase(cfg internal.Config, repository *repository.Registry, jwt *jwtauth.JWTAuthentication, notifier notifier.Notifier) *Registry {
+	var oidcClient *oidc.Client
+	if cfg.Auth.OIDC.Enabled {
+		oidcClient = oidc.NewClient(cfg.Auth.OIDC, &http.Client{Timeout: oidcRequestTimeout})
+	}
+
+	// Bulk imports check rows against the rules of these usecases
+	organizationUsecase := organization.NewUsecase(repository.DepartmentRepository, repository.EmployeeRepository, repository.LocationRepository)
+	overtimeUsecase := overtime.NewUsecase(repository.OvertimeRepository, repository.EmployeeRepository, repository.AttendanceRepository, repository.LocationRepository)
+	reimbursementUsecase := reimbursement.NewUsecase(repository.ReimbursementRepository, repository.EmployeeRepository)
+	passwordUsecase := password.NewUsecase(repository.UserRepository, repository.PasswordHistoryRepository, repository.PasswordResetTokenRepository, repository.RefreshTokenRepository, repository.AuditLogRepository, notifier, cfg.Auth.PasswordPolicy, cfg.Auth.PasswordResetTokenDuration)
+
 	return &Registry{
-		Auth:                   authusecase.NewUsecase(repository.UserRepository, jwt),
-		CreateAttendancePeriod: attendance_period.NewUsecase(repository.AttendancePeriodRepository),
-		SubmitAttendance:       attendance.NewUsecase(repository.AttendanceRepository, repository.EmployeeRepository),
-		SubmitOvertime:         overtime.NewUsecase(repository.OvertimeRepository, repository.EmployeeRepository, repository.AttendanceRepository),
-		SubmitReimbursement:    reimbursement.NewUsecase(repository.ReimbursementRepository, repository.EmployeeRepository),
-		PayrollUsecase:         payroll.NewUsecase(repository.PayrollRepository, repository.PayslipRepository, repository.EmployeeRepository, repository.AttendanceRepository, repository.AttendancePeriodRepository, repository.OvertimeRepository, repository.ReimbursementRepository, repository.UserRepository),
+		Auth:                   authusecase.NewUsecase(repository.UserRepository, repository.RolePermissionRepository, repository.RefreshTokenRepository, repository.LoginThrottleRepository, repository.AuditLogRepository, repository.RoleRepository, repository.UserTwoFactorRepository, repository.TwoFactorRecoveryCodeRepository, repository.LoginChallengeRepository, repository.UserIdentityRepository, repository.OIDCLoginStateRepository, jwt, oidcClient, cfg.Auth.LoginThrottle, cfg.Auth.TwoFactor, cfg.Auth.OIDC),
+		CreateAttendancePeriod: attendance_period.NewUsecase(repository.AttendancePeriodRepository, repository.AttendanceRepository, repository.PayrollRepository),
+		SubmitAttendance:       attendance.NewUsecase(repository.AttendanceRepository, repository.AttendanceCorrectionRepository, repository.EmployeeRepository, repository.LocationRepository),
+		SubmitOvertime:         overtimeUsecase,
+		SubmitReimbursement:    reimbursementUsecase,
+		PayrollUsecase:         payroll.NewUsecase(repository.PayrollRepository, repository.PayslipRepository, repository.EmployeeRepository, repository.AttendanceRepository, repository.AttendancePeriodRepository, repository.OvertimeRepository, repository.ReimbursementRepository, repository.UserRepository, repository.DepartmentRepository, repository.LocationRepository, repository.ExchangeRateRepository, repository.PayrollExchangeRateRepository, repository.PayrollRoundingRepository, repository.PayslipTraceRepository, repository.DB, cfg.Payroll),
 		GetPayslip:             payslip.NewUsecase(repository.PayslipRepository, repository.PayrollRepository, repository.EmployeeRepository, repository.ReimbursementRepository, repository.AttendancePeriodRepository),
+		Organization:           organizationUsecase,
+		Approval:               approval.NewUsecase(repository.OvertimeRepository, repository.ReimbursementRepository, repository.EmployeeRepository, repository.AttendancePeriodRepository, repository.PayrollRepository, repository.AttendanceRepository, repository.AttendanceCorrectionRepository, repository.AttendanceViolationRepository, repository.LocationRepository),
+		Role:                   role.NewUsecase(repository.RoleRepository, repository.RolePermissionRepository, repository.UserRepository, repository.DB),
+		Password:               passwordUsecase,
+		ServiceAccount:         service_account.NewUsecase(repository.UserRepository, repository.APIKeyRepository, repository.AuditLogRepository),
+		History:                history.NewUsecase(repository.EmployeeRepository, repository.AttendanceRepository, repository.OvertimeRepository, repository.ReimbursementRepository, repository.PayslipRepository, repository.AttendancePeriodRepository),
+		Search:                 search.NewUsecase(repository.AttendancePeriodRepository, repository.PayrollRepository, repository.AttendanceRepository, repository.OvertimeRepository, repository.ReimbursementRepository),
+		Roster:                 roster.NewUsecase(repository.ShiftRepository, repository.RosterEntryRepository, repository.EmployeeRepository),
+		AttendanceImport:       attendance_import.NewUsecase(repository.AttendanceRepository, repository.EmployeeRepository, repository.LocationRepository, repository.AttendancePeriodRepository, repository.PayrollRepository),
+		BulkImport:             bulk_import.NewUsecase(repository.UserRepository, repository.EmployeeRepository, repository.RoleRepository, repository.OvertimeRepository, repository.ReimbursementRepository, organizationUsecase, overtimeUsecase, reimbursementUsecase, passwordUsecase),
+		ExchangeRate:           exchange_rate.NewUsecase(repository.ExchangeRateRepository),
 	}
 }
@@ -0,0 +1,189 @@
+// Package csvimport reads CSV files with a header row into rows addressed by
+// column name, runs them through a mapping that validates and stores one kind
+// of record, and reports the rows that could not be imported.
+package csvimport
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Row is one record of a file. Line is where it starts in the file, the
+// header being line 1.
+type Row struct {
+	Line   int
+	values map[string]string
+}
+
+// Get returns the trimmed value of a column, or "" when the file or the row
+// does not have it.
+func (r Row) Get(column string) string {
+	return r.values[column]
+}
+
+// Error is a problem with one row. Column names the field at fault, if any.
+type Error struct {
+	Line    int
+	Column  string
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Column != "" {
+		return fmt.Sprintf("line %d, %s: %s", e.Line, e.Column, e.Message)
+	}
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// Invalid reports a row that cannot be imported. The run fills in the line.
+func Invalid(column, message string) error {
+	return &Error{Column: column, Message: message}
+}
+
+// Read reads a file whose first record names its columns. Column names are
+// matched case-insensitively and must include every required column. Blank
+// lines are skipped; values are trimmed.
+func Read(file io.Reader, required []string, maxRows int) ([]Row, error) {
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("the file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+
+	columns := make([]string, len(header))
+	present := make(map[string]bool, len(header))
+	for i, name := range header {
+		// Spreadsheet exports often start with a byte order mark
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		columns[i] = name
+		present[name] = true
+	}
+	for _, name := range required {
+		if !present[name] {
+			return nil, fmt.Errorf("the file has no %s column", name)
+		}
+	}
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+		if len(rows) == maxRows {
+			return nil, fmt.Errorf("at most %d rows can be imported at once", maxRows)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := Row{Line: line, values: make(map[string]string, len(columns))}
+		for i, value := range record {
+			if i < len(columns) && columns[i] != "" {
+				row.values[columns[i]] = strings.TrimSpace(value)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, errors.New("the file has no rows")
+	}
+	return rows, nil
+}
+
+// Mapping validates the rows of one kind of record and stores them. A
+// mapping is used for one run only, so it can keep track of the rows it has
+// prepared, for example to catch duplicates within the file.
+type Mapping[T any] interface {
+	// Columns lists the columns a file must have.
+	Columns() []string
+	// Prepare validates a row and returns the record it imports. An error
+	// made with Invalid rejects the row; any other error aborts the run.
+	Prepare(ctx context.Context, row Row) (T, error)
+	// Store saves the records of a run all together or not at all.
+	Store(ctx context.Context, records []T) error
+}
+
+// Options tune a run.
+type Options struct {
+	MaxRows int  // larger files are refused
+	DryRun  bool // prepare every row but store nothing
+}
+
+// Report tells what a run did. The records are only stored when no row has
+// an error and the run is not a dry run.
+type Report struct {
+	Rows     int
+	Imported int
+	DryRun   bool
+	Applied  bool
+	Errors   []Error
+}
+
+// Run reads a file and prepares every row through the mapping, then stores
+// the records unless a row was rejected or the run is a dry run. Errors
+// reading the file are returned as is; the caller decides how to surface them.
+func Run[T any](ctx context.Context, file io.Reader, mapping Mapping[T], opts Options) (*Report, error) {
+	rows, err := Read(file, mapping.Columns(), opts.MaxRows)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Rows: len(rows), DryRun: opts.DryRun}
+	records := make([]T, 0, len(rows))
+	for _, row := range rows {
+		record, err := mapping.Prepare(ctx, row)
+		if err != nil {
+			var rowErr *Error
+			if !errors.As(err, &rowErr) {
+				return nil, err
+			}
+			rowErr.Line = row.Line
+			report.Errors = append(report.Errors, *rowErr)
+			continue
+		}
+		records = append(records, record)
+	}
+
+	report.Imported = len(records)
+
+	if len(report.Errors) > 0 || opts.DryRun {
+		return report, nil
+	}
+
+	if err := mapping.Store(ctx, records); err != nil {
+		return nil, err
+	}
+	report.Applied = true
+
+	return report, nil
+}
+
+// WriteReport writes errors as a CSV file with the line, column and message
+// of each, to hand back with the file they were found in.
+func WriteReport(w io.Writer, errs []Error) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"line", "column", "message"}); err != nil {
+		return err
+	}
+	for _, e := range errs {
+		if err := writer.Write([]string{strconv.Itoa(e.Line), e.Column, e.Message}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -1,10 +1,14 @@
 package http
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
 
 type ErrorWrapper struct {
 	StatusCode int
 	Message    string
+	RetryAfter time.Duration
 }
 
 func (e *ErrorWrapper) Error() string {
@@ -56,3 +60,18 @@ func NewUnprocessableEntityError(errorMessage string) error {
 		Message:    errorMessage,
 	}
 }
+
+func NewForbiddenError(errorMessage string) error {
+	return &ErrorWrapper{
+		StatusCode: http.StatusForbidden,
+		Message:    errorMessage,
+	}
+}
+
+func NewTooManyRequestsError(errorMessage string, retryAfter time.Duration) error {
+	return &ErrorWrapper{
+		StatusCode: http.StatusTooManyRequests,
+		Message:    errorMessage,
+		RetryAfter: retryAfter,
+	}
+}
@@ -0,0 +1,40 @@
+package jwt_auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/lestrrat-go/jwx/jwk"
+)
+
+// jwksMaxAge bounds how long clients may cache the key set, so a key added
+// ahead of a rotation must be published at least this long before it becomes
+// active.
+const jwksMaxAge = 5 * time.Minute
+
+// JWKS serves the public keys that verify access tokens as a JSON Web Key Set.
+// The set is empty while access tokens are signed with HS256.
+func (ja *JWTAuthentication) JWKS(w http.ResponseWriter, r *http.Request) {
+	set := jwk.NewSet()
+	if ja.accessTokenKeys != nil {
+		var err error
+		set, err = ja.accessTokenKeys.publicKeys(time.Now())
+		if err != nil {
+			handleErr(w, r, "failed to build key set", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	payload, err := json.Marshal(set)
+	if err != nil {
+		handleErr(w, r, "failed to build key set", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/jwk-set+json")
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(jwksMaxAge.Seconds())))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(payload)
+}
@@ -0,0 +1,72 @@
+package jwt_auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal"
+)
+
+func TestJWTAuthentication_JWKS(t *testing.T) {
+	edFile := newEd25519KeyFile(t, "ed")
+	rsaFile := newRSAKeyFile(t, "rsa")
+
+	now := time.Now()
+	ring, err := newKeyRing(internal.SigningConfig{
+		ActiveKeyID: "new",
+		GracePeriod: time.Hour,
+		Keys: []internal.SigningKeyConfig{
+			{KeyID: "old", PrivateKeyFile: edFile, RetiredAt: now.Add(-time.Hour + time.Minute).Format(time.RFC3339)},
+			{KeyID: "new", PrivateKeyFile: rsaFile},
+			{KeyID: "expired", PrivateKeyFile: edFile, RetiredAt: now.Add(-2 * time.Hour).Format(time.RFC3339)},
+		},
+	}, time.Hour, now)
+	if err != nil {
+		t.Fatalf("failed to load key ring: %v", err)
+	}
+	// The grace period of old has ended by the time the request is served
+	ring.keys["old"].expiresAt = &now
+
+	tests := []struct {
+		name        string
+		ja          *JWTAuthentication
+		expectedKID []string
+	}{
+		{name: "retired keys are left out", ja: &JWTAuthentication{accessTokenKeys: ring}, expectedKID: []string{"new"}},
+		{name: "empty while signing with HS256", ja: &JWTAuthentication{}, expectedKID: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			tt.ja.JWKS(w, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("Expected status 200 but got %d", w.Code)
+			}
+			if contentType := w.Header().Get("Content-Type"); contentType != "application/jwk-set+json" {
+				t.Errorf("Expected a JWK set content type but got %q", contentType)
+			}
+
+			var jwks struct {
+				Keys []struct {
+					KID string `json:"kid"`
+				} `json:"keys"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &jwks); err != nil {
+				t.Fatalf("failed to unmarshal key set: %v", err)
+			}
+			if len(jwks.Keys) != len(tt.expectedKID) {
+				t.Fatalf("Expected keys %v but got %s", tt.expectedKID, w.Body.String())
+			}
+			for i, kid := range tt.expectedKID {
+				if jwks.Keys[i].KID != kid {
+					t.Errorf("Expected key %d to be %q but got %q", i, kid, jwks.Keys[i].KID)
+				}
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	v1 "github.com/asyauqi15/payslip-system/pkg/openapi/v1"
 	"github.com/go-chi/jwtauth"
 	"github.com/go-chi/render"
+	"github.com/lestrrat-go/jwx/jwt"
 	"github.com/spf13/cast"
 )
 
@@ -20,43 +21,69 @@ const (
 	jwtUserIDKey       = "user_id"
 	jwtUserUsernameKey = "username"
 	jwtUserRoleKey     = "user_role"
+	jwtPermissionsKey  = "permissions"
+	jwtIDKey           = "jti"
 )
 
 type JWTAuthentication struct {
-	accessTokenAuth      *jwtauth.JWTAuth
+	accessTokenAuth *jwtauth.JWTAuth
+	// accessTokenKeys replaces accessTokenAuth when asymmetric signing keys
+	// are configured
+	accessTokenKeys      *keyRing
 	refreshTokenAuth     *jwtauth.JWTAuth
 	accessTokenDuration  time.Duration
 	refreshTokenDuration time.Duration
 }
 
 type TokenClaims struct {
-	UserID    string `json:"user_id"`
-	UserEmail string `json:"user_email"`
-	UserRole  string `json:"user_role"`
+	UserID      string   `json:"user_id"`
+	UserEmail   string   `json:"user_email"`
+	UserRole    string   `json:"user_role"`
+	Permissions []string `json:"permissions"`
+	JTI         string   `json:"jti"`
 }
 
+// NewJWTAuthentication signs tokens with the secrets loaded by
+// HTTPServerConfig.LoadTokenSecrets.
 func NewJWTAuthentication(config internal.HTTPServerConfig) (*JWTAuthentication, error) {
-	at, err := config.GetAccessTokenSecret()
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode access token secret: %w", err)
-	}
-
 	rt, err := config.GetRefreshTokenSecret()
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode refresh token secret: %w", err)
+		return nil, fmt.Errorf("failed to get refresh token secret: %w", err)
 	}
 
-	return &JWTAuthentication{
-		accessTokenAuth:      jwtauth.New("HS256", at, nil),
+	ja := &JWTAuthentication{
 		refreshTokenAuth:     jwtauth.New("HS256", rt, nil),
 		accessTokenDuration:  config.AccessTokenDuration,
 		refreshTokenDuration: config.RefreshTokenDuration,
-	}, nil
+	}
+
+	// Refresh tokens are only read by this service and stay HS256
+	if len(config.AccessTokenSigning.Keys) > 0 {
+		ja.accessTokenKeys, err = newKeyRing(config.AccessTokenSigning, config.AccessTokenDuration, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load access token signing keys: %w", err)
+		}
+		return ja, nil
+	}
+
+	at, err := config.GetAccessTokenSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access token secret: %w", err)
+	}
+	ja.accessTokenAuth = jwtauth.New("HS256", at, nil)
+
+	return ja, nil
 }
 
 func (ja *JWTAuthentication) Authenticator(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		token, err := jwtauth.VerifyRequest(ja.accessTokenAuth, r, jwtauth.TokenFromHeader)
+		tokenString := jwtauth.TokenFromHeader(r)
+		if tokenString == "" {
+			handleErr(w, r, jwtauth.ErrNoTokenFound.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		token, err := ja.verifyAccessToken(tokenString)
 		if err != nil {
 			handleErr(w, r, err.Error(), http.StatusUnauthorized)
 			return
@@ -73,24 +100,30 @@ func (ja *JWTAuthentication) Authenticator(next http.Handler) http.Handler {
 			ctx = context.WithValue(ctx, constant.ContextKeyUserID, cast.ToString(claims[jwtUserIDKey]))
 			ctx = context.WithValue(ctx, constant.ContextKeyUsername, cast.ToString(claims[jwtUserUsernameKey]))
 			ctx = context.WithValue(ctx, constant.ContextKeyUserRole, cast.ToString(claims[jwtUserRoleKey]))
+			ctx = context.WithValue(ctx, constant.ContextKeyUserPermissions, cast.ToStringSlice(claims[jwtPermissionsKey]))
 		}
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
-func (ja *JWTAuthentication) GenerateAccessToken(ctx context.Context, user *entity.User) (string, time.Time, error) {
+// GenerateAccessToken embeds the permissions of the user's role so routes can
+// be authorized without a database lookup. Permission changes apply on the
+// next token issued.
+func (ja *JWTAuthentication) GenerateAccessToken(ctx context.Context, user *entity.User, permissions []string) (string, time.Time, error) {
 	expiredAt := time.Now().Add(ja.accessTokenDuration)
-	token, err := generateToken(ctx, ja.accessTokenAuth, user, expiredAt)
+	token, err := generateToken(ctx, ja.signAccessToken, user, permissions, expiredAt, "")
 	if err != nil {
 		return "", time.Time{}, err
 	}
 	return token, expiredAt, err
 }
 
-func (ja *JWTAuthentication) GenerateRefreshToken(ctx context.Context, user *entity.User) (string, time.Time, error) {
+// GenerateRefreshToken issues a refresh token carrying the given jti so it
+// can be matched with its server-side record.
+func (ja *JWTAuthentication) GenerateRefreshToken(ctx context.Context, user *entity.User, jti string) (string, time.Time, error) {
 	expiredAt := time.Now().Add(ja.refreshTokenDuration)
-	token, err := generateToken(ctx, ja.refreshTokenAuth, user, expiredAt)
+	token, err := generateToken(ctx, ja.refreshTokenAuth.Encode, user, nil, expiredAt, jti)
 	if err != nil {
 		return "", time.Time{}, err
 	}
@@ -98,15 +131,42 @@ func (ja *JWTAuthentication) GenerateRefreshToken(ctx context.Context, user *ent
 }
 
 func (ja *JWTAuthentication) ParseAccessToken(ctx context.Context, token string) (TokenClaims, error) {
-	return parseToken(ctx, ja.accessTokenAuth, token)
+	return parseToken(ctx, ja.verifyAccessToken, token)
 }
 
 func (ja *JWTAuthentication) ParseRefreshToken(ctx context.Context, token string) (TokenClaims, error) {
-	return parseToken(ctx, ja.refreshTokenAuth, token)
+	return parseToken(ctx, func(token string) (jwt.Token, error) {
+		return jwtauth.VerifyToken(ja.refreshTokenAuth, token)
+	}, token)
 }
 
-func parseToken(ctx context.Context, jwt *jwtauth.JWTAuth, token string) (TokenClaims, error) {
-	refreshToken, err := jwtauth.VerifyToken(jwt, token)
+func (ja *JWTAuthentication) signAccessToken(claims map[string]interface{}) (jwt.Token, string, error) {
+	if ja.accessTokenKeys == nil {
+		return ja.accessTokenAuth.Encode(claims)
+	}
+
+	token := jwt.New()
+	for k, v := range claims {
+		if err := token.Set(k, v); err != nil {
+			return nil, "", err
+		}
+	}
+	payload, err := ja.accessTokenKeys.sign(token)
+	if err != nil {
+		return nil, "", err
+	}
+	return token, string(payload), nil
+}
+
+func (ja *JWTAuthentication) verifyAccessToken(token string) (jwt.Token, error) {
+	if ja.accessTokenKeys == nil {
+		return jwtauth.VerifyToken(ja.accessTokenAuth, token)
+	}
+	return ja.accessTokenKeys.verify(token, time.Now())
+}
+
+func parseToken(ctx context.Context, verify func(string) (jwt.Token, error), token string) (TokenClaims, error) {
+	refreshToken, err := verify(token)
 	if err != nil {
 		logger.Error(ctx, "error when verify token", "error", err)
 		return TokenClaims{}, err
@@ -119,22 +179,30 @@ func parseToken(ctx context.Context, jwt *jwtauth.JWTAuth, token string) (TokenC
 	}
 
 	return TokenClaims{
-		UserID:    cast.ToString(claims[jwtUserIDKey]),
-		UserEmail: cast.ToString(claims[jwtUserUsernameKey]),
-		UserRole:  cast.ToString(claims[jwtUserRoleKey]),
+		UserID:      cast.ToString(claims[jwtUserIDKey]),
+		UserEmail:   cast.ToString(claims[jwtUserUsernameKey]),
+		UserRole:    cast.ToString(claims[jwtUserRoleKey]),
+		Permissions: cast.ToStringSlice(claims[jwtPermissionsKey]),
+		JTI:         cast.ToString(claims[jwtIDKey]),
 	}, nil
 }
 
-func generateToken(ctx context.Context, jwt *jwtauth.JWTAuth, user *entity.User, expiredAt time.Time) (string, error) {
+func generateToken(ctx context.Context, encode func(map[string]interface{}) (jwt.Token, string, error), user *entity.User, permissions []string, expiredAt time.Time, jti string) (string, error) {
 	claims := map[string]interface{}{
 		jwtUserIDKey:       user.ID,
 		jwtUserUsernameKey: user.Username,
 		jwtUserRoleKey:     user.Role,
 	}
+	if permissions != nil {
+		claims[jwtPermissionsKey] = permissions
+	}
+	if jti != "" {
+		claims[jwtIDKey] = jti
+	}
 	jwtauth.SetExpiry(claims, expiredAt)
 	jwtauth.SetIssuedNow(claims)
 
-	_, tokenString, err := jwt.Encode(claims)
+	_, tokenString, err := encode(claims)
 	if err != nil {
 		logger.Error(ctx, "error when encode token claims", "error", err)
 		return "", err
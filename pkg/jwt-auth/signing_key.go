@@ -0,0 +1,211 @@
+package jwt_auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal"
+	"github.com/go-chi/jwtauth"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+const minRSAKeyBits = 2048
+
+type signingKey struct {
+	kid        string
+	alg        jwa.SignatureAlgorithm
+	privateKey crypto.Signer
+	// expiresAt is the end of the grace period of a retired key, nil while
+	// the key is not retired
+	expiresAt *time.Time
+}
+
+func (k *signingKey) usableAt(now time.Time) bool {
+	return k.expiresAt == nil || now.Before(*k.expiresAt)
+}
+
+// keyRing signs tokens with its active key and verifies them with the key
+// named by their kid header.
+type keyRing struct {
+	active *signingKey
+	keys   map[string]*signingKey
+	// order keeps the configured key order for the JWKS
+	order []*signingKey
+}
+
+func newKeyRing(config internal.SigningConfig, defaultGracePeriod time.Duration, now time.Time) (*keyRing, error) {
+	gracePeriod := config.GracePeriod
+	if gracePeriod <= 0 {
+		gracePeriod = defaultGracePeriod
+	}
+
+	ring := &keyRing{keys: make(map[string]*signingKey, len(config.Keys))}
+	for _, kc := range config.Keys {
+		if kc.KeyID == "" {
+			return nil, errors.New("signing key without kid")
+		}
+		if _, ok := ring.keys[kc.KeyID]; ok {
+			return nil, fmt.Errorf("duplicate signing key %q", kc.KeyID)
+		}
+
+		var expiresAt *time.Time
+		if kc.RetiredAt != "" {
+			retiredAt, err := time.Parse(time.RFC3339, kc.RetiredAt)
+			if err != nil {
+				return nil, fmt.Errorf("invalid retired_at of signing key %q: %w", kc.KeyID, err)
+			}
+			if kc.KeyID == config.ActiveKeyID {
+				return nil, fmt.Errorf("active signing key %q is retired", kc.KeyID)
+			}
+			t := retiredAt.Add(gracePeriod)
+			if !now.Before(t) {
+				// Past its grace period, the key cannot verify anything
+				continue
+			}
+			expiresAt = &t
+		}
+
+		pemBytes, err := readPrivateKey(kc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read signing key %q: %w", kc.KeyID, err)
+		}
+		privateKey, alg, err := parsePrivateKey(pemBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse signing key %q: %w", kc.KeyID, err)
+		}
+
+		key := &signingKey{kid: kc.KeyID, alg: alg, privateKey: privateKey, expiresAt: expiresAt}
+		ring.keys[key.kid] = key
+		ring.order = append(ring.order, key)
+	}
+
+	active, ok := ring.keys[config.ActiveKeyID]
+	if !ok {
+		return nil, fmt.Errorf("active signing key %q is not configured", config.ActiveKeyID)
+	}
+	ring.active = active
+
+	return ring, nil
+}
+
+func readPrivateKey(config internal.SigningKeyConfig) ([]byte, error) {
+	switch {
+	case config.PrivateKeyFile != "" && config.PrivateKeyEnv != "":
+		return nil, errors.New("private_key_file and private_key_env are mutually exclusive")
+	case config.PrivateKeyFile != "":
+		return os.ReadFile(config.PrivateKeyFile)
+	case config.PrivateKeyEnv != "":
+		value, ok := os.LookupEnv(config.PrivateKeyEnv)
+		if !ok || value == "" {
+			return nil, fmt.Errorf("environment variable %s is not set", config.PrivateKeyEnv)
+		}
+		return []byte(value), nil
+	default:
+		return nil, errors.New("either private_key_file or private_key_env is required")
+	}
+}
+
+// parsePrivateKey accepts PKCS #8 RSA and Ed25519 keys as well as PKCS #1 RSA
+// keys.
+func parsePrivateKey(data []byte) (crypto.Signer, jwa.SignatureAlgorithm, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, "", errors.New("no PEM data found")
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, "", fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		if key.N.BitLen() < minRSAKeyBits {
+			return nil, "", fmt.Errorf("RSA key must be at least %d bits", minRSAKeyBits)
+		}
+		return key, jwa.RS256, nil
+	case ed25519.PrivateKey:
+		return key, jwa.EdDSA, nil
+	default:
+		return nil, "", fmt.Errorf("unsupported key type %T", parsed)
+	}
+}
+
+func (kr *keyRing) sign(token jwt.Token) ([]byte, error) {
+	headers := jws.NewHeaders()
+	if err := headers.Set(jws.KeyIDKey, kr.active.kid); err != nil {
+		return nil, err
+	}
+	return jwt.Sign(token, kr.active.alg, kr.active.privateKey, jwt.WithHeaders(headers))
+}
+
+// verify only trusts the algorithm of the key named by the kid header, so a
+// token cannot pick a weaker algorithm or be verified with a retired key past
+// its grace period.
+func (kr *keyRing) verify(tokenString string, now time.Time) (jwt.Token, error) {
+	msg, err := jws.ParseString(tokenString)
+	if err != nil || len(msg.Signatures()) != 1 {
+		return nil, jwtauth.ErrUnauthorized
+	}
+
+	headers := msg.Signatures()[0].ProtectedHeaders()
+	key, ok := kr.keys[headers.KeyID()]
+	if !ok || headers.Algorithm() != key.alg || !key.usableAt(now) {
+		return nil, jwtauth.ErrUnauthorized
+	}
+
+	token, err := jwt.ParseString(tokenString, jwt.WithVerify(key.alg, key.privateKey.Public()))
+	if err != nil {
+		return nil, jwtauth.ErrorReason(err)
+	}
+	if err := jwt.Validate(token, jwt.WithClock(jwt.ClockFunc(func() time.Time { return now }))); err != nil {
+		return token, jwtauth.ErrorReason(err)
+	}
+	return token, nil
+}
+
+// publicKeys returns the public half of every key that can still verify
+// tokens.
+func (kr *keyRing) publicKeys(now time.Time) (jwk.Set, error) {
+	set := jwk.NewSet()
+	for _, key := range kr.order {
+		if !key.usableAt(now) {
+			continue
+		}
+
+		publicKey, err := jwk.New(key.privateKey.Public())
+		if err != nil {
+			return nil, err
+		}
+		if err := publicKey.Set(jwk.KeyIDKey, key.kid); err != nil {
+			return nil, err
+		}
+		if err := publicKey.Set(jwk.AlgorithmKey, key.alg.String()); err != nil {
+			return nil, err
+		}
+		if err := publicKey.Set(jwk.KeyUsageKey, jwk.ForSignature.String()); err != nil {
+			return nil, err
+		}
+		set.Add(publicKey)
+	}
+	return set, nil
+}
@@ -0,0 +1,378 @@
+package jwt_auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+var testNow = time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+// writeKey stores a PKCS #8 PEM private key in the test's directory and
+// returns its path.
+func writeKey(t *testing.T, name string, key crypto.Signer) string {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), name+".pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+	return path
+}
+
+func newRSAKeyFile(t *testing.T, name string) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, minRSAKeyBits)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+	return writeKey(t, name, key)
+}
+
+func newEd25519KeyFile(t *testing.T, name string) string {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate Ed25519 key: %v", err)
+	}
+	return writeKey(t, name, key)
+}
+
+func testToken(t *testing.T) jwt.Token {
+	t.Helper()
+	token := jwt.New()
+	if err := token.Set(jwt.SubjectKey, "1"); err != nil {
+		t.Fatalf("failed to set subject: %v", err)
+	}
+	if err := token.Set(jwt.ExpirationKey, testNow.Add(time.Hour)); err != nil {
+		t.Fatalf("failed to set expiry: %v", err)
+	}
+	return token
+}
+
+func TestNewKeyRing(t *testing.T) {
+	edFile := newEd25519KeyFile(t, "ed")
+	retiredAt := testNow.Add(-time.Minute).Format(time.RFC3339)
+
+	tests := []struct {
+		name        string
+		config      internal.SigningConfig
+		expectError bool
+		expectedKID []string
+	}{
+		{
+			name: "active and retired keys",
+			config: internal.SigningConfig{
+				ActiveKeyID: "new",
+				Keys: []internal.SigningKeyConfig{
+					{KeyID: "old", PrivateKeyFile: edFile, RetiredAt: retiredAt},
+					{KeyID: "new", PrivateKeyFile: edFile},
+				},
+			},
+			expectedKID: []string{"old", "new"},
+		},
+		{
+			name: "retired key past its grace period is dropped",
+			config: internal.SigningConfig{
+				ActiveKeyID: "new",
+				Keys: []internal.SigningKeyConfig{
+					{KeyID: "old", PrivateKeyFile: edFile, RetiredAt: testNow.Add(-2 * time.Hour).Format(time.RFC3339)},
+					{KeyID: "new", PrivateKeyFile: edFile},
+				},
+			},
+			expectedKID: []string{"new"},
+		},
+		{
+			name: "retired active key",
+			config: internal.SigningConfig{
+				ActiveKeyID: "old",
+				Keys:        []internal.SigningKeyConfig{{KeyID: "old", PrivateKeyFile: edFile, RetiredAt: retiredAt}},
+			},
+			expectError: true,
+		},
+		{
+			name: "missing kid",
+			config: internal.SigningConfig{
+				ActiveKeyID: "new",
+				Keys:        []internal.SigningKeyConfig{{PrivateKeyFile: edFile}},
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicate kid",
+			config: internal.SigningConfig{
+				ActiveKeyID: "new",
+				Keys: []internal.SigningKeyConfig{
+					{KeyID: "new", PrivateKeyFile: edFile},
+					{KeyID: "new", PrivateKeyFile: edFile},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "active key not configured",
+			config: internal.SigningConfig{
+				ActiveKeyID: "missing",
+				Keys:        []internal.SigningKeyConfig{{KeyID: "new", PrivateKeyFile: edFile}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ring, err := newKeyRing(tt.config, time.Hour, testNow)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if len(ring.order) != len(tt.expectedKID) {
+				t.Fatalf("Expected keys %v but got %d keys", tt.expectedKID, len(ring.order))
+			}
+			for i, kid := range tt.expectedKID {
+				if ring.order[i].kid != kid {
+					t.Errorf("Expected key %d to be %q but got %q", i, kid, ring.order[i].kid)
+				}
+			}
+		})
+	}
+}
+
+func TestKeyRing_SignAndVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		keyFile     string
+		expectedAlg jwa.SignatureAlgorithm
+	}{
+		{name: "RS256", keyFile: newRSAKeyFile(t, "rsa"), expectedAlg: jwa.RS256},
+		{name: "EdDSA", keyFile: newEd25519KeyFile(t, "ed"), expectedAlg: jwa.EdDSA},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ring, err := newKeyRing(internal.SigningConfig{
+				ActiveKeyID: "key",
+				Keys:        []internal.SigningKeyConfig{{KeyID: "key", PrivateKeyFile: tt.keyFile}},
+			}, time.Hour, testNow)
+			if err != nil {
+				t.Fatalf("failed to load key ring: %v", err)
+			}
+
+			signed, err := ring.sign(testToken(t))
+			if err != nil {
+				t.Fatalf("failed to sign token: %v", err)
+			}
+
+			msg, err := jws.Parse(signed)
+			if err != nil {
+				t.Fatalf("failed to parse token: %v", err)
+			}
+			headers := msg.Signatures()[0].ProtectedHeaders()
+			if headers.Algorithm() != tt.expectedAlg || headers.KeyID() != "key" {
+				t.Errorf("Expected alg %s and kid key but got %s and %s", tt.expectedAlg, headers.Algorithm(), headers.KeyID())
+			}
+
+			token, err := ring.verify(string(signed), testNow)
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if token.Subject() != "1" {
+				t.Errorf("Expected subject 1 but got %q", token.Subject())
+			}
+		})
+	}
+}
+
+func TestKeyRing_Verify(t *testing.T) {
+	edFile := newEd25519KeyFile(t, "ed")
+	rsaFile := newRSAKeyFile(t, "rsa")
+
+	// old signs the tokens before it is retired
+	signer, err := newKeyRing(internal.SigningConfig{
+		ActiveKeyID: "old",
+		Keys:        []internal.SigningKeyConfig{{KeyID: "old", PrivateKeyFile: edFile}},
+	}, time.Hour, testNow)
+	if err != nil {
+		t.Fatalf("failed to load key ring: %v", err)
+	}
+	signed, err := signer.sign(testToken(t))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	retiredAt := testNow.Add(-30 * time.Minute)
+	verifier, err := newKeyRing(internal.SigningConfig{
+		ActiveKeyID: "new",
+		Keys: []internal.SigningKeyConfig{
+			{KeyID: "old", PrivateKeyFile: edFile, RetiredAt: retiredAt.Format(time.RFC3339)},
+			{KeyID: "new", PrivateKeyFile: rsaFile},
+		},
+	}, time.Hour, testNow)
+	if err != nil {
+		t.Fatalf("failed to load key ring: %v", err)
+	}
+
+	// A token naming the RSA key but signed with EdDSA
+	edKey, _, err := parsePrivateKey(mustReadFile(t, edFile))
+	if err != nil {
+		t.Fatalf("failed to parse key: %v", err)
+	}
+	headers := jws.NewHeaders()
+	if err := headers.Set(jws.KeyIDKey, "new"); err != nil {
+		t.Fatalf("failed to set kid: %v", err)
+	}
+	wrongAlg, err := jwt.Sign(testToken(t), jwa.EdDSA, edKey, jwt.WithHeaders(headers))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	unknownHeaders := jws.NewHeaders()
+	if err := unknownHeaders.Set(jws.KeyIDKey, "unknown"); err != nil {
+		t.Fatalf("failed to set kid: %v", err)
+	}
+	unknownKID, err := jwt.Sign(testToken(t), jwa.EdDSA, edKey, jwt.WithHeaders(unknownHeaders))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		token       string
+		now         time.Time
+		expectError bool
+	}{
+		{
+			name:  "retired key within its grace period",
+			token: string(signed),
+			now:   testNow,
+		},
+		{
+			name:        "retired key past its grace period",
+			token:       string(signed),
+			now:         retiredAt.Add(time.Hour),
+			expectError: true,
+		},
+		{
+			name:        "unknown kid",
+			token:       string(unknownKID),
+			now:         testNow,
+			expectError: true,
+		},
+		{
+			name:        "alg not matching the key",
+			token:       string(wrongAlg),
+			now:         testNow,
+			expectError: true,
+		},
+		{
+			name:        "not a token",
+			token:       "not-a-token",
+			now:         testNow,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := verifier.verify(tt.token, tt.now)
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestKeyRing_PublicKeys(t *testing.T) {
+	edFile := newEd25519KeyFile(t, "ed")
+	rsaFile := newRSAKeyFile(t, "rsa")
+
+	ring, err := newKeyRing(internal.SigningConfig{
+		ActiveKeyID: "new",
+		Keys: []internal.SigningKeyConfig{
+			{KeyID: "old", PrivateKeyFile: edFile, RetiredAt: testNow.Add(-30 * time.Minute).Format(time.RFC3339)},
+			{KeyID: "new", PrivateKeyFile: rsaFile},
+		},
+	}, time.Hour, testNow)
+	if err != nil {
+		t.Fatalf("failed to load key ring: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		expectedKID []string
+	}{
+		{name: "retired key within its grace period", now: testNow, expectedKID: []string{"old", "new"}},
+		{name: "retired key past its grace period", now: testNow.Add(time.Hour), expectedKID: []string{"new"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := ring.publicKeys(tt.now)
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+
+			payload, err := json.Marshal(set)
+			if err != nil {
+				t.Fatalf("failed to marshal key set: %v", err)
+			}
+			var jwks struct {
+				Keys []struct {
+					KID string `json:"kid"`
+					D   string `json:"d"`
+				} `json:"keys"`
+			}
+			if err := json.Unmarshal(payload, &jwks); err != nil {
+				t.Fatalf("failed to unmarshal key set: %v", err)
+			}
+
+			if len(jwks.Keys) != len(tt.expectedKID) {
+				t.Fatalf("Expected keys %v but got %s", tt.expectedKID, payload)
+			}
+			for i, kid := range tt.expectedKID {
+				if jwks.Keys[i].KID != kid {
+					t.Errorf("Expected key %d to be %q but got %q", i, kid, jwks.Keys[i].KID)
+				}
+				if jwks.Keys[i].D != "" {
+					t.Errorf("Expected only public keys but key %q has a private part", kid)
+				}
+			}
+		})
+	}
+}
+
+func mustReadFile(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", path, err)
+	}
+	return data
+}
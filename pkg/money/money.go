@@ -0,0 +1,288 @@
+// Package money keeps amounts together with their currency, so amounts in
+// different currencies cannot be added up by mistake, and converts them
+// between currencies with exact exchange rates. Calculations are carried out
+// on exact amounts and rounded to whole units once, with an explicit rounding
+// mode.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// Currency is an ISO 4217 code such as IDR or USD.
+type Currency string
+
+// ErrCurrencyMismatch is returned when amounts in different currencies are
+// combined, or converted with a rate for another currency.
+var ErrCurrencyMismatch = errors.New("amounts are in different currencies")
+
+// ParseCurrency reads a three-letter currency code, in any case.
+func ParseCurrency(code string) (Currency, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(code))
+	if len(normalized) != 3 {
+		return "", fmt.Errorf("invalid currency %q, expected a three-letter code", code)
+	}
+	for _, c := range normalized {
+		if c < 'A' || c > 'Z' {
+			return "", fmt.Errorf("invalid currency %q, expected a three-letter code", code)
+		}
+	}
+	return Currency(normalized), nil
+}
+
+// Money is an amount in whole units of its currency.
+type Money struct {
+	amount   int64
+	currency Currency
+}
+
+func New(amount int64, currency Currency) Money {
+	return Money{amount: amount, currency: currency}
+}
+
+func Zero(currency Currency) Money {
+	return Money{currency: currency}
+}
+
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+func (m Money) String() string {
+	return fmt.Sprintf("%d %s", m.amount, m.currency)
+}
+
+// Add returns the sum of two amounts in the same currency.
+func (m Money) Add(other Money) (Money, error) {
+	if m.currency != other.currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, other.currency)
+	}
+	return Money{amount: m.amount + other.amount, currency: m.currency}, nil
+}
+
+// Sub returns the difference of two amounts in the same currency.
+func (m Money) Sub(other Money) (Money, error) {
+	if m.currency != other.currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, other.currency)
+	}
+	return Money{amount: m.amount - other.amount, currency: m.currency}, nil
+}
+
+// Sum adds up amounts that must all be in the given currency. No amounts sum
+// to zero in that currency.
+func Sum(currency Currency, amounts ...Money) (Money, error) {
+	total := Zero(currency)
+	for _, amount := range amounts {
+		var err error
+		if total, err = total.Add(amount); err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// Exact returns the amount to calculate with.
+func (m Money) Exact() Exact {
+	return Exact{value: new(big.Rat).SetInt64(m.amount), currency: m.currency}
+}
+
+// Convert returns the amount in the currency the rate converts to, rounded to
+// whole units.
+func (m Money) Convert(rate Rate, rounding Rounding) (Money, error) {
+	converted, err := m.Exact().Convert(rate)
+	if err != nil {
+		return Money{}, err
+	}
+	return converted.Round(rounding)
+}
+
+// Exact is an amount being calculated, kept as an exact fraction of units of
+// its currency until it is rounded. The zero value is zero in no currency.
+type Exact struct {
+	value    *big.Rat
+	currency Currency
+}
+
+func (e Exact) Currency() Currency {
+	return e.currency
+}
+
+// Add returns the sum of two exact amounts in the same currency.
+func (e Exact) Add(other Exact) (Exact, error) {
+	if e.currency != other.currency {
+		return Exact{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, e.currency, other.currency)
+	}
+	return Exact{value: new(big.Rat).Add(e.rat(), other.rat()), currency: e.currency}, nil
+}
+
+// Sub returns the difference of two exact amounts in the same currency.
+func (e Exact) Sub(other Exact) (Exact, error) {
+	if e.currency != other.currency {
+		return Exact{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, e.currency, other.currency)
+	}
+	return Exact{value: new(big.Rat).Sub(e.rat(), other.rat()), currency: e.currency}, nil
+}
+
+// Mul returns the amount multiplied by a whole number.
+func (e Exact) Mul(n int64) Exact {
+	return Exact{value: new(big.Rat).Mul(e.rat(), new(big.Rat).SetInt64(n)), currency: e.currency}
+}
+
+// Div returns the amount divided by a whole number, which must not be zero.
+func (e Exact) Div(n int64) Exact {
+	return Exact{value: new(big.Rat).Quo(e.rat(), new(big.Rat).SetInt64(n)), currency: e.currency}
+}
+
+// Convert returns the amount in the currency the rate converts to.
+func (e Exact) Convert(rate Rate) (Exact, error) {
+	if e.currency != rate.From {
+		return Exact{}, fmt.Errorf("%w: %s converted with a rate from %s", ErrCurrencyMismatch, e.currency, rate.From)
+	}
+	return Exact{value: new(big.Rat).Mul(e.rat(), rate.value()), currency: rate.To}, nil
+}
+
+// Round returns the amount in whole units.
+func (e Exact) Round(rounding Rounding) (Money, error) {
+	amount, err := round(e.rat(), rounding)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: amount, currency: e.currency}, nil
+}
+
+// Decimal returns the amount as a decimal of at most RateDecimals places,
+// without trailing zeros.
+func (e Exact) Decimal() string {
+	return decimalString(e.rat())
+}
+
+func (e Exact) rat() *big.Rat {
+	if e.value == nil {
+		return new(big.Rat)
+	}
+	return e.value
+}
+
+// Rounding is how an exact amount is rounded to whole units.
+type Rounding string
+
+const (
+	RoundHalfUp   Rounding = "half_up"  // to the nearest unit, halves away from zero
+	RoundBankers  Rounding = "bankers"  // to the nearest unit, halves to the even one
+	RoundTruncate Rounding = "truncate" // towards zero
+)
+
+// ParseRounding reads a rounding mode by name.
+func ParseRounding(name string) (Rounding, error) {
+	switch rounding := Rounding(strings.ToLower(strings.TrimSpace(name))); rounding {
+	case RoundHalfUp, RoundBankers, RoundTruncate:
+		return rounding, nil
+	}
+	return "", fmt.Errorf("invalid rounding mode %q, expected %s, %s or %s", name, RoundHalfUp, RoundBankers, RoundTruncate)
+}
+
+// RateDecimals is the precision rates are kept at, matching the database
+// columns that record them.
+const RateDecimals = 10
+
+// Rate converts From into To: one unit of From is worth the rate in units of
+// To.
+type Rate struct {
+	From  Currency
+	To    Currency
+	ratio *big.Rat
+}
+
+// decimalPattern matches a plain decimal, without exponent or fraction.
+var decimalPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// ParseRate reads a positive decimal rate such as 16250.5.
+func ParseRate(from, to Currency, value string) (Rate, error) {
+	trimmed := strings.TrimSpace(value)
+	if !decimalPattern.MatchString(trimmed) {
+		return Rate{}, fmt.Errorf("invalid rate %q", value)
+	}
+	ratio, _ := new(big.Rat).SetString(trimmed)
+	if ratio.Sign() <= 0 {
+		return Rate{}, fmt.Errorf("rate must be greater than 0, got %q", value)
+	}
+	return Rate{From: from, To: to, ratio: ratio}, nil
+}
+
+// Identity is the rate of a currency to itself.
+func Identity(currency Currency) Rate {
+	return Rate{From: currency, To: currency, ratio: big.NewRat(1, 1)}
+}
+
+// Invert returns the rate the other way round, rounded to RateDecimals so the
+// rate that converts is the one recorded.
+func (r Rate) Invert() Rate {
+	inverted := new(big.Rat).Inv(r.value())
+	rounded, _ := ParseRate(r.To, r.From, inverted.FloatString(RateDecimals))
+	if rounded.ratio == nil {
+		// Smaller than the precision rates are kept at
+		rounded = Rate{From: r.To, To: r.From, ratio: inverted}
+	}
+	return rounded
+}
+
+// String returns the rate as a decimal without trailing zeros.
+func (r Rate) String() string {
+	return decimalString(r.value())
+}
+
+func (r Rate) value() *big.Rat {
+	if r.ratio == nil {
+		return big.NewRat(1, 1)
+	}
+	return r.ratio
+}
+
+// decimalString writes a number with RateDecimals places, trimming trailing
+// zeros.
+func decimalString(r *big.Rat) string {
+	s := r.FloatString(RateDecimals)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+// round rounds to an integer with a rounding mode, working on the magnitude
+// so negative amounts round the same way as positive ones.
+func round(r *big.Rat, rounding Rounding) (int64, error) {
+	num := new(big.Int).Abs(r.Num())
+	quotient, remainder := new(big.Int).QuoRem(num, r.Denom(), new(big.Int))
+	half := remainder.Lsh(remainder, 1).Cmp(r.Denom())
+	switch rounding {
+	case RoundHalfUp:
+		if half >= 0 {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	case RoundBankers:
+		if half > 0 || (half == 0 && quotient.Bit(0) == 1) {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	case RoundTruncate:
+	default:
+		return 0, fmt.Errorf("invalid rounding mode %q", rounding)
+	}
+	if r.Sign() < 0 {
+		quotient.Neg(quotient)
+	}
+	if !quotient.IsInt64() {
+		return 0, fmt.Errorf("amount %s is out of range", r.FloatString(0))
+	}
+	return quotient.Int64(), nil
+}
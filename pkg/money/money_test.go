@@ -0,0 +1,214 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    *big.Rat
+		rounding Rounding
+		expected int64
+	}{
+		{name: "half up rounds a tie away from zero", value: big.NewRat(5, 2), rounding: RoundHalfUp, expected: 3},
+		{name: "half up rounds a negative tie away from zero", value: big.NewRat(-5, 2), rounding: RoundHalfUp, expected: -3},
+		{name: "half up rounds below a tie down", value: big.NewRat(249, 100), rounding: RoundHalfUp, expected: 2},
+		{name: "half up rounds above a tie up", value: big.NewRat(251, 100), rounding: RoundHalfUp, expected: 3},
+		{name: "half up rounds a negative amount below a tie towards zero", value: big.NewRat(-249, 100), rounding: RoundHalfUp, expected: -2},
+		{name: "bankers rounds a tie to the even unit below", value: big.NewRat(5, 2), rounding: RoundBankers, expected: 2},
+		{name: "bankers rounds a tie to the even unit above", value: big.NewRat(7, 2), rounding: RoundBankers, expected: 4},
+		{name: "bankers rounds a negative tie to the even unit", value: big.NewRat(-5, 2), rounding: RoundBankers, expected: -2},
+		{name: "bankers rounds a negative odd tie away from zero", value: big.NewRat(-7, 2), rounding: RoundBankers, expected: -4},
+		{name: "bankers rounds above a tie up", value: big.NewRat(251, 100), rounding: RoundBankers, expected: 3},
+		{name: "truncate drops the fraction", value: big.NewRat(299, 100), rounding: RoundTruncate, expected: 2},
+		{name: "truncate rounds a negative amount towards zero", value: big.NewRat(-299, 100), rounding: RoundTruncate, expected: -2},
+		{name: "truncate keeps a tie below", value: big.NewRat(5, 2), rounding: RoundTruncate, expected: 2},
+		{name: "whole amount is kept", value: big.NewRat(-4, 1), rounding: RoundBankers, expected: -4},
+		{name: "zero is kept", value: new(big.Rat), rounding: RoundHalfUp, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := round(tt.value, tt.rounding)
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("Expected %d but got %d", tt.expected, result)
+			}
+		})
+	}
+
+	t.Run("unknown rounding mode", func(t *testing.T) {
+		if _, err := round(big.NewRat(5, 2), Rounding("ceiling")); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+
+	t.Run("amount out of range", func(t *testing.T) {
+		tooLarge := new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 64))
+		if _, err := round(tooLarge, RoundHalfUp); err == nil {
+			t.Error("Expected error but got none")
+		}
+	})
+}
+
+func TestMoney_AddSub(t *testing.T) {
+	tests := []struct {
+		name        string
+		a           Money
+		b           Money
+		expectedAdd Money
+		expectedSub Money
+		expectedErr error
+	}{
+		{
+			name:        "same currency",
+			a:           New(1500, "IDR"),
+			b:           New(500, "IDR"),
+			expectedAdd: New(2000, "IDR"),
+			expectedSub: New(1000, "IDR"),
+		},
+		{
+			name:        "difference below zero",
+			a:           New(500, "IDR"),
+			b:           New(1500, "IDR"),
+			expectedAdd: New(2000, "IDR"),
+			expectedSub: New(-1000, "IDR"),
+		},
+		{
+			name:        "different currencies",
+			a:           New(1500, "IDR"),
+			b:           New(500, "USD"),
+			expectedErr: ErrCurrencyMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum, err := tt.a.Add(tt.b)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Expected error %v on Add but got: %v", tt.expectedErr, err)
+			}
+			if sum != tt.expectedAdd {
+				t.Errorf("Expected sum %s but got %s", tt.expectedAdd, sum)
+			}
+
+			difference, err := tt.a.Sub(tt.b)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Expected error %v on Sub but got: %v", tt.expectedErr, err)
+			}
+			if difference != tt.expectedSub {
+				t.Errorf("Expected difference %s but got %s", tt.expectedSub, difference)
+			}
+		})
+	}
+}
+
+func TestExact_AddSub(t *testing.T) {
+	idr := New(100, "IDR").Exact()
+	usd := New(1, "USD").Exact()
+
+	if _, err := idr.Add(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected currency mismatch on Add but got: %v", err)
+	}
+	if _, err := idr.Sub(usd); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected currency mismatch on Sub but got: %v", err)
+	}
+
+	half := New(1, "IDR").Exact().Div(2)
+	sum, err := idr.Add(half)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	if sum.Decimal() != "100.5" {
+		t.Errorf("Expected 100.5 but got %s", sum.Decimal())
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    string
+		expectError bool
+	}{
+		{name: "decimal", value: "16250.5", expected: "16250.5"},
+		{name: "whole number", value: "15000", expected: "15000"},
+		{name: "surrounding spaces", value: " 0.0000615 ", expected: "0.0000615"},
+		{name: "trailing zeros", value: "1.2500000000", expected: "1.25"},
+		{name: "exponent", value: "1e5", expectError: true},
+		{name: "exponent with fraction", value: "1.5E-3", expectError: true},
+		{name: "fraction", value: "1/3", expectError: true},
+		{name: "leading plus", value: "+2", expectError: true},
+		{name: "missing whole part", value: ".5", expectError: true},
+		{name: "missing fraction", value: "5.", expectError: true},
+		{name: "hexadecimal", value: "0x10", expectError: true},
+		{name: "empty", value: "", expectError: true},
+		{name: "zero", value: "0", expectError: true},
+		{name: "negative", value: "-1.5", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := ParseRate("USD", "IDR", tt.value)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error but got rate %s", rate)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error but got: %v", err)
+			}
+			if rate.From != "USD" || rate.To != "IDR" {
+				t.Errorf("Expected a USD to IDR rate but got %s to %s", rate.From, rate.To)
+			}
+			if rate.String() != tt.expected {
+				t.Errorf("Expected rate %s but got %s", tt.expected, rate.String())
+			}
+		})
+	}
+}
+
+func TestRate_Invert(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "exact inverse", value: "4", expected: "0.25"},
+		{name: "inverse rounded to the rate precision", value: "3", expected: "0.3333333333"},
+		{name: "inverse of a small rate", value: "0.0000625", expected: "16000"},
+		{name: "inverse below the rate precision is kept exact", value: "30000000000", expected: "1/30000000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := ParseRate("IDR", "USD", tt.value)
+			if err != nil {
+				t.Fatalf("failed to parse rate: %v", err)
+			}
+
+			inverted := rate.Invert()
+			if inverted.From != "USD" || inverted.To != "IDR" {
+				t.Errorf("Expected a USD to IDR rate but got %s to %s", inverted.From, inverted.To)
+			}
+			if inverted.value().Cmp(mustRat(t, tt.expected)) != 0 {
+				t.Errorf("Expected rate %s but got %s", tt.expected, inverted.value().RatString())
+			}
+		})
+	}
+}
+
+func mustRat(t *testing.T, value string) *big.Rat {
+	t.Helper()
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		t.Fatalf("invalid number %q", value)
+	}
+	return r
+}
@@ -0,0 +1,23 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/asyauqi15/payslip-system/pkg/logger"
+)
+
+// LogNotifier writes messages to the application log. It is meant for local
+// development only since message bodies may contain secrets.
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, message Message) error {
+	logger.Info(ctx, "notification",
+		"recipient", message.Recipient,
+		"subject", message.Subject,
+		"body", message.Body)
+	return nil
+}
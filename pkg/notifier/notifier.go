@@ -0,0 +1,33 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	DriverLog = "log"
+)
+
+// Message is a notification addressed to a user. Recipient is the username;
+// drivers that deliver outside the application resolve it to an address.
+type Message struct {
+	Recipient string
+	Subject   string
+	Body      string
+}
+
+// Notifier delivers messages to users, for example password reset tokens.
+type Notifier interface {
+	Notify(ctx context.Context, message Message) error
+}
+
+// New returns the notifier for the configured driver.
+func New(driver string) (Notifier, error) {
+	switch driver {
+	case "", DriverLog:
+		return NewLogNotifier(), nil
+	default:
+		return nil, fmt.Errorf("unknown notifier driver %q", driver)
+	}
+}
@@ -0,0 +1,372 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/asyauqi15/payslip-system/internal"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+
+	// maxResponseSize bounds the provider responses that are read into memory
+	maxResponseSize = 1 << 20
+	// keySetRefreshInterval rate limits refetching the provider's key set when
+	// an ID token names an unknown key
+	keySetRefreshInterval = time.Minute
+	clockSkew             = time.Minute
+)
+
+var defaultScopes = []string{"openid", "email", "profile"}
+
+// supportedAlgorithms are the ID token signature algorithms that are
+// accepted. Symmetric algorithms and "none" are never accepted.
+var supportedAlgorithms = map[jwa.SignatureAlgorithm]bool{
+	jwa.RS256: true,
+	jwa.RS384: true,
+	jwa.RS512: true,
+	jwa.PS256: true,
+	jwa.PS384: true,
+	jwa.PS512: true,
+	jwa.ES256: true,
+	jwa.ES384: true,
+	jwa.ES512: true,
+	jwa.EdDSA: true,
+}
+
+var ErrInvalidIDToken = errors.New("invalid ID token")
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Claims are the verified claims of an ID token.
+type Claims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	raw           map[string]interface{}
+}
+
+// String returns a string claim, or an empty string when the claim is missing
+// or not a string.
+func (c *Claims) String(name string) string {
+	value, _ := c.raw[name].(string)
+	return value
+}
+
+// Client runs the authorization code flow against an OpenID Connect
+// provider. The discovery document and the provider's key set are fetched on
+// first use and cached.
+type Client struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	scopes       []string
+	httpClient   *http.Client
+
+	mu            sync.Mutex
+	discovery     *discoveryDocument
+	keys          jwk.Set
+	keysFetchedAt time.Time
+}
+
+func NewClient(config internal.OIDCConfig, httpClient *http.Client) *Client {
+	scopes := config.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+
+	return &Client{
+		issuer:       strings.TrimSuffix(config.IssuerURL, "/"),
+		clientID:     config.ClientID,
+		clientSecret: config.ClientSecret,
+		redirectURL:  config.RedirectURL,
+		scopes:       scopes,
+		httpClient:   httpClient,
+	}
+}
+
+func (c *Client) Issuer() string {
+	return c.issuer
+}
+
+// NewCodeVerifier returns a random PKCE code verifier.
+func NewCodeVerifier() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CodeChallenge derives the S256 PKCE code challenge of a code verifier.
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthCodeURL returns the provider URL the user has to be sent to.
+func (c *Client) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	doc, err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(doc.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid authorization endpoint: %w", err)
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", c.clientID)
+	query.Set("redirect_uri", c.redirectURL)
+	query.Set("scope", strings.Join(c.scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", CodeChallenge(codeVerifier))
+	query.Set("code_challenge_method", "S256")
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+// Exchange redeems an authorization code and returns the raw ID token.
+func (c *Client) Exchange(ctx context.Context, code, codeVerifier string) (string, error) {
+	doc, err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.redirectURL)
+	form.Set("code_verifier", codeVerifier)
+	form.Set("client_id", c.clientID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if c.clientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(c.clientID), url.QueryEscape(c.clientSecret))
+	}
+
+	var body struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := c.doJSON(req, &body)
+	if err != nil {
+		return "", fmt.Errorf("token request failed: %w", err)
+	}
+	if status != http.StatusOK {
+		return "", fmt.Errorf("token request failed with status %d: %s %s", status, body.Error, body.ErrorDescription)
+	}
+	if body.IDToken == "" {
+		return "", errors.New("token response has no id_token")
+	}
+
+	return body.IDToken, nil
+}
+
+// VerifyIDToken checks the signature, issuer, audience, lifetime and nonce of
+// an ID token.
+func (c *Client) VerifyIDToken(ctx context.Context, rawIDToken, nonce string, now time.Time) (*Claims, error) {
+	doc, err := c.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := jws.ParseString(rawIDToken)
+	if err != nil || len(msg.Signatures()) != 1 {
+		return nil, ErrInvalidIDToken
+	}
+
+	headers := msg.Signatures()[0].ProtectedHeaders()
+	alg := headers.Algorithm()
+	if !supportedAlgorithms[alg] {
+		return nil, fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidIDToken, alg)
+	}
+
+	key, err := c.verificationKey(ctx, doc.JWKSURI, headers.KeyID(), now)
+	if err != nil {
+		return nil, err
+	}
+	if keyAlg := key.Algorithm(); keyAlg != "" && keyAlg != alg.String() {
+		return nil, fmt.Errorf("%w: algorithm %q does not match key", ErrInvalidIDToken, alg)
+	}
+
+	var rawKey interface{}
+	if err := key.Raw(&rawKey); err != nil {
+		return nil, fmt.Errorf("invalid provider key: %w", err)
+	}
+
+	token, err := jwt.ParseString(rawIDToken, jwt.WithVerify(alg, rawKey))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidIDToken, err)
+	}
+
+	err = jwt.Validate(token,
+		jwt.WithClock(jwt.ClockFunc(func() time.Time { return now })),
+		jwt.WithAcceptableSkew(clockSkew),
+		jwt.WithAudience(c.clientID),
+		jwt.WithClaimValue("nonce", nonce),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidIDToken, err)
+	}
+	// Validate skips claims that are absent, so the required ones are checked
+	// here
+	if token.Issuer() != doc.Issuer {
+		return nil, fmt.Errorf("%w: issuer does not match", ErrInvalidIDToken)
+	}
+	if token.Expiration().IsZero() || token.IssuedAt().IsZero() || token.Subject() == "" {
+		return nil, fmt.Errorf("%w: missing exp, iat or sub", ErrInvalidIDToken)
+	}
+
+	raw, err := token.AsMap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidIDToken, err)
+	}
+	// A token issued to several audiences has to be authorized for this client
+	if len(token.Audience()) > 1 {
+		if azp, _ := raw["azp"].(string); azp != c.clientID {
+			return nil, fmt.Errorf("%w: azp does not match client", ErrInvalidIDToken)
+		}
+	}
+
+	claims := &Claims{Subject: token.Subject(), raw: raw}
+	claims.Email, _ = raw["email"].(string)
+	switch verified := raw["email_verified"].(type) {
+	case bool:
+		claims.EmailVerified = verified
+	case string:
+		// Some providers send the flag as a string
+		claims.EmailVerified = verified == "true"
+	}
+
+	return claims, nil
+}
+
+func (c *Client) discover(ctx context.Context) (*discoveryDocument, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.discovery != nil {
+		return c.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.issuer+discoveryPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc discoveryDocument
+	status, err := c.doJSON(req, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("discovery failed: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("discovery failed with status %d", status)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != c.issuer {
+		return nil, fmt.Errorf("discovery issuer %q does not match %q", doc.Issuer, c.issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, errors.New("discovery document is missing endpoints")
+	}
+
+	c.discovery = &doc
+	return c.discovery, nil
+}
+
+// verificationKey looks up a provider key by kid. The key set is refetched
+// when the kid is unknown, which happens after the provider rotated its keys.
+func (c *Client) verificationKey(ctx context.Context, jwksURI, kid string, now time.Time) (jwk.Key, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if key, ok := lookupKey(c.keys, kid); ok {
+		return key, nil
+	}
+	if c.keys != nil && now.Sub(c.keysFetchedAt) < keySetRefreshInterval {
+		return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidIDToken, kid)
+	}
+
+	keys, err := jwk.Fetch(ctx, jwksURI, jwk.WithHTTPClient(c.httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch provider keys: %w", err)
+	}
+	c.keys = keys
+	c.keysFetchedAt = now
+
+	key, ok := lookupKey(c.keys, kid)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidIDToken, kid)
+	}
+	return key, nil
+}
+
+// lookupKey finds a signing key by kid. Tokens without a kid are only
+// accepted when the provider publishes a single key.
+func lookupKey(keys jwk.Set, kid string) (jwk.Key, bool) {
+	if keys == nil {
+		return nil, false
+	}
+	if kid == "" {
+		if keys.Len() != 1 {
+			return nil, false
+		}
+		return keys.Get(0)
+	}
+
+	key, ok := keys.LookupKeyID(kid)
+	if !ok || (key.KeyUsage() != "" && key.KeyUsage() != string(jwk.ForSignature)) {
+		return nil, false
+	}
+	return key, true
+}
+
+func (c *Client) doJSON(req *http.Request, v interface{}) (int, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if err := json.Unmarshal(body, v); err != nil && resp.StatusCode == http.StatusOK {
+		return resp.StatusCode, fmt.Errorf("invalid JSON response: %w", err)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -21,21 +21,382 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
-// Defines values for PostEmployeeAttendanceJSONBodyAttendanceType.
+// Defines values for AttendanceCorrectionSubmissionStatus.
 const (
-	CheckIn  PostEmployeeAttendanceJSONBodyAttendanceType = "check_in"
-	CheckOut PostEmployeeAttendanceJSONBodyAttendanceType = "check_out"
+	AttendanceCorrectionSubmissionStatusApproved AttendanceCorrectionSubmissionStatus = "approved"
+	AttendanceCorrectionSubmissionStatusPending  AttendanceCorrectionSubmissionStatus = "pending"
+	AttendanceCorrectionSubmissionStatusRejected AttendanceCorrectionSubmissionStatus = "rejected"
 )
 
+// Defines values for AttendanceRequestAttendanceType.
+const (
+	AttendanceRequestAttendanceTypeCheckIn  AttendanceRequestAttendanceType = "check_in"
+	AttendanceRequestAttendanceTypeCheckOut AttendanceRequestAttendanceType = "check_out"
+)
+
+// Defines values for AttendanceViolationKind.
+const (
+	DeviceMismatch     AttendanceViolationKind = "device_mismatch"
+	IpNotAllowed       AttendanceViolationKind = "ip_not_allowed"
+	LocationInaccurate AttendanceViolationKind = "location_inaccurate"
+	LocationMissing    AttendanceViolationKind = "location_missing"
+	OutsideGeofence    AttendanceViolationKind = "outside_geofence"
+)
+
+// Defines values for AttendanceViolationSubmissionAttendanceType.
+const (
+	AttendanceViolationSubmissionAttendanceTypeCheckIn  AttendanceViolationSubmissionAttendanceType = "check_in"
+	AttendanceViolationSubmissionAttendanceTypeCheckOut AttendanceViolationSubmissionAttendanceType = "check_out"
+)
+
+// Defines values for AttendanceViolationSubmissionStatus.
+const (
+	AttendanceViolationSubmissionStatusApproved AttendanceViolationSubmissionStatus = "approved"
+	AttendanceViolationSubmissionStatusPending  AttendanceViolationSubmissionStatus = "pending"
+	AttendanceViolationSubmissionStatusRejected AttendanceViolationSubmissionStatus = "rejected"
+)
+
+// Defines values for EmployeeComparisonFlagged.
+const (
+	EmployeeComparisonFlaggedBaseSalary     EmployeeComparisonFlagged = "base_salary"
+	EmployeeComparisonFlaggedOvertime       EmployeeComparisonFlagged = "overtime"
+	EmployeeComparisonFlaggedProratedSalary EmployeeComparisonFlagged = "prorated_salary"
+	EmployeeComparisonFlaggedReimbursement  EmployeeComparisonFlagged = "reimbursement"
+	EmployeeComparisonFlaggedTakeHome       EmployeeComparisonFlagged = "take_home"
+)
+
+// Defines values for ImportKind.
+const (
+	Employees      ImportKind = "employees"
+	Overtimes      ImportKind = "overtimes"
+	Reimbursements ImportKind = "reimbursements"
+)
+
+// Defines values for OvertimeSubmissionStatus.
+const (
+	OvertimeSubmissionStatusApproved OvertimeSubmissionStatus = "approved"
+	OvertimeSubmissionStatusPending  OvertimeSubmissionStatus = "pending"
+	OvertimeSubmissionStatusRejected OvertimeSubmissionStatus = "rejected"
+)
+
+// Defines values for PayrollRoundingCalculation.
+const (
+	PayrollRoundingCalculationConversion     PayrollRoundingCalculation = "conversion"
+	PayrollRoundingCalculationOvertime       PayrollRoundingCalculation = "overtime"
+	PayrollRoundingCalculationProratedSalary PayrollRoundingCalculation = "prorated_salary"
+)
+
+// Defines values for PayrollStatus.
+const (
+	Draft     PayrollStatus = "draft"
+	Finalized PayrollStatus = "finalized"
+)
+
+// Defines values for PayslipCalculationStepName.
+const (
+	ConvertedReimbursements PayslipCalculationStepName = "converted_reimbursements"
+	HourlyRate              PayslipCalculationStepName = "hourly_rate"
+	OvertimePay             PayslipCalculationStepName = "overtime_pay"
+	ProratedSalary          PayslipCalculationStepName = "prorated_salary"
+	ReimbursementTotal      PayslipCalculationStepName = "reimbursement_total"
+	TotalTakeHome           PayslipCalculationStepName = "total_take_home"
+)
+
+// Defines values for ReimbursementSubmissionStatus.
+const (
+	ReimbursementSubmissionStatusApproved ReimbursementSubmissionStatus = "approved"
+	ReimbursementSubmissionStatusPending  ReimbursementSubmissionStatus = "pending"
+	ReimbursementSubmissionStatusRejected ReimbursementSubmissionStatus = "rejected"
+)
+
+// Defines values for ReviewRequestDecision.
+const (
+	ReviewRequestDecisionApproved ReviewRequestDecision = "approved"
+	ReviewRequestDecisionRejected ReviewRequestDecision = "rejected"
+)
+
+// Defines values for RoundingMode.
+const (
+	Bankers  RoundingMode = "bankers"
+	HalfUp   RoundingMode = "half_up"
+	Truncate RoundingMode = "truncate"
+)
+
+// Defines values for Weekday.
+const (
+	Fri Weekday = "fri"
+	Mon Weekday = "mon"
+	Sat Weekday = "sat"
+	Sun Weekday = "sun"
+	Thu Weekday = "thu"
+	Tue Weekday = "tue"
+	Wed Weekday = "wed"
+)
+
+// Defines values for SortOrder.
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// Defines values for SubmissionStatus.
+const (
+	SubmissionStatusApproved SubmissionStatus = "approved"
+	SubmissionStatusPending  SubmissionStatus = "pending"
+	SubmissionStatusRejected SubmissionStatus = "rejected"
+)
+
+// Defines values for GetAdminAttendancePeriodsParamsSort.
+const (
+	GetAdminAttendancePeriodsParamsSortCreatedAt GetAdminAttendancePeriodsParamsSort = "created_at"
+	GetAdminAttendancePeriodsParamsSortStartDate GetAdminAttendancePeriodsParamsSort = "start_date"
+)
+
+// Defines values for GetAdminAttendancePeriodsParamsOrder.
+const (
+	GetAdminAttendancePeriodsParamsOrderAsc  GetAdminAttendancePeriodsParamsOrder = "asc"
+	GetAdminAttendancePeriodsParamsOrderDesc GetAdminAttendancePeriodsParamsOrder = "desc"
+)
+
+// Defines values for GetAdminAttendancesParamsSort.
+const (
+	GetAdminAttendancesParamsSortClockInTime GetAdminAttendancesParamsSort = "clock_in_time"
+	GetAdminAttendancesParamsSortCreatedAt   GetAdminAttendancesParamsSort = "created_at"
+)
+
+// Defines values for GetAdminAttendancesParamsOrder.
+const (
+	GetAdminAttendancesParamsOrderAsc  GetAdminAttendancesParamsOrder = "asc"
+	GetAdminAttendancesParamsOrderDesc GetAdminAttendancesParamsOrder = "desc"
+)
+
+// Defines values for GetAdminOvertimesParamsSort.
+const (
+	GetAdminOvertimesParamsSortCreatedAt GetAdminOvertimesParamsSort = "created_at"
+	GetAdminOvertimesParamsSortStartAt   GetAdminOvertimesParamsSort = "start_at"
+)
+
+// Defines values for GetAdminOvertimesParamsOrder.
+const (
+	GetAdminOvertimesParamsOrderAsc  GetAdminOvertimesParamsOrder = "asc"
+	GetAdminOvertimesParamsOrderDesc GetAdminOvertimesParamsOrder = "desc"
+)
+
+// Defines values for GetAdminOvertimesParamsStatus.
+const (
+	GetAdminOvertimesParamsStatusApproved GetAdminOvertimesParamsStatus = "approved"
+	GetAdminOvertimesParamsStatusPending  GetAdminOvertimesParamsStatus = "pending"
+	GetAdminOvertimesParamsStatusRejected GetAdminOvertimesParamsStatus = "rejected"
+)
+
+// Defines values for GetAdminPayrollsParamsSort.
+const (
+	GetAdminPayrollsParamsSortCreatedAt    GetAdminPayrollsParamsSort = "created_at"
+	GetAdminPayrollsParamsSortTotalPayroll GetAdminPayrollsParamsSort = "total_payroll"
+)
+
+// Defines values for GetAdminPayrollsParamsOrder.
+const (
+	GetAdminPayrollsParamsOrderAsc  GetAdminPayrollsParamsOrder = "asc"
+	GetAdminPayrollsParamsOrderDesc GetAdminPayrollsParamsOrder = "desc"
+)
+
+// Defines values for GetAdminReimbursementsParamsSort.
+const (
+	Amount    GetAdminReimbursementsParamsSort = "amount"
+	CreatedAt GetAdminReimbursementsParamsSort = "created_at"
+	Date      GetAdminReimbursementsParamsSort = "date"
+)
+
+// Defines values for GetAdminReimbursementsParamsOrder.
+const (
+	GetAdminReimbursementsParamsOrderAsc  GetAdminReimbursementsParamsOrder = "asc"
+	GetAdminReimbursementsParamsOrderDesc GetAdminReimbursementsParamsOrder = "desc"
+)
+
+// Defines values for GetAdminReimbursementsParamsStatus.
+const (
+	GetAdminReimbursementsParamsStatusApproved GetAdminReimbursementsParamsStatus = "approved"
+	GetAdminReimbursementsParamsStatusPending  GetAdminReimbursementsParamsStatus = "pending"
+	GetAdminReimbursementsParamsStatusRejected GetAdminReimbursementsParamsStatus = "rejected"
+)
+
+// Defines values for GetEmployeeOvertimeParamsStatus.
+const (
+	GetEmployeeOvertimeParamsStatusApproved GetEmployeeOvertimeParamsStatus = "approved"
+	GetEmployeeOvertimeParamsStatusPending  GetEmployeeOvertimeParamsStatus = "pending"
+	GetEmployeeOvertimeParamsStatusRejected GetEmployeeOvertimeParamsStatus = "rejected"
+)
+
+// Defines values for GetEmployeeReimbursementParamsStatus.
+const (
+	GetEmployeeReimbursementParamsStatusApproved GetEmployeeReimbursementParamsStatus = "approved"
+	GetEmployeeReimbursementParamsStatusPending  GetEmployeeReimbursementParamsStatus = "pending"
+	GetEmployeeReimbursementParamsStatusRejected GetEmployeeReimbursementParamsStatus = "rejected"
+)
+
+// Defines values for GetManagerAttendanceCorrectionsParamsStatus.
+const (
+	GetManagerAttendanceCorrectionsParamsStatusApproved GetManagerAttendanceCorrectionsParamsStatus = "approved"
+	GetManagerAttendanceCorrectionsParamsStatusPending  GetManagerAttendanceCorrectionsParamsStatus = "pending"
+	GetManagerAttendanceCorrectionsParamsStatusRejected GetManagerAttendanceCorrectionsParamsStatus = "rejected"
+)
+
+// Defines values for GetManagerAttendanceViolationsParamsStatus.
+const (
+	GetManagerAttendanceViolationsParamsStatusApproved GetManagerAttendanceViolationsParamsStatus = "approved"
+	GetManagerAttendanceViolationsParamsStatusPending  GetManagerAttendanceViolationsParamsStatus = "pending"
+	GetManagerAttendanceViolationsParamsStatusRejected GetManagerAttendanceViolationsParamsStatus = "rejected"
+)
+
+// Defines values for GetManagerOvertimesParamsStatus.
+const (
+	GetManagerOvertimesParamsStatusApproved GetManagerOvertimesParamsStatus = "approved"
+	GetManagerOvertimesParamsStatusPending  GetManagerOvertimesParamsStatus = "pending"
+	GetManagerOvertimesParamsStatusRejected GetManagerOvertimesParamsStatus = "rejected"
+)
+
+// Defines values for GetManagerReimbursementsParamsStatus.
+const (
+	GetManagerReimbursementsParamsStatusApproved GetManagerReimbursementsParamsStatus = "approved"
+	GetManagerReimbursementsParamsStatusPending  GetManagerReimbursementsParamsStatus = "pending"
+	GetManagerReimbursementsParamsStatusRejected GetManagerReimbursementsParamsStatus = "rejected"
+)
+
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	Id         int64      `json:"id"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	Scopes     []string   `json:"scopes"`
+}
+
+// APIKeyCreatedResponse defines model for APIKeyCreatedResponse.
+type APIKeyCreatedResponse struct {
+	ApiKey APIKey `json:"api_key"`
+
+	// Key The full key. It is only returned once.
+	Key string `json:"key"`
+}
+
+// APIKeyRequest defines model for APIKeyRequest.
+type APIKeyRequest struct {
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Name      string     `json:"name"`
+
+	// Scopes Permissions granted to requests made with the key. Only permissions the caller holds can be granted.
+	Scopes []string `json:"scopes"`
+}
+
 // AdminPayrollSummaryResponse defines model for AdminPayrollSummaryResponse.
 type AdminPayrollSummaryResponse struct {
-	AttendancePeriod       AttendancePeriod `json:"attendance_period"`
-	EmployeesCount         int64            `json:"employees_count"`
-	PayrollId              int64            `json:"payroll_id"`
-	PayslipList            []PayslipItem    `json:"payslip_list"`
-	TotalOvertimePay       int64            `json:"total_overtime_pay"`
-	TotalPayroll           int64            `json:"total_payroll"`
-	TotalReimbursementsPay int64            `json:"total_reimbursements_pay"`
+	AttendancePeriod    AttendancePeriod     `json:"attendance_period"`
+	DepartmentSubtotals []DepartmentSubtotal `json:"department_subtotals"`
+	EmployeesCount      int64                `json:"employees_count"`
+
+	// ExchangeRates Rates the payroll converted amounts with
+	ExchangeRates []PayrollExchangeRate `json:"exchange_rates"`
+	PayrollId     int64                 `json:"payroll_id"`
+	PayslipList   []PayslipItem         `json:"payslip_list"`
+
+	// ReportingCurrency Currency the totals are converted to
+	ReportingCurrency string `json:"reporting_currency"`
+
+	// Roundings What rounding to whole units changed, per calculation and currency
+	Roundings []PayrollRounding `json:"roundings"`
+
+	// Status A draft payroll can be removed and run again until it is finalized
+	Status                 PayrollStatus `json:"status"`
+	TotalOvertimePay       int64         `json:"total_overtime_pay"`
+	TotalPayroll           int64         `json:"total_payroll"`
+	TotalReimbursementsPay int64         `json:"total_reimbursements_pay"`
+}
+
+// AttendanceCorrectionRequest defines model for AttendanceCorrectionRequest.
+type AttendanceCorrectionRequest struct {
+	// ClockInTime Required when no attendance was recorded on the date
+	ClockInTime  *time.Time `json:"clock_in_time,omitempty"`
+	ClockOutTime *time.Time `json:"clock_out_time,omitempty"`
+
+	// Date Past weekday to correct
+	Date   openapi_types.Date `json:"date"`
+	Reason string             `json:"reason"`
+}
+
+// AttendanceCorrectionSubmission defines model for AttendanceCorrectionSubmission.
+type AttendanceCorrectionSubmission struct {
+	// AttendanceId Attendance the correction changes, absent when the date had none
+	AttendanceId *int64 `json:"attendance_id,omitempty"`
+
+	// ClockInTime Requested RFC 3339 timestamp, absent to keep the recorded one
+	ClockInTime *string `json:"clock_in_time,omitempty"`
+
+	// ClockOutTime Requested RFC 3339 timestamp, absent to keep the recorded one
+	ClockOutTime *string            `json:"clock_out_time,omitempty"`
+	Date         openapi_types.Date `json:"date"`
+	EmployeeId   int64              `json:"employee_id"`
+	Id           int64              `json:"id"`
+
+	// OriginalClockInTime Recorded RFC 3339 timestamp before the correction
+	OriginalClockInTime *string `json:"original_clock_in_time,omitempty"`
+
+	// OriginalClockOutTime Recorded RFC 3339 timestamp before the correction
+	OriginalClockOutTime *string                              `json:"original_clock_out_time,omitempty"`
+	Reason               string                               `json:"reason"`
+	ReviewNote           *string                              `json:"review_note,omitempty"`
+	ReviewedAt           *time.Time                           `json:"reviewed_at,omitempty"`
+	ReviewedBy           *int64                               `json:"reviewed_by,omitempty"`
+	Status               AttendanceCorrectionSubmissionStatus `json:"status"`
+}
+
+// AttendanceCorrectionSubmissionStatus defines model for AttendanceCorrectionSubmission.Status.
+type AttendanceCorrectionSubmissionStatus string
+
+// AttendanceHistoryResponse defines model for AttendanceHistoryResponse.
+type AttendanceHistoryResponse struct {
+	Data       []AttendanceRecord `json:"data"`
+	Pagination Pagination         `json:"pagination"`
+}
+
+// AttendanceImportError defines model for AttendanceImportError.
+type AttendanceImportError struct {
+	Message string `json:"message"`
+
+	// Row Line of the file, the header being line 1
+	Row int `json:"row"`
+}
+
+// AttendanceImportResult defines model for AttendanceImportResult.
+type AttendanceImportResult struct {
+	// Applied Whether the import took effect; never for a dry run or a file with errors
+	Applied bool `json:"applied"`
+
+	// Created Attendance records the punches add
+	Created int                     `json:"created"`
+	DryRun  bool                    `json:"dry_run"`
+	Errors  []AttendanceImportError `json:"errors"`
+
+	// Rows Punches read from the file
+	Rows int `json:"rows"`
+
+	// Skipped Corrected attendance records left as they are
+	Skipped int `json:"skipped"`
+
+	// Unchanged Existing attendance records the punches already match, as on a re-import
+	Unchanged int `json:"unchanged"`
+
+	// Updated Existing attendance records the punches extend
+	Updated int `json:"updated"`
+}
+
+// AttendanceListResponse defines model for AttendanceListResponse.
+type AttendanceListResponse struct {
+	Data       []AttendanceRecord `json:"data"`
+	Pagination CursorPagination   `json:"pagination"`
 }
 
 // AttendancePeriod defines model for AttendancePeriod.
@@ -44,12 +405,105 @@ type AttendancePeriod struct {
 	StartDate openapi_types.Date `json:"start_date"`
 }
 
+// AttendancePeriodItem defines model for AttendancePeriodItem.
+type AttendancePeriodItem struct {
+	CreatedAt time.Time          `json:"created_at"`
+	EndDate   openapi_types.Date `json:"end_date"`
+	Id        int64              `json:"id"`
+	StartDate openapi_types.Date `json:"start_date"`
+}
+
+// AttendancePeriodListResponse defines model for AttendancePeriodListResponse.
+type AttendancePeriodListResponse struct {
+	Data       []AttendancePeriodItem `json:"data"`
+	Pagination CursorPagination       `json:"pagination"`
+}
+
 // AttendancePeriodRequest defines model for AttendancePeriodRequest.
 type AttendancePeriodRequest struct {
 	EndDate   openapi_types.Date `json:"end_date"`
 	StartDate openapi_types.Date `json:"start_date"`
 }
 
+// AttendancePolicy defines model for AttendancePolicy.
+type AttendancePolicy struct {
+	// AllowedNetworks IP addresses and CIDR ranges attendance is expected from; empty to accept any address
+	AllowedNetworks []string `json:"allowed_networks"`
+
+	// Geofences Offices attendance is expected from; empty to accept any position
+	Geofences []Geofence `json:"geofences"`
+}
+
+// AttendanceRecord defines model for AttendanceRecord.
+type AttendanceRecord struct {
+	// ClockInTime RFC 3339 timestamp
+	ClockInTime string `json:"clock_in_time"`
+
+	// ClockOutTime RFC 3339 timestamp, absent until the employee checked out
+	ClockOutTime *string `json:"clock_out_time,omitempty"`
+
+	// Corrected Whether an approved correction changed the record
+	Corrected bool `json:"corrected"`
+
+	// CorrectionId Approved correction that last changed the record
+	CorrectionId *int64 `json:"correction_id,omitempty"`
+	EmployeeId   int64  `json:"employee_id"`
+	Id           int64  `json:"id"`
+}
+
+// AttendanceRequest defines model for AttendanceRequest.
+type AttendanceRequest struct {
+	// Accuracy Accuracy radius of the position in meters
+	Accuracy       *float64                        `json:"accuracy,omitempty"`
+	AttendanceType AttendanceRequestAttendanceType `json:"attendance_type"`
+
+	// DeviceId Identifier of the device; the first one sent binds the employee to it
+	DeviceId *string `json:"device_id,omitempty"`
+
+	// Latitude Position of the device, required by locations with geofences
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// AttendanceRequestAttendanceType defines model for AttendanceRequest.AttendanceType.
+type AttendanceRequestAttendanceType string
+
+// AttendanceResult defines model for AttendanceResult.
+type AttendanceResult struct {
+	// Flagged Whether the attendance was recorded with violations that wait for review
+	Flagged    bool                       `json:"flagged"`
+	Violations *[]AttendanceViolationKind `json:"violations,omitempty"`
+}
+
+// AttendanceViolationKind defines model for AttendanceViolationKind.
+type AttendanceViolationKind string
+
+// AttendanceViolationSubmission defines model for AttendanceViolationSubmission.
+type AttendanceViolationSubmission struct {
+	Accuracy       *float64                                    `json:"accuracy,omitempty"`
+	AttendanceId   int64                                       `json:"attendance_id"`
+	AttendanceType AttendanceViolationSubmissionAttendanceType `json:"attendance_type"`
+	CreatedAt      time.Time                                   `json:"created_at"`
+	Detail         string                                      `json:"detail"`
+	DeviceId       *string                                     `json:"device_id,omitempty"`
+	EmployeeId     int64                                       `json:"employee_id"`
+	Id             int64                                       `json:"id"`
+	IpAddress      *string                                     `json:"ip_address,omitempty"`
+	Kind           AttendanceViolationKind                     `json:"kind"`
+	Latitude       *float64                                    `json:"latitude,omitempty"`
+	Longitude      *float64                                    `json:"longitude,omitempty"`
+	ReviewNote     *string                                     `json:"review_note,omitempty"`
+	ReviewedAt     *time.Time                                  `json:"reviewed_at,omitempty"`
+	ReviewedBy     *int64                                      `json:"reviewed_by,omitempty"`
+	Status         AttendanceViolationSubmissionStatus         `json:"status"`
+}
+
+// AttendanceViolationSubmissionAttendanceType defines model for AttendanceViolationSubmission.AttendanceType.
+type AttendanceViolationSubmissionAttendanceType string
+
+// AttendanceViolationSubmissionStatus defines model for AttendanceViolationSubmission.Status.
+type AttendanceViolationSubmissionStatus string
+
 // AuthRequest defines model for AuthRequest.
 type AuthRequest struct {
 	Password string `json:"password"`
@@ -58,8 +512,39 @@ type AuthRequest struct {
 
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
+	AccessToken string `json:"access_token"`
+
+	// PasswordChangeRequired When true the access token carries no permissions until the password is changed
+	PasswordChangeRequired bool   `json:"password_change_required"`
+	RefreshToken           string `json:"refresh_token"`
+
+	// TwoFactorEnrollmentRequired When true the user's role requires two-factor authentication and the access token carries no permissions until it is activated
+	TwoFactorEnrollmentRequired bool `json:"two_factor_enrollment_required"`
+}
+
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// ComparedPayroll defines model for ComparedPayroll.
+type ComparedPayroll struct {
+	AttendancePeriod AttendancePeriod `json:"attendance_period"`
+
+	// PayrollId Absent for a preview
+	PayrollId         *int64 `json:"payroll_id,omitempty"`
+	Preview           bool   `json:"preview"`
+	ReportingCurrency string `json:"reporting_currency"`
+	TotalPayroll      int64  `json:"total_payroll"`
+}
+
+// CursorPagination defines model for CursorPagination.
+type CursorPagination struct {
+	Limit int `json:"limit"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
 // DefaultErrorResponse defines model for DefaultErrorResponse.
@@ -69,6 +554,195 @@ type DefaultErrorResponse struct {
 	} `json:"error"`
 }
 
+// Department defines model for Department.
+type Department struct {
+	Code       string `json:"code"`
+	CostCenter string `json:"cost_center"`
+	Id         int64  `json:"id"`
+	Name       string `json:"name"`
+	ParentId   *int64 `json:"parent_id,omitempty"`
+}
+
+// DepartmentRequest defines model for DepartmentRequest.
+type DepartmentRequest struct {
+	Code       string `json:"code"`
+	CostCenter string `json:"cost_center"`
+	Name       string `json:"name"`
+	ParentId   *int64 `json:"parent_id,omitempty"`
+}
+
+// DepartmentSubtotal Totals of a department in the reporting currency of the payroll
+type DepartmentSubtotal struct {
+	CostCenter             *string `json:"cost_center,omitempty"`
+	DepartmentCode         *string `json:"department_code,omitempty"`
+	DepartmentId           *int64  `json:"department_id,omitempty"`
+	DepartmentName         *string `json:"department_name,omitempty"`
+	EmployeesCount         int64   `json:"employees_count"`
+	TotalOvertimePay       int64   `json:"total_overtime_pay"`
+	TotalPayroll           int64   `json:"total_payroll"`
+	TotalProratedSalary    int64   `json:"total_prorated_salary"`
+	TotalReimbursementsPay int64   `json:"total_reimbursements_pay"`
+}
+
+// EmployeeCodeRequest defines model for EmployeeCodeRequest.
+type EmployeeCodeRequest struct {
+	// Code Number the employee is enrolled under on time clocks; absent to clear it
+	Code *string `json:"code,omitempty"`
+}
+
+// EmployeeComparison defines model for EmployeeComparison.
+type EmployeeComparison struct {
+	// CurrencyChanged The employee is paid in another currency, so amounts are not compared
+	CurrencyChanged bool           `json:"currency_changed"`
+	Current         PayslipAmounts `json:"current"`
+
+	// Delta Current amounts less previous amounts
+	Delta      *PayslipAmountDeltas `json:"delta,omitempty"`
+	EmployeeId int64                `json:"employee_id"`
+
+	// Flagged Amounts that changed by more than the thresholds
+	Flagged  []EmployeeComparisonFlagged `json:"flagged"`
+	Previous PayslipAmounts              `json:"previous"`
+}
+
+// EmployeeComparisonFlagged defines model for EmployeeComparison.Flagged.
+type EmployeeComparisonFlagged string
+
+// EmployeeCurrencyRequest defines model for EmployeeCurrencyRequest.
+type EmployeeCurrencyRequest struct {
+	// Currency Three-letter code of the currency the employee's base salary and payslips are in
+	Currency string `json:"currency"`
+}
+
+// EmployeeLocationRequest defines model for EmployeeLocationRequest.
+type EmployeeLocationRequest struct {
+	// LocationId Absent to fall back to the default schedule
+	LocationId *int64 `json:"location_id,omitempty"`
+}
+
+// EmployeeOrganizationRequest defines model for EmployeeOrganizationRequest.
+type EmployeeOrganizationRequest struct {
+	DepartmentId *int64 `json:"department_id,omitempty"`
+	ManagerId    *int64 `json:"manager_id,omitempty"`
+}
+
+// ExchangeRate defines model for ExchangeRate.
+type ExchangeRate struct {
+	CreatedAt     time.Time          `json:"created_at"`
+	CreatedBy     *int64             `json:"created_by,omitempty"`
+	EffectiveDate openapi_types.Date `json:"effective_date"`
+	FromCurrency  string             `json:"from_currency"`
+	Id            int64              `json:"id"`
+	Rate          string             `json:"rate"`
+	ToCurrency    string             `json:"to_currency"`
+}
+
+// ExchangeRateRequest defines model for ExchangeRateRequest.
+type ExchangeRateRequest struct {
+	// EffectiveDate First day the rate applies; it applies until a later rate for the pair takes over
+	EffectiveDate openapi_types.Date `json:"effective_date"`
+	FromCurrency  string             `json:"from_currency"`
+
+	// Rate Value of one unit of from_currency in to_currency, as a decimal with up to 10 decimal places
+	Rate       string `json:"rate"`
+	ToCurrency string `json:"to_currency"`
+}
+
+// Geofence defines model for Geofence.
+type Geofence struct {
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+	Name         string  `json:"name"`
+	RadiusMeters float64 `json:"radius_meters"`
+}
+
+// ImportError defines model for ImportError.
+type ImportError struct {
+	// Column Column at fault, when the error concerns one
+	Column  *string `json:"column,omitempty"`
+	Message string  `json:"message"`
+
+	// Row Line of the file, the header being line 1
+	Row int `json:"row"`
+}
+
+// ImportKind defines model for ImportKind.
+type ImportKind string
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	// Applied Whether the import took effect; never for a dry run or a file with errors
+	Applied bool          `json:"applied"`
+	DryRun  bool          `json:"dry_run"`
+	Errors  []ImportError `json:"errors"`
+
+	// Imported Rows that pass validation and are, or would be, imported
+	Imported int        `json:"imported"`
+	Kind     ImportKind `json:"kind"`
+
+	// Rows Rows read from the file
+	Rows int `json:"rows"`
+}
+
+// Location defines model for Location.
+type Location struct {
+	Id         int64     `json:"id"`
+	Name       string    `json:"name"`
+	Rostered   bool      `json:"rostered"`
+	ShiftEnd   string    `json:"shift_end"`
+	ShiftStart string    `json:"shift_start"`
+	Timezone   string    `json:"timezone"`
+	Workdays   []Weekday `json:"workdays"`
+}
+
+// LocationRequest defines model for LocationRequest.
+type LocationRequest struct {
+	Name string `json:"name"`
+
+	// Rostered Employees at a rostered location work the shifts assigned to them per date; shift_start, shift_end and workdays are not used for them
+	Rostered *bool `json:"rostered,omitempty"`
+
+	// ShiftEnd End of the regular shift as HH:MM, on the next day when not after shift_start; weekday overtime starts after it
+	ShiftEnd string `json:"shift_end"`
+
+	// ShiftStart Start of the regular shift as HH:MM in the location's timezone
+	ShiftStart string `json:"shift_start"`
+
+	// Timezone IANA timezone name, for example Asia/Jakarta
+	Timezone string    `json:"timezone"`
+	Workdays []Weekday `json:"workdays"`
+}
+
+// OIDCAuthorizationResponse defines model for OIDCAuthorizationResponse.
+type OIDCAuthorizationResponse struct {
+	// AuthorizationUrl Identity provider URL to send the user to
+	AuthorizationUrl string `json:"authorization_url"`
+
+	// ExpiresAt The login has to be completed before this time
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// OIDCCallbackRequest defines model for OIDCCallbackRequest.
+type OIDCCallbackRequest struct {
+	// Code Authorization code the identity provider redirected back with
+	Code string `json:"code"`
+
+	// State State parameter the identity provider redirected back with
+	State string `json:"state"`
+}
+
+// OvertimeHistoryResponse defines model for OvertimeHistoryResponse.
+type OvertimeHistoryResponse struct {
+	Data       []OvertimeSubmission `json:"data"`
+	Pagination Pagination           `json:"pagination"`
+}
+
+// OvertimeListResponse defines model for OvertimeListResponse.
+type OvertimeListResponse struct {
+	Data       []OvertimeSubmission `json:"data"`
+	Pagination CursorPagination     `json:"pagination"`
+}
+
 // OvertimeRequest defines model for OvertimeRequest.
 type OvertimeRequest struct {
 	Description string    `json:"description"`
@@ -76,10 +750,174 @@ type OvertimeRequest struct {
 	StartTime   time.Time `json:"start_time"`
 }
 
+// OvertimeSubmission defines model for OvertimeSubmission.
+type OvertimeSubmission struct {
+	Description string                   `json:"description"`
+	EmployeeId  int64                    `json:"employee_id"`
+	EndTime     time.Time                `json:"end_time"`
+	Id          int64                    `json:"id"`
+	ReviewNote  *string                  `json:"review_note,omitempty"`
+	ReviewedAt  *time.Time               `json:"reviewed_at,omitempty"`
+	ReviewedBy  *int64                   `json:"reviewed_by,omitempty"`
+	StartTime   time.Time                `json:"start_time"`
+	Status      OvertimeSubmissionStatus `json:"status"`
+}
+
+// OvertimeSubmissionStatus defines model for OvertimeSubmission.Status.
+type OvertimeSubmissionStatus string
+
+// Pagination defines model for Pagination.
+type Pagination struct {
+	Page     int `json:"page"`
+	PageSize int `json:"page_size"`
+
+	// Total Number of items matching the filters across all pages
+	Total int64 `json:"total"`
+}
+
+// PasswordResetRequest defines model for PasswordResetRequest.
+type PasswordResetRequest struct {
+	NewPassword string `json:"new_password"`
+	Token       string `json:"token"`
+}
+
+// PayrollComparison defines model for PayrollComparison.
+type PayrollComparison struct {
+	// AddedEmployees Employees only the current payroll pays
+	AddedEmployees []PayslipAmounts `json:"added_employees"`
+	Current        ComparedPayroll  `json:"current"`
+
+	// Employees Employees paid by both payrolls
+	Employees []EmployeeComparison `json:"employees"`
+	Previous  ComparedPayroll      `json:"previous"`
+
+	// RemovedEmployees Employees only the previous payroll paid
+	RemovedEmployees []PayslipAmounts `json:"removed_employees"`
+
+	// Thresholds A change is flagged when it is above both thresholds
+	Thresholds VarianceThresholds `json:"thresholds"`
+}
+
+// PayrollExchangeRate defines model for PayrollExchangeRate.
+type PayrollExchangeRate struct {
+	// EffectiveDate Effective date of the rate the payroll converted with
+	EffectiveDate openapi_types.Date `json:"effective_date"`
+	FromCurrency  string             `json:"from_currency"`
+	Rate          string             `json:"rate"`
+	ToCurrency    string             `json:"to_currency"`
+}
+
+// PayrollItem defines model for PayrollItem.
+type PayrollItem struct {
+	AttendancePeriodId int64      `json:"attendance_period_id"`
+	CreatedAt          time.Time  `json:"created_at"`
+	EmployeesCount     int64      `json:"employees_count"`
+	FinalizedAt        *time.Time `json:"finalized_at,omitempty"`
+	Id                 int64      `json:"id"`
+	ReportingCurrency  string     `json:"reporting_currency"`
+
+	// Status A draft payroll can be removed and run again until it is finalized
+	Status                 PayrollStatus `json:"status"`
+	TotalOvertimePay       int64         `json:"total_overtime_pay"`
+	TotalPayroll           int64         `json:"total_payroll"`
+	TotalReimbursementsPay int64         `json:"total_reimbursements_pay"`
+}
+
+// PayrollListResponse defines model for PayrollListResponse.
+type PayrollListResponse struct {
+	Data       []PayrollItem    `json:"data"`
+	Pagination CursorPagination `json:"pagination"`
+}
+
+// PayrollRounding defines model for PayrollRounding.
+type PayrollRounding struct {
+	Calculation PayrollRoundingCalculation `json:"calculation"`
+	Currency    string                     `json:"currency"`
+
+	// Difference Rounded amounts less the exact amounts, as a decimal. Positive when rounding paid out more than calculated.
+	Difference   string       `json:"difference"`
+	RoundingMode RoundingMode `json:"rounding_mode"`
+}
+
+// PayrollRoundingCalculation defines model for PayrollRounding.Calculation.
+type PayrollRoundingCalculation string
+
+// PayrollStatus A draft payroll can be removed and run again until it is finalized
+type PayrollStatus string
+
+// PayslipAmountDeltas Current amounts less previous amounts
+type PayslipAmountDeltas struct {
+	BaseSalary     int64 `json:"base_salary"`
+	Overtime       int64 `json:"overtime"`
+	ProratedSalary int64 `json:"prorated_salary"`
+	Reimbursement  int64 `json:"reimbursement"`
+	TakeHome       int64 `json:"take_home"`
+}
+
+// PayslipAmounts defines model for PayslipAmounts.
+type PayslipAmounts struct {
+	BaseSalary     int64  `json:"base_salary"`
+	Currency       string `json:"currency"`
+	EmployeeId     int64  `json:"employee_id"`
+	Overtime       int64  `json:"overtime"`
+	ProratedSalary int64  `json:"prorated_salary"`
+	Reimbursement  int64  `json:"reimbursement"`
+	TakeHome       int64  `json:"take_home"`
+}
+
+// PayslipCalculationStep defines model for PayslipCalculationStep.
+type PayslipCalculationStep struct {
+	// Exact Result of the step before rounding, as a decimal
+	Exact string `json:"exact"`
+
+	// Formula The values the step worked with
+	Formula string                     `json:"formula"`
+	Name    PayslipCalculationStepName `json:"name"`
+
+	// Result Result in whole units, for steps that produce an amount on the payslip
+	Result       *int64        `json:"result,omitempty"`
+	RoundingMode *RoundingMode `json:"rounding_mode,omitempty"`
+}
+
+// PayslipCalculationStepName defines model for PayslipCalculationStep.Name.
+type PayslipCalculationStepName string
+
+// PayslipExplanation defines model for PayslipExplanation.
+type PayslipExplanation struct {
+	// AttendanceIds Attendance that counted towards the prorated salary
+	AttendanceIds []int64 `json:"attendance_ids"`
+	BaseSalary    int64   `json:"base_salary"`
+	Currency      string  `json:"currency"`
+	EmployeeId    int64   `json:"employee_id"`
+
+	// ExchangeRates Rates reimbursements were converted to the payslip currency with
+	ExchangeRates []TracedExchangeRate `json:"exchange_rates"`
+
+	// HourlyRate Hourly rate overtime was paid at before the multiplier, as a decimal
+	HourlyRate         string                   `json:"hourly_rate"`
+	OvertimeMultiplier int64                    `json:"overtime_multiplier"`
+	Overtimes          []TracedOvertime         `json:"overtimes"`
+	PayrollId          int64                    `json:"payroll_id"`
+	PayslipId          int64                    `json:"payslip_id"`
+	Reimbursements     []TracedReimbursement    `json:"reimbursements"`
+	Steps              []PayslipCalculationStep `json:"steps"`
+	TotalTakeHome      int64                    `json:"total_take_home"`
+	WorkingDays        int                      `json:"working_days"`
+}
+
+// PayslipHistoryResponse defines model for PayslipHistoryResponse.
+type PayslipHistoryResponse struct {
+	Data       []PayslipSummary `json:"data"`
+	Pagination Pagination       `json:"pagination"`
+}
+
 // PayslipItem defines model for PayslipItem.
 type PayslipItem struct {
-	AttendanceCount       int    `json:"attendance_count"`
-	BaseSalary            int64  `json:"base_salary"`
+	AttendanceCount int   `json:"attendance_count"`
+	BaseSalary      int64 `json:"base_salary"`
+
+	// Currency Three-letter currency code of the amounts
+	Currency              string `json:"currency"`
 	EmployeeId            int64  `json:"employee_id"`
 	OvertimeCount         int    `json:"overtime_count"`
 	OvertimePayment       int64  `json:"overtime_payment"`
@@ -91,58 +929,676 @@ type PayslipItem struct {
 
 // PayslipResponse defines model for PayslipResponse.
 type PayslipResponse struct {
-	AttendanceCount     int                 `json:"attendance_count"`
-	AttendancePeriod    AttendancePeriod    `json:"attendance_period"`
-	BaseSalary          int64               `json:"base_salary"`
-	EmployeeId          int64               `json:"employee_id"`
-	OvertimePayment     int64               `json:"overtime_payment"`
-	OvertimeTotalHours  int                 `json:"overtime_total_hours"`
-	PayrollId           int64               `json:"payroll_id"`
-	ProratedSalary      int64               `json:"prorated_salary"`
-	Reimbursements      []ReimbursementItem `json:"reimbursements"`
-	ReimbursementsTotal int64               `json:"reimbursements_total"`
-	TotalTakeHome       int64               `json:"total_take_home"`
-	TotalWorkingDays    int                 `json:"total_working_days"`
+	AttendanceCount  int              `json:"attendance_count"`
+	AttendancePeriod AttendancePeriod `json:"attendance_period"`
+	BaseSalary       int64            `json:"base_salary"`
+
+	// Currency Three-letter currency code of the amounts
+	Currency           string              `json:"currency"`
+	EmployeeId         int64               `json:"employee_id"`
+	OvertimePayment    int64               `json:"overtime_payment"`
+	OvertimeTotalHours int                 `json:"overtime_total_hours"`
+	PayrollId          int64               `json:"payroll_id"`
+	ProratedSalary     int64               `json:"prorated_salary"`
+	Reimbursements     []ReimbursementItem `json:"reimbursements"`
+
+	// ReimbursementsTotal Sum of the reimbursements converted to the currency of the payslip
+	ReimbursementsTotal int64 `json:"reimbursements_total"`
+	TotalTakeHome       int64 `json:"total_take_home"`
+	TotalWorkingDays    int   `json:"total_working_days"`
+}
+
+// PayslipSummary defines model for PayslipSummary.
+type PayslipSummary struct {
+	AttendancePeriod   AttendancePeriod `json:"attendance_period"`
+	AttendancePeriodId int64            `json:"attendance_period_id"`
+	CreatedAt          time.Time        `json:"created_at"`
+	Currency           string           `json:"currency"`
+	PayrollId          int64            `json:"payroll_id"`
+	TotalTakeHome      int64            `json:"total_take_home"`
+}
+
+// RefreshTokenRequest defines model for RefreshTokenRequest.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// ReimbursementHistoryResponse defines model for ReimbursementHistoryResponse.
+type ReimbursementHistoryResponse struct {
+	Data       []ReimbursementSubmission `json:"data"`
+	Pagination Pagination                `json:"pagination"`
 }
 
 // ReimbursementItem defines model for ReimbursementItem.
 type ReimbursementItem struct {
-	Amount      *int                `json:"amount,omitempty"`
+	Amount *int `json:"amount,omitempty"`
+
+	// Currency Currency the reimbursement was submitted in
+	Currency    *string             `json:"currency,omitempty"`
 	Date        *openapi_types.Date `json:"date,omitempty"`
 	Description *string             `json:"description,omitempty"`
 }
 
+// ReimbursementListResponse defines model for ReimbursementListResponse.
+type ReimbursementListResponse struct {
+	Data       []ReimbursementSubmission `json:"data"`
+	Pagination CursorPagination          `json:"pagination"`
+}
+
 // ReimbursementRequest defines model for ReimbursementRequest.
 type ReimbursementRequest struct {
-	Amount      int                `json:"amount"`
+	Amount int `json:"amount"`
+
+	// Currency Three-letter currency code of the amount; defaults to the currency of the employee's salary
+	Currency    *string            `json:"currency,omitempty"`
 	Date        openapi_types.Date `json:"date"`
 	Description string             `json:"description"`
 }
 
+// ReimbursementSubmission defines model for ReimbursementSubmission.
+type ReimbursementSubmission struct {
+	Amount      int64                         `json:"amount"`
+	Currency    string                        `json:"currency"`
+	Date        openapi_types.Date            `json:"date"`
+	Description string                        `json:"description"`
+	EmployeeId  int64                         `json:"employee_id"`
+	Id          int64                         `json:"id"`
+	ReviewNote  *string                       `json:"review_note,omitempty"`
+	ReviewedAt  *time.Time                    `json:"reviewed_at,omitempty"`
+	ReviewedBy  *int64                        `json:"reviewed_by,omitempty"`
+	Status      ReimbursementSubmissionStatus `json:"status"`
+}
+
+// ReimbursementSubmissionStatus defines model for ReimbursementSubmission.Status.
+type ReimbursementSubmissionStatus string
+
+// ReviewRequest defines model for ReviewRequest.
+type ReviewRequest struct {
+	Decision ReviewRequestDecision `json:"decision"`
+	Note     *string               `json:"note,omitempty"`
+}
+
+// ReviewRequestDecision defines model for ReviewRequest.Decision.
+type ReviewRequestDecision string
+
+// Role defines model for Role.
+type Role struct {
+	Description string   `json:"description"`
+	Id          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+
+	// RequireTwoFactor Users with this role must enable two-factor authentication before their tokens carry permissions
+	RequireTwoFactor bool `json:"require_two_factor"`
+}
+
+// RoleRequest defines model for RoleRequest.
+type RoleRequest struct {
+	Description      *string  `json:"description,omitempty"`
+	Name             string   `json:"name"`
+	Permissions      []string `json:"permissions"`
+	RequireTwoFactor *bool    `json:"require_two_factor,omitempty"`
+}
+
+// RoleUpdateRequest defines model for RoleUpdateRequest.
+type RoleUpdateRequest struct {
+	Description      *string  `json:"description,omitempty"`
+	Permissions      []string `json:"permissions"`
+	RequireTwoFactor *bool    `json:"require_two_factor,omitempty"`
+}
+
+// RosterEntry defines model for RosterEntry.
+type RosterEntry struct {
+	Date       openapi_types.Date `json:"date"`
+	EmployeeId int64              `json:"employee_id"`
+	EndTime    string             `json:"end_time"`
+	Id         int64              `json:"id"`
+	ShiftId    int64              `json:"shift_id"`
+	ShiftName  string             `json:"shift_name"`
+	StartTime  string             `json:"start_time"`
+}
+
+// RosterEntryRequest defines model for RosterEntryRequest.
+type RosterEntryRequest struct {
+	Date       openapi_types.Date `json:"date"`
+	EmployeeId int64              `json:"employee_id"`
+
+	// ShiftId Absent to clear the date, making it a day off
+	ShiftId *int64 `json:"shift_id,omitempty"`
+}
+
+// RosterUploadRequest defines model for RosterUploadRequest.
+type RosterUploadRequest struct {
+	Entries []RosterEntryRequest `json:"entries"`
+}
+
+// RosterUploadResponse defines model for RosterUploadResponse.
+type RosterUploadResponse struct {
+	// Assigned Dates a shift was assigned to
+	Assigned int `json:"assigned"`
+
+	// Cleared Dates cleared to a day off
+	Cleared int `json:"cleared"`
+}
+
+// RoundingMode defines model for RoundingMode.
+type RoundingMode string
+
+// ServiceAccount defines model for ServiceAccount.
+type ServiceAccount struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        int64     `json:"id"`
+	Name      string    `json:"name"`
+}
+
+// ServiceAccountRequest defines model for ServiceAccountRequest.
+type ServiceAccountRequest struct {
+	Name string `json:"name"`
+}
+
+// Shift defines model for Shift.
+type Shift struct {
+	EndTime   string `json:"end_time"`
+	Id        int64  `json:"id"`
+	Name      string `json:"name"`
+	StartTime string `json:"start_time"`
+}
+
+// ShiftRequest defines model for ShiftRequest.
+type ShiftRequest struct {
+	// EndTime HH:MM, on the next day when not after start_time; overtime on a rostered day starts after it
+	EndTime string `json:"end_time"`
+	Name    string `json:"name"`
+
+	// StartTime HH:MM in the timezone of the employee's location
+	StartTime string `json:"start_time"`
+}
+
+// TracedExchangeRate defines model for TracedExchangeRate.
+type TracedExchangeRate struct {
+	FromCurrency string `json:"from_currency"`
+	Rate         string `json:"rate"`
+	ToCurrency   string `json:"to_currency"`
+}
+
+// TracedOvertime defines model for TracedOvertime.
+type TracedOvertime struct {
+	Hours int   `json:"hours"`
+	Id    int64 `json:"id"`
+}
+
+// TracedReimbursement defines model for TracedReimbursement.
+type TracedReimbursement struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	Id       int64  `json:"id"`
+}
+
+// TwoFactorChallengeResponse defines model for TwoFactorChallengeResponse.
+type TwoFactorChallengeResponse struct {
+	// ChallengeToken Submit with a TOTP or recovery code to /auth/login/2fa
+	ChallengeToken string    `json:"challenge_token"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// TwoFactorCodeRequest defines model for TwoFactorCodeRequest.
+type TwoFactorCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// TwoFactorEnrollmentResponse defines model for TwoFactorEnrollmentResponse.
+type TwoFactorEnrollmentResponse struct {
+	// ProvisioningUri otpauth URI to render as a QR code
+	ProvisioningUri string `json:"provisioning_uri"`
+
+	// Secret Base32 TOTP secret for manual entry
+	Secret string `json:"secret"`
+}
+
+// TwoFactorLoginRequest defines model for TwoFactorLoginRequest.
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challenge_token"`
+
+	// Code Current TOTP code or an unused recovery code
+	Code string `json:"code"`
+}
+
+// TwoFactorRecoveryCodesResponse defines model for TwoFactorRecoveryCodesResponse.
+type TwoFactorRecoveryCodesResponse struct {
+	// RecoveryCodes Single-use codes; they are only shown once
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// UserRoleRequest defines model for UserRoleRequest.
+type UserRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// VarianceThresholds A change is flagged when it is above both thresholds
+type VarianceThresholds struct {
+	// VarianceAmount In whole units of the payslip currency
+	VarianceAmount int64 `json:"variance_amount"`
+
+	// VariancePercent Of the previous amount
+	VariancePercent float64 `json:"variance_percent"`
+}
+
+// Weekday defines model for Weekday.
+type Weekday string
+
+// AttendancePeriodId defines model for AttendancePeriodId.
+type AttendancePeriodId = int64
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// EmployeeId defines model for EmployeeId.
+type EmployeeId = int64
+
+// From defines model for From.
+type From = openapi_types.Date
+
+// Limit defines model for Limit.
+type Limit = int
+
+// Page defines model for Page.
+type Page = int
+
+// PageSize defines model for PageSize.
+type PageSize = int
+
+// SortOrder defines model for SortOrder.
+type SortOrder string
+
+// SubmissionStatus defines model for SubmissionStatus.
+type SubmissionStatus string
+
+// To defines model for To.
+type To = openapi_types.Date
+
+// GetAdminAttendancePeriodsParams defines parameters for GetAdminAttendancePeriods.
+type GetAdminAttendancePeriodsParams struct {
+	// Limit Items per page, at most 100
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from the next_cursor of the previous page. Only valid with the same sort and order.
+	Cursor *Cursor                               `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Sort   *GetAdminAttendancePeriodsParamsSort  `form:"sort,omitempty" json:"sort,omitempty"`
+	Order  *GetAdminAttendancePeriodsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// From First date to include
+	From *From `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last date to include
+	To *To `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetAdminAttendancePeriodsParamsSort defines parameters for GetAdminAttendancePeriods.
+type GetAdminAttendancePeriodsParamsSort string
+
+// GetAdminAttendancePeriodsParamsOrder defines parameters for GetAdminAttendancePeriods.
+type GetAdminAttendancePeriodsParamsOrder string
+
+// GetAdminAttendancesParams defines parameters for GetAdminAttendances.
+type GetAdminAttendancesParams struct {
+	// Limit Items per page, at most 100
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from the next_cursor of the previous page. Only valid with the same sort and order.
+	Cursor     *Cursor                         `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Sort       *GetAdminAttendancesParamsSort  `form:"sort,omitempty" json:"sort,omitempty"`
+	Order      *GetAdminAttendancesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	EmployeeId *EmployeeId                     `form:"employee_id,omitempty" json:"employee_id,omitempty"`
+
+	// From First date to include
+	From *From `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last date to include
+	To *To `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetAdminAttendancesParamsSort defines parameters for GetAdminAttendances.
+type GetAdminAttendancesParamsSort string
+
+// GetAdminAttendancesParamsOrder defines parameters for GetAdminAttendances.
+type GetAdminAttendancesParamsOrder string
+
+// PostAdminAttendancesImportParams defines parameters for PostAdminAttendancesImport.
+type PostAdminAttendancesImportParams struct {
+	// DryRun Report what the import would do without storing anything
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetAdminExchangeRatesParams defines parameters for GetAdminExchangeRates.
+type GetAdminExchangeRatesParams struct {
+	FromCurrency *string `form:"from_currency,omitempty" json:"from_currency,omitempty"`
+	ToCurrency   *string `form:"to_currency,omitempty" json:"to_currency,omitempty"`
+}
+
+// PostAdminImportsKindParams defines parameters for PostAdminImportsKind.
+type PostAdminImportsKindParams struct {
+	// DryRun Check the file without storing anything
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetAdminOvertimesParams defines parameters for GetAdminOvertimes.
+type GetAdminOvertimesParams struct {
+	// Limit Items per page, at most 100
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from the next_cursor of the previous page. Only valid with the same sort and order.
+	Cursor     *Cursor                        `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Sort       *GetAdminOvertimesParamsSort   `form:"sort,omitempty" json:"sort,omitempty"`
+	Order      *GetAdminOvertimesParamsOrder  `form:"order,omitempty" json:"order,omitempty"`
+	EmployeeId *EmployeeId                    `form:"employee_id,omitempty" json:"employee_id,omitempty"`
+	Status     *GetAdminOvertimesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// From First date to include
+	From *From `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last date to include
+	To *To `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetAdminOvertimesParamsSort defines parameters for GetAdminOvertimes.
+type GetAdminOvertimesParamsSort string
+
+// GetAdminOvertimesParamsOrder defines parameters for GetAdminOvertimes.
+type GetAdminOvertimesParamsOrder string
+
+// GetAdminOvertimesParamsStatus defines parameters for GetAdminOvertimes.
+type GetAdminOvertimesParamsStatus string
+
+// GetAdminPayrollComparisonParams defines parameters for GetAdminPayrollComparison.
+type GetAdminPayrollComparisonParams struct {
+	PayrollId *int64 `form:"payroll_id,omitempty" json:"payroll_id,omitempty"`
+
+	// AttendancePeriodId Preview the payroll of this period
+	AttendancePeriodId *int64 `form:"attendance_period_id,omitempty" json:"attendance_period_id,omitempty"`
+
+	// PreviousPayrollId Compare with this payroll; its period must end before the compared one starts
+	PreviousPayrollId *int64 `form:"previous_payroll_id,omitempty" json:"previous_payroll_id,omitempty"`
+}
+
+// GetAdminPayrollsParams defines parameters for GetAdminPayrolls.
+type GetAdminPayrollsParams struct {
+	// Limit Items per page, at most 100
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from the next_cursor of the previous page. Only valid with the same sort and order.
+	Cursor *Cursor                      `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Sort   *GetAdminPayrollsParamsSort  `form:"sort,omitempty" json:"sort,omitempty"`
+	Order  *GetAdminPayrollsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// From First date to include
+	From *From `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last date to include
+	To                 *To    `form:"to,omitempty" json:"to,omitempty"`
+	AttendancePeriodId *int64 `form:"attendance_period_id,omitempty" json:"attendance_period_id,omitempty"`
+}
+
+// GetAdminPayrollsParamsSort defines parameters for GetAdminPayrolls.
+type GetAdminPayrollsParamsSort string
+
+// GetAdminPayrollsParamsOrder defines parameters for GetAdminPayrolls.
+type GetAdminPayrollsParamsOrder string
+
 // PostAdminPayrollsJSONBody defines parameters for PostAdminPayrolls.
 type PostAdminPayrollsJSONBody struct {
 	AttendancePeriodId int `json:"attendance_period_id"`
 }
 
-// PostEmployeeAttendanceJSONBody defines parameters for PostEmployeeAttendance.
-type PostEmployeeAttendanceJSONBody struct {
-	AttendanceType PostEmployeeAttendanceJSONBodyAttendanceType `json:"attendance_type"`
+// GetAdminPayrollsIdParams defines parameters for GetAdminPayrollsId.
+type GetAdminPayrollsIdParams struct {
+	// DepartmentId Only include payslips of this department, with the totals covering just those payslips
+	DepartmentId *int64 `form:"department_id,omitempty" json:"department_id,omitempty"`
+}
+
+// GetAdminReimbursementsParams defines parameters for GetAdminReimbursements.
+type GetAdminReimbursementsParams struct {
+	// Limit Items per page, at most 100
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from the next_cursor of the previous page. Only valid with the same sort and order.
+	Cursor     *Cursor                             `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Sort       *GetAdminReimbursementsParamsSort   `form:"sort,omitempty" json:"sort,omitempty"`
+	Order      *GetAdminReimbursementsParamsOrder  `form:"order,omitempty" json:"order,omitempty"`
+	EmployeeId *EmployeeId                         `form:"employee_id,omitempty" json:"employee_id,omitempty"`
+	Status     *GetAdminReimbursementsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// From First date to include
+	From *From `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last date to include
+	To *To `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetAdminReimbursementsParamsSort defines parameters for GetAdminReimbursements.
+type GetAdminReimbursementsParamsSort string
+
+// GetAdminReimbursementsParamsOrder defines parameters for GetAdminReimbursements.
+type GetAdminReimbursementsParamsOrder string
+
+// GetAdminReimbursementsParamsStatus defines parameters for GetAdminReimbursements.
+type GetAdminReimbursementsParamsStatus string
+
+// GetAdminRostersParams defines parameters for GetAdminRosters.
+type GetAdminRostersParams struct {
+	EmployeeId *EmployeeId `form:"employee_id,omitempty" json:"employee_id,omitempty"`
+
+	// From First date to include
+	From openapi_types.Date `form:"from" json:"from"`
+
+	// To Last date to include, at most 92 days after from
+	To openapi_types.Date `form:"to" json:"to"`
+}
+
+// GetEmployeeAttendanceParams defines parameters for GetEmployeeAttendance.
+type GetEmployeeAttendanceParams struct {
+	// Page Page number, starting at 1
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Items per page, at most 100
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// From First date to include
+	From *From `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last date to include
+	To *To `form:"to,omitempty" json:"to,omitempty"`
+
+	// AttendancePeriodId Only include the dates of this attendance period. Cannot be combined with from and to.
+	AttendancePeriodId *AttendancePeriodId `form:"attendance_period_id,omitempty" json:"attendance_period_id,omitempty"`
+}
+
+// GetEmployeeOvertimeParams defines parameters for GetEmployeeOvertime.
+type GetEmployeeOvertimeParams struct {
+	// Page Page number, starting at 1
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Items per page, at most 100
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// From First date to include
+	From *From `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last date to include
+	To *To `form:"to,omitempty" json:"to,omitempty"`
+
+	// AttendancePeriodId Only include the dates of this attendance period. Cannot be combined with from and to.
+	AttendancePeriodId *AttendancePeriodId              `form:"attendance_period_id,omitempty" json:"attendance_period_id,omitempty"`
+	Status             *GetEmployeeOvertimeParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetEmployeeOvertimeParamsStatus defines parameters for GetEmployeeOvertime.
+type GetEmployeeOvertimeParamsStatus string
+
+// GetEmployeePayslipsParams defines parameters for GetEmployeePayslips.
+type GetEmployeePayslipsParams struct {
+	// Page Page number, starting at 1
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Items per page, at most 100
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// From First date to include
+	From *From `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last date to include
+	To *To `form:"to,omitempty" json:"to,omitempty"`
+
+	// AttendancePeriodId Only include the dates of this attendance period. Cannot be combined with from and to.
+	AttendancePeriodId *AttendancePeriodId `form:"attendance_period_id,omitempty" json:"attendance_period_id,omitempty"`
 }
 
-// PostEmployeeAttendanceJSONBodyAttendanceType defines parameters for PostEmployeeAttendance.
-type PostEmployeeAttendanceJSONBodyAttendanceType string
+// GetEmployeeReimbursementParams defines parameters for GetEmployeeReimbursement.
+type GetEmployeeReimbursementParams struct {
+	// Page Page number, starting at 1
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Items per page, at most 100
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// From First date to include
+	From *From `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last date to include
+	To *To `form:"to,omitempty" json:"to,omitempty"`
+
+	// AttendancePeriodId Only include the dates of this attendance period. Cannot be combined with from and to.
+	AttendancePeriodId *AttendancePeriodId                   `form:"attendance_period_id,omitempty" json:"attendance_period_id,omitempty"`
+	Status             *GetEmployeeReimbursementParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetEmployeeReimbursementParamsStatus defines parameters for GetEmployeeReimbursement.
+type GetEmployeeReimbursementParamsStatus string
+
+// GetManagerAttendanceCorrectionsParams defines parameters for GetManagerAttendanceCorrections.
+type GetManagerAttendanceCorrectionsParams struct {
+	// Status Defaults to pending
+	Status *GetManagerAttendanceCorrectionsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetManagerAttendanceCorrectionsParamsStatus defines parameters for GetManagerAttendanceCorrections.
+type GetManagerAttendanceCorrectionsParamsStatus string
+
+// GetManagerAttendanceViolationsParams defines parameters for GetManagerAttendanceViolations.
+type GetManagerAttendanceViolationsParams struct {
+	// Status Defaults to pending
+	Status *GetManagerAttendanceViolationsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetManagerAttendanceViolationsParamsStatus defines parameters for GetManagerAttendanceViolations.
+type GetManagerAttendanceViolationsParamsStatus string
+
+// GetManagerOvertimesParams defines parameters for GetManagerOvertimes.
+type GetManagerOvertimesParams struct {
+	// Status Defaults to pending
+	Status *GetManagerOvertimesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetManagerOvertimesParamsStatus defines parameters for GetManagerOvertimes.
+type GetManagerOvertimesParamsStatus string
+
+// GetManagerReimbursementsParams defines parameters for GetManagerReimbursements.
+type GetManagerReimbursementsParams struct {
+	// Status Defaults to pending
+	Status *GetManagerReimbursementsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetManagerReimbursementsParamsStatus defines parameters for GetManagerReimbursements.
+type GetManagerReimbursementsParamsStatus string
 
 // PostAdminAttendancePeriodsJSONRequestBody defines body for PostAdminAttendancePeriods for application/json ContentType.
 type PostAdminAttendancePeriodsJSONRequestBody = AttendancePeriodRequest
 
+// PostAdminDepartmentsJSONRequestBody defines body for PostAdminDepartments for application/json ContentType.
+type PostAdminDepartmentsJSONRequestBody = DepartmentRequest
+
+// PutAdminDepartmentsIdJSONRequestBody defines body for PutAdminDepartmentsId for application/json ContentType.
+type PutAdminDepartmentsIdJSONRequestBody = DepartmentRequest
+
+// PutAdminEmployeesIdCodeJSONRequestBody defines body for PutAdminEmployeesIdCode for application/json ContentType.
+type PutAdminEmployeesIdCodeJSONRequestBody = EmployeeCodeRequest
+
+// PutAdminEmployeesIdCurrencyJSONRequestBody defines body for PutAdminEmployeesIdCurrency for application/json ContentType.
+type PutAdminEmployeesIdCurrencyJSONRequestBody = EmployeeCurrencyRequest
+
+// PutAdminEmployeesIdLocationJSONRequestBody defines body for PutAdminEmployeesIdLocation for application/json ContentType.
+type PutAdminEmployeesIdLocationJSONRequestBody = EmployeeLocationRequest
+
+// PutAdminEmployeesIdOrganizationJSONRequestBody defines body for PutAdminEmployeesIdOrganization for application/json ContentType.
+type PutAdminEmployeesIdOrganizationJSONRequestBody = EmployeeOrganizationRequest
+
+// PostAdminExchangeRatesJSONRequestBody defines body for PostAdminExchangeRates for application/json ContentType.
+type PostAdminExchangeRatesJSONRequestBody = ExchangeRateRequest
+
+// PostAdminLocationsJSONRequestBody defines body for PostAdminLocations for application/json ContentType.
+type PostAdminLocationsJSONRequestBody = LocationRequest
+
+// PutAdminLocationsIdJSONRequestBody defines body for PutAdminLocationsId for application/json ContentType.
+type PutAdminLocationsIdJSONRequestBody = LocationRequest
+
+// PutAdminLocationsIdAttendancePolicyJSONRequestBody defines body for PutAdminLocationsIdAttendancePolicy for application/json ContentType.
+type PutAdminLocationsIdAttendancePolicyJSONRequestBody = AttendancePolicy
+
 // PostAdminPayrollsJSONRequestBody defines body for PostAdminPayrolls for application/json ContentType.
 type PostAdminPayrollsJSONRequestBody PostAdminPayrollsJSONBody
 
+// PostAdminRolesJSONRequestBody defines body for PostAdminRoles for application/json ContentType.
+type PostAdminRolesJSONRequestBody = RoleRequest
+
+// PutAdminRolesIdJSONRequestBody defines body for PutAdminRolesId for application/json ContentType.
+type PutAdminRolesIdJSONRequestBody = RoleUpdateRequest
+
+// PostAdminRostersJSONRequestBody defines body for PostAdminRosters for application/json ContentType.
+type PostAdminRostersJSONRequestBody = RosterUploadRequest
+
+// PostAdminServiceAccountsJSONRequestBody defines body for PostAdminServiceAccounts for application/json ContentType.
+type PostAdminServiceAccountsJSONRequestBody = ServiceAccountRequest
+
+// PostAdminServiceAccountsIdApiKeysJSONRequestBody defines body for PostAdminServiceAccountsIdApiKeys for application/json ContentType.
+type PostAdminServiceAccountsIdApiKeysJSONRequestBody = APIKeyRequest
+
+// PostAdminShiftsJSONRequestBody defines body for PostAdminShifts for application/json ContentType.
+type PostAdminShiftsJSONRequestBody = ShiftRequest
+
+// PutAdminUsersIdRoleJSONRequestBody defines body for PutAdminUsersIdRole for application/json ContentType.
+type PutAdminUsersIdRoleJSONRequestBody = UserRoleRequest
+
+// PostAuth2faActivateJSONRequestBody defines body for PostAuth2faActivate for application/json ContentType.
+type PostAuth2faActivateJSONRequestBody = TwoFactorCodeRequest
+
+// PostAuth2faDisableJSONRequestBody defines body for PostAuth2faDisable for application/json ContentType.
+type PostAuth2faDisableJSONRequestBody = TwoFactorCodeRequest
+
 // PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
 type PostAuthLoginJSONRequestBody = AuthRequest
 
+// PostAuthLogin2faJSONRequestBody defines body for PostAuthLogin2fa for application/json ContentType.
+type PostAuthLogin2faJSONRequestBody = TwoFactorLoginRequest
+
+// PostAuthLogoutJSONRequestBody defines body for PostAuthLogout for application/json ContentType.
+type PostAuthLogoutJSONRequestBody = RefreshTokenRequest
+
+// PostAuthOidcCallbackJSONRequestBody defines body for PostAuthOidcCallback for application/json ContentType.
+type PostAuthOidcCallbackJSONRequestBody = OIDCCallbackRequest
+
+// PostAuthPasswordJSONRequestBody defines body for PostAuthPassword for application/json ContentType.
+type PostAuthPasswordJSONRequestBody = ChangePasswordRequest
+
+// PostAuthPasswordResetJSONRequestBody defines body for PostAuthPasswordReset for application/json ContentType.
+type PostAuthPasswordResetJSONRequestBody = PasswordResetRequest
+
+// PostAuthRefreshJSONRequestBody defines body for PostAuthRefresh for application/json ContentType.
+type PostAuthRefreshJSONRequestBody = RefreshTokenRequest
+
 // PostEmployeeAttendanceJSONRequestBody defines body for PostEmployeeAttendance for application/json ContentType.
-type PostEmployeeAttendanceJSONRequestBody PostEmployeeAttendanceJSONBody
+type PostEmployeeAttendanceJSONRequestBody = AttendanceRequest
+
+// PostEmployeeAttendanceCorrectionsJSONRequestBody defines body for PostEmployeeAttendanceCorrections for application/json ContentType.
+type PostEmployeeAttendanceCorrectionsJSONRequestBody = AttendanceCorrectionRequest
 
 // PostEmployeeOvertimeJSONRequestBody defines body for PostEmployeeOvertime for application/json ContentType.
 type PostEmployeeOvertimeJSONRequestBody = OvertimeRequest
@@ -150,31 +1606,206 @@ type PostEmployeeOvertimeJSONRequestBody = OvertimeRequest
 // PostEmployeeReimbursementJSONRequestBody defines body for PostEmployeeReimbursement for application/json ContentType.
 type PostEmployeeReimbursementJSONRequestBody = ReimbursementRequest
 
+// PostManagerAttendanceCorrectionsIdReviewJSONRequestBody defines body for PostManagerAttendanceCorrectionsIdReview for application/json ContentType.
+type PostManagerAttendanceCorrectionsIdReviewJSONRequestBody = ReviewRequest
+
+// PostManagerAttendanceViolationsIdReviewJSONRequestBody defines body for PostManagerAttendanceViolationsIdReview for application/json ContentType.
+type PostManagerAttendanceViolationsIdReviewJSONRequestBody = ReviewRequest
+
+// PostManagerOvertimesIdReviewJSONRequestBody defines body for PostManagerOvertimesIdReview for application/json ContentType.
+type PostManagerOvertimesIdReviewJSONRequestBody = ReviewRequest
+
+// PostManagerReimbursementsIdReviewJSONRequestBody defines body for PostManagerReimbursementsIdReview for application/json ContentType.
+type PostManagerReimbursementsIdReviewJSONRequestBody = ReviewRequest
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RY3Y7bNhN9FYLfd6msnaYoCt9t2jTYokCNTYFeLAyBlsYWsxKpDKkNjIXfveCPZP1Q",
-	"tuTsBu2dLXGGw3Nmzoz4TBNZlFKA0IqunqlKMiiY/XmbFlys2QFlnn+qioLh4R5UKYUC87pEWQJqDnYx",
-	"0xpEykQCcQnIZWoe/h9hR1f0f4vTHgu/weK2MVi79ceIQlHm8gCg4kRWQhsXO4kF03RFudA//Ugjqg8l",
-	"uL+wBzRWpQsx5ul0A5XzMs65sntwDYW6FO/aGd1pKIwP75UhsoP9LzXLY/lkECkgLtlhYjDO0J9hlg0C",
-	"L7YVKihMoJO3PEYU4UvFEVK6emijFwVYHJLSD/lMOEFYevhvmgjl9jMk2pxvkBuDdAORxinT0DmxfdC4",
-	"Uxq52Bt3SjPUU5f34GnZRqddpwR9D18qcAn274+90tlovCVT6qtEy8IgvkoBClZA4GUvmmZldPI4Hsuo",
-	"ziQJKBVr+QgiGBDCDkFloyt6UXX89a1D4f0KO1bl+gOixPEwwbwePi5AKbafAFa9cBhBb6HbKBTon77o",
-	"RnlNQSXIS81lGEmTMcbBIPHe2KejyTrHJpixfmmzf9QJNXTWtjifa01NWxlq6pYpiBXLGU5V7loYpzee",
-	"RgfPxNHWSqOkU3saSmQa0nlHGGr2jC2bNjBx/XSpaCMbtYWjTVI0JHaA8BCXAMCjMLSPeCbrJg1FZxh/",
-	"kdHpu6bvvDxpzByamaxQhZGYP8m9QNZPngDv22Zjc2AvleyRZ9WTZo8QZ7InoZesvkp85GIfp+wQxPba",
-	"oc+tuFh2gSDOll47EyZUJB3BdYhZqEyHvA0LtRgvz8mj2vl+erwU2Wif/j7BtdPDO/EbX2q/pvFDUiHX",
-	"h0+mVFzU74EhoBnmzL+t/fdbHeXvf/9FI/etaTy5t6ewM61LejSOudjJwaxCb9d3ZCeRqITlbJsD8V8U",
-	"ZA8CkJlV5CvXGTmlakTqLIsIEynp5JPZmevcbO01ndyu72hEnwCV2/LtzfJmadWsBMFKTlf03c3y5p2d",
-	"ZnVmj7xg5ot5cdr0jasnN0VLx61h1kZ4l5rtpNL2O7uv6S7nbUa8l6lVtkQK7VWXlWXOE+tm8Vk5Sp1M",
-	"ze0dddYduzmgsQL7wLU2e4Iflm+HVPyCYGq8kwV09dDl/2Fz3ERUuTuExqjFDmmER7O9snO5AYVujFsP",
-	"q9esKWCu66XXY3jhdsO3qAsyGzQLFNAE6JdD6P0p66SfS8J9JYjH1BYTI3v+BGIOFYtnnh5NYHsI0PER",
-	"umzcpbZYkBWgwYwAD8+Um4OYAqIRdbMhtR2nC0fUIqavXJswVC9TLGduwCxrXUL8kmsJ+Qia+H9E7gjL",
-	"81rXFOENV2PEVDpb5HLPxYX6qHT2h132SvrSukmYntgvuPU4PfbYRFX2i99R02Dv3jFF6sGHmIqw+Lbw",
-	"NrQ5uOtlLbE/j/sHb3DS39cQJ1cczxREVZiIkwySx9gewv2UVfvGbexOpOfwOsUKNIvT4YmqtgXXs2vk",
-	"kzVrdw4jXUmFCEKT1N05erpqjvqU1YPANMLqa5RXqpf+Lc210NZ+vhFYeTrtJRi9Hl3sATWQXkf/e12g",
-	"/5kfkJZ6akTQyOHpGuWvR9hOKx5I/hgZ3XF2UmLf9ybg18ju4AfOtSnecfaNeT4Y/wP4Wrf4VCdpv5kk",
-	"LCfuPY1ohbn/blktFrl5l0mlVz8vl0t63Bz/CQAA///6ma4GZhsAAA==",
+	"H4sIAAAAAAAC/+x9a3PcuLXgX0H1blXuraIl25Pd2tifFNmT6I4TayXPZKuyri6IRKsRsYkOAErumfJ/",
+	"v3UOHgRJ8NVPeWa+SU0Sj/PCeeGcX2apWK1FwQqtZm9+ma2ppCummcT/LrRmRUaLlF0zyUV2lcGvGVOp",
+	"5GvNRTF7M/tY5BvCizQvM0b0kpGMaqaIWBC95IpQPwRZ4xhn5JIWhdDkjpFUrO54wTLyxPWSLKRYEVpk",
+	"RIuzWTLjMPq/SyY3s2RW0BWbvZlVo83NaHOezZKZSpdsRWFtCyFXVM/ezHih//cfZ8lMb9bM/MvumZx9",
+	"/ZrMLkuphIzsZE3/XTKS4mOzGthPwb7ouf0Rd8XIWrJHLkpF1vSenREEwSPNud0IvKLoihElpMYdCZkx",
+	"2bUpM3ZtG3bVSkte3OOi36/WudgwZlAQG4bZN7YByfdSrNoA+Z5LpRGfRAuH445NALji08L3sySypQ98",
+	"xXV71ivNVgqIBaGbEKrJSihNXr182TF3jgOFk2dsQctcz968fpnMVvQLX5Wr2ZtXL+E/Xtj/opC4pves",
+	"vSb4lRTl6o7JhChNpebFPazsVceSYOnxFb0atYRb/jPbB2jgvbniP3csZjJ4boXUH4GYu6gQKT0+GW5m",
+	"lsxYAeP/c0bxP/zxc4w+bsu7FVeKi+JWU12qrimVeRrO6aZYsyKD4ZIZXa+leGTAGpL9i6WaZfFZP4k2",
+	"3D/Q0XygxSQu+OpeNvL2+uoHtoG/1lKsmdSc4e+pZFSzbE51a8wXmq8iAycz9mXNJVOTvuHZKIGRzHKq",
+	"9LxUE5dkQPRL+8FasgX/En0k2aN4mDiPSsXaAI4Dw0THtT9QKekG8S7Zv0suWQZkgxIUV+vX5kdNQmxU",
+	"FCTugKhgZIPES/PSDVNrUSjWxild8/mDQfb/lGwxezP7H+fVWXxuqeLcjAbj2pfrlPlpyciizHPywDZn",
+	"5EoTroiA40gyXUo4WkWRsrOo/A137FZjpune1g37d8mUbm9nG3LrpIcKgQ0xzKSVCIrcS1poBsoCkWZR",
+	"iqxoxqojGEGCZ/M6+A6epDTPmSRLkWeKpLQAXcSOh4f0dlRjCcYuPgrDbMWLa7qRIs9vy9WKyk0PgTR1",
+	"nUFSaWhrMGPG1lTqFSv0XJV3Wmia1xmjb8B3/uNb+20bBonXO9Q8FWWhR0oQ9iVd0uKezSXVMVTfwM9G",
+	"2TLwIqkoHpkElNMVTKQQ07Nk3F4s1N/baWH42GbsZPPRknBNNyrn63nODVeMXQx8BMd5bBGSrQVqGKB2",
+	"SlakEca/tE8QRAavhEoWQAlPorY8FSUeiRGQ/2NJNXHPga+eliJnpCy4VsSALUtQ+0hpnpY5he9QvfXL",
+	"nIaMGztXDAbKH/kjBrL6AQwDkJgLAAFfsfmabkbi0Xxo0T/pG8n46q6UigGjqNFTNoRHQHhJpdG0ZUCb",
+	"35pr71lXFD5RemsxaEg5DarvkDJRAej3cymkZClQUOeRkuYifZjzYg5LjUgICz3ytGQFKURoaj5RRSRL",
+	"hczwBPR26SwZeTSZuUWp/eTjvsNJIuaD0uSJsYeMboCxUrP55mqi/MqoEkXkMGrQj/3evj4W9pWK3Xv+",
+	"8IjdXw1njlQ/phUVKiH0TrFCG/w4DJAlzUghihoqullsBA0wBdLu5vtL8t133/2JwItK09Xaz68FeWBs",
+	"jUsIiGIk3g88oSOYQUIIzftx4mn0i0Lye17QfD4IbbuX9t7JHVsI2SSF2D4ak/WBeg+zdbIPWhacPc0L",
+	"oVnP84mmh//obuzBUx10OxitLeOl7gyqCwc/Z7+U+CtXWvQpqBnVdLTKU41r8BpXvoAytJVG/ee+fzMi",
+	"COmsNlb/Nq9WcPi9l1LI9hZXTCnrEIpoUk8RTwEvmPMSLnjOEvxryWjGJLljoFjl8MqrYY0Axk/8CsZs",
+	"4oYpdLS0Tc11zlkW0/iYXjKJa+Q4BNFCPBC2WLBUvyUFe2SSLIQklGRyQ2RZEPwHtmYMLQaAU9Vu7oTI",
+	"GS1QlhoTuPfoMOLRKvplkS6ZIjTLopySyc1cliEzB5PZdUwnxxD/MV1cPMUMUbtUyWhWeYoBKnEef+Dr",
+	"dQwS9iQGm6YNk5wtNKEImw3o9tGhy8Ict5HB33/hyvop++GdwzY2ZEV1ukxgRlDriWQvDE3E511nVO8y",
+	"K/sCj4fZwGE98WRskVIRWLWaEB4V2D119DPRB670s5R2JmCxR5l37f0J9T2yIpuPVkjQDT729caCg2+T",
+	"atYxi0abeT8O0imbHa1L7QIVZ/tFQDPsdWzA6SDEHKDgORJ0t3PyWdO1yHkacfrTPBegSRZMPwn5EDmF",
+	"rq7hrJRMKZDiRUYur97dEInWVyh+OUjbtTln4LB6S9hqrdESpWnK1hCj3LihJvg/k9k9EwtWpDEH3sfF",
+	"gqfbrGMtFLfq/Cjy/Itdw6B/tlps0gZuP46sFJ/somhZLlvZnN2WZllonuOx6hR+ki5Z+gD2ZqmjkzmN",
+	"o1sbpAVxhkfbrM8Cqzau9fkv4m6DyMgaHI8QUorPMcabfCjjeNi2qpNACOAhkuoQVjRNS0ljPt8L+4RI",
+	"mvFS+WwEyzCEF8TmboSOJVHehWqpiWLDWgIHj3lWmaBIRHNezBL7J1DT55j7gj3yDgfRVcYKzRec+bQJ",
+	"8/JbqytLpYkoGEFCvuOF1Q89IUOoNUrC4HjWZRbzszlI1CZMiEMhuduQXKR4opjwAQmFwgiY5aK495MP",
+	"vt8MsjUAPkQgcWtukdP7+yFrrssXint+5CK3MEDWe6Jco5VnvBdRtq6+2UJv+Ml9/AMvskE57TbYD576",
+	"mAHtOgTP0bOJfhT/Ey8Md+H5LEqteMbmjgRmyYyvwR80t6fDzNP3iiu0jqI8EFlTr1814O9pXDpauO2D",
+	"s7dRqTOmKc+jmkNNUBzTucnXc6fZxGZ+sNSzJSGHsmjv4uNX7qOsU3bzXG3SsEWVJ7IgSjZkGpV62Xna",
+	"rqlST1a3a0GqVEx2JCk0NuTfTKoRu9fSGfJPU6bUXIsHFvdZu7HnLjbnlxA5DAqiZWmc5GZgggOTlErJ",
+	"mYKQWZgYUemSbhZQ2CtvSvtMkGwhmVr2rFc/ifmCplrIOSsgOokxwrGrBqD+QREJUWj7jSL6SbwwQxJa",
+	"6iUrNE+rSPS0zXLMl6Gp5o/WfdTcZPMEDxHUBEAPegYhEaOVSxzk2o7ZHSbFmK2e91JywZ76XmhsszVk",
+	"Y4DoasVqTSXLrqsQ+gHyWer5GQ312NhExl299rrMmCQO+3LUsxzPxmiT+uQEggbQqxXHov7RGH19zihW",
+	"mp6WFlpyl4XbBkuQ+RxNQAkyouFVl5Rq0GCj7mjW2WzYfqIzC4lt4p3JIEUffbfwZFNDOI35uyMtjRfN",
+	"RPGFujyICJuKLH6Gp0LpecoKzWT0+WhNpzu9kiI372Dy4uJ9SmS44n4wdAutbaFxmE1uuz+fF9dOzDQp",
+	"WWIBoTP/PuGGKzw3+9Qpb8z7LJ4mwPrhUs0x74Rt8M5oogq+6QT9dhmAJ0jVWkshUVNUNKdyc4I0r87k",
+	"rcbKOjK1BjO7+s4Cd4XkUmRskDHr1Px3tEjq/hmuiNFkWEbKImMSRT5fMYIuMfU2SIdJc0alcees6JcP",
+	"rLjXy9mb//Uydib0rBs0DK5ip5hjo3lnNPRTY+1ryjNgR1oI9Jq4ERKihM8wpZKRQmi4qITKTdzjiV/q",
+	"kUmfF2Zow1u5ppM+ewdfqO0s5k6/kV2R8QU5D+zdhqxMig01IksvQdWFjOnQMe/swTuqWEW7bWp2dIya",
+	"TEC9sFL6wOZLsWJRN0QryGSvXk2FdgcfGlvTD1ohM2mTVNLrmfJUaj8b0NfTaDK/ZOxFzrQGehSZzyRJ",
+	"w2Rft/Q/KAJQJwbGaP3YtExDt7wYVLn8Uvo29ME6zzo3VHnXupVyLciC5jm5o+kD/GO8s6jWEUBZVuaj",
+	"MgL75MNHeU8L/nP/Yrc5A1e0oPdMTtAt2msM8873Er5234x24pikHv7IxodCIUjXb/WMhqGkHS4sLfpm",
+	"iCmj9VXVR2ht08486CQKEdRJPG0Qxi9MGkaFiYlJWlFvwddg/7bOB0pyCoyOr4HRatQ/LglIREVAZI7J",
+	"EB5Gkowu9iealyhhRGGS/OHv2mCorVbAxdQg0GZTvqK5CSWUa+DmVy/9r+ucmmDKbojeBscxtPrgcFtq",
+	"HdZz26ksm9jdvLpkPTWKZI0Uv/5wbc3hYyDpTXhMRV6uiliiGvwOF05RZidVUjdaw3DtJGWyUF1pzs87",
+	"kdKApBlI8rp6oL+opgKjolrLs8zH3Gf+5EDWpFl8bIs34slqmuBNNLfmK/ctlSyBHT2JMs/IHUuIHyl2",
+	"qIwJ3gS47UznxEWNyuVskJYLSXTnKAbr70lCdDpWm1Z29/5IoTSz3vY23tWSL/ScFXG3sXmKWU3R58AS",
+	"P4siPjEk1mR0M56o/mFuyEy6nutXUF9suLFgJQE0+tDQqQCMArK/8L6guWJJMz/WSRUQppS4D31eAoHF",
+	"IgniDhShSvH7wtx01Uu2wht4cO69JcGOE+I3jJzktuxN2FKxzKkZq6h8qJFCY9FF5oSxZPdlTqWZDjSC",
+	"v/71zd/+ljh/LzqCQQOyt7I0oQvNZLjUt/4qlJOqpqSCsq/Gsz4apFhf3y383L9C53pzcP6DIgHt9FJ2",
+	"I6/l4u8X/lMCFJEgXNkXulrnjFwoTs//iz5QqWls5IOxxVYcEWODj1fvLiFQKaS3pjojluFr81LmXXlA",
+	"ekMgTszh+P7x5gMQs2JF5uN8HddV69fK2x6dXNzzgiwhS17YijLrnGmWVVeEuEH0yKt/zYBfa3+1NXVB",
+	"75LmOZi6E91sNaAbDwCqAi0ISpZxe3EALWp7EzqWyxpT/eG+LCO+1s9Ok8Q96WbiKHgsz+/3epEbNUi8",
+	"Oe0FI7egPeZh73uPe8rCdsvqcbkEtPdLPBF/2i1bk2Y95ZtonrZ91c9fP6r79tqX3zW43cme2+kAGj30",
+	"80kwkno6ERz84uQYOum9TtkXeV/XDeJaUQlXtSn6uCPkaIM0YkFQnJj7XFjKwdgzmklIdpFCwZ2vHKPy",
+	"aqTTtV6q4J7NwkW6FcUB4NJXFOsOBw+kpyQzk2Ez6DYyr43IVrFZKn0hJZplLJtXToC2UuwemYo7laNe",
+	"+5ola2N0TCkKEsSHmqJ9ZKCpmYkTRmj7doERsbsNuRN66XYwevWRIN0O0ZvIFiRbicfJCAkq9TmM8Gzs",
+	"noYxEgTFBsb6iUpOi5R9qr7oyLqqx6NqYbfQI9UkzxiEeii/PyAx5Oh+756bmg7O8IK/4yV7rO64R2f2",
+	"8/AvW2jGbyVGq1WOOxC3utC4VRrGghc05z9PnGuCgjEqe+63Vu6nmeJcq2bq8pqPVOlnIDJmgb5HQyZk",
+	"mudgwTTLUbWt9areVU3f7EtzMLJP1ecMwrd93JDxxYJJF7dq+qrLIguKoOWY6wyBmC801e7neqzujJib",
+	"UY/MuOV8kS888kWpg1wPt1eWnf3/oq+C2HxlfRh9GHIw/Ru82zrwArAGEGlOUYNHD/6qKqENrwrJJF1U",
+	"Spkt+GePS/SWQiCF3lNe1BLDvWgMipbiULNAbEaxG0va6SjhpuuY9AqL/bWVCBhm2ows7+OIctzr2yXJ",
+	"1WTQyG+qzJ8tJOieM45q1BRqfS1hMB0Bvbw+3R3w68Rn41ZtJQwOjejLSgrdaraOlTalacT9bKK7TvdV",
+	"mvmyVE581YVwVN0VclXmNO7bfoQEDVWNDj77SpXuzIzuPp2WopT5Zu6uP9ZVA6+rN9SJJljnxtZ3CkZ/",
+	"9p70EfAo7HgR1pg0YRTYqosQS5GVKcML6ciNLshk89zGXe7Y33llAywOaYmljB7aev9lndMu/0/t/psa",
+	"qLAHqZkAAowDPlFfTccimXgkew1sDMM2NK+Ti7ZxVWHrBEqeWKPuaUgjVe7klGqxnyRNWTZULDbkp9ZS",
+	"/4oPjU3s45xwFxt1LqrDGnarMtd8nXMmh0WGZ9vqo4nnxvjIowGDc3rvtV7uBAOyJo2mLf0m/DheZpat",
+	"1dS6vc0TIzJuUziO2ytIeJBULjw8XDPWwTKpF5AdeZjW5kuaAqk366nzNAnIMlJE1sC77/BoydD9Bgrt",
+	"oLb69omDhGEd6L7jwftz2iSzm8juyz93cjNMRK+Mkj3qsX3bC5WUCZroHnReNXFK75oZ+f74u+V1Zvbf",
+	"NZm5RS8tCPdpz363nWAIt5j0Xx2wdD2qtn0P8vdyY/jXwCDTKNF/ZvAFclp1xRmnnt574KvRorp2eHfX",
+	"yq8Ra0do9LZcVdlh4QdtvTFyIXO8rbHdsW++mn74V+d9X51488agrIgsoldehMQ1QozMOlDVhtk42eIO",
+	"8MOUGThy5KbXiprMpNsQ4STi6qa5HlwOxhluTDGNTxDC70wUGCo50thH/fX4rAFR7lfVrA39bBLT2lK1",
+	"zUKr7jN5ZEeSGq+j2asAABoELS92KoTfn1/1dWjLe4xjHQTBe4pp1dbWyU7bYXqsGvTWXfFUXYdrcJe1",
+	"uvh+OMqINMywEBhO/etCdg9Md/Wf7WnjBy2v9tvrouApJjjaRucD3uDOelJlU64aUeZxy09mHShokr2b",
+	"Ibo6kbPpCa17KFBTVeaa0rXP72xeVddqLXj2o2JSua5w3JYTW5VKE1bQu5z1VBSrXLRcmnpiCguK1TrK",
+	"DVcNC+8r1Sklsv46NLqwtHWy9bFwMAASC40xW/0Ra/tvveHj7mt4Q0oz+b7QMdPlgL2AwhTy7RnY3CCa",
+	"+HonxdWTvrcVx35Ntfm6UrcHcNJNZYdDTQjUrtoZpn6O66GVkBUFKx1rCuBlO7FYbJHIHYFnN3x+XOeC",
+	"9pXY15JPCC1FgI6VNr5cma9fvbTdiN3/A5ff3PzDG+j0Sdp7lm08vMOoI7UXCsGYCe5kdrQtY1R2D2Uf",
+	"A2ZD/A2gyy+wGj++2yCEHegRS5ov5uUafUHFg6kSrmVZpHW8V6R8y+QjT9lF6l20u1ct2VVX6DlZB9wL",
+	"9d1MveEbO76iswCJxBtQ7C5+9ydJJ8tI3Fdvf41474KRl4P9Ot5WsXLThMhdj4avRtwSHgmiyCLd/WB/",
+	"s7dtorqrw4PXyyaDN5Jv0K77/ixS4zsz4Ru5Aq3l94QhdihMaUbtXs9NM43tULb6DntoG5TR/TyJ71EL",
+	"vVzSPGdAJp1HWepeqfykzXgIOOOMUUTJp4+frgmW/0+B+6wrRwtyDvbQOd6xPn+9oPtpM98AQXOtg/er",
+	"K0CMqWM44rZy7yTvfbHmbnDjjWnQ+SFyUkrehrfQawAl+fHmyjQsx3qJmOrzf2+IvTPdllkslSySPfdn",
+	"qth3rw3azDuYObeiRUlzwtC4GAK7HTtpL74XHB+AFrqB3qa7SIHXjHU5j7XZk/EkYiOassC6FTXK3IKk",
+	"hvF8Y2cAolLdqHYLwSKrKsJYvLjP2YtSMVyqeuu795k7aWopngrsyb99n/nGGmLbAp9Hr5tAWk9PPyDx",
+	"rdj4kdtskbR7c5phJr0pnGjOfVt1/U48MnPXsHbHrb7ORzvRvJLUjeoStRTSRtA2bEw+QsD7ydZMpiw2",
+	"28dF/VahF91Ti3i1pkpaW43B3ZUCCdT6ldFHSpjT9CzRyxJWJPksmSkKQ6uyiHs8FUtLyfXmFqwyA/GL",
+	"Nf+BbaASRYS6jQ4NFfZhieTi+oo8sE1i23cZBEssUkXJHaOSWbcZINzqgDRb8SIhtqYi3r7wZVmlKE2a",
+	"GIfZTLEvp62+mf2/FxfXVy9+YIFwo7hYgMyfcTa3bDP39w4p//WPTwAF3OTsjX1ajbLUej37CvDgxUJE",
+	"KPn6yqQmpzRHj6Ejr3tWMGmr9uBR6kOiiddjE9xiKzOda2BAF80GSAIJ2AtDb2avzl6evYR9iTUr6JrP",
+	"3sy+O3t59h0GfvQSMXWOoDyvJn1h4rD48D52cFyY65rYIc5cD2eKMBSs5lOTaAxLz+laEa7PZrgEs8mr",
+	"bPZm9hemL2DeZtgceddVFFGzN/+M2//VK+cf+IoDVQ++aEJi+CYSxr9LJjcVXSjTo9R4FmoFmJr9+AzH",
+	"1H6MWo0Vhwyu7VZI/VFmTI7ZyPdSrMa890nMvn7G7Hk8hhChr1++NHpNoa1owlpjxiI5/5e9wl4BYUrK",
+	"Qy0miozQmYluSYxIpiVnEJYI5QiiPWTFf36GjSiXrDGDmQhtDYd3Ne4VejmAuGafwXMrzMlVp79roboJ",
+	"UJrz7s8i2xwMWN5dVZfpWpbsawtnryKqjqG4aXAzH7UhFwHc16RbMpz/wrOvZkk5i2WtfwQlhTppAEIN",
+	"bgdSf3dOyKqXWLAYe6XOjJq1hcY7fBBH21XWlhzI5iDoKi5HU6kO7pDnm5zbZp8/9t6wsDu2OwDJ+8eX",
+	"f9obDUU7WIxhNDg4wU+CjTKnkYyBOV6e2ZJqwoNk6BB4luK/2ZvRN0Fr/H7UQ8BVqbjKvs0jY8JhseUh",
+	"ccuoTENtylWtCatdjCLgc9vB/M0v/jRp37O7vP3JFFBdokFua8xK8eSCxmDLQfnbagFoeiVVQ1bU8Uy1",
+	"NFAG/4NjXVZR6v88I5/cS6qSp9ChcqGYtoXaxzoez4hrPE+lqRWN6VRaYA3nOpuDxzQJ65lK7K/K0ocX",
+	"vHD5ODn1P4pSG1V1xeS96xTZaCXpOsW7IwB7JRgQY8d3A0ZzkdoYfyi9oAjRGbloVal1ws0WTcWCmHlu",
+	"rp0PHvrqyjWnb4id5k1DeIs8gV4blNU1BWYz4VGitJC4h2KD650lUWFTVXqNyBtb77MVIv7cp5ho9kWf",
+	"p+qxzopNGTRC1TgEr9fqGEd43TyHW4gpU2oqn9uvAwJDcqXICi9QSEPDaIPlbn6vSvoPH1jvgnd3hOCo",
+	"MGc1X8St0wJnsLqdFOystsvJmnUTSPvXqdsdosZr03teQAwPu6joFezHEq1XytdlDCtlCykH1JifDapf",
+	"HgnVJrtoIqrNR2NR7bUWRPS5c4D3YtuXMrvKLo3L+1vBd6zL1CiMR+wzj5wTWWO2NZT3T2Kd4aVx7U9V",
+	"aY36UWzRSGsScQUxytEEVjnJvzkiazRb2pnQtsFpT1J7V4OmKSg1nbPrTptO70qA1nfmu1P5Vn4s7iAR",
+	"aaJgLe64LQoeQNFA4G2QQoI/mJL2XBGciPBCaUazKaDNw7YLI7nFt2r49ril2d7gqNxygdlrldQLk2nG",
+	"4ksEHb6m4CzsDPYN4i3W2OykuAsamqLHwMTy+jFpc5te+MouvXZamAqlOlDWMM2beUvd6OpwJGox7vPP",
+	"xzAc+2vPtHUV9z4WnNnNemS1oRJsUwY/12u+okNpG+uyidqDcE6km9uRLcw6AntszNPotp9csznn0zPO",
+	"T0B5gGmM2VONGJ8oMrIM3Jw1WgoGto4el0XfKTaMu06d/wKdlr5u68ct6MqkyyvnyT0j7zHsDU+5Mi5Q",
+	"lhnXpTJqnaIrRmSZg7fVX181w0DGiijvjX/04vrKOE7pWAcnqQpVw1XpmofZlfdISHBjP8FLSwkxXuda",
+	"C0ufSTGvvgwacuLCnFR7SxhNl+SeQRItzgS5M0SZ/129dL85SiRTTJOcFw9nxGV22u6ENU84OLur6au0",
+	"14S4rFdcR4Cyt806DAiInkHNvQeTGpOEI9V2eEaubL8tgzBzE8d4y819QGLuFp6RC/Vg2iFZ/yu4xO+t",
+	"Rm+xZzjCOJABtb2eaTOx+sF0BBtWMWzrsG4lY2xTMzjOGqIFqNl3Mfvdxd0JQO/YTjrW2BYyljRqnQlb",
+	"YaKcFyyx/9mYimk+mMR2fQCXutebkyCfXjZ5zgphJzd7BbGTKcOq2wf/5jH0JDfbGB3Jr2wn9SgP9jdZ",
+	"+akDZ/+Kz1Ym3qu9T79vp3rVHA9ZzUVKXaO7sIX0CBIe53D3qPqm3O1bEcDLoxDALq72Ud6COoJr6U8i",
+	"5+lmvOi6yoI0JfPtUfxoB0nxM+uPIMQ82VYY/sUqS/e2xbJCdry6BhVXPOUgKsUCulx3oy4ZzYFHQ8dB",
+	"8wcDTJwimt9NCVux5g3DZt+7EkHFv7UCtb2M+tG/+WyTjqkOEs6Cn55xrllV1cZ3WPlm8tOi/RYjlP7R",
+	"t5wN7MPd8tREbMhJGWue8Afzcz8tw2nAv6DEQru0VWS+QhBoCM+kLxh+t3H5u2fkNlgjL+qpvujBsG0t",
+	"wm4Z4MK4Y+RR8Gwgudfz5QmTej2GzXpP5WCzTUlcy+Ugn9fDeBq1/SS4calJKQoGt48ihDeBzM4lU1pI",
+	"1u9UqxNbm6KMyyywgY1/hZgaSOArW9JsJ7Kzy4wQnreqArK7sZs6OfW5ZRv6++PR6e/vwjJAUH3e10Di",
+	"z5ktEmKf+YWnoljkPNW2jJPJynBPpyotiBZCW9AZyUSWQF+ktdaR0etWfwFPN+O42qqiJtZzwY9ZcLEC",
+	"3exQWkSWBQIgVnUTP3X9meBjy2wNYDYdflyfkU9BWz6u3AIsA7r7jPNqlbiGu42r4FfxtxvEesBscCi8",
+	"PsawP5WtncV1zGvq9Lh2G85Rob5aedKKPkfcvm+i6NpCsLUzrqq7ErEldPVu22Uxl5YqKj61S3qLoQsL",
+	"Y1u6LAsbSHh0isI10+9YdwTVE5d9SC2uTQ/dIqQiYiPLXh5fxFreFtLcYxaLkM0xJBRj4nv+yIpTHQvX",
+	"1UWu1rUgjFUtIJkGub8Q7fapgewCKp3ozGtKPSPovBgTC6Bfq0uApkNlzivZCav0CvUYGd19EfbTkoVX",
+	"YRHsDKuU4g31UBpYmXw2JMOe5wWoytgMbj+FP9Y7Sj6vK7Ad+92D6D2CDBsyRL0U2yUWEXRpnhyKCOh2",
+	"W7fXuHa3Q2XNYp+1ix+MdprF5Z29qj/Zx1UWXhIsUFih9B5zj9LhJmLTd9rPDicnNJ8dwGx7zFPbCdvb",
+	"yze4AUgyqXX/hGMZtanuVNyk3/l4WCQl0SvZvEjzMvOVJ5RXUqtkkMAGR6EO+tEjQwPgXyXmtAjFwm1H",
+	"g/9hbskzEqoh4G3rhz7qsa9sy/UQ2rD/ofs6zz3cwHsR9F8ex/3njoRDX8uAWL7KvncfnVoUBAx4cmHg",
+	"0tW2FAoOpk2xMIRLxL3BJav3dBwSFfjlVRZ2gvzGQomRXpYRHP3D3bC10ELFuWombZNNiszkoGFDU+tC",
+	"CNtAn84qwjW3DKBgbbghX/wClAGupxEfQpAXZCmejA0UgVM/IdZrWfeTXvDuvhJxhuqUteFaLWI3PTcY",
+	"x/RCNQVH7iW1LVExQ7I/ytJuQ9ULwJv668/QumvUNWr1J/g90ngIcdjdTSbCALWX9xhzlJ3jTgo81lni",
+	"W48+1jn2hDZUHeu/+jhkFzFOJb1fUUSySYqnDkvWKfL5xCYbfcK+jQDljhHH7dgF1ZtBncUqQYfPu4aZ",
+	"xmiAuKKddD/cuedmLpu9d6b6PSsY7T/XL6y5e+TUa4OQ/aZdA+iHqXJcOjWC/ZtKpW43Hzpy5mYXSndJ",
+	"pAaMta5P9XNUiG5l0DYohsx7U22mhhFR3/X3WMEMN6GFc4h2uDHB2dBLQgN9fNqzf6DtyRO40LcSSpM/",
+	"vYZya641hZ09fsd4p2V9Po5or3pUjZLw8DqxDXh2FPW1oe6YfmKsgAZtCPp+cd+4Dw1eJhhoQ6TJU1at",
+	"jpPYzie84npG3tupMUztbm1i3PueuVg/3ug0Nzl7FEDPAocRTe2WTEcXTpGmSt3kUe5QdwARhdF5b9Xa",
+	"CocDt4eVqVf+wtYrHxZc9SZBx9Gk6nOO4bhGGfbdeE41BttGpYqBbf9EH+/gdGQ1q4mt/SpctIkOG3Re",
+	"0XTJC0Yw2CaHb1I0Cd9eiFrzFw9sM5kNrjLTFEA9x8jFKB67uL6yfQKGeMu2NNiNp2xZADMQ3n9poHUP",
+	"THZ4pBzgKhSi4UScaya33Nl3Yu3EwIVDvOXcYcSP5drzXx7Y5mp0KkkXufwAgxwocSFW5sBOt+/K7RbI",
+	"kj2Kh+keGfgowFU/VlD7GJaZ5rWjaAww1ShFwShOO6kHqCRrULwGNfAuwVVB5gBKQdiY8Ni6gMHDfiVI",
+	"HeC9lFkqJq2QgO5sPYGb720tFYUFX3Jhzihea3gtpK2rd0YMg7jWMIoZb7sN18M4vWEYbLh9lZmGcadx",
+	"d3/qbOktmWJ6y0Qy2DnYjSwVRUZ8v+5RCHLVdV6YBfRGOHzLpIzl/BHbX4YFh+A2DL8v4edCaL7gto0S",
+	"V6QAjAG/l9J0p61aLvXYqhZh13aJN7jCo6DudSxSoZj2IFCljSsqVkxE2y3DmkyiMI0Iq/pGMpgBO/AC",
+	"msbi0TVO6/V1WoDeGPfpt6KdNZvGnaLEILontZiEEiuf1EjFyCLnlnmH52lklFvAbjoMZuouJFNLQ9HW",
+	"3pgCv7KAMsMjUhMt5H4075+ssKwCdxKuYfIRi23UF5TnLCPY1hQvwazWOgDbH6oibF0QhK6orxf0nEIx",
+	"O9esNyrOb1AUKxuHDppYKtsWbuCgxQ4b7h9TfxrO73sMWd/TTrFe6uXrBb1w6zuMwIh2Yj2yG3KggWes",
+	"8mGnbsAK6O53upKMnQsL8l7DNb5+ffQ1Vu1wMW8RXOF43ZBl7tIulu6DCH7xSHM+9RSwBEt0JyxsfgZO",
+	"47vb1NVYul6HnAszNRg34wrAOCDzDBO9s+8+Nx6apPbaDVva/u4k5UatcMUj3m5OdSP6VBTey4VA75YD",
+	"90LvlraGyT3aonuAyE1Tge6z6S/2coQilBTsKewnfUautGlYbKt9YuFW7FtM3KGXvbVtC7CmJPZ88jeS",
+	"qa6ibrEmogF7GYkyO8YREenl3S/irGj7Ng6ESbYRbCykOlZt2iU+5QJbNvOipUw2aA0VqWFRiq3DD9Wj",
+	"s9TLEykgZupuJOK2iSrTlCkFlGSt3j2fHq7leX9qm7WAXbvmBBFd82c4HfOOuZLHxo8QoBqdTcgSr47O",
+	"Ej9azRxkoTfoUfqmQkrbJfuPr0/Aq0KQFS02zrrwdoVjpzJYeZpz4LSr61liu1wjWd4wLTcvLhaayZi5",
+	"CFhSpCw0z6tWGHYeIxFyYTqAtyys6pIeLj3w8RojqMoXh+UZW2eA4Z3LcQTTG0/gQbUnnOdbkQCnYJwr",
+	"o57YEuJOd3FSA8inLB4K8VQk0HKPS6PgiNLU77PE/OyZ6+T8BCUuTNtd61/YUn/Lxb0o9Sj+gvcOlGVk",
+	"HEufwK+0q2nywWgTsNivXyOOLHMQef8HrXu1eiAleJaepzTP7+igE6vUy488Sy/d24cB28erd5duit+V",
+	"kiGlpFfJtcrtW5I6vrKaqeOs56KWRGQndqdSGvsWWDHFM9ie3pC1FI8cu1PYQdGb4k3I09jofxc+62hF",
+	"NbYZri0aHJZQSeAFL2xEBXYBHItFxlTDyXD8ayW3vLjPGYFAwouWvT7rFNSq/llu7ZRegdOyebrdvm2k",
+	"/3jzwWSHFEjyQtreTsaXEFD39Q+X723ptopgTJtp6C0HTR5EMyToXoBZEvK05OkSNWVWtS5ETCJlVpp9",
+	"TYx2G+wgPitD7lC1XK/eXV6EgBkhykJL/XlTnrG9J5Ods3b6iO5wwQRnOh7oyLzEJkFVAHo3XcONY3uQ",
+	"n8xZbrpT6rih6s4E8Lz5Fx65wDQbfOJ/Xbu63VNCXbj1+jiuZONYx4778LyVs9BPJC6B4BCUUpvjIIRy",
+	"gkKC9ZwHpwckRoUQ0mkVp6Ljv08j0VqDVFoncGsO+aSMIBejhxCtLdCfNVMzGACMUmisPCIKKxJLxc7I",
+	"NUxZaNMIyXtR3bvm43pY1vyGzEO1kdPdgtLaTM/NIjueafHJZCFYbLDsVCr5lWMiyzyJy7MwZjiyVt3G",
+	"rBOvb6jYMEVtXi9Qtfl/TXmHEHU+taATSV8aqbv9VjVsmHyB7preszE1MuC9W/7zqHcnVmwceCvoRoEX",
+	"q+Gq33H6oPyVKy36y4bZV3ZKmBVPRVBZNQFCYUq32mU62ui5wlYtHf1eILFqrTaSRp8Nl79JoIeyNcl8",
+	"vSJQ+Rc5xZN/IaQtihCXY1FCPGxrlFPdCQgWYFvBtW+EVGjwEZGJ0Tb8LKAKxEBqtcOMbuJ00SFCzq0C",
+	"6VLeunWyNh4vg08PjdJqrpMjt1pKVfUjqrD79ypUB9wyNUMPd425Kn5YdG3iCop703X0BS8wJIR/o7+9",
+	"MM04x1KGr4M/4mhx3Ql+IwfLNoWojtGk5ohHUaw5zbRDqVuuBNR0ED+6HX66+Ohr+bO1+BbVbodY0pYM",
+	"8oUxhtjS1pw5VsWqvZeGHFNacYcuc65SYq0Ac6tuZw8yFFa8HYcI8+7viveeSOOIos4h2ou3doODHoFX",
+	"o5laiaYxhFOrr/X76XqS07WGgyPSXY1Y9na4NinqMO6kYI5dj9lIqcutz1rZ2HwHv9rW92Gn14Zx1MW3",
+	"fzNfdllGDfatb9R6oDB6YrvKd1T7MSUHawkkrlhr9SFdQ3CPmUP9XywFmH0+UemfIZNp+Mpx9d1u944D",
+	"2R2gFOwnG940ffkV+Q+4AlXVhVm4/DX1nwHdWEIZRTauFCVafL32dR8RQclHHOEbqneGCz6VW3kXY90g",
+	"a/KVQ8N4xh8MjBcnurfEcCjNbREjBV+Y4somCkJDP9lokjOBlMmC6qfqs9/lVACOaWKqguK+pFSFzoMJ",
+	"qWqKHWRUtfPfRdS2IipKdD1Etq2AwjT9UD4VUYp7a5/DTA+MrZtSyaXxtm3mOMmN6sNtCaunE/dvRho5",
+	"GEwTQbe7F4M3Jkis/fReRVCrb/BosVNrzPu7rJnu++wXMNXTvalA/e1w4/QxvrGGJYuh1hq/GclRA8Rp",
+	"xEd3J4m9ypB4uf/RgqRdT/93abKln+foImVEvfuAZnAu+RgXBR9ESnNins+SWSnz2ZvZUuv1m/PzHJ4t",
+	"hdJv/s/Lly9nXz9//e8BAAffqSPdPAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file